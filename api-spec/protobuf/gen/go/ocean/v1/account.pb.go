@@ -797,6 +797,8 @@ type ListAddressesResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Labels of the labelled addresses.
+	AddressLabels []*AddressLabels `protobuf:"bytes,2,rep,name=address_labels,json=addressLabels,proto3" json:"address_labels,omitempty"`
 }
 
 func (x *ListAddressesResponse) Reset() {
@@ -838,6 +840,13 @@ func (x *ListAddressesResponse) GetAddresses() []string {
 	return nil
 }
 
+func (x *ListAddressesResponse) GetAddressLabels() []*AddressLabels {
+	if x != nil {
+		return x.AddressLabels
+	}
+	return nil
+}
+
 type BalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -877,51 +886,832 @@ func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
-func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BalanceRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BalanceRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type BalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The balance (total, confirmed, unconfirmed) per each asset.
+	Balance map[string]*BalanceInfo `protobuf:"bytes,1,rep,name=balance,proto3" json:"balance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BalanceResponse) Reset() {
+	*x = BalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceResponse) ProtoMessage() {}
+
+func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BalanceResponse) GetBalance() map[string]*BalanceInfo {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type ListUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// List of account's addresses for which listing utxos. If not specified,
+	// the list of all utxos owned by the account is returned.
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Optional label the utxos must be tagged with.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Optional asset of the utxos.
+	Asset string `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Optional min number of confirmations of the utxos.
+	MinConfirmations uint32 `protobuf:"varint,6,opt,name=min_confirmations,json=minConfirmations,proto3" json:"min_confirmations,omitempty"`
+	// Whether listing frozen utxos along with the others (default), exclusively
+	// or not at all.
+	FrozenFilter UtxoFrozenFilter `protobuf:"varint,7,opt,name=frozen_filter,json=frozenFilter,proto3,enum=ocean.v1.UtxoFrozenFilter" json:"frozen_filter,omitempty"`
+}
+
+func (x *ListUtxosRequest) Reset() {
+	*x = ListUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUtxosRequest) ProtoMessage() {}
+
+func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListUtxosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListUtxosRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *ListUtxosRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ListUtxosRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ListUtxosRequest) GetMinConfirmations() uint32 {
+	if x != nil {
+		return x.MinConfirmations
+	}
+	return 0
+}
+
+func (x *ListUtxosRequest) GetFrozenFilter() UtxoFrozenFilter {
+	if x != nil {
+		return x.FrozenFilter
+	}
+	return UtxoFrozenFilter_UTXO_FROZEN_FILTER_UNSPECIFIED
+}
+
+type ListUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of spendable utxos.
+	SpendableUtxos *Utxos `protobuf:"bytes,1,opt,name=spendable_utxos,json=spendableUtxos,proto3" json:"spendable_utxos,omitempty"`
+	// List of currently locked utxos.
+	LockedUtxos *Utxos `protobuf:"bytes,2,opt,name=locked_utxos,json=lockedUtxos,proto3" json:"locked_utxos,omitempty"`
+	// List of frozen utxos.
+	FrozenUtxos *Utxos `protobuf:"bytes,3,opt,name=frozen_utxos,json=frozenUtxos,proto3" json:"frozen_utxos,omitempty"`
+}
+
+func (x *ListUtxosResponse) Reset() {
+	*x = ListUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUtxosResponse) ProtoMessage() {}
+
+func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUtxosResponse.ProtoReflect.Descriptor instead.
+func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListUtxosResponse) GetSpendableUtxos() *Utxos {
+	if x != nil {
+		return x.SpendableUtxos
+	}
+	return nil
+}
+
+func (x *ListUtxosResponse) GetLockedUtxos() *Utxos {
+	if x != nil {
+		return x.LockedUtxos
+	}
+	return nil
+}
+
+func (x *ListUtxosResponse) GetFrozenUtxos() *Utxos {
+	if x != nil {
+		return x.FrozenUtxos
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{21}
+}
+
+type SetSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The wallet password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Spending limits per asset.
+	AssetLimits []*AssetLimit `protobuf:"bytes,3,rep,name=asset_limits,json=assetLimits,proto3" json:"asset_limits,omitempty"`
+	// The only output scripts (hex encoded) allowed as destinations. If empty,
+	// any destination is allowed.
+	AllowedScripts []string `protobuf:"bytes,4,rep,name=allowed_scripts,json=allowedScripts,proto3" json:"allowed_scripts,omitempty"`
+	// Max fee rate allowed. Zero means no limit.
+	MaxMillisatsPerByte uint64 `protobuf:"varint,5,opt,name=max_millisats_per_byte,json=maxMillisatsPerByte,proto3" json:"max_millisats_per_byte,omitempty"`
+}
+
+func (x *SetSpendingPolicyRequest) Reset() {
+	*x = SetSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *SetSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetSpendingPolicyRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SetSpendingPolicyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetSpendingPolicyRequest) GetAssetLimits() []*AssetLimit {
+	if x != nil {
+		return x.AssetLimits
+	}
+	return nil
+}
+
+func (x *SetSpendingPolicyRequest) GetAllowedScripts() []string {
+	if x != nil {
+		return x.AllowedScripts
+	}
+	return nil
+}
+
+func (x *SetSpendingPolicyRequest) GetMaxMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MaxMillisatsPerByte
+	}
+	return 0
+}
+
+type SetSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new spending policy.
+	Policy *SpendingPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetSpendingPolicyResponse) Reset() {
+	*x = SetSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *SetSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSpendingPolicyResponse) GetPolicy() *SpendingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *GetSpendingPolicyRequest) Reset() {
+	*x = GetSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *GetSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSpendingPolicyRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spending policy of the account.
+	Policy *SpendingPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *GetSpendingPolicyResponse) Reset() {
+	*x = GetSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *GetSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSpendingPolicyResponse) GetPolicy() *SpendingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The wallet password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *DeleteSpendingPolicyRequest) Reset() {
+	*x = DeleteSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteSpendingPolicyRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteSpendingPolicyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSpendingPolicyResponse) Reset() {
+	*x = DeleteSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{27}
+}
+
+type FreezeUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The utxos to freeze.
+	Utxos []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+}
+
+func (x *FreezeUtxosRequest) Reset() {
+	*x = FreezeUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeUtxosRequest) ProtoMessage() {}
+
+func (x *FreezeUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeUtxosRequest.ProtoReflect.Descriptor instead.
+func (*FreezeUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FreezeUtxosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *FreezeUtxosRequest) GetUtxos() []*Input {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type FreezeUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of utxos frozen, excluding those already frozen.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FreezeUtxosResponse) Reset() {
+	*x = FreezeUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeUtxosResponse) ProtoMessage() {}
+
+func (x *FreezeUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeUtxosResponse.ProtoReflect.Descriptor instead.
+func (*FreezeUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FreezeUtxosResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UnfreezeUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace or label.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The utxos to unfreeze.
+	Utxos []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+}
+
+func (x *UnfreezeUtxosRequest) Reset() {
+	*x = UnfreezeUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_account_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeUtxosRequest) ProtoMessage() {}
+
+func (x *UnfreezeUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeUtxosRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BalanceRequest) GetAccountName() string {
+func (x *UnfreezeUtxosRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *BalanceRequest) GetAddresses() []string {
+func (x *UnfreezeUtxosRequest) GetUtxos() []*Input {
 	if x != nil {
-		return x.Addresses
+		return x.Utxos
 	}
 	return nil
 }
 
-type BalanceResponse struct {
+type UnfreezeUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The balance (total, confirmed, unconfirmed) per each asset.
-	Balance map[string]*BalanceInfo `protobuf:"bytes,1,rep,name=balance,proto3" json:"balance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of utxos unfrozen, excluding those not frozen.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *BalanceResponse) Reset() {
-	*x = BalanceResponse{}
+func (x *UnfreezeUtxosResponse) Reset() {
+	*x = UnfreezeUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_account_proto_msgTypes[17]
+		mi := &file_ocean_v1_account_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BalanceResponse) String() string {
+func (x *UnfreezeUtxosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceResponse) ProtoMessage() {}
+func (*UnfreezeUtxosResponse) ProtoMessage() {}
 
-func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_account_proto_msgTypes[17]
+func (x *UnfreezeUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,47 +1722,48 @@ func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
-func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UnfreezeUtxosResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *BalanceResponse) GetBalance() map[string]*BalanceInfo {
+func (x *UnfreezeUtxosResponse) GetCount() uint64 {
 	if x != nil {
-		return x.Balance
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type ListUtxosRequest struct {
+type SetUtxoLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Account namespace or label.
 	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// List of account's addresses for which listing utxos. If not specified,
-	// the list of all utxos owned by the account is returned.
-	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The utxos to label.
+	Utxos []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// The new labels. An empty list removes all labels.
+	Labels []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
 }
 
-func (x *ListUtxosRequest) Reset() {
-	*x = ListUtxosRequest{}
+func (x *SetUtxoLabelsRequest) Reset() {
+	*x = SetUtxoLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_account_proto_msgTypes[18]
+		mi := &file_ocean_v1_account_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUtxosRequest) String() string {
+func (x *SetUtxoLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUtxosRequest) ProtoMessage() {}
+func (*SetUtxoLabelsRequest) ProtoMessage() {}
 
-func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_account_proto_msgTypes[18]
+func (x *SetUtxoLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,53 +1774,58 @@ func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
-func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetUtxoLabelsRequest.ProtoReflect.Descriptor instead.
+func (*SetUtxoLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListUtxosRequest) GetAccountName() string {
+func (x *SetUtxoLabelsRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *ListUtxosRequest) GetAddresses() []string {
+func (x *SetUtxoLabelsRequest) GetUtxos() []*Input {
 	if x != nil {
-		return x.Addresses
+		return x.Utxos
 	}
 	return nil
 }
 
-type ListUtxosResponse struct {
+func (x *SetUtxoLabelsRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type SetUtxoLabelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of spendable utxos.
-	SpendableUtxos *Utxos `protobuf:"bytes,1,opt,name=spendable_utxos,json=spendableUtxos,proto3" json:"spendable_utxos,omitempty"`
-	// List of currently locked utxos.
-	LockedUtxos *Utxos `protobuf:"bytes,2,opt,name=locked_utxos,json=lockedUtxos,proto3" json:"locked_utxos,omitempty"`
+	// Number of labelled utxos.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ListUtxosResponse) Reset() {
-	*x = ListUtxosResponse{}
+func (x *SetUtxoLabelsResponse) Reset() {
+	*x = SetUtxoLabelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_account_proto_msgTypes[19]
+		mi := &file_ocean_v1_account_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUtxosResponse) String() string {
+func (x *SetUtxoLabelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUtxosResponse) ProtoMessage() {}
+func (*SetUtxoLabelsResponse) ProtoMessage() {}
 
-func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_account_proto_msgTypes[19]
+func (x *SetUtxoLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,51 +1836,48 @@ func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUtxosResponse.ProtoReflect.Descriptor instead.
-func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListUtxosResponse) GetSpendableUtxos() *Utxos {
-	if x != nil {
-		return x.SpendableUtxos
-	}
-	return nil
+// Deprecated: Use SetUtxoLabelsResponse.ProtoReflect.Descriptor instead.
+func (*SetUtxoLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListUtxosResponse) GetLockedUtxos() *Utxos {
+func (x *SetUtxoLabelsResponse) GetCount() uint64 {
 	if x != nil {
-		return x.LockedUtxos
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type DeleteAccountRequest struct {
+type SetAddressLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Account namespace or label.
 	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// A derived address of the account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The new labels. An empty list removes all labels.
+	Labels []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
+func (x *SetAddressLabelsRequest) Reset() {
+	*x = SetAddressLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_account_proto_msgTypes[20]
+		mi := &file_ocean_v1_account_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *SetAddressLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*SetAddressLabelsRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_account_proto_msgTypes[20]
+func (x *SetAddressLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,41 +1888,55 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetAddressLabelsRequest.ProtoReflect.Descriptor instead.
+func (*SetAddressLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteAccountRequest) GetAccountName() string {
+func (x *SetAddressLabelsRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-type DeleteAccountResponse struct {
+func (x *SetAddressLabelsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetAddressLabelsRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type SetAddressLabelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteAccountResponse) Reset() {
-	*x = DeleteAccountResponse{}
+func (x *SetAddressLabelsResponse) Reset() {
+	*x = SetAddressLabelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_account_proto_msgTypes[21]
+		mi := &file_ocean_v1_account_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteAccountResponse) String() string {
+func (x *SetAddressLabelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountResponse) ProtoMessage() {}
+func (*SetAddressLabelsResponse) ProtoMessage() {}
 
-func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_account_proto_msgTypes[21]
+func (x *SetAddressLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_account_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,9 +1947,9 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_account_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetAddressLabelsResponse.ProtoReflect.Descriptor instead.
+func (*SetAddressLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_account_proto_rawDescGZIP(), []int{35}
 }
 
 var File_ocean_v1_account_proto protoreflect.FileDescriptor
@@ -1223,10 +2030,14 @@ var file_ocean_v1_account_proto_rawDesc = []byte{
 	0x39, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x15, 0x4c, 0x69,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x15, 0x4c, 0x69,
 	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
 	0x73, 0x22, 0x51, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
@@ -1242,26 +2053,113 @@ var file_ocean_v1_account_proto_rawDesc = []byte{
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63,
 	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x53, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x6e,
-	0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78,
-	0x6f, 0x73, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x74, 0x78,
-	0x6f, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78,
-	0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x39, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x07, 0x0a, 0x0e, 0x41,
+	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xed, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x0c, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xb5, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x0e, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x32, 0x0a,
+	0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x12, 0x32, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x75, 0x74, 0x78, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x39, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x4d, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3d, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x5c, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22,
+	0x2d, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x1a,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd2, 0x0c, 0x0a, 0x0e, 0x41,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a,
 	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x49,
 	0x50, 0x34, 0x34, 0x12, 0x23, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
@@ -1324,18 +2222,56 @@ var file_ocean_v1_account_proto_rawDesc = []byte{
 	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0xa5, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76,
-	0x75, 0x6c, 0x70, 0x65, 0x6d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x63,
-	0x65, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f,
-	0x76, 0x31, 0x3b, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58,
-	0xaa, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4f, 0x63,
-	0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09,
-	0x4f, 0x63, 0x65, 0x61, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x0d, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0xa5, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x42, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x75, 0x6c,
+	0x70, 0x65, 0x6d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02,
+	0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61,
+	0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x4f, 0x63,
+	0x65, 0x61, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1350,7 +2286,7 @@ func file_ocean_v1_account_proto_rawDescGZIP() []byte {
 	return file_ocean_v1_account_proto_rawDescData
 }
 
-var file_ocean_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_ocean_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_ocean_v1_account_proto_goTypes = []interface{}{
 	(*CreateAccountBIP44Request)(nil),     // 0: ocean.v1.CreateAccountBIP44Request
 	(*CreateAccountBIP44Response)(nil),    // 1: ocean.v1.CreateAccountBIP44Response
@@ -1374,49 +2310,91 @@ var file_ocean_v1_account_proto_goTypes = []interface{}{
 	(*ListUtxosResponse)(nil),             // 19: ocean.v1.ListUtxosResponse
 	(*DeleteAccountRequest)(nil),          // 20: ocean.v1.DeleteAccountRequest
 	(*DeleteAccountResponse)(nil),         // 21: ocean.v1.DeleteAccountResponse
-	nil,                                   // 22: ocean.v1.BalanceResponse.BalanceEntry
-	(*AccountInfo)(nil),                   // 23: ocean.v1.AccountInfo
-	(*Template)(nil),                      // 24: ocean.v1.Template
-	(*Utxos)(nil),                         // 25: ocean.v1.Utxos
-	(*BalanceInfo)(nil),                   // 26: ocean.v1.BalanceInfo
+	(*SetSpendingPolicyRequest)(nil),      // 22: ocean.v1.SetSpendingPolicyRequest
+	(*SetSpendingPolicyResponse)(nil),     // 23: ocean.v1.SetSpendingPolicyResponse
+	(*GetSpendingPolicyRequest)(nil),      // 24: ocean.v1.GetSpendingPolicyRequest
+	(*GetSpendingPolicyResponse)(nil),     // 25: ocean.v1.GetSpendingPolicyResponse
+	(*DeleteSpendingPolicyRequest)(nil),   // 26: ocean.v1.DeleteSpendingPolicyRequest
+	(*DeleteSpendingPolicyResponse)(nil),  // 27: ocean.v1.DeleteSpendingPolicyResponse
+	(*FreezeUtxosRequest)(nil),            // 28: ocean.v1.FreezeUtxosRequest
+	(*FreezeUtxosResponse)(nil),           // 29: ocean.v1.FreezeUtxosResponse
+	(*UnfreezeUtxosRequest)(nil),          // 30: ocean.v1.UnfreezeUtxosRequest
+	(*UnfreezeUtxosResponse)(nil),         // 31: ocean.v1.UnfreezeUtxosResponse
+	(*SetUtxoLabelsRequest)(nil),          // 32: ocean.v1.SetUtxoLabelsRequest
+	(*SetUtxoLabelsResponse)(nil),         // 33: ocean.v1.SetUtxoLabelsResponse
+	(*SetAddressLabelsRequest)(nil),       // 34: ocean.v1.SetAddressLabelsRequest
+	(*SetAddressLabelsResponse)(nil),      // 35: ocean.v1.SetAddressLabelsResponse
+	nil,                                   // 36: ocean.v1.BalanceResponse.BalanceEntry
+	(*AccountInfo)(nil),                   // 37: ocean.v1.AccountInfo
+	(*Template)(nil),                      // 38: ocean.v1.Template
+	(*AddressLabels)(nil),                 // 39: ocean.v1.AddressLabels
+	(UtxoFrozenFilter)(0),                 // 40: ocean.v1.UtxoFrozenFilter
+	(*Utxos)(nil),                         // 41: ocean.v1.Utxos
+	(*AssetLimit)(nil),                    // 42: ocean.v1.AssetLimit
+	(*SpendingPolicy)(nil),                // 43: ocean.v1.SpendingPolicy
+	(*Input)(nil),                         // 44: ocean.v1.Input
+	(*BalanceInfo)(nil),                   // 45: ocean.v1.BalanceInfo
 }
 var file_ocean_v1_account_proto_depIdxs = []int32{
-	23, // 0: ocean.v1.CreateAccountBIP44Response.info:type_name -> ocean.v1.AccountInfo
-	23, // 1: ocean.v1.CreateAccountMultiSigResponse.info:type_name -> ocean.v1.AccountInfo
-	23, // 2: ocean.v1.CreateAccountCustomResponse.info:type_name -> ocean.v1.AccountInfo
-	23, // 3: ocean.v1.SetAccountLabelResponse.info:type_name -> ocean.v1.AccountInfo
-	24, // 4: ocean.v1.SetAccountTemplateRequest.template:type_name -> ocean.v1.Template
-	22, // 5: ocean.v1.BalanceResponse.balance:type_name -> ocean.v1.BalanceResponse.BalanceEntry
-	25, // 6: ocean.v1.ListUtxosResponse.spendable_utxos:type_name -> ocean.v1.Utxos
-	25, // 7: ocean.v1.ListUtxosResponse.locked_utxos:type_name -> ocean.v1.Utxos
-	26, // 8: ocean.v1.BalanceResponse.BalanceEntry.value:type_name -> ocean.v1.BalanceInfo
-	0,  // 9: ocean.v1.AccountService.CreateAccountBIP44:input_type -> ocean.v1.CreateAccountBIP44Request
-	2,  // 10: ocean.v1.AccountService.CreateAccountMultiSig:input_type -> ocean.v1.CreateAccountMultiSigRequest
-	4,  // 11: ocean.v1.AccountService.CreateAccountCustom:input_type -> ocean.v1.CreateAccountCustomRequest
-	6,  // 12: ocean.v1.AccountService.SetAccountLabel:input_type -> ocean.v1.SetAccountLabelRequest
-	8,  // 13: ocean.v1.AccountService.SetAccountTemplate:input_type -> ocean.v1.SetAccountTemplateRequest
-	10, // 14: ocean.v1.AccountService.DeriveAddresses:input_type -> ocean.v1.DeriveAddressesRequest
-	12, // 15: ocean.v1.AccountService.DeriveChangeAddresses:input_type -> ocean.v1.DeriveChangeAddressesRequest
-	14, // 16: ocean.v1.AccountService.ListAddresses:input_type -> ocean.v1.ListAddressesRequest
-	16, // 17: ocean.v1.AccountService.Balance:input_type -> ocean.v1.BalanceRequest
-	18, // 18: ocean.v1.AccountService.ListUtxos:input_type -> ocean.v1.ListUtxosRequest
-	20, // 19: ocean.v1.AccountService.DeleteAccount:input_type -> ocean.v1.DeleteAccountRequest
-	1,  // 20: ocean.v1.AccountService.CreateAccountBIP44:output_type -> ocean.v1.CreateAccountBIP44Response
-	3,  // 21: ocean.v1.AccountService.CreateAccountMultiSig:output_type -> ocean.v1.CreateAccountMultiSigResponse
-	5,  // 22: ocean.v1.AccountService.CreateAccountCustom:output_type -> ocean.v1.CreateAccountCustomResponse
-	7,  // 23: ocean.v1.AccountService.SetAccountLabel:output_type -> ocean.v1.SetAccountLabelResponse
-	9,  // 24: ocean.v1.AccountService.SetAccountTemplate:output_type -> ocean.v1.SetAccountTemplateResponse
-	11, // 25: ocean.v1.AccountService.DeriveAddresses:output_type -> ocean.v1.DeriveAddressesResponse
-	13, // 26: ocean.v1.AccountService.DeriveChangeAddresses:output_type -> ocean.v1.DeriveChangeAddressesResponse
-	15, // 27: ocean.v1.AccountService.ListAddresses:output_type -> ocean.v1.ListAddressesResponse
-	17, // 28: ocean.v1.AccountService.Balance:output_type -> ocean.v1.BalanceResponse
-	19, // 29: ocean.v1.AccountService.ListUtxos:output_type -> ocean.v1.ListUtxosResponse
-	21, // 30: ocean.v1.AccountService.DeleteAccount:output_type -> ocean.v1.DeleteAccountResponse
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	37, // 0: ocean.v1.CreateAccountBIP44Response.info:type_name -> ocean.v1.AccountInfo
+	37, // 1: ocean.v1.CreateAccountMultiSigResponse.info:type_name -> ocean.v1.AccountInfo
+	37, // 2: ocean.v1.CreateAccountCustomResponse.info:type_name -> ocean.v1.AccountInfo
+	37, // 3: ocean.v1.SetAccountLabelResponse.info:type_name -> ocean.v1.AccountInfo
+	38, // 4: ocean.v1.SetAccountTemplateRequest.template:type_name -> ocean.v1.Template
+	39, // 5: ocean.v1.ListAddressesResponse.address_labels:type_name -> ocean.v1.AddressLabels
+	36, // 6: ocean.v1.BalanceResponse.balance:type_name -> ocean.v1.BalanceResponse.BalanceEntry
+	40, // 7: ocean.v1.ListUtxosRequest.frozen_filter:type_name -> ocean.v1.UtxoFrozenFilter
+	41, // 8: ocean.v1.ListUtxosResponse.spendable_utxos:type_name -> ocean.v1.Utxos
+	41, // 9: ocean.v1.ListUtxosResponse.locked_utxos:type_name -> ocean.v1.Utxos
+	41, // 10: ocean.v1.ListUtxosResponse.frozen_utxos:type_name -> ocean.v1.Utxos
+	42, // 11: ocean.v1.SetSpendingPolicyRequest.asset_limits:type_name -> ocean.v1.AssetLimit
+	43, // 12: ocean.v1.SetSpendingPolicyResponse.policy:type_name -> ocean.v1.SpendingPolicy
+	43, // 13: ocean.v1.GetSpendingPolicyResponse.policy:type_name -> ocean.v1.SpendingPolicy
+	44, // 14: ocean.v1.FreezeUtxosRequest.utxos:type_name -> ocean.v1.Input
+	44, // 15: ocean.v1.UnfreezeUtxosRequest.utxos:type_name -> ocean.v1.Input
+	44, // 16: ocean.v1.SetUtxoLabelsRequest.utxos:type_name -> ocean.v1.Input
+	45, // 17: ocean.v1.BalanceResponse.BalanceEntry.value:type_name -> ocean.v1.BalanceInfo
+	0,  // 18: ocean.v1.AccountService.CreateAccountBIP44:input_type -> ocean.v1.CreateAccountBIP44Request
+	2,  // 19: ocean.v1.AccountService.CreateAccountMultiSig:input_type -> ocean.v1.CreateAccountMultiSigRequest
+	4,  // 20: ocean.v1.AccountService.CreateAccountCustom:input_type -> ocean.v1.CreateAccountCustomRequest
+	6,  // 21: ocean.v1.AccountService.SetAccountLabel:input_type -> ocean.v1.SetAccountLabelRequest
+	8,  // 22: ocean.v1.AccountService.SetAccountTemplate:input_type -> ocean.v1.SetAccountTemplateRequest
+	10, // 23: ocean.v1.AccountService.DeriveAddresses:input_type -> ocean.v1.DeriveAddressesRequest
+	12, // 24: ocean.v1.AccountService.DeriveChangeAddresses:input_type -> ocean.v1.DeriveChangeAddressesRequest
+	14, // 25: ocean.v1.AccountService.ListAddresses:input_type -> ocean.v1.ListAddressesRequest
+	16, // 26: ocean.v1.AccountService.Balance:input_type -> ocean.v1.BalanceRequest
+	18, // 27: ocean.v1.AccountService.ListUtxos:input_type -> ocean.v1.ListUtxosRequest
+	20, // 28: ocean.v1.AccountService.DeleteAccount:input_type -> ocean.v1.DeleteAccountRequest
+	22, // 29: ocean.v1.AccountService.SetSpendingPolicy:input_type -> ocean.v1.SetSpendingPolicyRequest
+	24, // 30: ocean.v1.AccountService.GetSpendingPolicy:input_type -> ocean.v1.GetSpendingPolicyRequest
+	26, // 31: ocean.v1.AccountService.DeleteSpendingPolicy:input_type -> ocean.v1.DeleteSpendingPolicyRequest
+	28, // 32: ocean.v1.AccountService.FreezeUtxos:input_type -> ocean.v1.FreezeUtxosRequest
+	30, // 33: ocean.v1.AccountService.UnfreezeUtxos:input_type -> ocean.v1.UnfreezeUtxosRequest
+	32, // 34: ocean.v1.AccountService.SetUtxoLabels:input_type -> ocean.v1.SetUtxoLabelsRequest
+	34, // 35: ocean.v1.AccountService.SetAddressLabels:input_type -> ocean.v1.SetAddressLabelsRequest
+	1,  // 36: ocean.v1.AccountService.CreateAccountBIP44:output_type -> ocean.v1.CreateAccountBIP44Response
+	3,  // 37: ocean.v1.AccountService.CreateAccountMultiSig:output_type -> ocean.v1.CreateAccountMultiSigResponse
+	5,  // 38: ocean.v1.AccountService.CreateAccountCustom:output_type -> ocean.v1.CreateAccountCustomResponse
+	7,  // 39: ocean.v1.AccountService.SetAccountLabel:output_type -> ocean.v1.SetAccountLabelResponse
+	9,  // 40: ocean.v1.AccountService.SetAccountTemplate:output_type -> ocean.v1.SetAccountTemplateResponse
+	11, // 41: ocean.v1.AccountService.DeriveAddresses:output_type -> ocean.v1.DeriveAddressesResponse
+	13, // 42: ocean.v1.AccountService.DeriveChangeAddresses:output_type -> ocean.v1.DeriveChangeAddressesResponse
+	15, // 43: ocean.v1.AccountService.ListAddresses:output_type -> ocean.v1.ListAddressesResponse
+	17, // 44: ocean.v1.AccountService.Balance:output_type -> ocean.v1.BalanceResponse
+	19, // 45: ocean.v1.AccountService.ListUtxos:output_type -> ocean.v1.ListUtxosResponse
+	21, // 46: ocean.v1.AccountService.DeleteAccount:output_type -> ocean.v1.DeleteAccountResponse
+	23, // 47: ocean.v1.AccountService.SetSpendingPolicy:output_type -> ocean.v1.SetSpendingPolicyResponse
+	25, // 48: ocean.v1.AccountService.GetSpendingPolicy:output_type -> ocean.v1.GetSpendingPolicyResponse
+	27, // 49: ocean.v1.AccountService.DeleteSpendingPolicy:output_type -> ocean.v1.DeleteSpendingPolicyResponse
+	29, // 50: ocean.v1.AccountService.FreezeUtxos:output_type -> ocean.v1.FreezeUtxosResponse
+	31, // 51: ocean.v1.AccountService.UnfreezeUtxos:output_type -> ocean.v1.UnfreezeUtxosResponse
+	33, // 52: ocean.v1.AccountService.SetUtxoLabels:output_type -> ocean.v1.SetUtxoLabelsResponse
+	35, // 53: ocean.v1.AccountService.SetAddressLabels:output_type -> ocean.v1.SetAddressLabelsResponse
+	36, // [36:54] is the sub-list for method output_type
+	18, // [18:36] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_ocean_v1_account_proto_init() }
@@ -1690,6 +2668,174 @@ func file_ocean_v1_account_proto_init() {
 				return nil
 			}
 		}
+		file_ocean_v1_account_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSpendingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSpendingPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUtxoLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUtxoLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAddressLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_account_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAddressLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1697,7 +2843,7 @@ func file_ocean_v1_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ocean_v1_account_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -43,6 +43,23 @@ type AccountServiceClient interface {
 	// DeleteAccount deletes an existing account. The operation is allowed only
 	// if the account has zero balance.
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	// SetSpendingPolicy sets the spending policy for the account, overwriting
+	// the existing one if any. The policy is checked before signing any
+	// transaction spending funds of the account.
+	SetSpendingPolicy(ctx context.Context, in *SetSpendingPolicyRequest, opts ...grpc.CallOption) (*SetSpendingPolicyResponse, error)
+	// GetSpendingPolicy returns the spending policy of the account.
+	GetSpendingPolicy(ctx context.Context, in *GetSpendingPolicyRequest, opts ...grpc.CallOption) (*GetSpendingPolicyResponse, error)
+	// DeleteSpendingPolicy removes the spending policy of the account.
+	DeleteSpendingPolicy(ctx context.Context, in *DeleteSpendingPolicyRequest, opts ...grpc.CallOption) (*DeleteSpendingPolicyResponse, error)
+	// FreezeUtxos freezes utxos of the account. Unlike locked ones, frozen utxos
+	// are never selected for spending until unfrozen.
+	FreezeUtxos(ctx context.Context, in *FreezeUtxosRequest, opts ...grpc.CallOption) (*FreezeUtxosResponse, error)
+	// UnfreezeUtxos makes frozen utxos of the account spendable again.
+	UnfreezeUtxos(ctx context.Context, in *UnfreezeUtxosRequest, opts ...grpc.CallOption) (*UnfreezeUtxosResponse, error)
+	// SetUtxoLabels overwrites the labels of utxos of the account.
+	SetUtxoLabels(ctx context.Context, in *SetUtxoLabelsRequest, opts ...grpc.CallOption) (*SetUtxoLabelsResponse, error)
+	// SetAddressLabels overwrites the labels of a derived address of the account.
+	SetAddressLabels(ctx context.Context, in *SetAddressLabelsRequest, opts ...grpc.CallOption) (*SetAddressLabelsResponse, error)
 }
 
 type accountServiceClient struct {
@@ -152,6 +169,69 @@ func (c *accountServiceClient) DeleteAccount(ctx context.Context, in *DeleteAcco
 	return out, nil
 }
 
+func (c *accountServiceClient) SetSpendingPolicy(ctx context.Context, in *SetSpendingPolicyRequest, opts ...grpc.CallOption) (*SetSpendingPolicyResponse, error) {
+	out := new(SetSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/SetSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetSpendingPolicy(ctx context.Context, in *GetSpendingPolicyRequest, opts ...grpc.CallOption) (*GetSpendingPolicyResponse, error) {
+	out := new(GetSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/GetSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) DeleteSpendingPolicy(ctx context.Context, in *DeleteSpendingPolicyRequest, opts ...grpc.CallOption) (*DeleteSpendingPolicyResponse, error) {
+	out := new(DeleteSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/DeleteSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) FreezeUtxos(ctx context.Context, in *FreezeUtxosRequest, opts ...grpc.CallOption) (*FreezeUtxosResponse, error) {
+	out := new(FreezeUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/FreezeUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UnfreezeUtxos(ctx context.Context, in *UnfreezeUtxosRequest, opts ...grpc.CallOption) (*UnfreezeUtxosResponse, error) {
+	out := new(UnfreezeUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/UnfreezeUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetUtxoLabels(ctx context.Context, in *SetUtxoLabelsRequest, opts ...grpc.CallOption) (*SetUtxoLabelsResponse, error) {
+	out := new(SetUtxoLabelsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/SetUtxoLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetAddressLabels(ctx context.Context, in *SetAddressLabelsRequest, opts ...grpc.CallOption) (*SetAddressLabelsResponse, error) {
+	out := new(SetAddressLabelsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/SetAddressLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations should embed UnimplementedAccountServiceServer
 // for forward compatibility
@@ -181,6 +261,23 @@ type AccountServiceServer interface {
 	// DeleteAccount deletes an existing account. The operation is allowed only
 	// if the account has zero balance.
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// SetSpendingPolicy sets the spending policy for the account, overwriting
+	// the existing one if any. The policy is checked before signing any
+	// transaction spending funds of the account.
+	SetSpendingPolicy(context.Context, *SetSpendingPolicyRequest) (*SetSpendingPolicyResponse, error)
+	// GetSpendingPolicy returns the spending policy of the account.
+	GetSpendingPolicy(context.Context, *GetSpendingPolicyRequest) (*GetSpendingPolicyResponse, error)
+	// DeleteSpendingPolicy removes the spending policy of the account.
+	DeleteSpendingPolicy(context.Context, *DeleteSpendingPolicyRequest) (*DeleteSpendingPolicyResponse, error)
+	// FreezeUtxos freezes utxos of the account. Unlike locked ones, frozen utxos
+	// are never selected for spending until unfrozen.
+	FreezeUtxos(context.Context, *FreezeUtxosRequest) (*FreezeUtxosResponse, error)
+	// UnfreezeUtxos makes frozen utxos of the account spendable again.
+	UnfreezeUtxos(context.Context, *UnfreezeUtxosRequest) (*UnfreezeUtxosResponse, error)
+	// SetUtxoLabels overwrites the labels of utxos of the account.
+	SetUtxoLabels(context.Context, *SetUtxoLabelsRequest) (*SetUtxoLabelsResponse, error)
+	// SetAddressLabels overwrites the labels of a derived address of the account.
+	SetAddressLabels(context.Context, *SetAddressLabelsRequest) (*SetAddressLabelsResponse, error)
 }
 
 // UnimplementedAccountServiceServer should be embedded to have forward compatible implementations.
@@ -220,6 +317,27 @@ func (UnimplementedAccountServiceServer) ListUtxos(context.Context, *ListUtxosRe
 func (UnimplementedAccountServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedAccountServiceServer) SetSpendingPolicy(context.Context, *SetSpendingPolicyRequest) (*SetSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingPolicy not implemented")
+}
+func (UnimplementedAccountServiceServer) GetSpendingPolicy(context.Context, *GetSpendingPolicyRequest) (*GetSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingPolicy not implemented")
+}
+func (UnimplementedAccountServiceServer) DeleteSpendingPolicy(context.Context, *DeleteSpendingPolicyRequest) (*DeleteSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpendingPolicy not implemented")
+}
+func (UnimplementedAccountServiceServer) FreezeUtxos(context.Context, *FreezeUtxosRequest) (*FreezeUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeUtxos not implemented")
+}
+func (UnimplementedAccountServiceServer) UnfreezeUtxos(context.Context, *UnfreezeUtxosRequest) (*UnfreezeUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeUtxos not implemented")
+}
+func (UnimplementedAccountServiceServer) SetUtxoLabels(context.Context, *SetUtxoLabelsRequest) (*SetUtxoLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUtxoLabels not implemented")
+}
+func (UnimplementedAccountServiceServer) SetAddressLabels(context.Context, *SetAddressLabelsRequest) (*SetAddressLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressLabels not implemented")
+}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AccountServiceServer will
@@ -430,6 +548,132 @@ func _AccountService_DeleteAccount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_SetSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/SetSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetSpendingPolicy(ctx, req.(*SetSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/GetSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetSpendingPolicy(ctx, req.(*GetSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_DeleteSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).DeleteSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/DeleteSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).DeleteSpendingPolicy(ctx, req.(*DeleteSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_FreezeUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).FreezeUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/FreezeUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).FreezeUtxos(ctx, req.(*FreezeUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UnfreezeUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UnfreezeUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/UnfreezeUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UnfreezeUtxos(ctx, req.(*UnfreezeUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetUtxoLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUtxoLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetUtxoLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/SetUtxoLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetUtxoLabels(ctx, req.(*SetUtxoLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetAddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetAddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/SetAddressLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetAddressLabels(ctx, req.(*SetAddressLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,6 +725,34 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _AccountService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "SetSpendingPolicy",
+			Handler:    _AccountService_SetSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "GetSpendingPolicy",
+			Handler:    _AccountService_GetSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteSpendingPolicy",
+			Handler:    _AccountService_DeleteSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "FreezeUtxos",
+			Handler:    _AccountService_FreezeUtxos_Handler,
+		},
+		{
+			MethodName: "UnfreezeUtxos",
+			Handler:    _AccountService_UnfreezeUtxos_Handler,
+		},
+		{
+			MethodName: "SetUtxoLabels",
+			Handler:    _AccountService_SetUtxoLabels_Handler,
+		},
+		{
+			MethodName: "SetAddressLabels",
+			Handler:    _AccountService_SetAddressLabels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ocean/v1/account.proto",
@@ -30,6 +30,10 @@ type NotificationServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (NotificationService_TransactionNotificationsClient, error)
 	// Notifies about events realted to wallet utxos.
 	UtxosNotifications(ctx context.Context, in *UtxosNotificationsRequest, opts ...grpc.CallOption) (NotificationService_UtxosNotificationsClient, error)
+	// Notifies about events related to queued payments.
+	PaymentNotifications(ctx context.Context, in *PaymentNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PaymentNotificationsClient, error)
+	// Notifies about events related to the txs broadcasted by the wallet.
+	BroadcastNotifications(ctx context.Context, in *BroadcastNotificationsRequest, opts ...grpc.CallOption) (NotificationService_BroadcastNotificationsClient, error)
 	// Adds a webhook registered for some kind of event.
 	AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookResponse, error)
 	// Removes some previously added webhook.
@@ -128,6 +132,70 @@ func (x *notificationServiceUtxosNotificationsClient) Recv() (*UtxosNotification
 	return m, nil
 }
 
+func (c *notificationServiceClient) PaymentNotifications(ctx context.Context, in *PaymentNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PaymentNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[2], "/ocean.v1.NotificationService/PaymentNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServicePaymentNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_PaymentNotificationsClient interface {
+	Recv() (*PaymentNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type notificationServicePaymentNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServicePaymentNotificationsClient) Recv() (*PaymentNotificationsResponse, error) {
+	m := new(PaymentNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) BroadcastNotifications(ctx context.Context, in *BroadcastNotificationsRequest, opts ...grpc.CallOption) (NotificationService_BroadcastNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[3], "/ocean.v1.NotificationService/BroadcastNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceBroadcastNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_BroadcastNotificationsClient interface {
+	Recv() (*BroadcastNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type notificationServiceBroadcastNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceBroadcastNotificationsClient) Recv() (*BroadcastNotificationsResponse, error) {
+	m := new(BroadcastNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *notificationServiceClient) AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookResponse, error) {
 	out := new(AddWebhookResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.NotificationService/AddWebhook", in, out, opts...)
@@ -171,6 +239,10 @@ type NotificationServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, NotificationService_TransactionNotificationsServer) error
 	// Notifies about events realted to wallet utxos.
 	UtxosNotifications(*UtxosNotificationsRequest, NotificationService_UtxosNotificationsServer) error
+	// Notifies about events related to queued payments.
+	PaymentNotifications(*PaymentNotificationsRequest, NotificationService_PaymentNotificationsServer) error
+	// Notifies about events related to the txs broadcasted by the wallet.
+	BroadcastNotifications(*BroadcastNotificationsRequest, NotificationService_BroadcastNotificationsServer) error
 	// Adds a webhook registered for some kind of event.
 	AddWebhook(context.Context, *AddWebhookRequest) (*AddWebhookResponse, error)
 	// Removes some previously added webhook.
@@ -195,6 +267,12 @@ func (UnimplementedNotificationServiceServer) TransactionNotifications(*Transact
 func (UnimplementedNotificationServiceServer) UtxosNotifications(*UtxosNotificationsRequest, NotificationService_UtxosNotificationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method UtxosNotifications not implemented")
 }
+func (UnimplementedNotificationServiceServer) PaymentNotifications(*PaymentNotificationsRequest, NotificationService_PaymentNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PaymentNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) BroadcastNotifications(*BroadcastNotificationsRequest, NotificationService_BroadcastNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method BroadcastNotifications not implemented")
+}
 func (UnimplementedNotificationServiceServer) AddWebhook(context.Context, *AddWebhookRequest) (*AddWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
 }
@@ -294,6 +372,48 @@ func (x *notificationServiceUtxosNotificationsServer) Send(m *UtxosNotifications
 	return x.ServerStream.SendMsg(m)
 }
 
+func _NotificationService_PaymentNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaymentNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).PaymentNotifications(m, &notificationServicePaymentNotificationsServer{stream})
+}
+
+type NotificationService_PaymentNotificationsServer interface {
+	Send(*PaymentNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type notificationServicePaymentNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServicePaymentNotificationsServer) Send(m *PaymentNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NotificationService_BroadcastNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BroadcastNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).BroadcastNotifications(m, &notificationServiceBroadcastNotificationsServer{stream})
+}
+
+type NotificationService_BroadcastNotificationsServer interface {
+	Send(*BroadcastNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type notificationServiceBroadcastNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceBroadcastNotificationsServer) Send(m *BroadcastNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _NotificationService_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddWebhookRequest)
 	if err := dec(in); err != nil {
@@ -387,6 +507,16 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _NotificationService_UtxosNotifications_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PaymentNotifications",
+			Handler:       _NotificationService_PaymentNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BroadcastNotifications",
+			Handler:       _NotificationService_BroadcastNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "ocean/v1/notification.proto",
 }
@@ -24,9 +24,20 @@ const (
 type SelectUtxosRequest_Strategy int32
 
 const (
-	SelectUtxosRequest_STRATEGY_UNSPECIFIED  SelectUtxosRequest_Strategy = 0
+	// Selects as few utxos as possible, regardless of their cost.
+	SelectUtxosRequest_STRATEGY_UNSPECIFIED SelectUtxosRequest_Strategy = 0
+	// Same as STRATEGY_UNSPECIFIED, kept for backward compatibility.
+	// Use STRATEGY_LOWEST_WASTE for the branch-and-bound selector.
+	//
+	// Deprecated: Do not use.
 	SelectUtxosRequest_STRATEGY_BRANCH_BOUND SelectUtxosRequest_Strategy = 1
 	SelectUtxosRequest_STRATEGY_FRAGMENT     SelectUtxosRequest_Strategy = 2
+	// Looks for the selection closest to the target amount.
+	SelectUtxosRequest_STRATEGY_KNAPSACK SelectUtxosRequest_Strategy = 3
+	// Looks for a selection that doesn't require any change with a
+	// branch-and-bound search, or falls back to the knapsack one, whichever
+	// wastes less in fees.
+	SelectUtxosRequest_STRATEGY_LOWEST_WASTE SelectUtxosRequest_Strategy = 4
 )
 
 // Enum value maps for SelectUtxosRequest_Strategy.
@@ -35,11 +46,15 @@ var (
 		0: "STRATEGY_UNSPECIFIED",
 		1: "STRATEGY_BRANCH_BOUND",
 		2: "STRATEGY_FRAGMENT",
+		3: "STRATEGY_KNAPSACK",
+		4: "STRATEGY_LOWEST_WASTE",
 	}
 	SelectUtxosRequest_Strategy_value = map[string]int32{
 		"STRATEGY_UNSPECIFIED":  0,
 		"STRATEGY_BRANCH_BOUND": 1,
 		"STRATEGY_FRAGMENT":     2,
+		"STRATEGY_KNAPSACK":     3,
+		"STRATEGY_LOWEST_WASTE": 4,
 	}
 )
 
@@ -186,6 +201,11 @@ type SelectUtxosRequest struct {
 	// Target amount to cover.
 	TargetAmount uint64                      `protobuf:"varint,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
 	Strategy     SelectUtxosRequest_Strategy `protobuf:"varint,4,opt,name=strategy,proto3,enum=ocean.v1.SelectUtxosRequest_Strategy" json:"strategy,omitempty"`
+	// mSats/byte fee ratio used by the fee-aware strategies to account for the
+	// cost of spending every utxo.
+	MillisatsPerByte uint64 `protobuf:"varint,5,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Duration of the lock in seconds. The default one is used if not defined.
+	LockExpirySeconds uint64 `protobuf:"varint,6,opt,name=lock_expiry_seconds,json=lockExpirySeconds,proto3" json:"lock_expiry_seconds,omitempty"`
 }
 
 func (x *SelectUtxosRequest) Reset() {
@@ -248,6 +268,20 @@ func (x *SelectUtxosRequest) GetStrategy() SelectUtxosRequest_Strategy {
 	return SelectUtxosRequest_STRATEGY_UNSPECIFIED
 }
 
+func (x *SelectUtxosRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *SelectUtxosRequest) GetLockExpirySeconds() uint64 {
+	if x != nil {
+		return x.LockExpirySeconds
+	}
+	return 0
+}
+
 type SelectUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -260,6 +294,9 @@ type SelectUtxosResponse struct {
 	// Expiration date for the selected utxo, which are temporary locked to
 	// prevent double spending them.
 	ExpirationDate int64 `protobuf:"varint,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// Token identifying the owner of the lock, required to unlock the utxos or
+	// to extend their lock.
+	LockToken string `protobuf:"bytes,4,opt,name=lock_token,json=lockToken,proto3" json:"lock_token,omitempty"`
 }
 
 func (x *SelectUtxosResponse) Reset() {
@@ -315,6 +352,13 @@ func (x *SelectUtxosResponse) GetExpirationDate() int64 {
 	return 0
 }
 
+func (x *SelectUtxosResponse) GetLockToken() string {
+	if x != nil {
+		return x.LockToken
+	}
+	return ""
+}
+
 type LockUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -322,6 +366,10 @@ type LockUtxosRequest struct {
 
 	AccountName string   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 	Utxos       []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// Token of an existing lock to extend. A new lock is created if not defined.
+	LockToken string `protobuf:"bytes,3,opt,name=lock_token,json=lockToken,proto3" json:"lock_token,omitempty"`
+	// Duration of the lock in seconds. The default one is used if not defined.
+	LockExpirySeconds uint64 `protobuf:"varint,4,opt,name=lock_expiry_seconds,json=lockExpirySeconds,proto3" json:"lock_expiry_seconds,omitempty"`
 }
 
 func (x *LockUtxosRequest) Reset() {
@@ -370,12 +418,28 @@ func (x *LockUtxosRequest) GetUtxos() []*Input {
 	return nil
 }
 
+func (x *LockUtxosRequest) GetLockToken() string {
+	if x != nil {
+		return x.LockToken
+	}
+	return ""
+}
+
+func (x *LockUtxosRequest) GetLockExpirySeconds() uint64 {
+	if x != nil {
+		return x.LockExpirySeconds
+	}
+	return 0
+}
+
 type LockUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ExpirationDate int64 `protobuf:"varint,1,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// Token identifying the owner of the lock.
+	LockToken string `protobuf:"bytes,2,opt,name=lock_token,json=lockToken,proto3" json:"lock_token,omitempty"`
 }
 
 func (x *LockUtxosResponse) Reset() {
@@ -417,6 +481,125 @@ func (x *LockUtxosResponse) GetExpirationDate() int64 {
 	return 0
 }
 
+func (x *LockUtxosResponse) GetLockToken() string {
+	if x != nil {
+		return x.LockToken
+	}
+	return ""
+}
+
+type UnlockUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName string   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Utxos       []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// Token of the lock. Can be omitted only by an admin.
+	LockToken string `protobuf:"bytes,3,opt,name=lock_token,json=lockToken,proto3" json:"lock_token,omitempty"`
+}
+
+func (x *UnlockUtxosRequest) Reset() {
+	*x = UnlockUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUtxosRequest) ProtoMessage() {}
+
+func (x *UnlockUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUtxosRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnlockUtxosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UnlockUtxosRequest) GetUtxos() []*Input {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *UnlockUtxosRequest) GetLockToken() string {
+	if x != nil {
+		return x.LockToken
+	}
+	return ""
+}
+
+type UnlockUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of unlocked utxos.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *UnlockUtxosResponse) Reset() {
+	*x = UnlockUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUtxosResponse) ProtoMessage() {}
+
+func (x *UnlockUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUtxosResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnlockUtxosResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type EstimateFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -430,7 +613,7 @@ type EstimateFeesRequest struct {
 func (x *EstimateFeesRequest) Reset() {
 	*x = EstimateFeesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[6]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +626,7 @@ func (x *EstimateFeesRequest) String() string {
 func (*EstimateFeesRequest) ProtoMessage() {}
 
 func (x *EstimateFeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[6]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +639,7 @@ func (x *EstimateFeesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateFeesRequest.ProtoReflect.Descriptor instead.
 func (*EstimateFeesRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{6}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EstimateFeesRequest) GetInputs() []*Input {
@@ -491,7 +674,7 @@ type EstimateFeesResponse struct {
 func (x *EstimateFeesResponse) Reset() {
 	*x = EstimateFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[7]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -504,7 +687,7 @@ func (x *EstimateFeesResponse) String() string {
 func (*EstimateFeesResponse) ProtoMessage() {}
 
 func (x *EstimateFeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[7]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +700,7 @@ func (x *EstimateFeesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateFeesResponse.ProtoReflect.Descriptor instead.
 func (*EstimateFeesResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{7}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EstimateFeesResponse) GetFeeAmount() uint64 {
@@ -541,7 +724,7 @@ type SignTransactionRequest struct {
 func (x *SignTransactionRequest) Reset() {
 	*x = SignTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[8]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +737,7 @@ func (x *SignTransactionRequest) String() string {
 func (*SignTransactionRequest) ProtoMessage() {}
 
 func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[8]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +750,7 @@ func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{8}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SignTransactionRequest) GetTxHex() string {
@@ -596,7 +779,7 @@ type SignTransactionResponse struct {
 func (x *SignTransactionResponse) Reset() {
 	*x = SignTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[9]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +792,7 @@ func (x *SignTransactionResponse) String() string {
 func (*SignTransactionResponse) ProtoMessage() {}
 
 func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[9]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +805,7 @@ func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTransactionResponse.ProtoReflect.Descriptor instead.
 func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{9}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SignTransactionResponse) GetTxHex() string {
@@ -639,12 +822,15 @@ type BroadcastTransactionRequest struct {
 
 	// Transaction to broadcast.
 	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *BroadcastTransactionRequest) Reset() {
 	*x = BroadcastTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[10]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +843,7 @@ func (x *BroadcastTransactionRequest) String() string {
 func (*BroadcastTransactionRequest) ProtoMessage() {}
 
 func (x *BroadcastTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[10]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +856,7 @@ func (x *BroadcastTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastTransactionRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{10}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BroadcastTransactionRequest) GetTxHex() string {
@@ -680,6 +866,13 @@ func (x *BroadcastTransactionRequest) GetTxHex() string {
 	return ""
 }
 
+func (x *BroadcastTransactionRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type BroadcastTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -692,7 +885,7 @@ type BroadcastTransactionResponse struct {
 func (x *BroadcastTransactionResponse) Reset() {
 	*x = BroadcastTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[11]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +898,7 @@ func (x *BroadcastTransactionResponse) String() string {
 func (*BroadcastTransactionResponse) ProtoMessage() {}
 
 func (x *BroadcastTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[11]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +911,7 @@ func (x *BroadcastTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastTransactionResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{11}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BroadcastTransactionResponse) GetTxid() string {
@@ -737,12 +930,15 @@ type CreatePsetRequest struct {
 	Inputs []*Input `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
 	// Outputs of the partial transaction
 	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *CreatePsetRequest) Reset() {
 	*x = CreatePsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[12]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +951,7 @@ func (x *CreatePsetRequest) String() string {
 func (*CreatePsetRequest) ProtoMessage() {}
 
 func (x *CreatePsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[12]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +964,7 @@ func (x *CreatePsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePsetRequest.ProtoReflect.Descriptor instead.
 func (*CreatePsetRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{12}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreatePsetRequest) GetInputs() []*Input {
@@ -785,6 +981,13 @@ func (x *CreatePsetRequest) GetOutputs() []*Output {
 	return nil
 }
 
+func (x *CreatePsetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreatePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -797,7 +1000,7 @@ type CreatePsetResponse struct {
 func (x *CreatePsetResponse) Reset() {
 	*x = CreatePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[13]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +1013,7 @@ func (x *CreatePsetResponse) String() string {
 func (*CreatePsetResponse) ProtoMessage() {}
 
 func (x *CreatePsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[13]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1026,7 @@ func (x *CreatePsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePsetResponse.ProtoReflect.Descriptor instead.
 func (*CreatePsetResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{13}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreatePsetResponse) GetPset() string {
@@ -844,12 +1047,15 @@ type UpdatePsetRequest struct {
 	Inputs []*Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
 	// Outputs to add to the partil transaction.
 	Outputs []*Output `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *UpdatePsetRequest) Reset() {
 	*x = UpdatePsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[14]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -862,7 +1068,7 @@ func (x *UpdatePsetRequest) String() string {
 func (*UpdatePsetRequest) ProtoMessage() {}
 
 func (x *UpdatePsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[14]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1081,7 @@ func (x *UpdatePsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePsetRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePsetRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{14}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdatePsetRequest) GetPset() string {
@@ -899,6 +1105,13 @@ func (x *UpdatePsetRequest) GetOutputs() []*Output {
 	return nil
 }
 
+func (x *UpdatePsetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type UpdatePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -911,7 +1124,7 @@ type UpdatePsetResponse struct {
 func (x *UpdatePsetResponse) Reset() {
 	*x = UpdatePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[15]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -924,7 +1137,7 @@ func (x *UpdatePsetResponse) String() string {
 func (*UpdatePsetResponse) ProtoMessage() {}
 
 func (x *UpdatePsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[15]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1150,7 @@ func (x *UpdatePsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePsetResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePsetResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{15}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdatePsetResponse) GetPset() string {
@@ -959,12 +1172,15 @@ type BlindPsetRequest struct {
 	// Optional list of unblinded data related to existing pset inputs in order
 	// to make the wallet blind also outputs it wouldn't own otherwise.
 	ExtraUnblindedInputs []*UnblindedInput `protobuf:"bytes,3,rep,name=extra_unblinded_inputs,json=extraUnblindedInputs,proto3" json:"extra_unblinded_inputs,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *BlindPsetRequest) Reset() {
 	*x = BlindPsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[16]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -977,7 +1193,7 @@ func (x *BlindPsetRequest) String() string {
 func (*BlindPsetRequest) ProtoMessage() {}
 
 func (x *BlindPsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[16]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1206,7 @@ func (x *BlindPsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindPsetRequest.ProtoReflect.Descriptor instead.
 func (*BlindPsetRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{16}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BlindPsetRequest) GetPset() string {
@@ -1014,6 +1230,13 @@ func (x *BlindPsetRequest) GetExtraUnblindedInputs() []*UnblindedInput {
 	return nil
 }
 
+func (x *BlindPsetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type BlindPsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1026,7 +1249,7 @@ type BlindPsetResponse struct {
 func (x *BlindPsetResponse) Reset() {
 	*x = BlindPsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[17]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1039,7 +1262,7 @@ func (x *BlindPsetResponse) String() string {
 func (*BlindPsetResponse) ProtoMessage() {}
 
 func (x *BlindPsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[17]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1275,7 @@ func (x *BlindPsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindPsetResponse.ProtoReflect.Descriptor instead.
 func (*BlindPsetResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{17}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BlindPsetResponse) GetPset() string {
@@ -1072,12 +1295,15 @@ type SignPsetRequest struct {
 	// The sighash type. If not specified, SIGHASH_ALL is used for any input
 	// to sign that doesn't already have one set.
 	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *SignPsetRequest) Reset() {
 	*x = SignPsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[18]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1090,7 +1316,7 @@ func (x *SignPsetRequest) String() string {
 func (*SignPsetRequest) ProtoMessage() {}
 
 func (x *SignPsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[18]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1329,7 @@ func (x *SignPsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignPsetRequest.ProtoReflect.Descriptor instead.
 func (*SignPsetRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{18}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SignPsetRequest) GetPset() string {
@@ -1120,6 +1346,13 @@ func (x *SignPsetRequest) GetSighashType() uint32 {
 	return 0
 }
 
+func (x *SignPsetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type SignPsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1132,7 +1365,7 @@ type SignPsetResponse struct {
 func (x *SignPsetResponse) Reset() {
 	*x = SignPsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[19]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1145,7 +1378,7 @@ func (x *SignPsetResponse) String() string {
 func (*SignPsetResponse) ProtoMessage() {}
 
 func (x *SignPsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[19]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1391,7 @@ func (x *SignPsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignPsetResponse.ProtoReflect.Descriptor instead.
 func (*SignPsetResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{19}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SignPsetResponse) GetPset() string {
@@ -1192,7 +1425,7 @@ type MintRequest struct {
 func (x *MintRequest) Reset() {
 	*x = MintRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[20]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1205,7 +1438,7 @@ func (x *MintRequest) String() string {
 func (*MintRequest) ProtoMessage() {}
 
 func (x *MintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[20]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,7 +1451,7 @@ func (x *MintRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintRequest.ProtoReflect.Descriptor instead.
 func (*MintRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{20}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MintRequest) GetAccountName() string {
@@ -1282,7 +1515,7 @@ type MintResponse struct {
 func (x *MintResponse) Reset() {
 	*x = MintResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1295,7 +1528,7 @@ func (x *MintResponse) String() string {
 func (*MintResponse) ProtoMessage() {}
 
 func (x *MintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +1541,7 @@ func (x *MintResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintResponse.ProtoReflect.Descriptor instead.
 func (*MintResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{21}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MintResponse) GetTxHex() string {
@@ -1336,7 +1569,7 @@ type RemintRequest struct {
 func (x *RemintRequest) Reset() {
 	*x = RemintRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[22]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +1582,7 @@ func (x *RemintRequest) String() string {
 func (*RemintRequest) ProtoMessage() {}
 
 func (x *RemintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[22]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +1595,7 @@ func (x *RemintRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemintRequest.ProtoReflect.Descriptor instead.
 func (*RemintRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{22}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RemintRequest) GetAccountName() string {
@@ -1405,7 +1638,7 @@ type RemintResponse struct {
 func (x *RemintResponse) Reset() {
 	*x = RemintResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[23]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1418,7 +1651,7 @@ func (x *RemintResponse) String() string {
 func (*RemintResponse) ProtoMessage() {}
 
 func (x *RemintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[23]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1664,7 @@ func (x *RemintResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemintResponse.ProtoReflect.Descriptor instead.
 func (*RemintResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{23}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RemintResponse) GetTxHex() string {
@@ -1458,7 +1691,7 @@ type BurnRequest struct {
 func (x *BurnRequest) Reset() {
 	*x = BurnRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[24]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1471,7 +1704,7 @@ func (x *BurnRequest) String() string {
 func (*BurnRequest) ProtoMessage() {}
 
 func (x *BurnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[24]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1717,7 @@ func (x *BurnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BurnRequest.ProtoReflect.Descriptor instead.
 func (*BurnRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{24}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *BurnRequest) GetAccountName() string {
@@ -1520,7 +1753,7 @@ type BurnResponse struct {
 func (x *BurnResponse) Reset() {
 	*x = BurnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[25]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1533,7 +1766,7 @@ func (x *BurnResponse) String() string {
 func (*BurnResponse) ProtoMessage() {}
 
 func (x *BurnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[25]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +1779,7 @@ func (x *BurnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BurnResponse.ProtoReflect.Descriptor instead.
 func (*BurnResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{25}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BurnResponse) GetTxHex() string {
@@ -1567,12 +1800,27 @@ type TransferRequest struct {
 	Receivers []*Output `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
 	// mSats/byte fee ratio.
 	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Coin-selection algorithm.
+	CoinSelectionStrategy SelectUtxosRequest_Strategy `protobuf:"varint,5,opt,name=coin_selection_strategy,json=coinSelectionStrategy,proto3,enum=ocean.v1.SelectUtxosRequest_Strategy" json:"coin_selection_strategy,omitempty"`
+	// Whether to subtract the fee amount from the LBTC receivers, in equal
+	// parts, instead of adding it to the amount spent.
+	SubtractFeeFromOutputs bool `protobuf:"varint,6,opt,name=subtract_fee_from_outputs,json=subtractFeeFromOutputs,proto3" json:"subtract_fee_from_outputs,omitempty"`
+	// Optional nLockTime of the tx, either a block height or a unix timestamp
+	// if greater than or equal to 500000000.
+	Locktime uint32 `protobuf:"varint,7,opt,name=locktime,proto3" json:"locktime,omitempty"`
+	// Optional nSequence of the inputs of the tx. Since inputs are selected by
+	// the daemon, at most one can be given, applied to all of them. If only the
+	// locktime is set, inputs default to 0xfffffffe to enforce it.
+	Sequences []uint32 `protobuf:"varint,8,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
 }
 
 func (x *TransferRequest) Reset() {
 	*x = TransferRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[26]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1585,7 +1833,7 @@ func (x *TransferRequest) String() string {
 func (*TransferRequest) ProtoMessage() {}
 
 func (x *TransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[26]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,7 +1846,7 @@ func (x *TransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
 func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{26}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TransferRequest) GetAccountName() string {
@@ -1622,6 +1870,41 @@ func (x *TransferRequest) GetMillisatsPerByte() uint64 {
 	return 0
 }
 
+func (x *TransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetCoinSelectionStrategy() SelectUtxosRequest_Strategy {
+	if x != nil {
+		return x.CoinSelectionStrategy
+	}
+	return SelectUtxosRequest_STRATEGY_UNSPECIFIED
+}
+
+func (x *TransferRequest) GetSubtractFeeFromOutputs() bool {
+	if x != nil {
+		return x.SubtractFeeFromOutputs
+	}
+	return false
+}
+
+func (x *TransferRequest) GetLocktime() uint32 {
+	if x != nil {
+		return x.Locktime
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetSequences() []uint32 {
+	if x != nil {
+		return x.Sequences
+	}
+	return nil
+}
+
 type TransferResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1634,7 +1917,7 @@ type TransferResponse struct {
 func (x *TransferResponse) Reset() {
 	*x = TransferResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[27]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1647,7 +1930,7 @@ func (x *TransferResponse) String() string {
 func (*TransferResponse) ProtoMessage() {}
 
 func (x *TransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[27]
+	mi := &file_ocean_v1_transaction_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +1943,7 @@ func (x *TransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
 func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{27}
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TransferResponse) GetTxHex() string {
@@ -1670,29 +1953,41 @@ func (x *TransferResponse) GetTxHex() string {
 	return ""
 }
 
-type PegInAddressRequest struct {
+type SweepAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Address receiving the funds.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Optional list of assets to sweep, all those of the account if empty.
+	Assets []string `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,4,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *PegInAddressRequest) Reset() {
-	*x = PegInAddressRequest{}
+func (x *SweepAccountRequest) Reset() {
+	*x = SweepAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[28]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PegInAddressRequest) String() string {
+func (x *SweepAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PegInAddressRequest) ProtoMessage() {}
+func (*SweepAccountRequest) ProtoMessage() {}
 
-func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[28]
+func (x *SweepAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,41 +1998,72 @@ func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PegInAddressRequest.ProtoReflect.Descriptor instead.
-func (*PegInAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SweepAccountRequest.ProtoReflect.Descriptor instead.
+func (*SweepAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{30}
 }
 
-type PegInAddressResponse struct {
+func (x *SweepAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SweepAccountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SweepAccountRequest) GetAssets() []string {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *SweepAccountRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *SweepAccountRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SweepAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Main-chain deposit address to send bitcoin to.
-	MainChainAddress string `protobuf:"bytes,2,opt,name=main_chain_address,json=mainChainAddress,proto3" json:"main_chain_address,omitempty"`
-	// Claim script committed to by the main-chain address.
-	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
 }
 
-func (x *PegInAddressResponse) Reset() {
-	*x = PegInAddressResponse{}
+func (x *SweepAccountResponse) Reset() {
+	*x = SweepAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[29]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PegInAddressResponse) String() string {
+func (x *SweepAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PegInAddressResponse) ProtoMessage() {}
+func (*SweepAccountResponse) ProtoMessage() {}
 
-func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[29]
+func (x *SweepAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,62 +2074,52 @@ func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PegInAddressResponse.ProtoReflect.Descriptor instead.
-func (*PegInAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *PegInAddressResponse) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
-}
-
-func (x *PegInAddressResponse) GetMainChainAddress() string {
-	if x != nil {
-		return x.MainChainAddress
-	}
-	return ""
+// Deprecated: Use SweepAccountResponse.ProtoReflect.Descriptor instead.
+func (*SweepAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PegInAddressResponse) GetClaimScript() string {
+func (x *SweepAccountResponse) GetTxHex() string {
 	if x != nil {
-		return x.ClaimScript
+		return x.TxHex
 	}
 	return ""
 }
 
-type ClaimPegInRequest struct {
+type ConsolidateUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw bitcoin transaction (in hex) depositing bitcoin to the main-chain address generated by PegInAddress.
-	BitcoinTx string `protobuf:"bytes,1,opt,name=bitcoin_tx,json=bitcoinTx,proto3" json:"bitcoin_tx,omitempty"`
-	// A raw tx_out_proof (in hex) generated by the main-chain daemon's `gettxoutproof` containing a proof of only bitcoin_tx.
-	TxOutProof string `protobuf:"bytes,2,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
-	// The witness program generated by PegInAddress.
-	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Optional asset whose utxos to merge, all assets if empty.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Utxos with value lower than this threshold are merged.
+	ValueThreshold uint64 `protobuf:"varint,3,opt,name=value_threshold,json=valueThreshold,proto3" json:"value_threshold,omitempty"`
+	// Optional max number of inputs per tx, defaults to 50.
+	MaxInputs uint32 `protobuf:"varint,4,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,5,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
 }
 
-func (x *ClaimPegInRequest) Reset() {
-	*x = ClaimPegInRequest{}
+func (x *ConsolidateUtxosRequest) Reset() {
+	*x = ConsolidateUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[30]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimPegInRequest) String() string {
+func (x *ConsolidateUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimPegInRequest) ProtoMessage() {}
+func (*ConsolidateUtxosRequest) ProtoMessage() {}
 
-func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[30]
+func (x *ConsolidateUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,58 +2130,72 @@ func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimPegInRequest.ProtoReflect.Descriptor instead.
-func (*ClaimPegInRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ConsolidateUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidateUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ClaimPegInRequest) GetBitcoinTx() string {
+func (x *ConsolidateUtxosRequest) GetAccountName() string {
 	if x != nil {
-		return x.BitcoinTx
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *ClaimPegInRequest) GetTxOutProof() string {
+func (x *ConsolidateUtxosRequest) GetAsset() string {
 	if x != nil {
-		return x.TxOutProof
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *ClaimPegInRequest) GetClaimScript() string {
+func (x *ConsolidateUtxosRequest) GetValueThreshold() uint64 {
 	if x != nil {
-		return x.ClaimScript
+		return x.ValueThreshold
 	}
-	return ""
+	return 0
 }
 
-type ClaimPegInResponse struct {
+func (x *ConsolidateUtxosRequest) GetMaxInputs() uint32 {
+	if x != nil {
+		return x.MaxInputs
+	}
+	return 0
+}
+
+func (x *ConsolidateUtxosRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type ConsolidateUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Hashes of the broadcasted txs.
+	Txids []string `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
 }
 
-func (x *ClaimPegInResponse) Reset() {
-	*x = ClaimPegInResponse{}
+func (x *ConsolidateUtxosResponse) Reset() {
+	*x = ConsolidateUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[31]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimPegInResponse) String() string {
+func (x *ConsolidateUtxosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimPegInResponse) ProtoMessage() {}
+func (*ConsolidateUtxosResponse) ProtoMessage() {}
 
-func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[31]
+func (x *ConsolidateUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1876,46 +2206,46 @@ func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimPegInResponse.ProtoReflect.Descriptor instead.
-func (*ClaimPegInResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ConsolidateUtxosResponse.ProtoReflect.Descriptor instead.
+func (*ConsolidateUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ClaimPegInResponse) GetTxHex() string {
+func (x *ConsolidateUtxosResponse) GetTxids() []string {
 	if x != nil {
-		return x.TxHex
+		return x.Txids
 	}
-	return ""
+	return nil
 }
 
-type SignPsetWithSchnorrKeyRequest struct {
+type BumpFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partial transaction to sign in base64 format.
-	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// The sighash type. SIGHASH_DEFAULT is used for any input that does not specify one.
-	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Hash of the tx to bump.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// New mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,2,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) Reset() {
-	*x = SignPsetWithSchnorrKeyRequest{}
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) String() string {
+func (x *BumpFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignPsetWithSchnorrKeyRequest) ProtoMessage() {}
+func (*BumpFeeRequest) ProtoMessage() {}
 
-func (x *SignPsetWithSchnorrKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,50 +2256,55 @@ func (x *SignPsetWithSchnorrKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignPsetWithSchnorrKeyRequest.ProtoReflect.Descriptor instead.
-func (*SignPsetWithSchnorrKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) GetTx() string {
+func (x *BumpFeeRequest) GetTxid() string {
 	if x != nil {
-		return x.Tx
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) GetSighashType() uint32 {
+func (x *BumpFeeRequest) GetMillisatsPerByte() uint64 {
 	if x != nil {
-		return x.SighashType
+		return x.MillisatsPerByte
 	}
 	return 0
 }
 
-type SignPsetWithSchnorrKeyResponse struct {
+type BumpFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignedTx string `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+	// Hash of the broadcasted tx, either the replacement or the child one.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Broadcasted tx in hex format.
+	TxHex string `protobuf:"bytes,2,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Method used to bump the fees.
+	Method BumpFeeMethod `protobuf:"varint,3,opt,name=method,proto3,enum=ocean.v1.BumpFeeMethod" json:"method,omitempty"`
 }
 
-func (x *SignPsetWithSchnorrKeyResponse) Reset() {
-	*x = SignPsetWithSchnorrKeyResponse{}
+func (x *BumpFeeResponse) Reset() {
+	*x = BumpFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[33]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignPsetWithSchnorrKeyResponse) String() string {
+func (x *BumpFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignPsetWithSchnorrKeyResponse) ProtoMessage() {}
+func (*BumpFeeResponse) ProtoMessage() {}
 
-func (x *SignPsetWithSchnorrKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[33]
+func (x *BumpFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1980,70 +2315,1520 @@ func (x *SignPsetWithSchnorrKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignPsetWithSchnorrKeyResponse.ProtoReflect.Descriptor instead.
-func (*SignPsetWithSchnorrKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BumpFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SignPsetWithSchnorrKeyResponse) GetSignedTx() string {
+func (x *BumpFeeResponse) GetTxid() string {
 	if x != nil {
-		return x.SignedTx
+		return x.Txid
 	}
 	return ""
 }
 
-var File_ocean_v1_transaction_proto protoreflect.FileDescriptor
+func (x *BumpFeeResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
 
-var file_ocean_v1_transaction_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6f, 0x63,
-	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+func (x *BumpFeeResponse) GetMethod() BumpFeeMethod {
+	if x != nil {
+		return x.Method
+	}
+	return BumpFeeMethod_BUMP_FEE_METHOD_UNSPECIFIED
+}
+
+type EnqueuePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The receiver of the payment.
+	Receiver *Output `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Optional unix timestamp by which the payment must be broadcasted.
+	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Optional key to safely retry the request: retries with the same key
+	// within the configured window get back the response of the first call.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *EnqueuePaymentRequest) Reset() {
+	*x = EnqueuePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueuePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePaymentRequest) ProtoMessage() {}
+
+func (x *EnqueuePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePaymentRequest.ProtoReflect.Descriptor instead.
+func (*EnqueuePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EnqueuePaymentRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *EnqueuePaymentRequest) GetReceiver() *Output {
+	if x != nil {
+		return x.Receiver
+	}
+	return nil
+}
+
+func (x *EnqueuePaymentRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *EnqueuePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type EnqueuePaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The payment id.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+}
+
+func (x *EnqueuePaymentResponse) Reset() {
+	*x = EnqueuePaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueuePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePaymentResponse) ProtoMessage() {}
+
+func (x *EnqueuePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePaymentResponse.ProtoReflect.Descriptor instead.
+func (*EnqueuePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EnqueuePaymentResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type GetPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The payment id.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+}
+
+func (x *GetPaymentRequest) Reset() {
+	*x = GetPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequest) ProtoMessage() {}
+
+func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type GetPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *GetPaymentResponse) Reset() {
+	*x = GetPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentResponse) ProtoMessage() {}
+
+func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type FlushPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional account name, all accounts if empty.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Optional max number of payments per tx, defaults to 100.
+	MaxBatchSize uint32 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *FlushPaymentsRequest) Reset() {
+	*x = FlushPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushPaymentsRequest) ProtoMessage() {}
+
+func (x *FlushPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*FlushPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FlushPaymentsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *FlushPaymentsRequest) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *FlushPaymentsRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type FlushPaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the broadcasted txs.
+	Txids []string `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
+}
+
+func (x *FlushPaymentsResponse) Reset() {
+	*x = FlushPaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushPaymentsResponse) ProtoMessage() {}
+
+func (x *FlushPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*FlushPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FlushPaymentsResponse) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+type GetBroadcastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the broadcasted tx.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *GetBroadcastRequest) Reset() {
+	*x = GetBroadcastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastRequest) ProtoMessage() {}
+
+func (x *GetBroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastRequest.ProtoReflect.Descriptor instead.
+func (*GetBroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetBroadcastRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type GetBroadcastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broadcast *Broadcast `protobuf:"bytes,1,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+}
+
+func (x *GetBroadcastResponse) Reset() {
+	*x = GetBroadcastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastResponse) ProtoMessage() {}
+
+func (x *GetBroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastResponse.ProtoReflect.Descriptor instead.
+func (*GetBroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetBroadcastResponse) GetBroadcast() *Broadcast {
+	if x != nil {
+		return x.Broadcast
+	}
+	return nil
+}
+
+type ScheduleTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Optional unix timestamp before which the tx must not be broadcasted,
+	// required if the tx has no locktime.
+	BroadcastAt int64 `protobuf:"varint,2,opt,name=broadcast_at,json=broadcastAt,proto3" json:"broadcast_at,omitempty"`
+}
+
+func (x *ScheduleTransactionRequest) Reset() {
+	*x = ScheduleTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransactionRequest) ProtoMessage() {}
+
+func (x *ScheduleTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ScheduleTransactionRequest) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+func (x *ScheduleTransactionRequest) GetBroadcastAt() int64 {
+	if x != nil {
+		return x.BroadcastAt
+	}
+	return 0
+}
+
+type ScheduleTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTransaction *ScheduledTransaction `protobuf:"bytes,1,opt,name=scheduled_transaction,json=scheduledTransaction,proto3" json:"scheduled_transaction,omitempty"`
+}
+
+func (x *ScheduleTransactionResponse) Reset() {
+	*x = ScheduleTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransactionResponse) ProtoMessage() {}
+
+func (x *ScheduleTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScheduleTransactionResponse) GetScheduledTransaction() *ScheduledTransaction {
+	if x != nil {
+		return x.ScheduledTransaction
+	}
+	return nil
+}
+
+type GetScheduledTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the scheduled tx.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *GetScheduledTransactionRequest) Reset() {
+	*x = GetScheduledTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledTransactionRequest) ProtoMessage() {}
+
+func (x *GetScheduledTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetScheduledTransactionRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type GetScheduledTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTransaction *ScheduledTransaction `protobuf:"bytes,1,opt,name=scheduled_transaction,json=scheduledTransaction,proto3" json:"scheduled_transaction,omitempty"`
+}
+
+func (x *GetScheduledTransactionResponse) Reset() {
+	*x = GetScheduledTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledTransactionResponse) ProtoMessage() {}
+
+func (x *GetScheduledTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetScheduledTransactionResponse) GetScheduledTransaction() *ScheduledTransaction {
+	if x != nil {
+		return x.ScheduledTransaction
+	}
+	return nil
+}
+
+type ListScheduledTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListScheduledTransactionsRequest) Reset() {
+	*x = ListScheduledTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransactionsRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+type ListScheduledTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTransactions []*ScheduledTransaction `protobuf:"bytes,1,rep,name=scheduled_transactions,json=scheduledTransactions,proto3" json:"scheduled_transactions,omitempty"`
+}
+
+func (x *ListScheduledTransactionsResponse) Reset() {
+	*x = ListScheduledTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransactionsResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListScheduledTransactionsResponse) GetScheduledTransactions() []*ScheduledTransaction {
+	if x != nil {
+		return x.ScheduledTransactions
+	}
+	return nil
+}
+
+type CancelScheduledTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the scheduled tx.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *CancelScheduledTransactionRequest) Reset() {
+	*x = CancelScheduledTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransactionRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CancelScheduledTransactionRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type CancelScheduledTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTransaction *ScheduledTransaction `protobuf:"bytes,1,opt,name=scheduled_transaction,json=scheduledTransaction,proto3" json:"scheduled_transaction,omitempty"`
+}
+
+func (x *CancelScheduledTransactionResponse) Reset() {
+	*x = CancelScheduledTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransactionResponse) ProtoMessage() {}
+
+func (x *CancelScheduledTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelScheduledTransactionResponse) GetScheduledTransaction() *ScheduledTransaction {
+	if x != nil {
+		return x.ScheduledTransaction
+	}
+	return nil
+}
+
+type PegInAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PegInAddressRequest) Reset() {
+	*x = PegInAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PegInAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PegInAddressRequest) ProtoMessage() {}
+
+func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PegInAddressRequest.ProtoReflect.Descriptor instead.
+func (*PegInAddressRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+type PegInAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Main-chain deposit address to send bitcoin to.
+	MainChainAddress string `protobuf:"bytes,2,opt,name=main_chain_address,json=mainChainAddress,proto3" json:"main_chain_address,omitempty"`
+	// Claim script committed to by the main-chain address.
+	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+}
+
+func (x *PegInAddressResponse) Reset() {
+	*x = PegInAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PegInAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PegInAddressResponse) ProtoMessage() {}
+
+func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PegInAddressResponse.ProtoReflect.Descriptor instead.
+func (*PegInAddressResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PegInAddressResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *PegInAddressResponse) GetMainChainAddress() string {
+	if x != nil {
+		return x.MainChainAddress
+	}
+	return ""
+}
+
+func (x *PegInAddressResponse) GetClaimScript() string {
+	if x != nil {
+		return x.ClaimScript
+	}
+	return ""
+}
+
+type ClaimPegInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw bitcoin transaction (in hex) depositing bitcoin to the main-chain address generated by PegInAddress.
+	BitcoinTx string `protobuf:"bytes,1,opt,name=bitcoin_tx,json=bitcoinTx,proto3" json:"bitcoin_tx,omitempty"`
+	// A raw tx_out_proof (in hex) generated by the main-chain daemon's `gettxoutproof` containing a proof of only bitcoin_tx.
+	TxOutProof string `protobuf:"bytes,2,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
+	// The witness program generated by PegInAddress.
+	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+}
+
+func (x *ClaimPegInRequest) Reset() {
+	*x = ClaimPegInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimPegInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPegInRequest) ProtoMessage() {}
+
+func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPegInRequest.ProtoReflect.Descriptor instead.
+func (*ClaimPegInRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClaimPegInRequest) GetBitcoinTx() string {
+	if x != nil {
+		return x.BitcoinTx
+	}
+	return ""
+}
+
+func (x *ClaimPegInRequest) GetTxOutProof() string {
+	if x != nil {
+		return x.TxOutProof
+	}
+	return ""
+}
+
+func (x *ClaimPegInRequest) GetClaimScript() string {
+	if x != nil {
+		return x.ClaimScript
+	}
+	return ""
+}
+
+type ClaimPegInResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *ClaimPegInResponse) Reset() {
+	*x = ClaimPegInResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimPegInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPegInResponse) ProtoMessage() {}
+
+func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPegInResponse.ProtoReflect.Descriptor instead.
+func (*ClaimPegInResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClaimPegInResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type SignPsetWithSchnorrKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partial transaction to sign in base64 format.
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// The sighash type. SIGHASH_DEFAULT is used for any input that does not specify one.
+	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) Reset() {
+	*x = SignPsetWithSchnorrKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPsetWithSchnorrKeyRequest) ProtoMessage() {}
+
+func (x *SignPsetWithSchnorrKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPsetWithSchnorrKeyRequest.ProtoReflect.Descriptor instead.
+func (*SignPsetWithSchnorrKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) GetSighashType() uint32 {
+	if x != nil {
+		return x.SighashType
+	}
+	return 0
+}
+
+type SignPsetWithSchnorrKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedTx string `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) Reset() {
+	*x = SignPsetWithSchnorrKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPsetWithSchnorrKeyResponse) ProtoMessage() {}
+
+func (x *SignPsetWithSchnorrKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPsetWithSchnorrKeyResponse.ProtoReflect.Descriptor instead.
+func (*SignPsetWithSchnorrKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) GetSignedTx() string {
+	if x != nil {
+		return x.SignedTx
+	}
+	return ""
+}
+
+type ListSpendApprovalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSpendApprovalsRequest) Reset() {
+	*x = ListSpendApprovalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSpendApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendApprovalsRequest) ProtoMessage() {}
+
+func (x *ListSpendApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListSpendApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{58}
+}
+
+type ListSpendApprovalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of transactions waiting to be approved.
+	Approvals []*SpendApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *ListSpendApprovalsResponse) Reset() {
+	*x = ListSpendApprovalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSpendApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendApprovalsResponse) ProtoMessage() {}
+
+func (x *ListSpendApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListSpendApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListSpendApprovalsResponse) GetApprovals() []*SpendApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ApproveSpendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The approval id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApproveSpendRequest) Reset() {
+	*x = ApproveSpendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSpendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSpendRequest) ProtoMessage() {}
+
+func (x *ApproveSpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSpendRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSpendRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApproveSpendRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApproveSpendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signed tx, either in hex or base64 format like the one approved.
+	SignedTx string `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+	// The finalized tx in hex format, if the signed tx is complete.
+	TxHex string `protobuf:"bytes,2,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *ApproveSpendResponse) Reset() {
+	*x = ApproveSpendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSpendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSpendResponse) ProtoMessage() {}
+
+func (x *ApproveSpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSpendResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSpendResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ApproveSpendResponse) GetSignedTx() string {
+	if x != nil {
+		return x.SignedTx
+	}
+	return ""
+}
+
+func (x *ApproveSpendResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type RejectSpendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The approval id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectSpendRequest) Reset() {
+	*x = RejectSpendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectSpendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSpendRequest) ProtoMessage() {}
+
+func (x *RejectSpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSpendRequest.ProtoReflect.Descriptor instead.
+func (*RejectSpendRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RejectSpendRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectSpendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RejectSpendResponse) Reset() {
+	*x = RejectSpendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectSpendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSpendResponse) ProtoMessage() {}
+
+func (x *RejectSpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSpendResponse.ProtoReflect.Descriptor instead.
+func (*RejectSpendResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{63}
+}
+
+var File_ocean_v1_transaction_proto protoreflect.FileDescriptor
+
+var file_ocean_v1_transaction_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xaf, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
 	0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61,
 	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72,
 	0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x74, 0x72,
 	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x63,
 	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78,
 	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x56, 0x0a, 0x08,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41,
-	0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x42,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a,
-	0x11, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x22, 0x7c, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74,
-	0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75,
-	0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x63, 0x65,
-	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x74, 0x78,
-	0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73,
-	0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x98,
+	0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x08, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x42, 0x52,
+	0x41, 0x4e, 0x43, 0x48, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x52, 0x41,
+	0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x4b, 0x4e, 0x41, 0x50, 0x53, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x53,
+	0x54, 0x5f, 0x57, 0x41, 0x53, 0x54, 0x45, 0x10, 0x04, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f,
+	0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75,
+	0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x74, 0x78,
+	0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x2b, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98,
 	0x01, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
@@ -2065,152 +3850,333 @@ var file_ocean_v1_transaction_proto_rawDesc = []byte{
 	0x54, 0x79, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e,
 	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x34, 0x0a, 0x1b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x5d, 0x0a, 0x1b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
 	0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x32, 0x0a, 0x1c,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
-	0x22, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a,
-	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x73, 0x65, 0x74, 0x22, 0x7c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a,
-	0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x99, 0x01, 0x0a,
-	0x10, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
-	0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x61, 0x73,
-	0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x16, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x5f, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x52, 0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64,
-	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x42, 0x6c, 0x69, 0x6e,
-	0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x28, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65,
-	0x74, 0x22, 0x48, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68,
-	0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x53,
-	0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x73, 0x65, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x28, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x16, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x75,
+	0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x27,
+	0x0a, 0x11, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x71, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x50,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x26, 0x0a, 0x10, 0x53, 0x69,
+	0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73,
+	0x65, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x25,
+	0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22,
+	0x8e, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65,
+	0x22, 0x25, 0x0a, 0x0c, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8f, 0x03, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x5d, 0x0a, 0x17, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x15, 0x63,
+	0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x78, 0x48, 0x65, 0x78, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x53, 0x77, 0x65, 0x65, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x14, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0xc8, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
-	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22,
-	0x25, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
-	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f,
-	0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78,
-	0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79,
+	0x74, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x78, 0x69, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x6d, 0x0a, 0x0f, 0x42, 0x75, 0x6d, 0x70,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x16, 0x45, 0x6e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74,
-	0x65, 0x22, 0x25, 0x0a, 0x0c, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x29, 0x0a,
-	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x65, 0x67, 0x49,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x8a, 0x01, 0x0a, 0x14, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
-	0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61,
-	0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x77, 0x0a, 0x11,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x78,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x75, 0x74, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x2b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65,
-	0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74,
-	0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48,
-	0x65, 0x78, 0x22, 0x52, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x74, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61,
-	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3d, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73,
-	0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x1a,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65,
+	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x41, 0x74, 0x22, 0x72, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x76,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7a, 0x0a, 0x21, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x21, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22,
+	0x79, 0x0a, 0x22, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x65,
+	0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x77,
+	0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
+	0x54, 0x78, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x75, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x2b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x78, 0x48, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67,
+	0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3d, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e,
+	0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4a, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
 	0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x54, 0x78, 0x32, 0x97, 0x0a, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x65,
+	0x6e, 0x65, 0x64, 0x54, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x24, 0x0a, 0x12,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcb, 0x14, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1a,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65,
 	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46,
 	0x65, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
 	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -2260,35 +4226,113 @@ var file_ocean_v1_transaction_proto_rawDesc = []byte{
 	0x66, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
 	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x65,
-	0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65,
-	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x21, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x12,
+	0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6f,
-	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68,
-	0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0xa9, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x76, 0x75, 0x6c, 0x70, 0x65, 0x6d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f,
-	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f,
-	0x58, 0x58, 0xaa, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08,
-	0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x09, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a,
+	0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65,
+	0x67, 0x49, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63,
+	0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x12, 0x23, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa9, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x75, 0x6c, 0x70, 0x65, 0x6d, 0x76,
+	0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x4f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2304,102 +4348,178 @@ func file_ocean_v1_transaction_proto_rawDescGZIP() []byte {
 }
 
 var file_ocean_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ocean_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_ocean_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_ocean_v1_transaction_proto_goTypes = []interface{}{
-	(SelectUtxosRequest_Strategy)(0),       // 0: ocean.v1.SelectUtxosRequest.Strategy
-	(*GetTransactionRequest)(nil),          // 1: ocean.v1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),         // 2: ocean.v1.GetTransactionResponse
-	(*SelectUtxosRequest)(nil),             // 3: ocean.v1.SelectUtxosRequest
-	(*SelectUtxosResponse)(nil),            // 4: ocean.v1.SelectUtxosResponse
-	(*LockUtxosRequest)(nil),               // 5: ocean.v1.LockUtxosRequest
-	(*LockUtxosResponse)(nil),              // 6: ocean.v1.LockUtxosResponse
-	(*EstimateFeesRequest)(nil),            // 7: ocean.v1.EstimateFeesRequest
-	(*EstimateFeesResponse)(nil),           // 8: ocean.v1.EstimateFeesResponse
-	(*SignTransactionRequest)(nil),         // 9: ocean.v1.SignTransactionRequest
-	(*SignTransactionResponse)(nil),        // 10: ocean.v1.SignTransactionResponse
-	(*BroadcastTransactionRequest)(nil),    // 11: ocean.v1.BroadcastTransactionRequest
-	(*BroadcastTransactionResponse)(nil),   // 12: ocean.v1.BroadcastTransactionResponse
-	(*CreatePsetRequest)(nil),              // 13: ocean.v1.CreatePsetRequest
-	(*CreatePsetResponse)(nil),             // 14: ocean.v1.CreatePsetResponse
-	(*UpdatePsetRequest)(nil),              // 15: ocean.v1.UpdatePsetRequest
-	(*UpdatePsetResponse)(nil),             // 16: ocean.v1.UpdatePsetResponse
-	(*BlindPsetRequest)(nil),               // 17: ocean.v1.BlindPsetRequest
-	(*BlindPsetResponse)(nil),              // 18: ocean.v1.BlindPsetResponse
-	(*SignPsetRequest)(nil),                // 19: ocean.v1.SignPsetRequest
-	(*SignPsetResponse)(nil),               // 20: ocean.v1.SignPsetResponse
-	(*MintRequest)(nil),                    // 21: ocean.v1.MintRequest
-	(*MintResponse)(nil),                   // 22: ocean.v1.MintResponse
-	(*RemintRequest)(nil),                  // 23: ocean.v1.RemintRequest
-	(*RemintResponse)(nil),                 // 24: ocean.v1.RemintResponse
-	(*BurnRequest)(nil),                    // 25: ocean.v1.BurnRequest
-	(*BurnResponse)(nil),                   // 26: ocean.v1.BurnResponse
-	(*TransferRequest)(nil),                // 27: ocean.v1.TransferRequest
-	(*TransferResponse)(nil),               // 28: ocean.v1.TransferResponse
-	(*PegInAddressRequest)(nil),            // 29: ocean.v1.PegInAddressRequest
-	(*PegInAddressResponse)(nil),           // 30: ocean.v1.PegInAddressResponse
-	(*ClaimPegInRequest)(nil),              // 31: ocean.v1.ClaimPegInRequest
-	(*ClaimPegInResponse)(nil),             // 32: ocean.v1.ClaimPegInResponse
-	(*SignPsetWithSchnorrKeyRequest)(nil),  // 33: ocean.v1.SignPsetWithSchnorrKeyRequest
-	(*SignPsetWithSchnorrKeyResponse)(nil), // 34: ocean.v1.SignPsetWithSchnorrKeyResponse
-	(*BlockDetails)(nil),                   // 35: ocean.v1.BlockDetails
-	(*Utxo)(nil),                           // 36: ocean.v1.Utxo
-	(*Input)(nil),                          // 37: ocean.v1.Input
-	(*Output)(nil),                         // 38: ocean.v1.Output
-	(*UnblindedInput)(nil),                 // 39: ocean.v1.UnblindedInput
+	(SelectUtxosRequest_Strategy)(0),           // 0: ocean.v1.SelectUtxosRequest.Strategy
+	(*GetTransactionRequest)(nil),              // 1: ocean.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),             // 2: ocean.v1.GetTransactionResponse
+	(*SelectUtxosRequest)(nil),                 // 3: ocean.v1.SelectUtxosRequest
+	(*SelectUtxosResponse)(nil),                // 4: ocean.v1.SelectUtxosResponse
+	(*LockUtxosRequest)(nil),                   // 5: ocean.v1.LockUtxosRequest
+	(*LockUtxosResponse)(nil),                  // 6: ocean.v1.LockUtxosResponse
+	(*UnlockUtxosRequest)(nil),                 // 7: ocean.v1.UnlockUtxosRequest
+	(*UnlockUtxosResponse)(nil),                // 8: ocean.v1.UnlockUtxosResponse
+	(*EstimateFeesRequest)(nil),                // 9: ocean.v1.EstimateFeesRequest
+	(*EstimateFeesResponse)(nil),               // 10: ocean.v1.EstimateFeesResponse
+	(*SignTransactionRequest)(nil),             // 11: ocean.v1.SignTransactionRequest
+	(*SignTransactionResponse)(nil),            // 12: ocean.v1.SignTransactionResponse
+	(*BroadcastTransactionRequest)(nil),        // 13: ocean.v1.BroadcastTransactionRequest
+	(*BroadcastTransactionResponse)(nil),       // 14: ocean.v1.BroadcastTransactionResponse
+	(*CreatePsetRequest)(nil),                  // 15: ocean.v1.CreatePsetRequest
+	(*CreatePsetResponse)(nil),                 // 16: ocean.v1.CreatePsetResponse
+	(*UpdatePsetRequest)(nil),                  // 17: ocean.v1.UpdatePsetRequest
+	(*UpdatePsetResponse)(nil),                 // 18: ocean.v1.UpdatePsetResponse
+	(*BlindPsetRequest)(nil),                   // 19: ocean.v1.BlindPsetRequest
+	(*BlindPsetResponse)(nil),                  // 20: ocean.v1.BlindPsetResponse
+	(*SignPsetRequest)(nil),                    // 21: ocean.v1.SignPsetRequest
+	(*SignPsetResponse)(nil),                   // 22: ocean.v1.SignPsetResponse
+	(*MintRequest)(nil),                        // 23: ocean.v1.MintRequest
+	(*MintResponse)(nil),                       // 24: ocean.v1.MintResponse
+	(*RemintRequest)(nil),                      // 25: ocean.v1.RemintRequest
+	(*RemintResponse)(nil),                     // 26: ocean.v1.RemintResponse
+	(*BurnRequest)(nil),                        // 27: ocean.v1.BurnRequest
+	(*BurnResponse)(nil),                       // 28: ocean.v1.BurnResponse
+	(*TransferRequest)(nil),                    // 29: ocean.v1.TransferRequest
+	(*TransferResponse)(nil),                   // 30: ocean.v1.TransferResponse
+	(*SweepAccountRequest)(nil),                // 31: ocean.v1.SweepAccountRequest
+	(*SweepAccountResponse)(nil),               // 32: ocean.v1.SweepAccountResponse
+	(*ConsolidateUtxosRequest)(nil),            // 33: ocean.v1.ConsolidateUtxosRequest
+	(*ConsolidateUtxosResponse)(nil),           // 34: ocean.v1.ConsolidateUtxosResponse
+	(*BumpFeeRequest)(nil),                     // 35: ocean.v1.BumpFeeRequest
+	(*BumpFeeResponse)(nil),                    // 36: ocean.v1.BumpFeeResponse
+	(*EnqueuePaymentRequest)(nil),              // 37: ocean.v1.EnqueuePaymentRequest
+	(*EnqueuePaymentResponse)(nil),             // 38: ocean.v1.EnqueuePaymentResponse
+	(*GetPaymentRequest)(nil),                  // 39: ocean.v1.GetPaymentRequest
+	(*GetPaymentResponse)(nil),                 // 40: ocean.v1.GetPaymentResponse
+	(*FlushPaymentsRequest)(nil),               // 41: ocean.v1.FlushPaymentsRequest
+	(*FlushPaymentsResponse)(nil),              // 42: ocean.v1.FlushPaymentsResponse
+	(*GetBroadcastRequest)(nil),                // 43: ocean.v1.GetBroadcastRequest
+	(*GetBroadcastResponse)(nil),               // 44: ocean.v1.GetBroadcastResponse
+	(*ScheduleTransactionRequest)(nil),         // 45: ocean.v1.ScheduleTransactionRequest
+	(*ScheduleTransactionResponse)(nil),        // 46: ocean.v1.ScheduleTransactionResponse
+	(*GetScheduledTransactionRequest)(nil),     // 47: ocean.v1.GetScheduledTransactionRequest
+	(*GetScheduledTransactionResponse)(nil),    // 48: ocean.v1.GetScheduledTransactionResponse
+	(*ListScheduledTransactionsRequest)(nil),   // 49: ocean.v1.ListScheduledTransactionsRequest
+	(*ListScheduledTransactionsResponse)(nil),  // 50: ocean.v1.ListScheduledTransactionsResponse
+	(*CancelScheduledTransactionRequest)(nil),  // 51: ocean.v1.CancelScheduledTransactionRequest
+	(*CancelScheduledTransactionResponse)(nil), // 52: ocean.v1.CancelScheduledTransactionResponse
+	(*PegInAddressRequest)(nil),                // 53: ocean.v1.PegInAddressRequest
+	(*PegInAddressResponse)(nil),               // 54: ocean.v1.PegInAddressResponse
+	(*ClaimPegInRequest)(nil),                  // 55: ocean.v1.ClaimPegInRequest
+	(*ClaimPegInResponse)(nil),                 // 56: ocean.v1.ClaimPegInResponse
+	(*SignPsetWithSchnorrKeyRequest)(nil),      // 57: ocean.v1.SignPsetWithSchnorrKeyRequest
+	(*SignPsetWithSchnorrKeyResponse)(nil),     // 58: ocean.v1.SignPsetWithSchnorrKeyResponse
+	(*ListSpendApprovalsRequest)(nil),          // 59: ocean.v1.ListSpendApprovalsRequest
+	(*ListSpendApprovalsResponse)(nil),         // 60: ocean.v1.ListSpendApprovalsResponse
+	(*ApproveSpendRequest)(nil),                // 61: ocean.v1.ApproveSpendRequest
+	(*ApproveSpendResponse)(nil),               // 62: ocean.v1.ApproveSpendResponse
+	(*RejectSpendRequest)(nil),                 // 63: ocean.v1.RejectSpendRequest
+	(*RejectSpendResponse)(nil),                // 64: ocean.v1.RejectSpendResponse
+	(*BlockDetails)(nil),                       // 65: ocean.v1.BlockDetails
+	(*Utxo)(nil),                               // 66: ocean.v1.Utxo
+	(*Input)(nil),                              // 67: ocean.v1.Input
+	(*Output)(nil),                             // 68: ocean.v1.Output
+	(*UnblindedInput)(nil),                     // 69: ocean.v1.UnblindedInput
+	(BumpFeeMethod)(0),                         // 70: ocean.v1.BumpFeeMethod
+	(*Payment)(nil),                            // 71: ocean.v1.Payment
+	(*Broadcast)(nil),                          // 72: ocean.v1.Broadcast
+	(*ScheduledTransaction)(nil),               // 73: ocean.v1.ScheduledTransaction
+	(*SpendApproval)(nil),                      // 74: ocean.v1.SpendApproval
 }
 var file_ocean_v1_transaction_proto_depIdxs = []int32{
-	35, // 0: ocean.v1.GetTransactionResponse.block_details:type_name -> ocean.v1.BlockDetails
+	65, // 0: ocean.v1.GetTransactionResponse.block_details:type_name -> ocean.v1.BlockDetails
 	0,  // 1: ocean.v1.SelectUtxosRequest.strategy:type_name -> ocean.v1.SelectUtxosRequest.Strategy
-	36, // 2: ocean.v1.SelectUtxosResponse.utxos:type_name -> ocean.v1.Utxo
-	37, // 3: ocean.v1.LockUtxosRequest.utxos:type_name -> ocean.v1.Input
-	37, // 4: ocean.v1.EstimateFeesRequest.inputs:type_name -> ocean.v1.Input
-	38, // 5: ocean.v1.EstimateFeesRequest.outputs:type_name -> ocean.v1.Output
-	37, // 6: ocean.v1.CreatePsetRequest.inputs:type_name -> ocean.v1.Input
-	38, // 7: ocean.v1.CreatePsetRequest.outputs:type_name -> ocean.v1.Output
-	37, // 8: ocean.v1.UpdatePsetRequest.inputs:type_name -> ocean.v1.Input
-	38, // 9: ocean.v1.UpdatePsetRequest.outputs:type_name -> ocean.v1.Output
-	39, // 10: ocean.v1.BlindPsetRequest.extra_unblinded_inputs:type_name -> ocean.v1.UnblindedInput
-	38, // 11: ocean.v1.BurnRequest.receivers:type_name -> ocean.v1.Output
-	38, // 12: ocean.v1.TransferRequest.receivers:type_name -> ocean.v1.Output
-	1,  // 13: ocean.v1.TransactionService.GetTransaction:input_type -> ocean.v1.GetTransactionRequest
-	3,  // 14: ocean.v1.TransactionService.SelectUtxos:input_type -> ocean.v1.SelectUtxosRequest
-	5,  // 15: ocean.v1.TransactionService.LockUtxos:input_type -> ocean.v1.LockUtxosRequest
-	7,  // 16: ocean.v1.TransactionService.EstimateFees:input_type -> ocean.v1.EstimateFeesRequest
-	9,  // 17: ocean.v1.TransactionService.SignTransaction:input_type -> ocean.v1.SignTransactionRequest
-	11, // 18: ocean.v1.TransactionService.BroadcastTransaction:input_type -> ocean.v1.BroadcastTransactionRequest
-	13, // 19: ocean.v1.TransactionService.CreatePset:input_type -> ocean.v1.CreatePsetRequest
-	15, // 20: ocean.v1.TransactionService.UpdatePset:input_type -> ocean.v1.UpdatePsetRequest
-	17, // 21: ocean.v1.TransactionService.BlindPset:input_type -> ocean.v1.BlindPsetRequest
-	19, // 22: ocean.v1.TransactionService.SignPset:input_type -> ocean.v1.SignPsetRequest
-	21, // 23: ocean.v1.TransactionService.Mint:input_type -> ocean.v1.MintRequest
-	23, // 24: ocean.v1.TransactionService.Remint:input_type -> ocean.v1.RemintRequest
-	25, // 25: ocean.v1.TransactionService.Burn:input_type -> ocean.v1.BurnRequest
-	27, // 26: ocean.v1.TransactionService.Transfer:input_type -> ocean.v1.TransferRequest
-	29, // 27: ocean.v1.TransactionService.PegInAddress:input_type -> ocean.v1.PegInAddressRequest
-	31, // 28: ocean.v1.TransactionService.ClaimPegIn:input_type -> ocean.v1.ClaimPegInRequest
-	33, // 29: ocean.v1.TransactionService.SignPsetWithSchnorrKey:input_type -> ocean.v1.SignPsetWithSchnorrKeyRequest
-	2,  // 30: ocean.v1.TransactionService.GetTransaction:output_type -> ocean.v1.GetTransactionResponse
-	4,  // 31: ocean.v1.TransactionService.SelectUtxos:output_type -> ocean.v1.SelectUtxosResponse
-	6,  // 32: ocean.v1.TransactionService.LockUtxos:output_type -> ocean.v1.LockUtxosResponse
-	8,  // 33: ocean.v1.TransactionService.EstimateFees:output_type -> ocean.v1.EstimateFeesResponse
-	10, // 34: ocean.v1.TransactionService.SignTransaction:output_type -> ocean.v1.SignTransactionResponse
-	12, // 35: ocean.v1.TransactionService.BroadcastTransaction:output_type -> ocean.v1.BroadcastTransactionResponse
-	14, // 36: ocean.v1.TransactionService.CreatePset:output_type -> ocean.v1.CreatePsetResponse
-	16, // 37: ocean.v1.TransactionService.UpdatePset:output_type -> ocean.v1.UpdatePsetResponse
-	18, // 38: ocean.v1.TransactionService.BlindPset:output_type -> ocean.v1.BlindPsetResponse
-	20, // 39: ocean.v1.TransactionService.SignPset:output_type -> ocean.v1.SignPsetResponse
-	22, // 40: ocean.v1.TransactionService.Mint:output_type -> ocean.v1.MintResponse
-	24, // 41: ocean.v1.TransactionService.Remint:output_type -> ocean.v1.RemintResponse
-	26, // 42: ocean.v1.TransactionService.Burn:output_type -> ocean.v1.BurnResponse
-	28, // 43: ocean.v1.TransactionService.Transfer:output_type -> ocean.v1.TransferResponse
-	30, // 44: ocean.v1.TransactionService.PegInAddress:output_type -> ocean.v1.PegInAddressResponse
-	32, // 45: ocean.v1.TransactionService.ClaimPegIn:output_type -> ocean.v1.ClaimPegInResponse
-	34, // 46: ocean.v1.TransactionService.SignPsetWithSchnorrKey:output_type -> ocean.v1.SignPsetWithSchnorrKeyResponse
-	30, // [30:47] is the sub-list for method output_type
-	13, // [13:30] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	66, // 2: ocean.v1.SelectUtxosResponse.utxos:type_name -> ocean.v1.Utxo
+	67, // 3: ocean.v1.LockUtxosRequest.utxos:type_name -> ocean.v1.Input
+	67, // 4: ocean.v1.UnlockUtxosRequest.utxos:type_name -> ocean.v1.Input
+	67, // 5: ocean.v1.EstimateFeesRequest.inputs:type_name -> ocean.v1.Input
+	68, // 6: ocean.v1.EstimateFeesRequest.outputs:type_name -> ocean.v1.Output
+	67, // 7: ocean.v1.CreatePsetRequest.inputs:type_name -> ocean.v1.Input
+	68, // 8: ocean.v1.CreatePsetRequest.outputs:type_name -> ocean.v1.Output
+	67, // 9: ocean.v1.UpdatePsetRequest.inputs:type_name -> ocean.v1.Input
+	68, // 10: ocean.v1.UpdatePsetRequest.outputs:type_name -> ocean.v1.Output
+	69, // 11: ocean.v1.BlindPsetRequest.extra_unblinded_inputs:type_name -> ocean.v1.UnblindedInput
+	68, // 12: ocean.v1.BurnRequest.receivers:type_name -> ocean.v1.Output
+	68, // 13: ocean.v1.TransferRequest.receivers:type_name -> ocean.v1.Output
+	0,  // 14: ocean.v1.TransferRequest.coin_selection_strategy:type_name -> ocean.v1.SelectUtxosRequest.Strategy
+	70, // 15: ocean.v1.BumpFeeResponse.method:type_name -> ocean.v1.BumpFeeMethod
+	68, // 16: ocean.v1.EnqueuePaymentRequest.receiver:type_name -> ocean.v1.Output
+	71, // 17: ocean.v1.GetPaymentResponse.payment:type_name -> ocean.v1.Payment
+	72, // 18: ocean.v1.GetBroadcastResponse.broadcast:type_name -> ocean.v1.Broadcast
+	73, // 19: ocean.v1.ScheduleTransactionResponse.scheduled_transaction:type_name -> ocean.v1.ScheduledTransaction
+	73, // 20: ocean.v1.GetScheduledTransactionResponse.scheduled_transaction:type_name -> ocean.v1.ScheduledTransaction
+	73, // 21: ocean.v1.ListScheduledTransactionsResponse.scheduled_transactions:type_name -> ocean.v1.ScheduledTransaction
+	73, // 22: ocean.v1.CancelScheduledTransactionResponse.scheduled_transaction:type_name -> ocean.v1.ScheduledTransaction
+	74, // 23: ocean.v1.ListSpendApprovalsResponse.approvals:type_name -> ocean.v1.SpendApproval
+	1,  // 24: ocean.v1.TransactionService.GetTransaction:input_type -> ocean.v1.GetTransactionRequest
+	3,  // 25: ocean.v1.TransactionService.SelectUtxos:input_type -> ocean.v1.SelectUtxosRequest
+	5,  // 26: ocean.v1.TransactionService.LockUtxos:input_type -> ocean.v1.LockUtxosRequest
+	7,  // 27: ocean.v1.TransactionService.UnlockUtxos:input_type -> ocean.v1.UnlockUtxosRequest
+	9,  // 28: ocean.v1.TransactionService.EstimateFees:input_type -> ocean.v1.EstimateFeesRequest
+	11, // 29: ocean.v1.TransactionService.SignTransaction:input_type -> ocean.v1.SignTransactionRequest
+	13, // 30: ocean.v1.TransactionService.BroadcastTransaction:input_type -> ocean.v1.BroadcastTransactionRequest
+	15, // 31: ocean.v1.TransactionService.CreatePset:input_type -> ocean.v1.CreatePsetRequest
+	17, // 32: ocean.v1.TransactionService.UpdatePset:input_type -> ocean.v1.UpdatePsetRequest
+	19, // 33: ocean.v1.TransactionService.BlindPset:input_type -> ocean.v1.BlindPsetRequest
+	21, // 34: ocean.v1.TransactionService.SignPset:input_type -> ocean.v1.SignPsetRequest
+	23, // 35: ocean.v1.TransactionService.Mint:input_type -> ocean.v1.MintRequest
+	25, // 36: ocean.v1.TransactionService.Remint:input_type -> ocean.v1.RemintRequest
+	27, // 37: ocean.v1.TransactionService.Burn:input_type -> ocean.v1.BurnRequest
+	29, // 38: ocean.v1.TransactionService.Transfer:input_type -> ocean.v1.TransferRequest
+	31, // 39: ocean.v1.TransactionService.SweepAccount:input_type -> ocean.v1.SweepAccountRequest
+	33, // 40: ocean.v1.TransactionService.ConsolidateUtxos:input_type -> ocean.v1.ConsolidateUtxosRequest
+	35, // 41: ocean.v1.TransactionService.BumpFee:input_type -> ocean.v1.BumpFeeRequest
+	37, // 42: ocean.v1.TransactionService.EnqueuePayment:input_type -> ocean.v1.EnqueuePaymentRequest
+	39, // 43: ocean.v1.TransactionService.GetPayment:input_type -> ocean.v1.GetPaymentRequest
+	41, // 44: ocean.v1.TransactionService.FlushPayments:input_type -> ocean.v1.FlushPaymentsRequest
+	43, // 45: ocean.v1.TransactionService.GetBroadcast:input_type -> ocean.v1.GetBroadcastRequest
+	45, // 46: ocean.v1.TransactionService.ScheduleTransaction:input_type -> ocean.v1.ScheduleTransactionRequest
+	47, // 47: ocean.v1.TransactionService.GetScheduledTransaction:input_type -> ocean.v1.GetScheduledTransactionRequest
+	49, // 48: ocean.v1.TransactionService.ListScheduledTransactions:input_type -> ocean.v1.ListScheduledTransactionsRequest
+	51, // 49: ocean.v1.TransactionService.CancelScheduledTransaction:input_type -> ocean.v1.CancelScheduledTransactionRequest
+	53, // 50: ocean.v1.TransactionService.PegInAddress:input_type -> ocean.v1.PegInAddressRequest
+	55, // 51: ocean.v1.TransactionService.ClaimPegIn:input_type -> ocean.v1.ClaimPegInRequest
+	57, // 52: ocean.v1.TransactionService.SignPsetWithSchnorrKey:input_type -> ocean.v1.SignPsetWithSchnorrKeyRequest
+	59, // 53: ocean.v1.TransactionService.ListSpendApprovals:input_type -> ocean.v1.ListSpendApprovalsRequest
+	61, // 54: ocean.v1.TransactionService.ApproveSpend:input_type -> ocean.v1.ApproveSpendRequest
+	63, // 55: ocean.v1.TransactionService.RejectSpend:input_type -> ocean.v1.RejectSpendRequest
+	2,  // 56: ocean.v1.TransactionService.GetTransaction:output_type -> ocean.v1.GetTransactionResponse
+	4,  // 57: ocean.v1.TransactionService.SelectUtxos:output_type -> ocean.v1.SelectUtxosResponse
+	6,  // 58: ocean.v1.TransactionService.LockUtxos:output_type -> ocean.v1.LockUtxosResponse
+	8,  // 59: ocean.v1.TransactionService.UnlockUtxos:output_type -> ocean.v1.UnlockUtxosResponse
+	10, // 60: ocean.v1.TransactionService.EstimateFees:output_type -> ocean.v1.EstimateFeesResponse
+	12, // 61: ocean.v1.TransactionService.SignTransaction:output_type -> ocean.v1.SignTransactionResponse
+	14, // 62: ocean.v1.TransactionService.BroadcastTransaction:output_type -> ocean.v1.BroadcastTransactionResponse
+	16, // 63: ocean.v1.TransactionService.CreatePset:output_type -> ocean.v1.CreatePsetResponse
+	18, // 64: ocean.v1.TransactionService.UpdatePset:output_type -> ocean.v1.UpdatePsetResponse
+	20, // 65: ocean.v1.TransactionService.BlindPset:output_type -> ocean.v1.BlindPsetResponse
+	22, // 66: ocean.v1.TransactionService.SignPset:output_type -> ocean.v1.SignPsetResponse
+	24, // 67: ocean.v1.TransactionService.Mint:output_type -> ocean.v1.MintResponse
+	26, // 68: ocean.v1.TransactionService.Remint:output_type -> ocean.v1.RemintResponse
+	28, // 69: ocean.v1.TransactionService.Burn:output_type -> ocean.v1.BurnResponse
+	30, // 70: ocean.v1.TransactionService.Transfer:output_type -> ocean.v1.TransferResponse
+	32, // 71: ocean.v1.TransactionService.SweepAccount:output_type -> ocean.v1.SweepAccountResponse
+	34, // 72: ocean.v1.TransactionService.ConsolidateUtxos:output_type -> ocean.v1.ConsolidateUtxosResponse
+	36, // 73: ocean.v1.TransactionService.BumpFee:output_type -> ocean.v1.BumpFeeResponse
+	38, // 74: ocean.v1.TransactionService.EnqueuePayment:output_type -> ocean.v1.EnqueuePaymentResponse
+	40, // 75: ocean.v1.TransactionService.GetPayment:output_type -> ocean.v1.GetPaymentResponse
+	42, // 76: ocean.v1.TransactionService.FlushPayments:output_type -> ocean.v1.FlushPaymentsResponse
+	44, // 77: ocean.v1.TransactionService.GetBroadcast:output_type -> ocean.v1.GetBroadcastResponse
+	46, // 78: ocean.v1.TransactionService.ScheduleTransaction:output_type -> ocean.v1.ScheduleTransactionResponse
+	48, // 79: ocean.v1.TransactionService.GetScheduledTransaction:output_type -> ocean.v1.GetScheduledTransactionResponse
+	50, // 80: ocean.v1.TransactionService.ListScheduledTransactions:output_type -> ocean.v1.ListScheduledTransactionsResponse
+	52, // 81: ocean.v1.TransactionService.CancelScheduledTransaction:output_type -> ocean.v1.CancelScheduledTransactionResponse
+	54, // 82: ocean.v1.TransactionService.PegInAddress:output_type -> ocean.v1.PegInAddressResponse
+	56, // 83: ocean.v1.TransactionService.ClaimPegIn:output_type -> ocean.v1.ClaimPegInResponse
+	58, // 84: ocean.v1.TransactionService.SignPsetWithSchnorrKey:output_type -> ocean.v1.SignPsetWithSchnorrKeyResponse
+	60, // 85: ocean.v1.TransactionService.ListSpendApprovals:output_type -> ocean.v1.ListSpendApprovalsResponse
+	62, // 86: ocean.v1.TransactionService.ApproveSpend:output_type -> ocean.v1.ApproveSpendResponse
+	64, // 87: ocean.v1.TransactionService.RejectSpend:output_type -> ocean.v1.RejectSpendResponse
+	56, // [56:88] is the sub-list for method output_type
+	24, // [24:56] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_ocean_v1_transaction_proto_init() }
@@ -2421,8 +4541,176 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2433,8 +4721,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectUtxosRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2445,8 +4733,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectUtxosResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2457,8 +4745,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockUtxosRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlindPsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2469,8 +4757,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockUtxosResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlindPsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2481,8 +4769,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateFeesRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2493,8 +4781,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateFeesResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2505,8 +4793,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignTransactionRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2517,8 +4805,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2529,8 +4817,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTransactionRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemintRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2541,8 +4829,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemintResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2553,8 +4841,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2565,8 +4853,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2577,8 +4865,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2589,8 +4877,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2601,8 +4889,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlindPsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2613,8 +4901,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlindPsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,8 +4913,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignPsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateUtxosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,8 +4925,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignPsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateUtxosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,8 +4937,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2661,8 +4949,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2673,8 +4961,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemintRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueuePaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2685,8 +4973,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemintResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueuePaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2697,8 +4985,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,8 +4997,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2721,8 +5009,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushPaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2733,8 +5021,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushPaymentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2745,7 +5033,127 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PegInAddressRequest); i {
 			case 0:
 				return &v.state
@@ -2757,7 +5165,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PegInAddressResponse); i {
 			case 0:
 				return &v.state
@@ -2769,7 +5177,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimPegInRequest); i {
 			case 0:
 				return &v.state
@@ -2781,7 +5189,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimPegInResponse); i {
 			case 0:
 				return &v.state
@@ -2793,7 +5201,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignPsetWithSchnorrKeyRequest); i {
 			case 0:
 				return &v.state
@@ -2805,7 +5213,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignPsetWithSchnorrKeyResponse); i {
 			case 0:
 				return &v.state
@@ -2817,6 +5225,78 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
+		file_ocean_v1_transaction_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendApprovalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendApprovalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSpendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSpendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSpendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSpendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2824,7 +5304,7 @@ func file_ocean_v1_transaction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ocean_v1_transaction_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   34,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,7 +26,13 @@ type TransactionServiceClient interface {
 	// double-spending them.
 	SelectUtxos(ctx context.Context, in *SelectUtxosRequest, opts ...grpc.CallOption) (*SelectUtxosResponse, error)
 	// LockUtxos allows to manually select utxos to spend by a subsequent tx.
+	// Providing the lock token of a previous selection or lock extends the
+	// expiration of the utxos it owns.
 	LockUtxos(ctx context.Context, in *LockUtxosRequest, opts ...grpc.CallOption) (*LockUtxosResponse, error)
+	// UnlockUtxos releases the given locked utxos before their expiration.
+	// Only the owner of the lock, identified by its token, or an admin can
+	// unlock them.
+	UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error)
 	// EstimateFees returns the fee amount to pay for a tx containing the given
 	// inputs and outputs.
 	EstimateFees(ctx context.Context, in *EstimateFeesRequest, opts ...grpc.CallOption) (*EstimateFeesResponse, error)
@@ -50,6 +56,42 @@ type TransactionServiceClient interface {
 	Burn(ctx context.Context, in *BurnRequest, opts ...grpc.CallOption) (*BurnResponse, error)
 	// Transfer returns a transaction to send funds to some receiver.
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// SweepAccount sends all the spendable funds of one or all assets of an
+	// account to the given address, with no change. The fee amount is
+	// subtracted from the LBTC output, if any.
+	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	// ConsolidateUtxos merges the spendable utxos of an account below a value
+	// threshold into new ones, with as many txs as required to respect the max
+	// number of inputs per tx. The txs are broadcasted right away.
+	ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error)
+	// BumpFee raises the fee rate of an unconfirmed tx of the wallet. The tx is
+	// replaced by one spending the same inputs if it signals replaceability,
+	// otherwise a child tx spending the outputs of the wallet is broadcasted.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// EnqueuePayment adds a payment to the payout queue of an account. The
+	// queued payments are batched into a single tx, broadcasted when the queue
+	// is full or old enough, or when the deadline of any payment is reached.
+	EnqueuePayment(ctx context.Context, in *EnqueuePaymentRequest, opts ...grpc.CallOption) (*EnqueuePaymentResponse, error)
+	// GetPayment returns the status of a queued payment and, once broadcasted,
+	// the output of the tx paying it.
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	// FlushPayments batches right away the queued payments of one or all
+	// accounts. The txs are broadcasted right away.
+	FlushPayments(ctx context.Context, in *FlushPaymentsRequest, opts ...grpc.CallOption) (*FlushPaymentsResponse, error)
+	// GetBroadcast returns the status of a tx broadcasted by the wallet, which
+	// is tracked until confirmed and rebroadcasted if missing from mempool.
+	GetBroadcast(ctx context.Context, in *GetBroadcastRequest, opts ...grpc.CallOption) (*GetBroadcastResponse, error)
+	// ScheduleTransaction holds a signed tx until its locktime and the given
+	// time, if any, are reached, and then broadcasts it.
+	ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduleTransactionResponse, error)
+	// GetScheduledTransaction returns the status of a scheduled tx.
+	GetScheduledTransaction(ctx context.Context, in *GetScheduledTransactionRequest, opts ...grpc.CallOption) (*GetScheduledTransactionResponse, error)
+	// ListScheduledTransactions returns all the scheduled txs, whatever their
+	// status.
+	ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error)
+	// CancelScheduledTransaction prevents a scheduled tx from being broadcasted
+	// and unlocks its inputs.
+	CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*CancelScheduledTransactionResponse, error)
 	// PegInAddress returns what's necessary to peg funds of the Bitcoin
 	// main-chain and have them available on the Liquid side-chain.
 	// Bitcoin funds must be sent to the main-chain address while the claim
@@ -61,6 +103,17 @@ type TransactionServiceClient interface {
 	// SignPsetWithSchnorrKey signs all taproot inputs of the provided tx with
 	// the key at the given derivation path.
 	SignPsetWithSchnorrKey(ctx context.Context, in *SignPsetWithSchnorrKeyRequest, opts ...grpc.CallOption) (*SignPsetWithSchnorrKeyResponse, error)
+	// ListSpendApprovals returns the transactions waiting to be approved
+	// because exceeding the approval threshold of some account's spending policy.
+	ListSpendApprovals(ctx context.Context, in *ListSpendApprovalsRequest, opts ...grpc.CallOption) (*ListSpendApprovalsResponse, error)
+	// ApproveSpend signs the transaction waiting for the given approval. The
+	// caller must be authenticated with a TLS client certificate, and must be
+	// different from the one that requested to sign it.
+	ApproveSpend(ctx context.Context, in *ApproveSpendRequest, opts ...grpc.CallOption) (*ApproveSpendResponse, error)
+	// RejectSpend discards the transaction waiting for the given approval and
+	// unlocks its inputs. Like for ApproveSpend, the caller must be
+	// authenticated with a TLS client certificate.
+	RejectSpend(ctx context.Context, in *RejectSpendRequest, opts ...grpc.CallOption) (*RejectSpendResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -98,6 +151,15 @@ func (c *transactionServiceClient) LockUtxos(ctx context.Context, in *LockUtxosR
 	return out, nil
 }
 
+func (c *transactionServiceClient) UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error) {
+	out := new(UnlockUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/UnlockUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) EstimateFees(ctx context.Context, in *EstimateFeesRequest, opts ...grpc.CallOption) (*EstimateFeesResponse, error) {
 	out := new(EstimateFeesResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/EstimateFees", in, out, opts...)
@@ -197,6 +259,105 @@ func (c *transactionServiceClient) Transfer(ctx context.Context, in *TransferReq
 	return out, nil
 }
 
+func (c *transactionServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
+	out := new(SweepAccountResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/SweepAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error) {
+	out := new(ConsolidateUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ConsolidateUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) EnqueuePayment(ctx context.Context, in *EnqueuePaymentRequest, opts ...grpc.CallOption) (*EnqueuePaymentResponse, error) {
+	out := new(EnqueuePaymentResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/EnqueuePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FlushPayments(ctx context.Context, in *FlushPaymentsRequest, opts ...grpc.CallOption) (*FlushPaymentsResponse, error) {
+	out := new(FlushPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/FlushPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetBroadcast(ctx context.Context, in *GetBroadcastRequest, opts ...grpc.CallOption) (*GetBroadcastResponse, error) {
+	out := new(GetBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/GetBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduleTransactionResponse, error) {
+	out := new(ScheduleTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ScheduleTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetScheduledTransaction(ctx context.Context, in *GetScheduledTransactionRequest, opts ...grpc.CallOption) (*GetScheduledTransactionResponse, error) {
+	out := new(GetScheduledTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/GetScheduledTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error) {
+	out := new(ListScheduledTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListScheduledTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*CancelScheduledTransactionResponse, error) {
+	out := new(CancelScheduledTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CancelScheduledTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) PegInAddress(ctx context.Context, in *PegInAddressRequest, opts ...grpc.CallOption) (*PegInAddressResponse, error) {
 	out := new(PegInAddressResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/PegInAddress", in, out, opts...)
@@ -224,6 +385,33 @@ func (c *transactionServiceClient) SignPsetWithSchnorrKey(ctx context.Context, i
 	return out, nil
 }
 
+func (c *transactionServiceClient) ListSpendApprovals(ctx context.Context, in *ListSpendApprovalsRequest, opts ...grpc.CallOption) (*ListSpendApprovalsResponse, error) {
+	out := new(ListSpendApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListSpendApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ApproveSpend(ctx context.Context, in *ApproveSpendRequest, opts ...grpc.CallOption) (*ApproveSpendResponse, error) {
+	out := new(ApproveSpendResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ApproveSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) RejectSpend(ctx context.Context, in *RejectSpendRequest, opts ...grpc.CallOption) (*RejectSpendResponse, error) {
+	out := new(RejectSpendResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/RejectSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations should embed UnimplementedTransactionServiceServer
 // for forward compatibility
@@ -236,7 +424,13 @@ type TransactionServiceServer interface {
 	// double-spending them.
 	SelectUtxos(context.Context, *SelectUtxosRequest) (*SelectUtxosResponse, error)
 	// LockUtxos allows to manually select utxos to spend by a subsequent tx.
+	// Providing the lock token of a previous selection or lock extends the
+	// expiration of the utxos it owns.
 	LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error)
+	// UnlockUtxos releases the given locked utxos before their expiration.
+	// Only the owner of the lock, identified by its token, or an admin can
+	// unlock them.
+	UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error)
 	// EstimateFees returns the fee amount to pay for a tx containing the given
 	// inputs and outputs.
 	EstimateFees(context.Context, *EstimateFeesRequest) (*EstimateFeesResponse, error)
@@ -260,6 +454,42 @@ type TransactionServiceServer interface {
 	Burn(context.Context, *BurnRequest) (*BurnResponse, error)
 	// Transfer returns a transaction to send funds to some receiver.
 	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// SweepAccount sends all the spendable funds of one or all assets of an
+	// account to the given address, with no change. The fee amount is
+	// subtracted from the LBTC output, if any.
+	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	// ConsolidateUtxos merges the spendable utxos of an account below a value
+	// threshold into new ones, with as many txs as required to respect the max
+	// number of inputs per tx. The txs are broadcasted right away.
+	ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error)
+	// BumpFee raises the fee rate of an unconfirmed tx of the wallet. The tx is
+	// replaced by one spending the same inputs if it signals replaceability,
+	// otherwise a child tx spending the outputs of the wallet is broadcasted.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// EnqueuePayment adds a payment to the payout queue of an account. The
+	// queued payments are batched into a single tx, broadcasted when the queue
+	// is full or old enough, or when the deadline of any payment is reached.
+	EnqueuePayment(context.Context, *EnqueuePaymentRequest) (*EnqueuePaymentResponse, error)
+	// GetPayment returns the status of a queued payment and, once broadcasted,
+	// the output of the tx paying it.
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	// FlushPayments batches right away the queued payments of one or all
+	// accounts. The txs are broadcasted right away.
+	FlushPayments(context.Context, *FlushPaymentsRequest) (*FlushPaymentsResponse, error)
+	// GetBroadcast returns the status of a tx broadcasted by the wallet, which
+	// is tracked until confirmed and rebroadcasted if missing from mempool.
+	GetBroadcast(context.Context, *GetBroadcastRequest) (*GetBroadcastResponse, error)
+	// ScheduleTransaction holds a signed tx until its locktime and the given
+	// time, if any, are reached, and then broadcasts it.
+	ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduleTransactionResponse, error)
+	// GetScheduledTransaction returns the status of a scheduled tx.
+	GetScheduledTransaction(context.Context, *GetScheduledTransactionRequest) (*GetScheduledTransactionResponse, error)
+	// ListScheduledTransactions returns all the scheduled txs, whatever their
+	// status.
+	ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error)
+	// CancelScheduledTransaction prevents a scheduled tx from being broadcasted
+	// and unlocks its inputs.
+	CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*CancelScheduledTransactionResponse, error)
 	// PegInAddress returns what's necessary to peg funds of the Bitcoin
 	// main-chain and have them available on the Liquid side-chain.
 	// Bitcoin funds must be sent to the main-chain address while the claim
@@ -271,6 +501,17 @@ type TransactionServiceServer interface {
 	// SignPsetWithSchnorrKey signs all taproot inputs of the provided tx with
 	// the key at the given derivation path.
 	SignPsetWithSchnorrKey(context.Context, *SignPsetWithSchnorrKeyRequest) (*SignPsetWithSchnorrKeyResponse, error)
+	// ListSpendApprovals returns the transactions waiting to be approved
+	// because exceeding the approval threshold of some account's spending policy.
+	ListSpendApprovals(context.Context, *ListSpendApprovalsRequest) (*ListSpendApprovalsResponse, error)
+	// ApproveSpend signs the transaction waiting for the given approval. The
+	// caller must be authenticated with a TLS client certificate, and must be
+	// different from the one that requested to sign it.
+	ApproveSpend(context.Context, *ApproveSpendRequest) (*ApproveSpendResponse, error)
+	// RejectSpend discards the transaction waiting for the given approval and
+	// unlocks its inputs. Like for ApproveSpend, the caller must be
+	// authenticated with a TLS client certificate.
+	RejectSpend(context.Context, *RejectSpendRequest) (*RejectSpendResponse, error)
 }
 
 // UnimplementedTransactionServiceServer should be embedded to have forward compatible implementations.
@@ -286,6 +527,9 @@ func (UnimplementedTransactionServiceServer) SelectUtxos(context.Context, *Selec
 func (UnimplementedTransactionServiceServer) LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockUtxos not implemented")
 }
+func (UnimplementedTransactionServiceServer) UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUtxos not implemented")
+}
 func (UnimplementedTransactionServiceServer) EstimateFees(context.Context, *EstimateFeesRequest) (*EstimateFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateFees not implemented")
 }
@@ -319,6 +563,39 @@ func (UnimplementedTransactionServiceServer) Burn(context.Context, *BurnRequest)
 func (UnimplementedTransactionServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
+func (UnimplementedTransactionServiceServer) SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepAccount not implemented")
+}
+func (UnimplementedTransactionServiceServer) ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateUtxos not implemented")
+}
+func (UnimplementedTransactionServiceServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedTransactionServiceServer) EnqueuePayment(context.Context, *EnqueuePaymentRequest) (*EnqueuePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueuePayment not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedTransactionServiceServer) FlushPayments(context.Context, *FlushPaymentsRequest) (*FlushPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushPayments not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetBroadcast(context.Context, *GetBroadcastRequest) (*GetBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcast not implemented")
+}
+func (UnimplementedTransactionServiceServer) ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduleTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetScheduledTransaction(context.Context, *GetScheduledTransactionRequest) (*GetScheduledTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*CancelScheduledTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) PegInAddress(context.Context, *PegInAddressRequest) (*PegInAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PegInAddress not implemented")
 }
@@ -328,6 +605,15 @@ func (UnimplementedTransactionServiceServer) ClaimPegIn(context.Context, *ClaimP
 func (UnimplementedTransactionServiceServer) SignPsetWithSchnorrKey(context.Context, *SignPsetWithSchnorrKeyRequest) (*SignPsetWithSchnorrKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignPsetWithSchnorrKey not implemented")
 }
+func (UnimplementedTransactionServiceServer) ListSpendApprovals(context.Context, *ListSpendApprovalsRequest) (*ListSpendApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpendApprovals not implemented")
+}
+func (UnimplementedTransactionServiceServer) ApproveSpend(context.Context, *ApproveSpendRequest) (*ApproveSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSpend not implemented")
+}
+func (UnimplementedTransactionServiceServer) RejectSpend(context.Context, *RejectSpendRequest) (*RejectSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSpend not implemented")
+}
 
 // UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TransactionServiceServer will
@@ -394,6 +680,24 @@ func _TransactionService_LockUtxos_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_UnlockUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlockUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/UnlockUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlockUtxos(ctx, req.(*UnlockUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_EstimateFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EstimateFeesRequest)
 	if err := dec(in); err != nil {
@@ -592,6 +896,204 @@ func _TransactionService_Transfer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SweepAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/SweepAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ConsolidateUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ConsolidateUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ConsolidateUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ConsolidateUtxos(ctx, req.(*ConsolidateUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_EnqueuePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueuePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EnqueuePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/EnqueuePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EnqueuePayment(ctx, req.(*EnqueuePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FlushPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FlushPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/FlushPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FlushPayments(ctx, req.(*FlushPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/GetBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetBroadcast(ctx, req.(*GetBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ScheduleTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ScheduleTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ScheduleTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ScheduleTransaction(ctx, req.(*ScheduleTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetScheduledTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetScheduledTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/GetScheduledTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetScheduledTransaction(ctx, req.(*GetScheduledTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListScheduledTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListScheduledTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListScheduledTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListScheduledTransactions(ctx, req.(*ListScheduledTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CancelScheduledTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CancelScheduledTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/CancelScheduledTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CancelScheduledTransaction(ctx, req.(*CancelScheduledTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_PegInAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PegInAddressRequest)
 	if err := dec(in); err != nil {
@@ -646,6 +1148,60 @@ func _TransactionService_SignPsetWithSchnorrKey_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ListSpendApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpendApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListSpendApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListSpendApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListSpendApprovals(ctx, req.(*ListSpendApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ApproveSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ApproveSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ApproveSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ApproveSpend(ctx, req.(*ApproveSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_RejectSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RejectSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/RejectSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RejectSpend(ctx, req.(*RejectSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -665,6 +1221,10 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LockUtxos",
 			Handler:    _TransactionService_LockUtxos_Handler,
 		},
+		{
+			MethodName: "UnlockUtxos",
+			Handler:    _TransactionService_UnlockUtxos_Handler,
+		},
 		{
 			MethodName: "EstimateFees",
 			Handler:    _TransactionService_EstimateFees_Handler,
@@ -709,6 +1269,50 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _TransactionService_Transfer_Handler,
 		},
+		{
+			MethodName: "SweepAccount",
+			Handler:    _TransactionService_SweepAccount_Handler,
+		},
+		{
+			MethodName: "ConsolidateUtxos",
+			Handler:    _TransactionService_ConsolidateUtxos_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _TransactionService_BumpFee_Handler,
+		},
+		{
+			MethodName: "EnqueuePayment",
+			Handler:    _TransactionService_EnqueuePayment_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _TransactionService_GetPayment_Handler,
+		},
+		{
+			MethodName: "FlushPayments",
+			Handler:    _TransactionService_FlushPayments_Handler,
+		},
+		{
+			MethodName: "GetBroadcast",
+			Handler:    _TransactionService_GetBroadcast_Handler,
+		},
+		{
+			MethodName: "ScheduleTransaction",
+			Handler:    _TransactionService_ScheduleTransaction_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransaction",
+			Handler:    _TransactionService_GetScheduledTransaction_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransactions",
+			Handler:    _TransactionService_ListScheduledTransactions_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransaction",
+			Handler:    _TransactionService_CancelScheduledTransaction_Handler,
+		},
 		{
 			MethodName: "PegInAddress",
 			Handler:    _TransactionService_PegInAddress_Handler,
@@ -721,6 +1325,18 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignPsetWithSchnorrKey",
 			Handler:    _TransactionService_SignPsetWithSchnorrKey_Handler,
 		},
+		{
+			MethodName: "ListSpendApprovals",
+			Handler:    _TransactionService_ListSpendApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveSpend",
+			Handler:    _TransactionService_ApproveSpend_Handler,
+		},
+		{
+			MethodName: "RejectSpend",
+			Handler:    _TransactionService_RejectSpend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ocean/v1/transaction.proto",
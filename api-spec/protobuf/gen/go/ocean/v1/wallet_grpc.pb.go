@@ -40,6 +40,20 @@ type WalletServiceClient interface {
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	// Auth verifies whether the given password is valid without unlocking the wallet
 	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// ListAuditEvents returns a page of the hash-chained audit log of all the
+	// state-changing operations, sorted by sequence.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// RotateTLS regenerates the TLS key pair of the daemon with a certificate
+	// bound to the given extra IPs and domains. The new certificate is served
+	// right away, without restarting the daemon.
+	RotateTLS(ctx context.Context, in *RotateTLSRequest, opts ...grpc.CallOption) (*RotateTLSResponse, error)
+	// ExportBackup returns a versioned backup of the wallet, its accounts,
+	// external scripts and spending policies, and optionally of the utxo and
+	// transaction cache, encrypted with the wallet password.
+	ExportBackup(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (*ExportBackupResponse, error)
+	// ImportBackup restores a previously exported backup into a not yet
+	// initialized wallet, regardless of the type of database in use.
+	ImportBackup(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (*ImportBackupResponse, error)
 }
 
 type walletServiceClient struct {
@@ -154,6 +168,42 @@ func (c *walletServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ..
 	return out, nil
 }
 
+func (c *walletServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.WalletService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RotateTLS(ctx context.Context, in *RotateTLSRequest, opts ...grpc.CallOption) (*RotateTLSResponse, error) {
+	out := new(RotateTLSResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.WalletService/RotateTLS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ExportBackup(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (*ExportBackupResponse, error) {
+	out := new(ExportBackupResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.WalletService/ExportBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportBackup(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (*ImportBackupResponse, error) {
+	out := new(ImportBackupResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.WalletService/ImportBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations should embed UnimplementedWalletServiceServer
 // for forward compatibility
@@ -180,6 +230,20 @@ type WalletServiceServer interface {
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	// Auth verifies whether the given password is valid without unlocking the wallet
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	// ListAuditEvents returns a page of the hash-chained audit log of all the
+	// state-changing operations, sorted by sequence.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// RotateTLS regenerates the TLS key pair of the daemon with a certificate
+	// bound to the given extra IPs and domains. The new certificate is served
+	// right away, without restarting the daemon.
+	RotateTLS(context.Context, *RotateTLSRequest) (*RotateTLSResponse, error)
+	// ExportBackup returns a versioned backup of the wallet, its accounts,
+	// external scripts and spending policies, and optionally of the utxo and
+	// transaction cache, encrypted with the wallet password.
+	ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error)
+	// ImportBackup restores a previously exported backup into a not yet
+	// initialized wallet, regardless of the type of database in use.
+	ImportBackup(context.Context, *ImportBackupRequest) (*ImportBackupResponse, error)
 }
 
 // UnimplementedWalletServiceServer should be embedded to have forward compatible implementations.
@@ -213,6 +277,18 @@ func (UnimplementedWalletServiceServer) GetInfo(context.Context, *GetInfoRequest
 func (UnimplementedWalletServiceServer) Auth(context.Context, *AuthRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
 }
+func (UnimplementedWalletServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedWalletServiceServer) RotateTLS(context.Context, *RotateTLSRequest) (*RotateTLSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTLS not implemented")
+}
+func (UnimplementedWalletServiceServer) ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBackup not implemented")
+}
+func (UnimplementedWalletServiceServer) ImportBackup(context.Context, *ImportBackupRequest) (*ImportBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBackup not implemented")
+}
 
 // UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WalletServiceServer will
@@ -390,6 +466,78 @@ func _WalletService_Auth_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.WalletService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RotateTLS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTLSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RotateTLS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.WalletService/RotateTLS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RotateTLS(ctx, req.(*RotateTLSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ExportBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExportBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.WalletService/ExportBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExportBackup(ctx, req.(*ExportBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.WalletService/ImportBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportBackup(ctx, req.(*ImportBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -429,6 +577,22 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Auth",
 			Handler:    _WalletService_Auth_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _WalletService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "RotateTLS",
+			Handler:    _WalletService_RotateTLS_Handler,
+		},
+		{
+			MethodName: "ExportBackup",
+			Handler:    _WalletService_ExportBackup_Handler,
+		},
+		{
+			MethodName: "ImportBackup",
+			Handler:    _WalletService_ImportBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -13,6 +14,9 @@ var (
 	accountName, accountLabel      string
 	numOfAddresses                 uint64
 	accountUnconf, changeAddresses bool
+	utxosLabel, utxosAsset         string
+	utxosFrozen                    string
+	utxosMinConfirmations          uint32
 
 	accountCreateCmd = &cobra.Command{
 		Use:   "create",
@@ -87,6 +91,21 @@ func init() {
 		"whether derive change (internal) addresses",
 	)
 
+	accountListUtxosCmd.Flags().StringVar(
+		&utxosLabel, "label", "", "list only the utxos with this label",
+	)
+	accountListUtxosCmd.Flags().StringVar(
+		&utxosAsset, "asset", "", "list only the utxos of this asset",
+	)
+	accountListUtxosCmd.Flags().Uint32Var(
+		&utxosMinConfirmations, "min-confirmations", 0,
+		"list only the utxos with at least this number of confirmations",
+	)
+	accountListUtxosCmd.Flags().StringVar(
+		&utxosFrozen, "frozen", "",
+		"use 'only' to list only frozen utxos, 'exclude' to omit them",
+	)
+
 	accountCmd.PersistentFlags().StringVar(
 		&accountName, "account-name", "", "account namespace or label",
 	)
@@ -203,23 +222,19 @@ func accountDeriveAddresses(cmd *cobra.Command, _ []string) error {
 }
 
 func accountBalance(cmd *cobra.Command, _ []string) error {
-	client, cleanup, err := getAccountClient()
+	c, err := getClient()
 	if err != nil {
 		return err
 	}
-	defer cleanup()
+	defer c.Close()
 
-	reply, err := client.Balance(
-		context.Background(), &pb.BalanceRequest{
-			AccountName: accountName,
-		},
-	)
+	balance, err := c.Balance(context.Background(), accountName)
 	if err != nil {
 		printErr(err)
 		return nil
 	}
 
-	jsonReply, err := jsonResponse(reply)
+	jsonReply, err := jsonResponse(&pb.BalanceResponse{Balance: balance})
 	if err != nil {
 		printErr(err)
 		return nil
@@ -257,15 +272,31 @@ func accountListAddresses(cmd *cobra.Command, _ []string) error {
 }
 
 func accountListUtxos(cmd *cobra.Command, _ []string) error {
-	client, cleanup, err := getAccountClient()
+	var frozenFilter pb.UtxoFrozenFilter
+	switch utxosFrozen {
+	case "":
+	case "only":
+		frozenFilter = pb.UtxoFrozenFilter_UTXO_FROZEN_FILTER_ONLY
+	case "exclude":
+		frozenFilter = pb.UtxoFrozenFilter_UTXO_FROZEN_FILTER_EXCLUDE
+	default:
+		printErr(fmt.Errorf("invalid frozen filter, must be only or exclude"))
+		return nil
+	}
+
+	c, err := getClient()
 	if err != nil {
 		return err
 	}
-	defer cleanup()
-
-	reply, err := client.ListUtxos(
-		context.Background(), &pb.ListUtxosRequest{
-			AccountName: accountName,
+	defer c.Close()
+
+	spendable, locked, frozen, err := c.FilterUtxos(
+		context.Background(), client.UtxoFilterArgs{
+			AccountName:      accountName,
+			Label:            utxosLabel,
+			Asset:            utxosAsset,
+			MinConfirmations: utxosMinConfirmations,
+			FrozenFilter:     frozenFilter,
 		},
 	)
 	if err != nil {
@@ -273,7 +304,11 @@ func accountListUtxos(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	jsonReply, err := jsonResponse(reply)
+	jsonReply, err := jsonResponse(&pb.ListUtxosResponse{
+		SpendableUtxos: &pb.Utxos{AccountName: accountName, Utxos: spendable},
+		LockedUtxos:    &pb.Utxos{AccountName: accountName, Utxos: locked},
+		FrozenUtxos:    &pb.Utxos{AccountName: accountName, Utxos: frozen},
+	})
 	if err != nil {
 		printErr(err)
 		return nil
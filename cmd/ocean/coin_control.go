@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
+)
+
+var (
+	coinControlUtxos   []string
+	coinControlLabels  []string
+	coinControlAddress string
+	lockToken          string
+	lockExpiry         uint64
+
+	accountFreezeCmd = &cobra.Command{
+		Use:   "freeze",
+		Short: "freeze account utxos",
+		Long: "this command lets you exclude the given utxos (txid:index) from " +
+			"any coin selection until they are unfrozen",
+		RunE: accountFreezeUtxos,
+	}
+	accountUnfreezeCmd = &cobra.Command{
+		Use:   "unfreeze",
+		Short: "unfreeze account utxos",
+		Long: "this command lets you make the given frozen utxos (txid:index) " +
+			"spendable again",
+		RunE: accountUnfreezeUtxos,
+	}
+	accountLabelUtxosCmd = &cobra.Command{
+		Use:   "label-utxos",
+		Short: "set labels for account utxos",
+		Long: "this command lets you replace the labels of the given utxos " +
+			"(txid:index), or remove them if no label is specified",
+		RunE: accountLabelUtxos,
+	}
+	accountLabelAddressCmd = &cobra.Command{
+		Use:   "label-address",
+		Short: "set labels for an account address",
+		Long: "this command lets you replace the labels of a derived address of " +
+			"the account, or remove them if no label is specified",
+		RunE: accountLabelAddress,
+	}
+	accountLockUtxosCmd = &cobra.Command{
+		Use:   "lock",
+		Short: "lock account utxos",
+		Long: "this command lets you lock the given utxos (txid:index) to prevent " +
+			"other transactions from spending them. The returned lock token is " +
+			"required to extend or release the lock",
+		RunE: accountLockUtxos,
+	}
+	accountUnlockUtxosCmd = &cobra.Command{
+		Use:   "unlock",
+		Short: "unlock account utxos",
+		Long: "this command lets you release the lock of the given utxos " +
+			"(txid:index) before it expires. Only the owner of the lock token, " +
+			"or an admin, can unlock them",
+		RunE: accountUnlockUtxos,
+	}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{
+		accountFreezeCmd, accountUnfreezeCmd, accountLabelUtxosCmd,
+		accountLockUtxosCmd, accountUnlockUtxosCmd,
+	} {
+		cmd.Flags().StringSliceVar(
+			&coinControlUtxos, "utxo", nil, "utxo as txid:index, repeatable",
+		)
+		cmd.MarkFlagRequired("utxo")
+	}
+	for _, cmd := range []*cobra.Command{
+		accountLabelUtxosCmd, accountLabelAddressCmd,
+	} {
+		cmd.Flags().StringSliceVar(
+			&coinControlLabels, "labels", nil, "comma separated list of labels",
+		)
+	}
+	accountLabelAddressCmd.Flags().StringVar(
+		&coinControlAddress, "address", "", "derived address to label",
+	)
+	accountLabelAddressCmd.MarkFlagRequired("address")
+	for _, cmd := range []*cobra.Command{
+		accountLockUtxosCmd, accountUnlockUtxosCmd,
+	} {
+		cmd.Flags().StringVar(&lockToken, "lock-token", "", "token of the lock")
+	}
+	accountLockUtxosCmd.Flags().Uint64Var(
+		&lockExpiry, "expiry", 0,
+		"lock duration in seconds, the daemon default is used if not set",
+	)
+
+	accountFreezeCmd.MarkPersistentFlagRequired("account-name")
+	accountUnfreezeCmd.MarkPersistentFlagRequired("account-name")
+	accountLabelUtxosCmd.MarkPersistentFlagRequired("account-name")
+	accountLabelAddressCmd.MarkPersistentFlagRequired("account-name")
+	accountLockUtxosCmd.MarkPersistentFlagRequired("account-name")
+	accountUnlockUtxosCmd.MarkPersistentFlagRequired("account-name")
+
+	accountCmd.AddCommand(
+		accountFreezeCmd, accountUnfreezeCmd, accountLabelUtxosCmd,
+		accountLabelAddressCmd, accountLockUtxosCmd, accountUnlockUtxosCmd,
+	)
+}
+
+func accountFreezeUtxos(_ *cobra.Command, _ []string) error {
+	utxos, err := parseUtxoRefs(coinControlUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	count, err := c.FreezeUtxos(context.Background(), accountName, utxos...)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.FreezeUtxosResponse{Count: count})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func accountUnfreezeUtxos(_ *cobra.Command, _ []string) error {
+	utxos, err := parseUtxoRefs(coinControlUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	count, err := c.UnfreezeUtxos(context.Background(), accountName, utxos...)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.UnfreezeUtxosResponse{Count: count})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func accountLabelUtxos(_ *cobra.Command, _ []string) error {
+	utxos, err := parseUtxoRefs(coinControlUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	count, err := c.SetUtxoLabels(
+		context.Background(), accountName, coinControlLabels, utxos...,
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.SetUtxoLabelsResponse{Count: count})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func accountLabelAddress(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if err := c.SetAddressLabels(
+		context.Background(), accountName, coinControlAddress,
+		coinControlLabels...,
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("address labels updated")
+	return nil
+}
+
+func accountLockUtxos(_ *cobra.Command, _ []string) error {
+	utxos, err := parseUtxoRefs(coinControlUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	lock, err := c.LockUtxos(context.Background(), client.LockUtxosArgs{
+		AccountName: accountName,
+		Utxos:       utxos,
+		LockToken:   lockToken,
+		Duration:    time.Duration(lockExpiry) * time.Second,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.LockUtxosResponse{
+		ExpirationDate: lock.ExpirationDate,
+		LockToken:      lock.Token,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func accountUnlockUtxos(_ *cobra.Command, _ []string) error {
+	utxos, err := parseUtxoRefs(coinControlUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	count, err := c.UnlockUtxos(
+		context.Background(), accountName, lockToken, utxos...,
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.UnlockUtxosResponse{Count: count})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func parseUtxoRefs(utxos []string) ([]client.UtxoRef, error) {
+	refs := make([]client.UtxoRef, 0, len(utxos))
+	for _, u := range utxos {
+		txid, index, ok := strings.Cut(u, ":")
+		if !ok || len(txid) <= 0 {
+			return nil, fmt.Errorf("invalid utxo %s, must be txid:index", u)
+		}
+		vout, err := strconv.ParseUint(index, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index for utxo %s", u)
+		}
+		refs = append(refs, client.UtxoRef{Txid: txid, Index: uint32(vout)})
+	}
+	return refs, nil
+}
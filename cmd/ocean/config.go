@@ -9,9 +9,12 @@ import (
 )
 
 var (
-	rpcServer   string
-	noTLS       bool
-	tlsCertPath string
+	rpcServer         string
+	noTLS             bool
+	tlsCertPath       string
+	tlsClientCertPath string
+	tlsClientKeyPath  string
+	walletID          string
 
 	configSetCmd = &cobra.Command{
 		Use:   "set",
@@ -51,6 +54,21 @@ func init() {
 		"the path of the TLS certificate file to use to connect to the ocean "+
 			"wallet if it has TLS enabled",
 	)
+	configInitCmd.Flags().StringVar(
+		&tlsClientCertPath, "tls-client-cert-path", "",
+		"the path of the TLS client certificate file to use to authenticate "+
+			"with the ocean wallet if it has client authentication enabled",
+	)
+	configInitCmd.Flags().StringVar(
+		&tlsClientKeyPath, "tls-client-key-path", "",
+		"the path of the TLS client key file to use to authenticate "+
+			"with the ocean wallet if it has client authentication enabled",
+	)
+	configInitCmd.Flags().StringVar(
+		&walletID, "wallet-id", "",
+		"the id of the wallet to use if the ocean daemon serves more than one, "+
+			"the default one is used if not set",
+	)
 	configCmd.AddCommand(configSetCmd, configInitCmd)
 }
 
@@ -68,8 +86,14 @@ func configSet(cmd *cobra.Command, args []string) error {
 		partialState["tls_cert_path"] = ""
 		if val, _ := strconv.ParseBool(value); !val {
 			partialState["tls_cert_path"] = initialState()["tls_cert_path"]
+		} else {
+			partialState["tls_client_cert_path"] = ""
+			partialState["tls_client_key_path"] = ""
 		}
 	}
+	if key == "tls_client_cert_path" || key == "tls_client_key_path" {
+		value = cleanAndExpandPath(value)
+	}
 	if key == "tls_cert_path" {
 		partialState["no_tls"] = "true"
 		if len(value) > 0 {
@@ -89,11 +113,16 @@ func configSet(cmd *cobra.Command, args []string) error {
 func configInit(cmd *cobra.Command, args []string) error {
 	if noTLS {
 		tlsCertPath = ""
+		tlsClientCertPath = ""
+		tlsClientKeyPath = ""
 	}
 	if err := setState(map[string]string{
-		"rpcserver":     rpcServer,
-		"no_tls":        strconv.FormatBool(noTLS),
-		"tls_cert_path": tlsCertPath,
+		"rpcserver":            rpcServer,
+		"no_tls":               strconv.FormatBool(noTLS),
+		"tls_cert_path":        tlsCertPath,
+		"tls_client_cert_path": cleanAndExpandPath(tlsClientCertPath),
+		"tls_client_key_path":  cleanAndExpandPath(tlsClientKeyPath),
+		"wallet_id":            walletID,
 	}); err != nil {
 		return err
 	}
@@ -66,8 +66,11 @@ func initialState() map[string]string {
 	}
 
 	return map[string]string{
-		"rpcserver":     "localhost:18000",
-		"no_tls":        strconv.FormatBool(false),
-		"tls_cert_path": filepath.Join(datadir, "tls", "cert.pem"),
+		"rpcserver":            "localhost:18000",
+		"no_tls":               strconv.FormatBool(false),
+		"tls_cert_path":        filepath.Join(datadir, "tls", "cert.pem"),
+		"tls_client_cert_path": "",
+		"tls_client_key_path":  "",
+		"wallet_id":            "",
 	}
 }
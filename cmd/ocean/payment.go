@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
+)
+
+var (
+	paymentReceiverJSON string
+	paymentDeadline     int64
+	paymentId           string
+
+	paymentEnqueueCmd = &cobra.Command{
+		Use:   "enqueue",
+		Short: "add a payment to the payout queue of an account",
+		Long: "this command lets you queue a payment to the given receiver " +
+			"({asset, amount, address}), broadcasted by the daemon along with " +
+			"the other queued ones of the account in a single transaction",
+		RunE: paymentEnqueue,
+	}
+	paymentGetCmd = &cobra.Command{
+		Use:   "get",
+		Short: "get the status of a queued payment",
+		Long: "this command lets you know whether a queued payment has been " +
+			"broadcasted or confirmed, and the transaction output paying it",
+		RunE: paymentGet,
+	}
+	paymentFlushCmd = &cobra.Command{
+		Use:   "flush",
+		Short: "broadcast right away the queued payments",
+		Long: "this command lets you broadcast right away the queued payments " +
+			"of an account, or of all accounts if not specified",
+		RunE: paymentFlush,
+	}
+	paymentCmd = &cobra.Command{
+		Use:   "payment",
+		Short: "interact with the payout queue",
+		Long: "this command lets you queue payments that the daemon batches " +
+			"into a single transaction when the queue is full or old enough, " +
+			"or when the deadline of any payment is reached",
+	}
+)
+
+func init() {
+	paymentEnqueueCmd.Flags().StringVar(
+		&paymentReceiverJSON, "receiver", "",
+		"JSON string of the receiver as "+
+			"{\"address\": \"<address>\", \"amount\": <amount in BTC>, \"asset\": \"<asset>\"}",
+	)
+	paymentEnqueueCmd.Flags().Int64Var(
+		&paymentDeadline, "deadline", 0,
+		"optional unix timestamp by which the payment must be broadcasted",
+	)
+
+	paymentGetCmd.Flags().StringVar(&paymentId, "id", "", "id of the payment")
+
+	paymentCmd.AddCommand(paymentEnqueueCmd, paymentGetCmd, paymentFlushCmd)
+	txCmd.AddCommand(paymentCmd)
+}
+
+func paymentEnqueue(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	receiver := output{}
+	if err := json.Unmarshal([]byte(paymentReceiverJSON), &receiver); err != nil {
+		printErr(fmt.Errorf("invalid receiver: %s", err))
+		return nil
+	}
+
+	id, err := c.EnqueuePayment(context.Background(), client.EnqueuePaymentArgs{
+		AccountName:    accountName,
+		Receiver:       receiver.receiver(),
+		Deadline:       paymentDeadline,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.EnqueuePaymentResponse{PaymentId: id})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func paymentGet(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	payment, err := c.GetPayment(context.Background(), paymentId)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.GetPaymentResponse{Payment: payment})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func paymentFlush(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	txids, err := c.FlushPayments(
+		context.Background(), accountName, uint64(satsPerByte*1000),
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(&pb.FlushPaymentsResponse{Txids: txids})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
@@ -9,12 +9,35 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
 )
 
 var (
 	satsPerByte     float32
 	txReceiversJSON []string
 	txNoBroadcast   bool
+	idempotencyKey  string
+	coinSelection   string
+	txSubtractFee   bool
+	sweepAddress    string
+	sweepAssets     []string
+
+	consolidationAsset     string
+	consolidationThreshold uint64
+	consolidationMaxInputs uint32
+
+	bumpFeeTxid string
+
+	txLocktime    uint32
+	txSequence    uint32
+	txBroadcastAt int64
+	scheduledTxid string
+
+	coinSelectionStrategies = map[string]pb.SelectUtxosRequest_Strategy{
+		"smallest-subset": pb.SelectUtxosRequest_STRATEGY_UNSPECIFIED,
+		"branch-bound":    pb.SelectUtxosRequest_STRATEGY_LOWEST_WASTE,
+		"knapsack":        pb.SelectUtxosRequest_STRATEGY_KNAPSACK,
+	}
 
 	txTransferCmd = &cobra.Command{
 		Use:   "transfer",
@@ -23,6 +46,53 @@ var (
 			"({asset, amount, addrres})",
 		RunE: txTransfer,
 	}
+	txSweepCmd = &cobra.Command{
+		Use:   "sweep",
+		Short: "send all funds of one or more assets to an address",
+		Long: "this command lets you send all the spendable funds of the given " +
+			"assets, or of all assets, to an address without change, paying " +
+			"the fees with the LBTC funds sent",
+		RunE: txSweep,
+	}
+	txConsolidateCmd = &cobra.Command{
+		Use:   "consolidate",
+		Short: "merge the small utxos of an account",
+		Long: "this command lets you merge the utxos of an account below a " +
+			"value threshold into new ones, with as many transactions as " +
+			"required to respect the max number of inputs per transaction",
+		RunE: txConsolidate,
+	}
+	txBumpFeeCmd = &cobra.Command{
+		Use:   "bump-fee",
+		Short: "raise the fees of an unconfirmed transaction",
+		Long: "this command lets you raise the fee rate of an unconfirmed " +
+			"transaction to the given sats/byte ratio, either by replacing it " +
+			"if it signals replaceability, or with a child transaction spending " +
+			"its outputs owned by the wallet",
+		RunE: txBumpFee,
+	}
+	txScheduleCmd = &cobra.Command{
+		Use:   "schedule",
+		Short: "broadcast a transaction once its locktime is reached",
+		Long: "this command lets you hand over a final signed transaction " +
+			"(in hex format) to be broadcasted once its locktime and the " +
+			"given time, if any, are reached",
+		RunE: txSchedule,
+	}
+	txScheduledCmd = &cobra.Command{
+		Use:   "scheduled",
+		Short: "list the scheduled transactions",
+		Long: "this command lets you get the status of the given scheduled " +
+			"transaction, or of all of them if not specified",
+		RunE: txScheduled,
+	}
+	txCancelScheduledCmd = &cobra.Command{
+		Use:   "cancel-scheduled",
+		Short: "cancel a scheduled transaction",
+		Long: "this command lets you prevent a scheduled transaction from " +
+			"being broadcasted and unlock its inputs",
+		RunE: txCancelScheduled,
+	}
 	txBroadcastCmd = &cobra.Command{
 		Use:   "broadcast",
 		Short: "send a transaction over the network to be included in a block",
@@ -46,6 +116,64 @@ func init() {
 			"{\"address\": \"<address>\", \"amount\": <amount in BTC>, \"asset\": \"<asset>\"}",
 	)
 	txTransferCmd.Flags().BoolVar(&txNoBroadcast, "no-broadcast", false, "use this flag to not broadcast the transaction and get the tx hex instead of its hash")
+	txTransferCmd.Flags().StringVar(
+		&coinSelection, "coin-selection", "smallest-subset",
+		"coin-selection algorithm, one of smallest-subset, branch-bound "+
+			"(looks for a selection without change) or knapsack",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txSubtractFee, "subtract-fee", false,
+		"use this flag to pay the fees with the LBTC amounts of the receivers",
+	)
+	txTransferCmd.Flags().Uint32Var(
+		&txLocktime, "locktime", 0,
+		"block height, or unix timestamp if not lower than 500000000, before "+
+			"which the transaction can't be included in a block; unless "+
+			"no-broadcast is set, the transaction is scheduled to be "+
+			"broadcasted once the locktime is reached",
+	)
+	txTransferCmd.Flags().Uint32Var(
+		&txSequence, "sequence", 0,
+		"sequence of all the selected inputs, defaults to 0xfffffffe if "+
+			"locktime is set",
+	)
+
+	txSweepCmd.Flags().StringVar(
+		&sweepAddress, "address", "", "address to send the funds to",
+	)
+	txSweepCmd.Flags().StringSliceVar(
+		&sweepAssets, "assets", nil,
+		"assets to sweep, all those of the account if not specified",
+	)
+	txSweepCmd.Flags().BoolVar(&txNoBroadcast, "no-broadcast", false, "use this flag to not broadcast the transaction and get the tx hex instead of its hash")
+
+	txConsolidateCmd.Flags().StringVar(
+		&consolidationAsset, "asset", "",
+		"asset whose utxos to merge, all assets if not specified",
+	)
+	txConsolidateCmd.Flags().Uint64Var(
+		&consolidationThreshold, "value-threshold", 0,
+		"value in satoshis below which utxos are merged",
+	)
+	txConsolidateCmd.Flags().Uint32Var(
+		&consolidationMaxInputs, "max-inputs", 0,
+		"max number of inputs per transaction, defaults to 50",
+	)
+
+	txBumpFeeCmd.Flags().StringVar(
+		&bumpFeeTxid, "txid", "", "hash of the transaction to bump",
+	)
+
+	txScheduleCmd.Flags().Int64Var(
+		&txBroadcastAt, "broadcast-at", 0,
+		"unix timestamp before which the transaction is not broadcasted",
+	)
+	txScheduledCmd.Flags().StringVar(
+		&scheduledTxid, "txid", "", "hash of the scheduled transaction",
+	)
+	txCancelScheduledCmd.Flags().StringVar(
+		&scheduledTxid, "txid", "", "hash of the scheduled transaction",
+	)
 
 	txCmd.PersistentFlags().StringVar(
 		&accountName, "account-name", "", "name of the account's funds to use",
@@ -53,16 +181,29 @@ func init() {
 	txCmd.PersistentFlags().Float32Var(
 		&satsPerByte, "sats-per-byte", 0.1, "sats/byte ratio to use for network fees",
 	)
+	txCmd.PersistentFlags().StringVar(
+		&idempotencyKey, "idempotency-key", "",
+		"optional key to safely retry the command without executing it twice",
+	)
 
-	txCmd.AddCommand(txTransferCmd, txBroadcastCmd)
+	txCmd.AddCommand(
+		txTransferCmd, txSweepCmd, txConsolidateCmd, txBumpFeeCmd,
+		txBroadcastCmd, txScheduleCmd, txScheduledCmd, txCancelScheduledCmd,
+	)
 }
 
-func txTransfer(_ *cobra.Command, _ []string) error {
-	client, cleanup, err := getTransactionClient()
+func txTransfer(cmd *cobra.Command, _ []string) error {
+	c, err := getClient()
 	if err != nil {
 		return err
 	}
-	defer cleanup()
+	defer c.Close()
+
+	strategy, ok := coinSelectionStrategies[coinSelection]
+	if !ok {
+		printErr(fmt.Errorf("unknown coin-selection algorithm %s", coinSelection))
+		return nil
+	}
 
 	ctx := context.Background()
 	receivers := make(outputs, 0, len(txReceiversJSON))
@@ -74,39 +215,166 @@ func txTransfer(_ *cobra.Command, _ []string) error {
 		}
 		receivers = append(receivers, receiver)
 	}
+	var sequences []uint32
+	if cmd.Flags().Changed("sequence") {
+		sequences = []uint32{txSequence}
+	}
 
-	reply, err := client.Transfer(ctx, &pb.TransferRequest{
+	txHex, err := c.Transfer(ctx, client.TransferArgs{
+		AccountName:            accountName,
+		Receivers:              receivers.receivers(),
+		MillisatsPerByte:       uint64(satsPerByte * 1000),
+		IdempotencyKey:         idempotencyKey,
+		CoinSelectionStrategy:  strategy,
+		SubtractFeeFromOutputs: txSubtractFee,
+		Locktime:               txLocktime,
+		Sequences:              sequences,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	if txLocktime > 0 && !txNoBroadcast {
+		printScheduledTx(c.ScheduleTransaction(ctx, txHex, 0))
+		return nil
+	}
+
+	printOrBroadcastTx(ctx, c, txHex)
+	return nil
+}
+
+func txSweep(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	txHex, err := c.Sweep(ctx, client.SweepArgs{
+		AccountName:      accountName,
+		Address:          sweepAddress,
+		Assets:           sweepAssets,
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+		IdempotencyKey:   idempotencyKey,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	printOrBroadcastTx(ctx, c, txHex)
+	return nil
+}
+
+func txConsolidate(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	txids, err := c.ConsolidateUtxos(context.Background(), client.ConsolidateArgs{
 		AccountName:      accountName,
+		Asset:            consolidationAsset,
+		ValueThreshold:   consolidationThreshold,
+		MaxInputs:        consolidationMaxInputs,
 		MillisatsPerByte: uint64(satsPerByte * 1000),
-		Receivers:        receivers.proto(),
 	})
 	if err != nil {
 		printErr(err)
 		return nil
 	}
 
+	jsonReply, err := jsonResponse(&pb.ConsolidateUtxosResponse{Txids: txids})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txBumpFee(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	reply, err := c.BumpFee(
+		context.Background(), bumpFeeTxid, uint64(satsPerByte*1000),
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+// printOrBroadcastTx prints the given tx, or broadcasts it and prints its
+// hash unless the no-broadcast flag is set.
+func printOrBroadcastTx(ctx context.Context, c *client.Client, txHex string) {
 	if txNoBroadcast {
-		jsonReply, err := jsonResponse(reply)
+		jsonReply, err := jsonResponse(&pb.TransferResponse{TxHex: txHex})
 		if err != nil {
 			printErr(err)
-			return nil
+			return
 		}
 
 		fmt.Println(jsonReply)
+		return
+	}
+
+	// The same key can't be reused for a different request.
+	broadcastKey := ""
+	if len(idempotencyKey) > 0 {
+		broadcastKey = fmt.Sprintf("%s-broadcast", idempotencyKey)
+	}
+	txid, err := c.Broadcast(ctx, txHex, broadcastKey)
+	if err != nil {
+		printErr(err)
+		return
+	}
+
+	jsonReply, err := jsonResponse(&pb.BroadcastTransactionResponse{Txid: txid})
+	if err != nil {
+		printErr(err)
+		return
+	}
+
+	fmt.Println(jsonReply)
+}
+
+func txBroadcast(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		printErr(fmt.Errorf("missing tx hex"))
 		return nil
 	}
 
-	bReply, err := client.BroadcastTransaction(
-		ctx, &pb.BroadcastTransactionRequest{
-			TxHex: reply.GetTxHex(),
-		},
-	)
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	txid, err := c.Broadcast(context.Background(), args[0], idempotencyKey)
 	if err != nil {
 		printErr(err)
 		return nil
 	}
 
-	jsonReply, err := jsonResponse(bReply)
+	jsonReply, err := jsonResponse(&pb.BroadcastTransactionResponse{Txid: txid})
 	if err != nil {
 		printErr(err)
 		return nil
@@ -116,28 +384,46 @@ func txTransfer(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
-func txBroadcast(_ *cobra.Command, args []string) error {
+func txSchedule(_ *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		printErr(fmt.Errorf("missing tx hex"))
 		return nil
 	}
 
-	client, cleanup, err := getTransactionClient()
+	c, err := getClient()
 	if err != nil {
 		return err
 	}
-	defer cleanup()
+	defer c.Close()
+
+	printScheduledTx(
+		c.ScheduleTransaction(context.Background(), args[0], txBroadcastAt),
+	)
+	return nil
+}
 
-	reply, err := client.BroadcastTransaction(
-		context.Background(), &pb.BroadcastTransactionRequest{
-			TxHex: args[0],
-		})
+func txScheduled(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	if len(scheduledTxid) > 0 {
+		printScheduledTx(c.GetScheduledTransaction(ctx, scheduledTxid))
+		return nil
+	}
+
+	txs, err := c.ListScheduledTransactions(ctx)
 	if err != nil {
 		printErr(err)
 		return nil
 	}
 
-	jsonReply, err := jsonResponse(reply)
+	jsonReply, err := jsonResponse(&pb.ListScheduledTransactionsResponse{
+		ScheduledTransactions: txs,
+	})
 	if err != nil {
 		printErr(err)
 		return nil
@@ -147,16 +433,47 @@ func txBroadcast(_ *cobra.Command, args []string) error {
 	return nil
 }
 
+func txCancelScheduled(_ *cobra.Command, _ []string) error {
+	c, err := getClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	printScheduledTx(
+		c.CancelScheduledTransaction(context.Background(), scheduledTxid),
+	)
+	return nil
+}
+
+// printScheduledTx prints the given scheduled tx or the given error.
+func printScheduledTx(tx *pb.ScheduledTransaction, err error) {
+	if err != nil {
+		printErr(err)
+		return
+	}
+
+	jsonReply, err := jsonResponse(&pb.GetScheduledTransactionResponse{
+		ScheduledTransaction: tx,
+	})
+	if err != nil {
+		printErr(err)
+		return
+	}
+
+	fmt.Println(jsonReply)
+}
+
 type output struct {
 	Address string  `json:"address"`
 	Amount  float64 `json:"amount"`
 	Asset   string  `json:"asset"`
 }
 
-func (o output) proto() *pb.Output {
+func (o output) receiver() client.Receiver {
 	btc := decimal.NewFromFloat(math.Pow10(8))
 	amount := decimal.NewFromFloat(o.Amount).Mul(btc).BigInt().Uint64()
-	return &pb.Output{
+	return client.Receiver{
 		Address: o.Address,
 		Amount:  amount,
 		Asset:   o.Asset,
@@ -165,10 +482,10 @@ func (o output) proto() *pb.Output {
 
 type outputs []output
 
-func (o outputs) proto() []*pb.Output {
-	outs := make([]*pb.Output, 0, len(o))
+func (o outputs) receivers() []client.Receiver {
+	receivers := make([]client.Receiver, 0, len(o))
 	for _, out := range o {
-		outs = append(outs, out.proto())
+		receivers = append(receivers, out.receiver())
 	}
-	return outs
+	return receivers
 }
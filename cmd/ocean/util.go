@@ -10,49 +10,45 @@ import (
 	"strings"
 
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	"github.com/vulpemventures/ocean/pkg/client"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
-var (
-	maxMsgRecvSize = grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 200)
-	colorRed       = string("\033[31m")
-)
+var colorRed = string("\033[31m")
 
 func getWalletClient() (pb.WalletServiceClient, func(), error) {
-	conn, err := getClientConn()
+	c, err := getClient()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cleanup := func() { conn.Close() }
-	return pb.NewWalletServiceClient(conn), cleanup, nil
+	cleanup := func() { c.Close() }
+	return c.Wallet(), cleanup, nil
 }
 
 func getAccountClient() (pb.AccountServiceClient, func(), error) {
-	conn, err := getClientConn()
+	c, err := getClient()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cleanup := func() { conn.Close() }
-	return pb.NewAccountServiceClient(conn), cleanup, nil
+	cleanup := func() { c.Close() }
+	return c.Account(), cleanup, nil
 }
 
 func getTransactionClient() (pb.TransactionServiceClient, func(), error) {
-	conn, err := getClientConn()
+	c, err := getClient()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cleanup := func() { conn.Close() }
-	return pb.NewTransactionServiceClient(conn), cleanup, nil
+	cleanup := func() { c.Close() }
+	return c.Transaction(), cleanup, nil
 }
 
-func getClientConn() (*grpc.ClientConn, error) {
+func getClient() (*client.Client, error) {
 	state, err := getState()
 	if err != nil {
 		return nil, err
@@ -62,11 +58,11 @@ func getClientConn() (*grpc.ClientConn, error) {
 		return nil, fmt.Errorf("set rpcserver with `config set rpcserver`")
 	}
 
-	opts := []grpc.DialOption{grpc.WithDefaultCallOptions(maxMsgRecvSize)}
+	opts := make([]client.Option, 0)
 
 	noTLS, _ := strconv.ParseBool(state["no_tls"])
 	if noTLS {
-		opts = append(opts, grpc.WithInsecure())
+		opts = append(opts, client.WithInsecure())
 	} else {
 		certPath, ok := state["tls_cert_path"]
 		if !ok {
@@ -75,19 +71,24 @@ func getClientConn() (*grpc.ClientConn, error) {
 					"'ocean config set tls_cert_path path/to/tls/certificate'",
 			)
 		}
-
-		tlsCreds, err := credentials.NewClientTLSFromFile(certPath, "")
-		if err != nil {
-			return nil, fmt.Errorf("failed to load TLS certificate:  %s", err)
+		opts = append(opts, client.WithTLSCert(certPath))
+
+		// The client key pair is required only if the daemon has client
+		// authentication enabled.
+		clientCertPath := state["tls_client_cert_path"]
+		clientKeyPath := state["tls_client_key_path"]
+		if len(clientCertPath) > 0 || len(clientKeyPath) > 0 {
+			opts = append(
+				opts, client.WithTLSClientCert(clientCertPath, clientKeyPath),
+			)
 		}
-		opts = append(opts, grpc.WithTransportCredentials(tlsCreds))
 	}
 
-	conn, err := grpc.Dial(address, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to ocean daemon: %v", err)
+	if walletID := state["wallet_id"]; len(walletID) > 0 {
+		opts = append(opts, client.WithWalletID(walletID))
 	}
-	return conn, nil
+
+	return client.New(address, opts...)
 }
 
 func getState() (map[string]string, error) {
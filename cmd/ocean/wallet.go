@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
@@ -18,6 +19,12 @@ var (
 	birthdayBlock,
 	accountThreshold,
 	addressThreshold uint32
+	fromSequence,
+	auditLimit uint64
+	extraIPs,
+	extraDomains []string
+	backupFile   string
+	includeCache bool
 
 	walletGenSeedCmd = &cobra.Command{
 		Use:   "genseed",
@@ -79,6 +86,34 @@ var (
 		Long:  "verifies whether the given password is valid without unlocking the wallet",
 		RunE:  authWallet,
 	}
+	walletAuditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "list audit log events",
+		Long: "this command returns a page of the audit log of the " +
+			"state-changing operations, starting from the given sequence",
+		RunE: walletAudit,
+	}
+	walletRotateTLSCmd = &cobra.Command{
+		Use:   "rotatetls",
+		Short: "rotate TLS key pair",
+		Long: "this command regenerates the TLS key pair of the daemon with a " +
+			"certificate bound to the given extra IPs and domains, and returns it",
+		RunE: walletRotateTLS,
+	}
+	walletExportBackupCmd = &cobra.Command{
+		Use:   "exportbackup",
+		Short: "export encrypted wallet backup",
+		Long: "this command exports an encrypted backup of the wallet, its " +
+			"accounts, external scripts and spending policies to the given file",
+		RunE: walletExportBackup,
+	}
+	walletImportBackupCmd = &cobra.Command{
+		Use:   "importbackup",
+		Short: "import encrypted wallet backup",
+		Long: "this command restores a previously exported backup into a not " +
+			"yet initialized wallet",
+		RunE: walletImportBackup,
+	}
 	walletCmd = &cobra.Command{
 		Use:   "wallet",
 		Short: "interact with ocean wallet interface",
@@ -122,9 +157,44 @@ func init() {
 	walletChangePwdCmd.MarkFlagRequired("old-password")
 	walletChangePwdCmd.MarkFlagRequired("new-password")
 
+	walletAuditCmd.Flags().Uint64Var(
+		&fromSequence, "from", 0, "sequence of the first event of the page",
+	)
+	walletAuditCmd.Flags().Uint64Var(
+		&auditLimit, "limit", 0, "max number of events of the page",
+	)
+
+	walletRotateTLSCmd.Flags().StringSliceVar(
+		&extraIPs, "extra-ip", nil, "public IP to bind the certificate to",
+	)
+	walletRotateTLSCmd.Flags().StringSliceVar(
+		&extraDomains, "extra-domain", nil,
+		"public dns domain to bind the certificate to",
+	)
+
+	walletExportBackupCmd.Flags().StringVar(&password, "password", "", "wallet password")
+	walletExportBackupCmd.Flags().StringVar(
+		&backupFile, "out", "", "path of the file where to write the backup",
+	)
+	walletExportBackupCmd.Flags().BoolVar(
+		&includeCache, "include-cache", false,
+		"whether to include also utxos and transactions in the backup",
+	)
+	walletExportBackupCmd.MarkFlagRequired("password")
+	walletExportBackupCmd.MarkFlagRequired("out")
+
+	walletImportBackupCmd.Flags().StringVar(&password, "password", "", "backup password")
+	walletImportBackupCmd.Flags().StringVar(
+		&backupFile, "backup-file", "", "path of the backup file",
+	)
+	walletImportBackupCmd.MarkFlagRequired("password")
+	walletImportBackupCmd.MarkFlagRequired("backup-file")
+
 	walletCmd.AddCommand(
 		walletGenSeedCmd, walletCreateCmd, walletRestoreCmd, walletUnlockCmd,
 		walletLockCmd, walletChangePwdCmd, walletInfoCmd, walletStatusCmd, authWalletCmd,
+		walletAuditCmd, walletRotateTLSCmd, walletExportBackupCmd,
+		walletImportBackupCmd,
 	)
 }
 
@@ -359,3 +429,107 @@ func authWallet(cmd *cobra.Command, _ []string) error {
 	fmt.Println(jsonReply)
 	return nil
 }
+
+func walletAudit(cmd *cobra.Command, _ []string) error {
+	client, cleanup, err := getWalletClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ListAuditEvents(
+		context.Background(), &pb.ListAuditEventsRequest{
+			FromSequence: fromSequence,
+			Limit:        auditLimit,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func walletRotateTLS(cmd *cobra.Command, _ []string) error {
+	client, cleanup, err := getWalletClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.RotateTLS(
+		context.Background(), &pb.RotateTLSRequest{
+			ExtraIps:     extraIPs,
+			ExtraDomains: extraDomains,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(reply.GetCert())
+	return nil
+}
+
+func walletExportBackup(cmd *cobra.Command, _ []string) error {
+	client, cleanup, err := getWalletClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ExportBackup(
+		context.Background(), &pb.ExportBackupRequest{
+			Password:     password,
+			IncludeCache: includeCache,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	if err := os.WriteFile(
+		cleanAndExpandPath(backupFile), reply.GetBackup(), 0600,
+	); err != nil {
+		return err
+	}
+
+	fmt.Println("wallet backup exported")
+	return nil
+}
+
+func walletImportBackup(cmd *cobra.Command, _ []string) error {
+	backup, err := os.ReadFile(cleanAndExpandPath(backupFile))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getWalletClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.ImportBackup(
+		context.Background(), &pb.ImportBackupRequest{
+			Backup:   backup,
+			Password: password,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("wallet backup imported")
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -9,13 +10,18 @@ import (
 
 	_ "net/http/pprof" // #nosec
 
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	appconfig "github.com/vulpemventures/ocean/internal/app-config"
 	"github.com/vulpemventures/ocean/internal/config"
+	"github.com/vulpemventures/ocean/internal/core/application"
 	electrum_scanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/electrum"
+	"github.com/vulpemventures/ocean/internal/infrastructure/metrics"
 	postgresdb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres"
+	"github.com/vulpemventures/ocean/internal/infrastructure/tracing"
 	"github.com/vulpemventures/ocean/internal/interfaces"
 	grpc_interface "github.com/vulpemventures/ocean/internal/interfaces/grpc"
+	grpc_handler "github.com/vulpemventures/ocean/internal/interfaces/grpc/handler"
 	"github.com/vulpemventures/ocean/pkg/profiler"
 )
 
@@ -32,16 +38,22 @@ var (
 	datadir            = config.GetDatadir()
 	port               = config.GetInt(config.PortKey)
 	profilerPort       = config.GetInt(config.ProfilerPortKey)
+	restPort           = config.GetInt(config.RestPortKey)
 	network            = config.GetNetwork()
 	noTLS              = config.GetBool(config.NoTLSKey)
 	noProfiler         = config.GetBool(config.NoProfilerKey)
+	noRest             = config.GetBool(config.NoRestKey)
 	tlsDir             = filepath.Join(datadir, config.TLSLocation)
 	profilerDir        = filepath.Join(datadir, config.ProfilerLocation)
 	electrumUrl        = config.GetString(config.ElectrumUrlKey)
 	tlsExtraIPs        = config.GetStringSlice(config.TLSExtraIPKey)
 	tlsExtraDomains    = config.GetStringSlice(config.TLSExtraDomainKey)
+	tlsClientCA        = config.GetString(config.TLSClientCAKey)
+	tlsClientRoles     = config.GetStringSlice(config.TLSClientRolesKey)
+	rateLimits         = config.GetStringSlice(config.RateLimitsKey)
 	statsInterval      = time.Duration(config.GetInt(config.StatsIntervalKey)) * time.Second
 	utxoExpiryDuration = time.Duration(config.GetInt(config.UtxoExpiryDurationKey))
+	idempotencyKeyTTL  = time.Duration(config.GetInt(config.IdempotencyKeyTTLKey))
 	rootPath           = config.GetRootPath()
 	dbUser             = config.GetString(config.DbUserKey)
 	dbPassword         = config.GetString(config.DbPassKey)
@@ -52,11 +64,48 @@ var (
 	dustAmount         = uint64(config.GetInt(config.DustAmountKey))
 	walletPassword     = config.GetString(config.PasswordKey)
 	walletMnemonic     = config.GetString(config.MnemonicKey)
+	otlpEndpoint       = config.GetString(config.OtlpEndpointKey)
+	otlpInsecure       = config.GetBool(config.OtlpInsecureKey)
+	tracingSampleRatio = config.GetFloat64(config.TracingSampleRatioKey)
+
+	requestLimits = grpc_handler.RequestLimits{
+		MaxNumOfAddresses: uint64(config.GetInt(config.MaxAddressesPerRequestKey)),
+		MaxNumOfInputs:    config.GetInt(config.MaxInputsPerRequestKey),
+		MaxNumOfOutputs:   config.GetInt(config.MaxOutputsPerRequestKey),
+		MaxTxSize:         config.GetInt(config.MaxTxSizeKey),
+	}
+
+	consolidationInterval = time.Duration(config.GetInt(config.ConsolidationIntervalKey)) * time.Second
+	consolidationOptions  = application.ConsolidationOptions{
+		ValueThreshold:               uint64(config.GetInt(config.ConsolidationValueThresholdKey)),
+		MaxInputs:                    uint32(config.GetInt(config.ConsolidationMaxInputsKey)),
+		MillisatsPerByte:             uint64(config.GetInt(config.ConsolidationMillisatsPerByteKey)),
+		MaxEstimatedMillisatsPerByte: uint64(config.GetInt(config.ConsolidationMaxEstimatedMillisatsPerByteKey)),
+	}
+	paymentBatcherOptions = application.PaymentBatcherOptions{
+		MaxBatchSize:     uint32(config.GetInt(config.PaymentBatchMaxSizeKey)),
+		MaxWaitTime:      time.Duration(config.GetInt(config.PaymentBatchMaxWaitKey)) * time.Second,
+		MillisatsPerByte: uint64(config.GetInt(config.PaymentBatchMillisatsPerByteKey)),
+	}
+	broadcastTrackerOptions = application.BroadcastTrackerOptions{
+		CheckInterval: time.Duration(config.GetInt(config.BroadcastCheckIntervalKey)) * time.Second,
+		MaxAttempts:   uint32(config.GetInt(config.BroadcastMaxAttemptsKey)),
+	}
+	txSchedulerOptions = application.TxSchedulerOptions{
+		CheckInterval: time.Duration(config.GetInt(config.TxSchedulerCheckIntervalKey)) * time.Second,
+	}
 )
 
 func main() {
 	log.SetLevel(log.Level(logLevel))
 
+	if len(os.Args) > 1 && os.Args[1] == migrateCmd {
+		if err := migrate(os.Args[2:]); err != nil {
+			log.WithError(err).Fatal("migrate: error while migrating data")
+		}
+		return
+	}
+
 	if profilerEnabled := !noProfiler; profilerEnabled {
 		profilerSvc, err := profiler.NewService(profiler.ServiceOpts{
 			Port:          profilerPort,
@@ -71,18 +120,43 @@ func main() {
 		defer profilerSvc.Stop()
 	}
 
+	shutdownTracing, err := tracing.Init(tracing.Config{
+		Endpoint:    otlpEndpoint,
+		Insecure:    otlpInsecure,
+		SampleRatio: tracingSampleRatio,
+		Version:     version,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("tracing: error while initializing")
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.WithError(err).Warn("tracing: error while flushing spans")
+		}
+	}()
+
 	bcScannerConfig := electrum_scanner.ServiceArgs{
 		Addr:    electrumUrl,
 		Network: network,
 	}
+	if noRest {
+		restPort = 0
+	}
 	serviceCfg := grpc_interface.ServiceConfig{
-		Port:         port,
-		NoTLS:        noTLS,
-		TLSLocation:  tlsDir,
-		ExtraIPs:     tlsExtraIPs,
-		ExtraDomains: tlsExtraDomains,
+		Port:          port,
+		RestPort:      restPort,
+		NoTLS:         noTLS,
+		TLSLocation:   tlsDir,
+		ExtraIPs:      tlsExtraIPs,
+		ExtraDomains:  tlsExtraDomains,
+		ClientCA:      tlsClientCA,
+		ClientRoles:   tlsClientRoles,
+		RateLimits:    rateLimits,
+		RequestLimits: requestLimits,
 	}
-	repoManagerConfig := dbConfigFromType()
+	repoManagerConfig := dbConfigFromType(dbType)
 	appCfg := &appconfig.AppConfig{
 		Version:                 version,
 		Commit:                  commit,
@@ -90,9 +164,16 @@ func main() {
 		RootPath:                rootPath,
 		Network:                 network,
 		UtxoExpiryDuration:      utxoExpiryDuration * time.Second,
+		IdempotencyKeyTTL:       idempotencyKeyTTL * time.Second,
 		DustAmount:              dustAmount,
+		ConsolidationInterval:   consolidationInterval,
+		ConsolidationOptions:    consolidationOptions,
+		PaymentBatcherOptions:   paymentBatcherOptions,
+		BroadcastTrackerOptions: broadcastTrackerOptions,
+		TxSchedulerOptions:      txSchedulerOptions,
 		Password:                walletPassword,
 		Mnemonic:                walletMnemonic,
+		Datadir:                 datadir,
 		RepoManagerType:         dbType,
 		BlockchainScannerType:   bcScannerType,
 		RepoManagerConfig:       repoManagerConfig,
@@ -104,6 +185,14 @@ func main() {
 		log.WithError(err).Fatal("service: error while initializing")
 	}
 
+	if profilerEnabled := !noProfiler; profilerEnabled {
+		if err := prometheus.Register(metrics.NewWalletCollector(
+			appCfg.RepoManager(), appCfg.BlockchainScanner(),
+		)); err != nil {
+			log.WithError(err).Fatal("profiler: error while registering metrics")
+		}
+	}
+
 	if err := serviceManager.Service.Start(); err != nil {
 		log.WithError(err).Fatal("service: error while starting")
 	}
@@ -114,7 +203,7 @@ func main() {
 	<-sigChan
 }
 
-func dbConfigFromType() interface{} {
+func dbConfigFromType(dbType string) interface{} {
 	switch dbType {
 	case "postgres":
 		return postgresdb.DbConfig{
@@ -125,7 +214,7 @@ func dbConfigFromType() interface{} {
 			DbName:             dbName,
 			MigrationSourceURL: migrationSourceURL,
 		}
-	case "badger":
+	case "badger", "sqlite":
 		return filepath.Join(datadir, "db")
 	case "inmemory":
 		fallthrough
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	appconfig "github.com/vulpemventures/ocean/internal/app-config"
+	"github.com/vulpemventures/ocean/internal/config"
+	"github.com/vulpemventures/ocean/internal/core/application"
+)
+
+const migrateCmd = "migrate"
+
+// migrate copies all data from a type of database to another one, configured
+// with the same env vars used by the daemon, ie. the badger and sqlite
+// databases are the ones in the datadir, while postgres is the one at the
+// configured host.
+// The daemon must not be running while migrating.
+func migrate(args []string) error {
+	flags := flag.NewFlagSet(migrateCmd, flag.ContinueOnError)
+	from := flags.String("from", "", "type of the source database")
+	to := flags.String("to", "", "type of the destination database")
+	flags.Usage = func() {
+		fmt.Fprintf(
+			flags.Output(), "Usage: oceand %s --from <db type> --to <db type>\n",
+			migrateCmd,
+		)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if _, ok := config.SupportedDbs[*from]; !ok {
+		return fmt.Errorf(
+			"unsupported source database type, must be one of %s",
+			config.SupportedDbs,
+		)
+	}
+	if _, ok := config.SupportedDbs[*to]; !ok {
+		return fmt.Errorf(
+			"unsupported destination database type, must be one of %s",
+			config.SupportedDbs,
+		)
+	}
+	if *from == *to {
+		return fmt.Errorf("source and destination databases must be different")
+	}
+	if *to == "inmemory" {
+		log.Warn(
+			"migrate: destination database is not persistent, data will be " +
+				"only verified",
+		)
+	}
+
+	src, err := appconfig.NewRepoManager(*from, dbConfigFromType(*from))
+	if err != nil {
+		return fmt.Errorf("failed to open source database: %s", err)
+	}
+	defer src.Close()
+
+	dst, err := appconfig.NewRepoManager(*to, dbConfigFromType(*to))
+	if err != nil {
+		return fmt.Errorf("failed to open destination database: %s", err)
+	}
+	defer dst.Close()
+
+	log.Infof("migrate: copying data from %s to %s", *from, *to)
+
+	stats, err := application.MigrateData(context.Background(), src, dst)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("migrate: data migrated and verified, %s", stats)
+	return nil
+}
@@ -1,7 +1,11 @@
 package appconfig
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -13,20 +17,40 @@ import (
 	electrum_scanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/electrum"
 	elements_scanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/elements"
 	neutrino_scanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/neutrino"
+	scopedscanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/scoped"
 	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
 	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
 	postgresdb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+	traceddb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/traced"
 	path "github.com/vulpemventures/ocean/pkg/wallet/derivation-path"
 )
 
+const (
+	// walletsDir is the directory, within the datadir of the default wallet,
+	// where the storage of any other wallet is located.
+	walletsDir = "wallets"
+	// walletSchemaPrefix is the prefix of the postgres schema of any wallet
+	// other than the default one.
+	walletSchemaPrefix = "wallet_"
+)
+
 // AppConfig is the struct holding all configuration options for
-// every application service (wallet, account, transaction and notification).
+// every application service (wallet, account, transaction, notification and
+// audit).
 // This data structure acts also as a factory of the mentioned application
 // services and the portable services used by them.
 // Public config args:
 //   - RootPath - (optional) Wallet root HD path (defaults to m/84'/0').
 //   - Network - (required) The Liquid network (mainnet, testnet, regtest).
 //   - UtxoExpiryDuration - (required) The duration in seconds for the app service to wait until unlocking one or more previously locked utxo.
+//   - IdempotencyKeyTTL - (optional) The duration the result of a request with an idempotency key is returned to its retries (defaults to 24 hours).
+//   - ConsolidationInterval - (optional) The interval between consecutive consolidations of the utxos of every account of every wallet, disabled if zero.
+//   - ConsolidationOptions - (optional) The options of the periodic consolidation, required if enabled.
+//   - PaymentBatcherOptions - (optional) The options of the batcher flushing the queued payments of every account of every wallet (defaults to 100 payments per tx, 10 minutes of max wait time and 100 millisats/byte).
+//   - BroadcastTrackerOptions - (optional) The options of the tracker of the txs broadcasted by every wallet (defaults to 30 seconds of check interval and 10 rebroadcasts before dropping a tx).
+//   - TxSchedulerOptions - (optional) The options of the scheduler broadcasting the time-locked or scheduled txs of every wallet (defaults to 30 seconds of check interval).
+//   - Datadir - (optional) The directory where the registry of the wallets other than the default one is persisted, kept in memory only if empty.
 //   - RepoManagerType - (required) One of the supported repository manager types.
 //   - BlockchainScannerType - (required) One of the supported blockchain scanner types.
 //   - RepoManagerConfig - (optional) Custom config args for the repository manager based on its type.
@@ -39,21 +63,43 @@ type AppConfig struct {
 	RootPath           string
 	Network            *network.Network
 	UtxoExpiryDuration time.Duration
+	IdempotencyKeyTTL  time.Duration
 	DustAmount         uint64
 	Password           string
 	Mnemonic           string
+	Datadir            string
+
+	ConsolidationInterval time.Duration
+	ConsolidationOptions  application.ConsolidationOptions
+	PaymentBatcherOptions application.PaymentBatcherOptions
+
+	BroadcastTrackerOptions application.BroadcastTrackerOptions
+	TxSchedulerOptions      application.TxSchedulerOptions
 
 	RepoManagerType         string
 	BlockchainScannerType   string
 	RepoManagerConfig       interface{}
 	BlockchainScannerConfig interface{}
 
-	rm         ports.RepoManager
-	bcs        ports.BlockchainScanner
-	walletSvc  *application.WalletService
-	accountSvc *application.AccountService
-	txSvc      *application.TransactionService
-	notifySvc  *application.NotificationService
+	rm        ports.RepoManager
+	bcs       ports.BlockchainScanner
+	healthSvc *application.HealthService
+
+	walletsLock    sync.Mutex
+	wallets        map[string]*WalletServices
+	repoManagers   map[string]ports.RepoManager
+	walletRegistry *walletRegistry
+}
+
+// WalletServices are the application services of a single wallet. Every
+// wallet has its own storage, while the blockchain scanner is shared among
+// all of them.
+type WalletServices struct {
+	WalletService       *application.WalletService
+	AccountService      *application.AccountService
+	TransactionService  *application.TransactionService
+	NotificationService *application.NotificationService
+	AuditService        *application.AuditService
 }
 
 func (c *AppConfig) WithAutoUnlock() bool {
@@ -104,6 +150,9 @@ func (c *AppConfig) Validate() error {
 	if _, err := path.ParseRootDerivationPath(c.RootPath); err != nil {
 		return err
 	}
+	if c.ConsolidationInterval < 0 {
+		return fmt.Errorf("consolidation interval must not be negative")
+	}
 	if len(c.Mnemonic) > 0 {
 		if !bip39.IsMnemonicValid(c.Mnemonic) {
 			return fmt.Errorf("invalid mnemonic")
@@ -124,58 +173,346 @@ func (c *AppConfig) BlockchainScanner() ports.BlockchainScanner {
 	return c.bcs
 }
 
-func (c *AppConfig) WalletService() *application.WalletService {
-	return c.walletService()
+func (c *AppConfig) WalletService() (*application.WalletService, error) {
+	svcs, err := c.defaultWallet()
+	if err != nil {
+		return nil, err
+	}
+	return svcs.WalletService, nil
 }
 
-func (c *AppConfig) AccountService() *application.AccountService {
-	return c.accountService()
+func (c *AppConfig) AccountService() (*application.AccountService, error) {
+	svcs, err := c.defaultWallet()
+	if err != nil {
+		return nil, err
+	}
+	return svcs.AccountService, nil
 }
 
-func (c *AppConfig) TransactionService() *application.TransactionService {
-	return c.transactionService()
+func (c *AppConfig) TransactionService() (
+	*application.TransactionService, error,
+) {
+	svcs, err := c.defaultWallet()
+	if err != nil {
+		return nil, err
+	}
+	return svcs.TransactionService, nil
 }
 
-func (c *AppConfig) NotificationService() *application.NotificationService {
-	return c.notificationService()
+func (c *AppConfig) NotificationService() (
+	*application.NotificationService, error,
+) {
+	svcs, err := c.defaultWallet()
+	if err != nil {
+		return nil, err
+	}
+	return svcs.NotificationService, nil
 }
 
-func (c *AppConfig) repoManager() (ports.RepoManager, error) {
+func (c *AppConfig) AuditService() (*application.AuditService, error) {
+	svcs, err := c.defaultWallet()
+	if err != nil {
+		return nil, err
+	}
+	return svcs.AuditService, nil
+}
+
+func (c *AppConfig) HealthService() *application.HealthService {
+	return c.healthService()
+}
+
+// WalletServices returns the application services of the wallet selected by
+// the given request context, opening its storage the first time. The default
+// wallet is stored where a single-wallet daemon stores it, the others are
+// namespaced by their id within the same database.
+// Any wallet other than the default one must be registered with
+// NewWalletServices first, and is accessible only to the caller owning it or
+// to admins. ErrWalletNotFound is returned otherwise, so that callers can't
+// find out the wallets of others.
+func (c *AppConfig) WalletServices(
+	ctx context.Context,
+) (*WalletServices, error) {
+	return c.walletServices(ctx, false)
+}
+
+// NewWalletServices is like WalletServices, but also registers the wallet
+// selected by the given request context as owned by the caller, if not
+// already registered. It's meant to serve the RPCs creating or restoring a
+// wallet.
+func (c *AppConfig) NewWalletServices(
+	ctx context.Context,
+) (*WalletServices, error) {
+	return c.walletServices(ctx, true)
+}
+
+func (c *AppConfig) walletServices(
+	ctx context.Context, register bool,
+) (*WalletServices, error) {
+	walletID := application.WalletIDFromContext(ctx)
+	if err := application.ValidateWalletID(walletID); err != nil {
+		return nil, err
+	}
+
+	c.walletsLock.Lock()
+	defer c.walletsLock.Unlock()
+
+	if c.wallets == nil {
+		c.wallets = make(map[string]*WalletServices)
+		c.repoManagers = make(map[string]ports.RepoManager)
+	}
+	if c.walletRegistry == nil {
+		registry, err := newWalletRegistry(c.Datadir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load wallet registry: %s", err)
+		}
+		c.walletRegistry = registry
+	}
+
+	isNew := false
+	caller := application.AuthenticatedCallerFromContext(ctx)
+	if walletID != application.DefaultWalletID {
+		// Callers identified only by their address can't own a wallet, since
+		// the same address may be shared by several clients.
+		if caller == "" && !application.HasAdminRights(ctx) {
+			return nil, application.ErrCallerNotAuthenticated
+		}
+		owner, ok := c.walletRegistry.owner(walletID)
+		if !ok && !register {
+			return nil, application.ErrWalletNotFound
+		}
+		if ok && owner != caller && !application.HasAdminRights(ctx) {
+			if register {
+				return nil, application.ErrWalletIDTaken
+			}
+			return nil, application.ErrWalletNotFound
+		}
+		if !ok {
+			if caller == "" {
+				return nil, application.ErrCallerNotAuthenticated
+			}
+			isNew = true
+		}
+	}
+
+	if svcs, ok := c.wallets[walletID]; ok {
+		return svcs, nil
+	}
+
+	svcs, rm, err := c.openWallet(walletID)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		if err := c.walletRegistry.add(walletID, caller); err != nil {
+			stopWalletJobs(svcs)
+			rm.Close()
+			return nil, fmt.Errorf(
+				"failed to register wallet %s: %s", walletID, err,
+			)
+		}
+	}
+	c.wallets[walletID] = svcs
+	c.repoManagers[walletID] = rm
+	return svcs, nil
+}
+
+// openWallet opens the storage of the wallet with the given id and starts the
+// background jobs of its services. The storage of any wallet other than the
+// default one is closed if any job fails to start.
+func (c *AppConfig) openWallet(
+	walletID string,
+) (*WalletServices, ports.RepoManager, error) {
+	rm, bcs, err := c.walletPorts(walletID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open wallet %s: %s", walletID, err)
+	}
+
+	svcs := &WalletServices{
+		WalletService: application.NewWalletService(
+			rm, bcs, c.RootPath, c.Network, c.buildInfo(),
+		),
+		AccountService: application.NewAccountService(rm, bcs),
+		TransactionService: application.NewTransactionService(
+			rm, bcs, c.Network, c.UtxoExpiryDuration, c.DustAmount,
+			c.IdempotencyKeyTTL,
+		),
+		NotificationService: application.NewNotificationService(rm, bcs),
+		AuditService:        application.NewAuditService(rm),
+	}
+	if err := c.startWalletJobs(svcs); err != nil {
+		stopWalletJobs(svcs)
+		if walletID != application.DefaultWalletID {
+			rm.Close()
+		}
+		return nil, nil, fmt.Errorf(
+			"failed to start jobs of wallet %s: %s", walletID, err,
+		)
+	}
+	return svcs, rm, nil
+}
+
+// startWalletJobs starts the background jobs of the given wallet services.
+func (c *AppConfig) startWalletJobs(svcs *WalletServices) error {
+	if c.ConsolidationInterval > 0 {
+		if err := svcs.TransactionService.StartUtxoConsolidation(
+			c.ConsolidationInterval, c.ConsolidationOptions,
+		); err != nil {
+			return fmt.Errorf("utxo consolidation: %s", err)
+		}
+	}
+	if err := svcs.TransactionService.StartPaymentBatcher(
+		c.PaymentBatcherOptions,
+	); err != nil {
+		return fmt.Errorf("payment batcher: %s", err)
+	}
+	if err := svcs.TransactionService.StartBroadcastTracker(
+		c.BroadcastTrackerOptions,
+	); err != nil {
+		return fmt.Errorf("broadcast tracker: %s", err)
+	}
+	if err := svcs.TransactionService.StartTxScheduler(
+		c.TxSchedulerOptions,
+	); err != nil {
+		return fmt.Errorf("tx scheduler: %s", err)
+	}
+	return nil
+}
+
+// stopWalletJobs stops any background job of the given wallet services.
+func stopWalletJobs(svcs *WalletServices) {
+	svcs.TransactionService.StopUtxoConsolidation()
+	svcs.TransactionService.StopPaymentBatcher()
+	svcs.TransactionService.StopBroadcastTracker()
+	svcs.TransactionService.StopTxScheduler()
+	svcs.TransactionService.StopUtxoUnlocker()
+}
+
+// Close closes the connection with the storage of every open wallet.
+func (c *AppConfig) Close() {
+	c.walletsLock.Lock()
+	defer c.walletsLock.Unlock()
+
+	for _, svcs := range c.wallets {
+		stopWalletJobs(svcs)
+	}
+	for walletID, rm := range c.repoManagers {
+		if walletID != application.DefaultWalletID {
+			rm.Close()
+		}
+	}
 	if c.rm != nil {
-		return c.rm, nil
+		c.rm.Close()
 	}
+}
 
-	switch c.RepoManagerType {
-	case "inmemory":
-		c.rm = inmemory.NewRepoManager()
-		return c.rm, nil
-	case "badger":
-		if c.RepoManagerConfig == nil {
-			return nil, fmt.Errorf("missing repo manager config args")
+// defaultWallet returns the services of the default wallet.
+func (c *AppConfig) defaultWallet() (*WalletServices, error) {
+	return c.WalletServices(context.Background())
+}
+
+// walletPorts returns the repo manager and the blockchain scanner of the
+// wallet with the given id. The accounts of any wallet other than the default
+// one are namespaced within the shared scanner.
+func (c *AppConfig) walletPorts(
+	walletID string,
+) (ports.RepoManager, ports.BlockchainScanner, error) {
+	bcs, err := c.bcScanner()
+	if err != nil {
+		return nil, nil, err
+	}
+	if walletID == application.DefaultWalletID {
+		rm, err := c.repoManager()
+		if err != nil {
+			return nil, nil, err
 		}
+		return rm, bcs, nil
+	}
+
+	rmConfig, err := c.walletRepoManagerConfig(walletID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rm, err := NewRepoManager(c.RepoManagerType, rmConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	rm = traceddb.NewRepoManager(rm, c.RepoManagerType)
+	return rm, scopedscanner.NewScanner(bcs, walletID), nil
+}
+
+// walletRepoManagerConfig returns the config of the repo manager of the
+// wallet with the given id, derived from the one of the default wallet.
+func (c *AppConfig) walletRepoManagerConfig(
+	walletID string,
+) (interface{}, error) {
+	switch c.RepoManagerType {
+	case "badger", "sqlite":
 		datadir, ok := c.RepoManagerConfig.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid repo manager config type, must be string")
 		}
-		rm, err := dbbadger.NewRepoManager(datadir, log.New())
-		if err != nil {
+		dir := filepath.Join(datadir, walletsDir, walletID)
+		if err := os.MkdirAll(dir, 0700); err != nil {
 			return nil, err
 		}
-		c.rm = rm
-		return c.rm, nil
+		return dir, nil
 	case "postgres":
 		dbConfig, ok := c.RepoManagerConfig.(postgresdb.DbConfig)
 		if !ok {
 			return nil, fmt.Errorf("invalid repo manager config type, must be postgresdb.DbConfig")
 		}
+		dbConfig.Schema = walletSchemaPrefix + walletID
+		return dbConfig, nil
+	default:
+		return c.RepoManagerConfig, nil
+	}
+}
 
-		rm, err := postgresdb.NewRepoManager(dbConfig)
-		if err != nil {
-			return nil, err
-		}
-
-		c.rm = rm
+func (c *AppConfig) repoManager() (ports.RepoManager, error) {
+	if c.rm != nil {
 		return c.rm, nil
+	}
+
+	rm, err := NewRepoManager(c.RepoManagerType, c.RepoManagerConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.rm = traceddb.NewRepoManager(rm, c.RepoManagerType)
+	return c.rm, nil
+}
+
+// NewRepoManager is the factory of the repo manager of the given type,
+// configured with the given args.
+func NewRepoManager(
+	rmType string, rmConfig interface{},
+) (ports.RepoManager, error) {
+	switch rmType {
+	case "inmemory":
+		return inmemory.NewRepoManager(), nil
+	case "badger":
+		if rmConfig == nil {
+			return nil, fmt.Errorf("missing repo manager config args")
+		}
+		datadir, ok := rmConfig.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid repo manager config type, must be string")
+		}
+		return dbbadger.NewRepoManager(datadir, log.New())
+	case "postgres":
+		dbConfig, ok := rmConfig.(postgresdb.DbConfig)
+		if !ok {
+			return nil, fmt.Errorf("invalid repo manager config type, must be postgresdb.DbConfig")
+		}
+		return postgresdb.NewRepoManager(dbConfig)
+	case "sqlite":
+		if rmConfig == nil {
+			return nil, fmt.Errorf("missing repo manager config args")
+		}
+		datadir, ok := rmConfig.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid repo manager config type, must be string")
+		}
+		return sqlitedb.NewRepoManager(datadir)
 	default:
 		return nil, fmt.Errorf("unknown repo manager type")
 	}
@@ -243,52 +580,15 @@ func (c *AppConfig) bcScanner() (ports.BlockchainScanner, error) {
 	}
 }
 
-func (c *AppConfig) walletService() *application.WalletService {
-	if c.walletSvc != nil {
-		return c.walletSvc
-	}
-
-	rm, _ := c.repoManager()
-	bcs, _ := c.bcScanner()
-	c.walletSvc = application.NewWalletService(
-		rm, bcs, c.RootPath, c.Network, c.buildInfo(),
-	)
-	return c.walletSvc
-}
-
-func (c *AppConfig) accountService() *application.AccountService {
-	if c.accountSvc != nil {
-		return c.accountSvc
-	}
-
-	rm, _ := c.repoManager()
-	bcs, _ := c.bcScanner()
-	c.accountSvc = application.NewAccountService(rm, bcs)
-	return c.accountSvc
-}
-
-func (c *AppConfig) transactionService() *application.TransactionService {
-	if c.txSvc != nil {
-		return c.txSvc
-	}
-
-	rm, _ := c.repoManager()
-	bcs, _ := c.bcScanner()
-	c.txSvc = application.NewTransactionService(
-		rm, bcs, c.Network, c.UtxoExpiryDuration, c.DustAmount,
-	)
-	return c.txSvc
-}
-
-func (c *AppConfig) notificationService() *application.NotificationService {
-	if c.notifySvc != nil {
-		return c.notifySvc
+func (c *AppConfig) healthService() *application.HealthService {
+	if c.healthSvc != nil {
+		return c.healthSvc
 	}
 
 	rm, _ := c.repoManager()
 	bcs, _ := c.bcScanner()
-	c.notifySvc = application.NewNotificationService(rm, bcs)
-	return c.notifySvc
+	c.healthSvc = application.NewHealthService(rm, bcs, c.BlockchainScannerType)
+	return c.healthSvc
 }
 
 func (c *AppConfig) buildInfo() application.BuildInfo {
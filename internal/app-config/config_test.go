@@ -0,0 +1,77 @@
+package appconfig_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appconfig "github.com/vulpemventures/ocean/internal/app-config"
+	"github.com/vulpemventures/ocean/internal/core/application"
+)
+
+func TestWalletServicesAccess(t *testing.T) {
+	datadir := t.TempDir()
+	err := os.WriteFile(
+		filepath.Join(datadir, "wallets.json"),
+		[]byte(`{"vault-1": "alice"}`), 0600,
+	)
+	require.NoError(t, err)
+
+	cfg := &appconfig.AppConfig{
+		Datadir:         datadir,
+		RepoManagerType: "inmemory",
+	}
+	defer cfg.Close()
+
+	requestCtx := func(caller, walletID string) context.Context {
+		ctx := application.ContextWithAuthenticatedCaller(
+			context.Background(), caller,
+		)
+		return application.ContextWithWalletID(ctx, walletID)
+	}
+
+	t.Run("unknown wallet", func(t *testing.T) {
+		svcs, err := cfg.WalletServices(requestCtx("alice", "vault-2"))
+		require.ErrorIs(t, err, application.ErrWalletNotFound)
+		require.Nil(t, svcs)
+	})
+
+	t.Run("wallet of others", func(t *testing.T) {
+		svcs, err := cfg.WalletServices(requestCtx("bob", "vault-1"))
+		require.ErrorIs(t, err, application.ErrWalletNotFound)
+		require.Nil(t, svcs)
+	})
+
+	t.Run("create wallet of others", func(t *testing.T) {
+		svcs, err := cfg.NewWalletServices(requestCtx("bob", "vault-1"))
+		require.ErrorIs(t, err, application.ErrWalletIDTaken)
+		require.Nil(t, svcs)
+	})
+
+	t.Run("unauthenticated caller", func(t *testing.T) {
+		// The owner identified by its address only is not enough.
+		ctx := application.ContextWithWalletID(
+			application.ContextWithCaller(context.Background(), "alice"),
+			"vault-1",
+		)
+		svcs, err := cfg.WalletServices(ctx)
+		require.ErrorIs(t, err, application.ErrCallerNotAuthenticated)
+		require.Nil(t, svcs)
+
+		ctx = application.ContextWithWalletID(
+			application.ContextWithCaller(context.Background(), "10.0.0.1"),
+			"vault-2",
+		)
+		svcs, err = cfg.NewWalletServices(ctx)
+		require.ErrorIs(t, err, application.ErrCallerNotAuthenticated)
+		require.Nil(t, svcs)
+	})
+
+	t.Run("invalid wallet id", func(t *testing.T) {
+		svcs, err := cfg.NewWalletServices(requestCtx("alice", "../vault"))
+		require.ErrorIs(t, err, application.ErrInvalidWalletID)
+		require.Nil(t, svcs)
+	})
+}
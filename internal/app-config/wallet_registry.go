@@ -0,0 +1,69 @@
+package appconfig
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// walletRegistryFile is the file, within the datadir, where the registry of
+// the wallets is persisted.
+const walletRegistryFile = "wallets.json"
+
+// walletRegistry keeps track of the wallets other than the default one,
+// created or restored by some caller, and maps each of them to the identity
+// of such caller, its owner.
+// The registry is persisted only if the path of its file is given.
+type walletRegistry struct {
+	path   string
+	owners map[string]string
+}
+
+func newWalletRegistry(datadir string) (*walletRegistry, error) {
+	r := &walletRegistry{owners: make(map[string]string)}
+	if datadir == "" {
+		return r, nil
+	}
+
+	r.path = filepath.Join(datadir, walletRegistryFile)
+	buf, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, &r.owners); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// owner returns the owner of the given wallet, if registered.
+func (r *walletRegistry) owner(walletID string) (string, bool) {
+	owner, ok := r.owners[walletID]
+	return owner, ok
+}
+
+// add registers the given wallet as owned by the given caller, and persists
+// the registry if required.
+func (r *walletRegistry) add(walletID, owner string) error {
+	r.owners[walletID] = owner
+	if r.path == "" {
+		return nil
+	}
+
+	buf, err := json.MarshalIndent(r.owners, "", "  ")
+	if err == nil {
+		// Write to a temp file first to never leave a truncated registry.
+		tmpPath := r.path + ".tmp"
+		if err = os.WriteFile(tmpPath, buf, 0600); err == nil {
+			err = os.Rename(tmpPath, r.path)
+		}
+	}
+	if err != nil {
+		delete(r.owners, walletID)
+		return err
+	}
+	return nil
+}
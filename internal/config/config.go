@@ -26,6 +26,9 @@ const (
 	// ProfilerPortKey is the key to customize the port where the profiler will
 	// be listening to.
 	ProfilerPortKey = "PROFILER_PORT"
+	// RestPortKey is the key to customize the port where the REST gateway will
+	// be listening to.
+	RestPortKey = "REST_PORT"
 	// NetworkKey is the key to customize the Liquid network.
 	NetworkKey = "NETWORK"
 	// NativeAssetKey is the key to customize the native LBTC asset of the Liquid
@@ -40,10 +43,39 @@ const (
 	// TLSExtraDomainKey is the key to bind one or more public dns domains to the
 	// TLS key pair. Should be used only when enabling TLS.
 	TLSExtraDomainKey = "TLS_EXTRA_DOMAIN"
+	// TLSClientCAKey is the key to set the path of the PEM encoded CA bundle
+	// used to verify client certificates. When set, clients are required to
+	// authenticate with a certificate signed by one of these CAs.
+	TLSClientCAKey = "TLS_CLIENT_CA"
+	// TLSClientRolesKey is the key to map the subject common names of client
+	// certificates to permission roles, in the form "subject:role".
+	// Should be used only when enabling client authentication.
+	TLSClientRolesKey = "TLS_CLIENT_ROLES"
+	// RateLimitsKey is the key to set the token-bucket budgets of every client
+	// per method, in the form "method:rate:burst", where rate is the number of
+	// requests per second and method is either the name of an RPC, like
+	// DeriveAddresses, or * for any RPC without a dedicated budget.
+	// Clients are identified by the subject of their certificate, if any,
+	// otherwise by their IP address.
+	RateLimitsKey = "RATE_LIMITS"
+	// MaxAddressesPerRequestKey is the key to customize the max number of
+	// addresses that can be derived with a single request.
+	MaxAddressesPerRequestKey = "MAX_ADDRESSES_PER_REQUEST"
+	// MaxInputsPerRequestKey is the key to customize the max number of inputs
+	// of a single request.
+	MaxInputsPerRequestKey = "MAX_INPUTS_PER_REQUEST"
+	// MaxOutputsPerRequestKey is the key to customize the max number of
+	// outputs of a single request.
+	MaxOutputsPerRequestKey = "MAX_OUTPUTS_PER_REQUEST"
+	// MaxTxSizeKey is the key to customize the max size in bytes of the
+	// serialized txs and psets of a single request.
+	MaxTxSizeKey = "MAX_TX_SIZE_IN_BYTES"
 	// NoTLSKey is the key to disable TLS encryption.
 	NoTLSKey = "NO_TLS"
 	// NoProfilerKey is the key to disable Prometheus profiling.
 	NoProfilerKey = "NO_PROFILER"
+	// NoRestKey is the key to disable the REST gateway.
+	NoRestKey = "NO_REST"
 	// StatsIntervalKey is the key to customize the interval for the profiled to
 	// gather profiling stats.
 	StatsIntervalKey = "STATS_INTERVAL"
@@ -56,6 +88,9 @@ const (
 	// UtxoExpiryDurationKey is the key to customize the waiting time for one or
 	// more previously locked utxos to be unlocked if not yet spent.
 	UtxoExpiryDurationKey = "UTXO_EXPIRY_DURATION_IN_SECONDS"
+	// IdempotencyKeyTTLKey is the key to customize for how long the result of a
+	// request with an idempotency key is returned to its retries.
+	IdempotencyKeyTTLKey = "IDEMPOTENCY_KEY_TTL_IN_SECONDS"
 	// RootPathKey is the key to use a custom root path for the wallet,
 	// instead of the default m/84'/[1776|1]' (depending on network).
 	RootPathKey = "ROOT_PATH"
@@ -76,10 +111,53 @@ const (
 	DbMigrationPath = "DB_MIGRATION_PATH"
 	// DustAmountKey is the key to customize the dust amount threshold
 	DustAmountKey = "DUST_AMOUNT"
+	// ConsolidationIntervalKey is the key to enable the periodic consolidation
+	// of the utxos of every account, by setting the interval in seconds between
+	// consecutive runs.
+	ConsolidationIntervalKey = "CONSOLIDATION_INTERVAL_IN_SECONDS"
+	// ConsolidationValueThresholdKey is the key to customize the value below
+	// which utxos are merged by the periodic consolidation.
+	ConsolidationValueThresholdKey = "CONSOLIDATION_VALUE_THRESHOLD"
+	// ConsolidationMaxInputsKey is the key to customize the max number of
+	// inputs of every consolidation tx.
+	ConsolidationMaxInputsKey = "CONSOLIDATION_MAX_INPUTS"
+	// ConsolidationMillisatsPerByteKey is the key to customize the fee rate of
+	// the consolidation txs, meant to be low.
+	ConsolidationMillisatsPerByteKey = "CONSOLIDATION_MILLISATS_PER_BYTE"
+	// ConsolidationMaxEstimatedMillisatsPerByteKey is the key to customize the
+	// network fee rate estimate above which the periodic consolidation is
+	// skipped, 0 disables the check.
+	ConsolidationMaxEstimatedMillisatsPerByteKey = "CONSOLIDATION_MAX_ESTIMATED_MILLISATS_PER_BYTE"
+	// PaymentBatchMaxSizeKey is the key to customize the max number of queued
+	// payments flushed into a single tx.
+	PaymentBatchMaxSizeKey = "PAYMENT_BATCH_MAX_SIZE"
+	// PaymentBatchMaxWaitKey is the key to customize the max time in seconds a
+	// payment waits in the queue before being flushed.
+	PaymentBatchMaxWaitKey = "PAYMENT_BATCH_MAX_WAIT_IN_SECONDS"
+	// PaymentBatchMillisatsPerByteKey is the key to customize the fee rate of
+	// the txs flushing the queued payments.
+	PaymentBatchMillisatsPerByteKey = "PAYMENT_BATCH_MILLISATS_PER_BYTE"
+	// BroadcastCheckIntervalKey is the key to customize the interval in seconds
+	// between the checks of the broadcasted txs not yet confirmed.
+	BroadcastCheckIntervalKey = "BROADCAST_CHECK_INTERVAL_IN_SECONDS"
+	// BroadcastMaxAttemptsKey is the key to customize the number of
+	// rebroadcasts after which a tx still missing from mempool is dropped.
+	BroadcastMaxAttemptsKey = "BROADCAST_MAX_ATTEMPTS"
+	// TxSchedulerCheckIntervalKey is the key to customize the interval in
+	// seconds between the checks of the txs scheduled to be broadcasted.
+	TxSchedulerCheckIntervalKey = "TX_SCHEDULER_CHECK_INTERVAL_IN_SECONDS"
 	// PasswordKey is the key to set the password for auto-init/auto-unlock.
 	PasswordKey = "PASSWORD"
 	// MnemonicKey is the key to set the mnemonic for auto-init.
 	MnemonicKey = "MNEMONIC"
+	// OtlpEndpointKey is the key to set the host:port of the OTLP collector
+	// where to export traces. Tracing is disabled if not set.
+	OtlpEndpointKey = "OTLP_ENDPOINT"
+	// OtlpInsecureKey is the key to connect to the OTLP collector without TLS.
+	OtlpInsecureKey = "OTLP_INSECURE"
+	// TracingSampleRatioKey is the key to customize the fraction of traces to
+	// sample, in range (0, 1].
+	TracingSampleRatioKey = "TRACING_SAMPLE_RATIO"
 
 	// DbLocation is the folder inside the datadir containing db files.
 	DbLocation = "db"
@@ -104,10 +182,33 @@ var (
 	defaultLogLevel           = 4
 	defaultNetwork            = network.Liquid.Name
 	defaultProfilerPort       = 18001
-	defaultStatsInterval      = 600 // 10 minutes
-	defaultUtxoExpiryDuration = 360 // 6 minutes (3 blocks)
+	defaultRestPort           = 18002
+	defaultStatsInterval      = 600   // 10 minutes
+	defaultUtxoExpiryDuration = 360   // 6 minutes (3 blocks)
+	defaultIdempotencyKeyTTL  = 86400 // 24 hours
 	defaultElectrumUrl        = "ssl://blockstream.info:995"
 	defaultDustAmount         = uint64(450)
+	defaultTracingSampleRatio = 1.0
+
+	defaultMaxAddressesPerRequest = 100
+	defaultMaxInputsPerRequest    = 256
+	defaultMaxOutputsPerRequest   = 256
+	defaultMaxTxSize              = 1 << 20 // 1 MiB
+
+	defaultConsolidationValueThreshold   = 100000 // 0.001 BTC
+	defaultConsolidationMaxInputs        = 50
+	defaultConsolidationMillisatsPerByte = 100
+
+	defaultConsolidationMaxEstimatedMillisatsPerByte = 200
+
+	defaultPaymentBatchMaxSize          = 100
+	defaultPaymentBatchMaxWait          = 600 // 10 minutes
+	defaultPaymentBatchMillisatsPerByte = 100
+
+	defaultBroadcastCheckInterval = 30
+	defaultBroadcastMaxAttempts   = 10
+
+	defaultTxSchedulerCheckInterval = 30
 
 	supportedNetworks = map[string]*network.Network{
 		network.Liquid.Name:  &network.Liquid,
@@ -123,6 +224,7 @@ var (
 		"badger":   {},
 		"inmemory": {},
 		"postgres": {},
+		"sqlite":   {},
 	}
 	SupportedBcScanners = supportedType{
 		"neutrino": {},
@@ -145,8 +247,11 @@ func init() {
 	vip.SetDefault(NoTLSKey, false)
 	vip.SetDefault(NoProfilerKey, false)
 	vip.SetDefault(ProfilerPortKey, defaultProfilerPort)
+	vip.SetDefault(NoRestKey, false)
+	vip.SetDefault(RestPortKey, defaultRestPort)
 	vip.SetDefault(StatsIntervalKey, defaultStatsInterval)
 	vip.SetDefault(UtxoExpiryDurationKey, defaultUtxoExpiryDuration)
+	vip.SetDefault(IdempotencyKeyTTLKey, defaultIdempotencyKeyTTL)
 	vip.SetDefault(DbUserKey, "root")
 	vip.SetDefault(DbPassKey, "secret")
 	vip.SetDefault(DbHostKey, "127.0.0.1")
@@ -155,6 +260,22 @@ func init() {
 	vip.SetDefault(DbMigrationPath, "file://internal/infrastructure/storage/db/postgres/migration")
 	vip.SetDefault(ElectrumUrlKey, defaultElectrumUrl)
 	vip.SetDefault(DustAmountKey, defaultDustAmount)
+	vip.SetDefault(TracingSampleRatioKey, defaultTracingSampleRatio)
+	vip.SetDefault(MaxAddressesPerRequestKey, defaultMaxAddressesPerRequest)
+	vip.SetDefault(MaxInputsPerRequestKey, defaultMaxInputsPerRequest)
+	vip.SetDefault(MaxOutputsPerRequestKey, defaultMaxOutputsPerRequest)
+	vip.SetDefault(MaxTxSizeKey, defaultMaxTxSize)
+	vip.SetDefault(ConsolidationIntervalKey, 0)
+	vip.SetDefault(ConsolidationValueThresholdKey, defaultConsolidationValueThreshold)
+	vip.SetDefault(ConsolidationMaxInputsKey, defaultConsolidationMaxInputs)
+	vip.SetDefault(ConsolidationMillisatsPerByteKey, defaultConsolidationMillisatsPerByte)
+	vip.SetDefault(ConsolidationMaxEstimatedMillisatsPerByteKey, defaultConsolidationMaxEstimatedMillisatsPerByte)
+	vip.SetDefault(PaymentBatchMaxSizeKey, defaultPaymentBatchMaxSize)
+	vip.SetDefault(PaymentBatchMaxWaitKey, defaultPaymentBatchMaxWait)
+	vip.SetDefault(PaymentBatchMillisatsPerByteKey, defaultPaymentBatchMillisatsPerByte)
+	vip.SetDefault(BroadcastCheckIntervalKey, defaultBroadcastCheckInterval)
+	vip.SetDefault(BroadcastMaxAttemptsKey, defaultBroadcastMaxAttempts)
+	vip.SetDefault(TxSchedulerCheckIntervalKey, defaultTxSchedulerCheckInterval)
 
 	if err := validate(); err != nil {
 		log.Fatalf("invalid config: %s", err)
@@ -225,6 +346,78 @@ func validate() error {
 			return fmt.Errorf("port and profiler port must not be equal")
 		}
 	}
+	noRest := GetBool(NoRestKey)
+	if !noRest {
+		restPort := GetInt(RestPortKey)
+		if port == restPort {
+			return fmt.Errorf("port and rest port must not be equal")
+		}
+		if !noProfiler && restPort == GetInt(ProfilerPortKey) {
+			return fmt.Errorf("rest port and profiler port must not be equal")
+		}
+	}
+
+	if IsSet(TLSClientCAKey) && GetBool(NoTLSKey) {
+		return fmt.Errorf("client CA must not be set if TLS is disabled")
+	}
+
+	if GetInt(MaxAddressesPerRequestKey) <= 0 {
+		return fmt.Errorf("max addresses per request must be a positive number")
+	}
+	if GetInt(MaxInputsPerRequestKey) <= 0 {
+		return fmt.Errorf("max inputs per request must be a positive number")
+	}
+	if GetInt(MaxOutputsPerRequestKey) <= 0 {
+		return fmt.Errorf("max outputs per request must be a positive number")
+	}
+	if GetInt(MaxTxSizeKey) <= 0 {
+		return fmt.Errorf("max tx size must be a positive number")
+	}
+
+	if GetInt(IdempotencyKeyTTLKey) <= 0 {
+		return fmt.Errorf("idempotency key ttl must be a positive number")
+	}
+
+	if ratio := GetFloat64(TracingSampleRatioKey); ratio <= 0 || ratio > 1 {
+		return fmt.Errorf("tracing sample ratio must be in range (0, 1]")
+	}
+
+	if GetInt(ConsolidationIntervalKey) < 0 {
+		return fmt.Errorf("consolidation interval must not be negative")
+	}
+	if GetInt(ConsolidationIntervalKey) > 0 {
+		if GetInt(ConsolidationValueThresholdKey) <= 0 {
+			return fmt.Errorf("consolidation value threshold must be a positive number")
+		}
+		if GetInt(ConsolidationMaxInputsKey) < 2 {
+			return fmt.Errorf("consolidation max inputs must be at least 2")
+		}
+		if GetInt(ConsolidationMillisatsPerByteKey) < 100 {
+			return fmt.Errorf("consolidation fee rate must be at least 100 millisats/byte")
+		}
+		if GetInt(ConsolidationMaxEstimatedMillisatsPerByteKey) < 0 {
+			return fmt.Errorf("consolidation max estimated fee rate must not be negative")
+		}
+	}
+
+	if GetInt(PaymentBatchMaxSizeKey) <= 0 {
+		return fmt.Errorf("payment batch max size must be a positive number")
+	}
+	if GetInt(PaymentBatchMaxWaitKey) <= 0 {
+		return fmt.Errorf("payment batch max wait time must be a positive number")
+	}
+	if GetInt(PaymentBatchMillisatsPerByteKey) < 100 {
+		return fmt.Errorf("payment batch fee rate must be at least 100 millisats/byte")
+	}
+	if GetInt(BroadcastCheckIntervalKey) <= 0 {
+		return fmt.Errorf("broadcast check interval must be a positive number")
+	}
+	if GetInt(BroadcastMaxAttemptsKey) <= 0 {
+		return fmt.Errorf("broadcast max attempts must be a positive number")
+	}
+	if GetInt(TxSchedulerCheckIntervalKey) <= 0 {
+		return fmt.Errorf("tx scheduler check interval must be a positive number")
+	}
 
 	if IsSet(MnemonicKey) && !IsSet(PasswordKey) {
 		return fmt.Errorf("password must be defined if mnemonic is set")
@@ -271,6 +464,10 @@ func GetBool(key string) bool {
 	return vip.GetBool(key)
 }
 
+func GetFloat64(key string) float64 {
+	return vip.GetFloat64(key)
+}
+
 func GetStringSlice(key string) []string {
 	return vip.GetStringSlice(key)
 }
@@ -16,8 +16,10 @@ import (
 //   - Derive addresses for an existing account.
 //   - List derived addresses for an existing account.
 //   - Get balance of an existing account.
-//   - List utxos of an existing account.
+//   - List utxos of an existing account, optionally filtered by label, asset, confirmations or frozen state.
+//   - Freeze, unfreeze and label utxos, and label addresses of an existing account.
 //   - Delete an existing account.
+//   - Set, get or delete the spending policy of an existing account. Changing a policy requires the wallet password.
 //
 // The service registers 3 handlers related to the following wallet events:
 //   - domain.WalletAccountCreated - whenever an account is created, the service initializes a dedicated blockchain scanner and starts listening for its reports.
@@ -58,8 +60,11 @@ func NewAccountService(
 
 func (as *AccountService) CreateAccountBIP44(
 	ctx context.Context, label string, unconf bool,
-) (*AccountInfo, error) {
-	_, birthdayBlockHeight, err := as.bcScanner.GetLatestBlock()
+) (_ *AccountInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.CreateAccountBIP44")
+	defer func() { endSpan(span, err) }()
+
+	_, birthdayBlockHeight, err := as.bcScanner.GetLatestBlock(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +79,10 @@ func (as *AccountService) CreateAccountBIP44(
 
 func (as *AccountService) SetAccountLabel(
 	ctx context.Context, accountName, label string,
-) (*AccountInfo, error) {
+) (_ *AccountInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.SetAccountLabel")
+	defer func() { endSpan(span, err) }()
+
 	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
 	if err != nil {
 		return nil, err
@@ -98,7 +106,10 @@ func (as *AccountService) SetAccountLabel(
 
 func (as *AccountService) DeriveAddressesForAccount(
 	ctx context.Context, accountName string, numOfAddresses uint64,
-) (AddressesInfo, error) {
+) (_ AddressesInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.DeriveAddressesForAccount")
+	defer func() { endSpan(span, err) }()
+
 	if numOfAddresses == 0 {
 		numOfAddresses = 1
 	}
@@ -113,7 +124,10 @@ func (as *AccountService) DeriveAddressesForAccount(
 
 func (as *AccountService) DeriveChangeAddressesForAccount(
 	ctx context.Context, accountName string, numOfAddresses uint64,
-) (AddressesInfo, error) {
+) (_ AddressesInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.DeriveChangeAddressesForAccount")
+	defer func() { endSpan(span, err) }()
+
 	if numOfAddresses == 0 {
 		numOfAddresses = 1
 	}
@@ -128,7 +142,10 @@ func (as *AccountService) DeriveChangeAddressesForAccount(
 
 func (as *AccountService) ListAddressesForAccount(
 	ctx context.Context, accountName string,
-) (AddressesInfo, error) {
+) (_ AddressesInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.ListAddressesForAccount")
+	defer func() { endSpan(span, err) }()
+
 	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
 	if err != nil {
 		return nil, err
@@ -138,12 +155,25 @@ func (as *AccountService) ListAddressesForAccount(
 	if err != nil {
 		return nil, err
 	}
+
+	account, _ := w.GetAccount(accountName)
+	labelsByScript, err := as.repoManager.AddressLabelRepository().
+		GetAddressLabelsForAccount(ctx, account.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for i, info := range addressesInfo {
+		addressesInfo[i].Labels = labelsByScript[info.Script]
+	}
 	return AddressesInfo(addressesInfo), nil
 }
 
 func (as *AccountService) GetBalanceForAccount(
 	ctx context.Context, accountName string,
-) (BalanceInfo, error) {
+) (_ BalanceInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.GetBalanceForAccount")
+	defer func() { endSpan(span, err) }()
+
 	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
 	if err != nil {
 		return nil, err
@@ -161,7 +191,11 @@ func (as *AccountService) GetBalanceForAccount(
 
 func (as *AccountService) ListUtxosForAccount(
 	ctx context.Context, accountName string, scripts [][]byte,
-) (*UtxoInfo, error) {
+	filter UtxoFilter,
+) (_ *UtxoInfo, err error) {
+	ctx, span := startSpan(ctx, "AccountService.ListUtxosForAccount")
+	defer func() { endSpan(span, err) }()
+
 	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
 	if err != nil {
 		return nil, err
@@ -172,21 +206,156 @@ func (as *AccountService) ListUtxosForAccount(
 		return nil, err
 	}
 
-	spendableUtxos, err := as.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
-		ctx, account.Namespace, scripts,
+	var tipHeight uint32
+	if filter.MinConfirmations > 0 {
+		if _, tipHeight, err = as.bcScanner.GetLatestBlock(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	info := &UtxoInfo{}
+	if filter.Frozen != FrozenFilterOnly {
+		spendableUtxos, err := as.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+			ctx, account.Namespace, scripts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		lockedUtxos, err := as.repoManager.UtxoRepository().GetLockedUtxosForAccount(
+			ctx, account.Namespace, scripts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		info.Spendable = filter.apply(spendableUtxos, tipHeight)
+		info.Locked = filter.apply(lockedUtxos, tipHeight)
+	}
+	if filter.Frozen != FrozenFilterNone {
+		frozenUtxos, err := as.repoManager.UtxoRepository().GetFrozenUtxosForAccount(
+			ctx, account.Namespace, scripts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		info.Frozen = filter.apply(frozenUtxos, tipHeight)
+	}
+
+	return info, nil
+}
+
+// FreezeUtxos freezes the given utxos of the account, that are never
+// selected for spending until unfrozen. Locked utxos can't be frozen.
+func (as *AccountService) FreezeUtxos(
+	ctx context.Context, accountName string, ins Inputs,
+) (_ int, err error) {
+	ctx, span := startSpan(ctx, "AccountService.FreezeUtxos")
+	defer func() { endSpan(span, err) }()
+
+	utxos, err := as.getUtxosForAccount(ctx, accountName, ins)
+	if err != nil {
+		return -1, err
+	}
+	for _, u := range utxos {
+		if u.IsLocked() {
+			return -1, fmt.Errorf("%w: %s", domain.ErrUtxoAlreadyLocked, u.Key())
+		}
+	}
+
+	count, err := as.repoManager.UtxoRepository().FreezeUtxos(
+		ctx, Utxos(utxos).Keys(), time.Now().Unix(),
 	)
 	if err != nil {
-		return nil, err
+		return -1, err
+	}
+	if count > 0 {
+		as.log(
+			"froze %d utxo(s) for account %s (%s)",
+			count, accountName, UtxoKeys(Utxos(utxos).Keys()),
+		)
+	}
+	return count, nil
+}
+
+// UnfreezeUtxos makes the given frozen utxos of the account spendable again.
+func (as *AccountService) UnfreezeUtxos(
+	ctx context.Context, accountName string, ins Inputs,
+) (_ int, err error) {
+	ctx, span := startSpan(ctx, "AccountService.UnfreezeUtxos")
+	defer func() { endSpan(span, err) }()
+
+	utxos, err := as.getUtxosForAccount(ctx, accountName, ins)
+	if err != nil {
+		return -1, err
 	}
 
-	lockedUtxos, err := as.repoManager.UtxoRepository().GetLockedUtxosForAccount(
-		ctx, account.Namespace, scripts,
+	count, err := as.repoManager.UtxoRepository().UnfreezeUtxos(
+		ctx, Utxos(utxos).Keys(),
 	)
 	if err != nil {
-		return nil, err
+		return -1, err
+	}
+	if count > 0 {
+		as.log(
+			"unfroze %d utxo(s) for account %s (%s)",
+			count, accountName, UtxoKeys(Utxos(utxos).Keys()),
+		)
+	}
+	return count, nil
+}
+
+// SetUtxoLabels overwrites the labels of the given utxos of the account. An
+// empty list removes all labels.
+func (as *AccountService) SetUtxoLabels(
+	ctx context.Context, accountName string, ins Inputs, labels []string,
+) (_ int, err error) {
+	ctx, span := startSpan(ctx, "AccountService.SetUtxoLabels")
+	defer func() { endSpan(span, err) }()
+
+	utxos, err := as.getUtxosForAccount(ctx, accountName, ins)
+	if err != nil {
+		return -1, err
+	}
+
+	return as.repoManager.UtxoRepository().SetUtxoLabels(
+		ctx, Utxos(utxos).Keys(), domain.NormalizeLabels(labels),
+	)
+}
+
+// SetAddressLabels overwrites the labels of the given derived address of the
+// account. An empty list removes all labels.
+func (as *AccountService) SetAddressLabels(
+	ctx context.Context, accountName, addr string, labels []string,
+) (err error) {
+	ctx, span := startSpan(ctx, "AccountService.SetAddressLabels")
+	defer func() { endSpan(span, err) }()
+
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return err
+	}
+	addressesInfo, err := w.AllDerivedAddressesForAccount(accountName)
+	if err != nil {
+		return err
 	}
 
-	return &UtxoInfo{spendableUtxos, lockedUtxos}, nil
+	for _, info := range addressesInfo {
+		if info.Address == addr {
+			return as.repoManager.AddressLabelRepository().SetAddressLabels(
+				ctx, account.Namespace, info.Script, domain.NormalizeLabels(labels),
+			)
+		}
+	}
+	return newError(
+		ReasonNotFound, "address %s not found for account %s", addr, accountName,
+	).withMetadata(ErrorMetadataAccount, account.Namespace)
 }
 
 func (as *AccountService) DeleteAccount(
@@ -197,9 +366,10 @@ func (as *AccountService) DeleteAccount(
 		return
 	}
 	if len(balance) > 0 {
-		err = fmt.Errorf(
+		err = newError(
+			ReasonAccountNotEmpty,
 			"account %s must have zero balance to be deleted", accountName,
-		)
+		).withMetadata(ErrorMetadataAccount, accountName)
 		return
 	}
 
@@ -213,6 +383,22 @@ func (as *AccountService) DeleteAccount(
 					accountName,
 				)
 			}
+			if err := as.repoManager.AddressLabelRepository().DeleteAddressLabelsForAccount(
+				ctx, accountName,
+			); err != nil {
+				as.warn(
+					err, "error while deleting address labels for account %s",
+					accountName,
+				)
+			}
+			if _, err := as.repoManager.SpendingPolicyRepository().DeletePolicy(
+				ctx, accountName,
+			); err != nil {
+				as.warn(
+					err, "error while deleting spending policy for account %s",
+					accountName,
+				)
+			}
 		}
 	}()
 
@@ -220,6 +406,142 @@ func (as *AccountService) DeleteAccount(
 	return
 }
 
+func (as *AccountService) SetSpendingPolicy(
+	ctx context.Context, accountName, password string,
+	limits []domain.AssetLimit, allowedScripts []string,
+	maxMillisatsPerByte uint64,
+) (_ *domain.SpendingPolicy, err error) {
+	ctx, span := startSpan(ctx, "AccountService.SetSpendingPolicy")
+	defer func() { endSpan(span, err) }()
+
+	account, err := as.getAccountWithPassword(ctx, accountName, password)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := domain.NewSpendingPolicy(
+		account.Namespace, limits, allowedScripts, maxMillisatsPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep track of the funds already spent within the rolling windows in case
+	// an existing policy is overwritten.
+	policyRepo := as.repoManager.SpendingPolicyRepository()
+	if oldPolicy, _ := policyRepo.GetPolicy(ctx, account.Namespace); oldPolicy != nil {
+		policy.Spends = oldPolicy.Spends
+	}
+
+	if err := policyRepo.SetPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	as.log("set spending policy for account %s", account.Namespace)
+
+	return policy, nil
+}
+
+func (as *AccountService) GetSpendingPolicy(
+	ctx context.Context, accountName string,
+) (_ *domain.SpendingPolicy, err error) {
+	ctx, span := startSpan(ctx, "AccountService.GetSpendingPolicy")
+	defer func() { endSpan(span, err) }()
+
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return as.repoManager.SpendingPolicyRepository().GetPolicy(
+		ctx, account.Namespace,
+	)
+}
+
+func (as *AccountService) DeleteSpendingPolicy(
+	ctx context.Context, accountName, password string,
+) (err error) {
+	ctx, span := startSpan(ctx, "AccountService.DeleteSpendingPolicy")
+	defer func() { endSpan(span, err) }()
+
+	account, err := as.getAccountWithPassword(ctx, accountName, password)
+	if err != nil {
+		return err
+	}
+
+	done, err := as.repoManager.SpendingPolicyRepository().DeletePolicy(
+		ctx, account.Namespace,
+	)
+	if err != nil {
+		return err
+	}
+	if !done {
+		return domain.ErrPolicyNotFound
+	}
+	as.log("deleted spending policy for account %s", account.Namespace)
+
+	return nil
+}
+
+// getUtxosForAccount returns the utxos with the given keys, making sure that
+// all of them exist and are owned by the account.
+func (as *AccountService) getUtxosForAccount(
+	ctx context.Context, accountName string, ins Inputs,
+) ([]*domain.Utxo, error) {
+	if len(ins) <= 0 {
+		return nil, newError(ReasonInvalidArgument, "missing utxos")
+	}
+
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := ins.Keys()
+	utxos, err := as.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	utxosByKey := make(map[domain.UtxoKey]*domain.Utxo)
+	for _, u := range utxos {
+		if u.AccountName == account.Namespace && !u.IsSpent() {
+			utxosByKey[u.Key()] = u
+		}
+	}
+	for _, key := range keys {
+		if _, ok := utxosByKey[key]; !ok {
+			return nil, newError(
+				ReasonNotFound, "utxo %s not found for account %s",
+				key, accountName,
+			).withMetadata(ErrorMetadataAccount, account.Namespace)
+		}
+	}
+	return utxos, nil
+}
+
+func (as *AccountService) getAccountWithPassword(
+	ctx context.Context, accountName, password string,
+) (*domain.Account, error) {
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !w.IsValidPassword(password) {
+		return nil, domain.ErrWalletInvalidPassword
+	}
+
+	return w.GetAccount(accountName)
+}
+
 func (as *AccountService) registerHandlerForWalletEvents() {
 	// Start watching all existing accounts' addresses as soon as wallet is unlocked.
 	as.repoManager.RegisterHandlerForWalletEvent(
@@ -49,12 +49,45 @@ func TestAccountService(t *testing.T) {
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(addresses), 2)
 
-	utxos, err := svc.ListUtxosForAccount(ctx, accountName, nil)
+	utxos, err := svc.ListUtxosForAccount(
+		ctx, accountName, nil, application.UtxoFilter{},
+	)
 	require.NoError(t, err)
 	require.NotNil(t, utxos)
 	require.NotEmpty(t, utxos.Spendable)
 	require.Empty(t, utxos.Locked)
 
+	frozenUtxo := utxos.Spendable[0].Key()
+	frozenIns := application.Inputs{{TxID: frozenUtxo.TxID, VOut: frozenUtxo.VOut}}
+	count, err := svc.FreezeUtxos(ctx, accountName, frozenIns)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = svc.SetUtxoLabels(ctx, accountName, frozenIns, []string{"cold"})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	frozenUtxos, err := svc.ListUtxosForAccount(
+		ctx, accountName, nil, application.UtxoFilter{
+			Label:  "cold",
+			Frozen: application.FrozenFilterOnly,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, frozenUtxos.Spendable)
+	require.Len(t, frozenUtxos.Frozen, 1)
+	require.Equal(t, []string{"cold"}, frozenUtxos.Frozen[0].Labels)
+
+	count, err = svc.UnfreezeUtxos(ctx, accountName, frozenIns)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	err = svc.SetAddressLabels(ctx, accountName, addresses[0].Address, []string{"deposit"})
+	require.NoError(t, err)
+	addresses, err = svc.ListAddressesForAccount(ctx, accountName)
+	require.NoError(t, err)
+	require.Equal(t, []string{"deposit"}, addresses[0].Labels)
+
 	balance, err := svc.GetBalanceForAccount(ctx, accountName)
 	require.NoError(t, err)
 	require.NotNil(t, balance)
@@ -0,0 +1,138 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+var (
+	DefaultAuditEventsPageSize = uint64(100)
+	MaxAuditEventsPageSize     = uint64(1000)
+)
+
+// AuditService is responsible for keeping the tamper-evident audit log of
+// the state-changing operations:
+//   - Record an event by chaining it to the last one of the log.
+//   - List the events of the log, paginated.
+//
+// The service guarantees that events are appended to the log one at a time so
+// that the hash chain is never forked.
+type AuditService struct {
+	repoManager ports.RepoManager
+	lock        *sync.Mutex
+
+	log  func(format string, a ...interface{})
+	warn func(err error, format string, a ...interface{})
+}
+
+func NewAuditService(repoManager ports.RepoManager) *AuditService {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("audit service: %s", format)
+		log.Debugf(format, a...)
+	}
+	warnFn := func(err error, format string, a ...interface{}) {
+		format = fmt.Sprintf("audit service: %s", format)
+		log.WithError(err).Warnf(format, a...)
+	}
+
+	return &AuditService{repoManager, &sync.Mutex{}, logFn, warnFn}
+}
+
+// RecordEvent appends a new event to the audit log. The identity of the
+// caller is retrieved from the given context.
+// If not given, spent amounts, destinations and accounts are extracted from
+// the transaction, if any, and so is its txid.
+func (as *AuditService) RecordEvent(
+	ctx context.Context, info AuditEventInfo,
+) error {
+	amounts, destinations := info.Amounts, info.Destinations
+	accountName, txid := info.AccountName, info.Txid
+
+	if len(info.Tx) > 0 {
+		if len(amounts) <= 0 && len(destinations) <= 0 {
+			spendInfo, err := getSpendInfo(ctx, as.repoManager, info.Tx, 0)
+			if err != nil {
+				as.warn(err, "failed to parse tx for method %s", info.Method)
+			} else {
+				amounts, destinations = spendInfo.totalAmounts(), spendInfo.destinations
+				if accountName == "" {
+					accountName = spendInfo.accountNames()
+				}
+			}
+		}
+		if txid == "" {
+			txid, _ = txHash(info.Tx)
+		}
+	}
+
+	errMsg := ""
+	if info.Err != nil {
+		errMsg = info.Err.Error()
+	}
+
+	as.lock.Lock()
+	defer as.lock.Unlock()
+
+	auditRepo := as.repoManager.AuditEventRepository()
+	prev, err := auditRepo.GetLastEvent(ctx)
+	if err != nil && !errors.Is(err, domain.ErrAuditEventNotFound) {
+		return err
+	}
+
+	event, err := domain.NewAuditEvent(
+		prev, CallerFromContext(ctx), info.Method, accountName, amounts,
+		destinations, txid, errMsg,
+	)
+	if err != nil {
+		return err
+	}
+
+	done, err := auditRepo.AddEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	if !done {
+		return fmt.Errorf("audit event %d already exists", event.Sequence)
+	}
+
+	as.log("recorded event %d for method %s", event.Sequence, event.Method)
+	return nil
+}
+
+// ListEvents returns a page of the audit log starting from the given
+// sequence, along with the sequence from which requesting the next page, or
+// zero if there are no more events.
+// A zero limit defaults to DefaultAuditEventsPageSize.
+func (as *AuditService) ListEvents(
+	ctx context.Context, fromSequence, limit uint64,
+) ([]domain.AuditEvent, uint64, error) {
+	if limit == 0 {
+		limit = DefaultAuditEventsPageSize
+	}
+	if limit > MaxAuditEventsPageSize {
+		limit = MaxAuditEventsPageSize
+	}
+	if fromSequence == 0 {
+		fromSequence = 1
+	}
+
+	events, err := as.repoManager.AuditEventRepository().GetEvents(
+		ctx, fromSequence, limit,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// There might be more events only if the page is full.
+	nextSequence := uint64(0)
+	if uint64(len(events)) == limit {
+		nextSequence = events[len(events)-1].Sequence + 1
+	}
+	return events, nextSequence, nil
+}
@@ -0,0 +1,56 @@
+package application_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestAuditService(t *testing.T) {
+	svc := application.NewAuditService(inmemory.NewRepoManager())
+
+	aliceCtx := application.ContextWithCaller(ctx, "alice")
+	txid := randomHex(32)
+	err := svc.RecordEvent(aliceCtx, application.AuditEventInfo{
+		Method:       "Transfer",
+		AccountName:  accountName,
+		Amounts:      map[string]uint64{regtest.AssetID: 1000},
+		Destinations: []string{randomHex(22)},
+		Txid:         txid,
+	})
+	require.NoError(t, err)
+
+	err = svc.RecordEvent(ctx, application.AuditEventInfo{
+		Method: "Lock",
+		Err:    fmt.Errorf("wrong password"),
+	})
+	require.NoError(t, err)
+
+	err = svc.RecordEvent(ctx, application.AuditEventInfo{})
+	require.EqualError(t, err, domain.ErrAuditEventMissingMethod.Error())
+
+	events, next, err := svc.ListEvents(ctx, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Zero(t, next)
+	require.NoError(t, domain.VerifyAuditEvents(nil, events))
+	require.Equal(t, "alice", events[0].Caller)
+	require.Equal(t, txid, events[0].Txid)
+	require.Empty(t, events[1].Caller)
+	require.Equal(t, "wrong password", events[1].Error)
+
+	events, next, err = svc.ListEvents(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(2), next)
+
+	events, next, err = svc.ListEvents(ctx, next, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(2), events[0].Sequence)
+	require.Equal(t, uint64(3), next)
+}
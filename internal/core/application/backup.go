@@ -0,0 +1,250 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const (
+	// BackupVersion is the version of the format of the backups exported by
+	// the wallet service.
+	BackupVersion = uint32(1)
+)
+
+var (
+	ErrBackupMalformed          = fmt.Errorf("malformed backup")
+	ErrBackupUnsupportedVersion = fmt.Errorf("unsupported backup version")
+	ErrBackupNetworkMismatch    = fmt.Errorf("backup network mismatch")
+)
+
+// backup is the versioned envelope of an exported backup. Only the payload is
+// encrypted, so that version and network can be checked before decrypting.
+type backup struct {
+	Version   uint32 `json:"version"`
+	Network   string `json:"network"`
+	CreatedAt int64  `json:"created_at"`
+	Payload   []byte `json:"payload"`
+}
+
+// backupPayload holds the whole state of the daemon. Utxos and transactions
+// are only a cache of what can be found in the blockchain, therefore they are
+// optional.
+type backupPayload struct {
+	Wallet           *domain.Wallet          `json:"wallet"`
+	ExternalScripts  []domain.AddressInfo    `json:"external_scripts"`
+	SpendingPolicies []domain.SpendingPolicy `json:"spending_policies"`
+	// AddressLabels are the labels of the addresses of every account, indexed
+	// by account and script.
+	AddressLabels map[string]map[string][]string `json:"address_labels,omitempty"`
+	Utxos         []*domain.Utxo                 `json:"utxos,omitempty"`
+	Transactions  []*domain.Transaction          `json:"transactions,omitempty"`
+}
+
+// encodeBackup serializes the given payload and encrypts it with the given
+// password, using the same cypher as for the wallet mnemonic.
+func encodeBackup(payload *backupPayload, password string) ([]byte, error) {
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize backup: %s", err)
+	}
+	encryptedPayload, err := domain.MnemonicCypher.Encrypt(buf, []byte(password))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt backup: %s", err)
+	}
+
+	return json.Marshal(backup{
+		Version:   BackupVersion,
+		Network:   payload.Wallet.NetworkName,
+		CreatedAt: time.Now().Unix(),
+		Payload:   encryptedPayload,
+	})
+}
+
+// decodeBackup decrypts the given backup with the password and returns its
+// payload after making sure it's compatible with the given network.
+func decodeBackup(
+	buf []byte, password, network string,
+) (*backupPayload, error) {
+	var b backup
+	if err := json.Unmarshal(buf, &b); err != nil {
+		return nil, ErrBackupMalformed
+	}
+	if b.Version == 0 || b.Version > BackupVersion {
+		return nil, fmt.Errorf("%w %d", ErrBackupUnsupportedVersion, b.Version)
+	}
+	if b.Network != network {
+		return nil, fmt.Errorf(
+			"%w: got %s, expected %s", ErrBackupNetworkMismatch, b.Network, network,
+		)
+	}
+
+	decryptedPayload, err := domain.MnemonicCypher.Decrypt(
+		b.Payload, []byte(password),
+	)
+	if err != nil {
+		return nil, domain.ErrWalletInvalidPassword
+	}
+
+	var payload backupPayload
+	if err := json.Unmarshal(decryptedPayload, &payload); err != nil {
+		return nil, ErrBackupMalformed
+	}
+	if payload.Wallet == nil {
+		return nil, ErrBackupMalformed
+	}
+	if !payload.Wallet.IsValidPassword(password) {
+		return nil, domain.ErrWalletInvalidPassword
+	}
+	if payload.Wallet.NetworkName != network {
+		return nil, fmt.Errorf(
+			"%w: got %s, expected %s", ErrBackupNetworkMismatch,
+			payload.Wallet.NetworkName, network,
+		)
+	}
+	return &payload, nil
+}
+
+// exportBackupPayload collects the state of the daemon from the given repo
+// manager. Utxos and transactions are included only if withCache is true.
+func exportBackupPayload(
+	ctx context.Context, repoManager ports.RepoManager, withCache bool,
+) (*backupPayload, error) {
+	w, err := repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts, err := repoManager.ExternalScriptRepository().GetAllScripts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]domain.SpendingPolicy, 0)
+	addressLabels := make(map[string]map[string][]string)
+	for accountName := range w.Accounts {
+		labelsByScript, err := repoManager.AddressLabelRepository().
+			GetAddressLabelsForAccount(ctx, accountName)
+		if err != nil {
+			return nil, err
+		}
+		if len(labelsByScript) > 0 {
+			addressLabels[accountName] = labelsByScript
+		}
+
+		policy, err := repoManager.SpendingPolicyRepository().GetPolicy(
+			ctx, accountName,
+		)
+		if err != nil {
+			if errors.Is(err, domain.ErrPolicyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+
+	payload := &backupPayload{
+		Wallet:           w,
+		ExternalScripts:  scripts,
+		SpendingPolicies: policies,
+		AddressLabels:    addressLabels,
+	}
+	if !withCache {
+		return payload, nil
+	}
+
+	utxos, err := repoManager.UtxoRepository().GetAllUtxos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Any wallet transaction either funds or spends at least one utxo.
+	txids := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, u := range utxos {
+		for _, txid := range []string{u.TxID, u.SpentStatus.Txid} {
+			if _, ok := seen[txid]; ok || txid == "" {
+				continue
+			}
+			seen[txid] = struct{}{}
+			txids = append(txids, txid)
+		}
+	}
+
+	txs := make([]*domain.Transaction, 0, len(txids))
+	for _, txid := range txids {
+		tx, err := repoManager.TransactionRepository().GetTransaction(ctx, txid)
+		if err != nil || tx == nil {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	payload.Utxos = utxos
+	payload.Transactions = txs
+	return payload, nil
+}
+
+// importBackupPayload restores the given state of the daemon with the given
+// repo manager, regardless of its concrete type.
+// The wallet is restored as last so that, in case of failure, the operation
+// can be safely retried, since all other repos prevent duplicates.
+func importBackupPayload(
+	ctx context.Context, repoManager ports.RepoManager, payload *backupPayload,
+) error {
+	for _, script := range payload.ExternalScripts {
+		if _, err := repoManager.ExternalScriptRepository().AddScript(
+			ctx, script,
+		); err != nil {
+			return fmt.Errorf("failed to import external scripts: %s", err)
+		}
+	}
+
+	for i := range payload.SpendingPolicies {
+		policy := payload.SpendingPolicies[i]
+		if err := repoManager.SpendingPolicyRepository().SetPolicy(
+			ctx, &policy,
+		); err != nil {
+			return fmt.Errorf("failed to import spending policies: %s", err)
+		}
+	}
+
+	for accountName, labelsByScript := range payload.AddressLabels {
+		for script, labels := range labelsByScript {
+			if err := repoManager.AddressLabelRepository().SetAddressLabels(
+				ctx, accountName, script, labels,
+			); err != nil {
+				return fmt.Errorf("failed to import address labels: %s", err)
+			}
+		}
+	}
+
+	if len(payload.Utxos) > 0 {
+		if _, err := repoManager.UtxoRepository().AddUtxos(
+			ctx, payload.Utxos,
+		); err != nil {
+			return fmt.Errorf("failed to import utxos: %s", err)
+		}
+	}
+
+	for _, tx := range payload.Transactions {
+		if _, err := repoManager.TransactionRepository().AddTransaction(
+			ctx, tx,
+		); err != nil {
+			return fmt.Errorf("failed to import transactions: %s", err)
+		}
+	}
+
+	if err := repoManager.WalletRepository().CreateWallet(
+		ctx, payload.Wallet,
+	); err != nil {
+		return fmt.Errorf("failed to import wallet: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const (
+	// defaultBroadcastCheckInterval is the interval between the checks of the
+	// broadcasted txs if not specified.
+	defaultBroadcastCheckInterval = 30 * time.Second
+	// defaultBroadcastMaxAttempts is the number of rebroadcasts after which a
+	// tx still missing is dropped if not specified.
+	defaultBroadcastMaxAttempts = 10
+	// maxBroadcastBackoff caps the time between two rebroadcasts of a tx.
+	maxBroadcastBackoff = time.Hour
+)
+
+// BroadcastTrackerOptions define how often the broadcasted txs are checked
+// and how many times those missing are rebroadcasted before being dropped.
+type BroadcastTrackerOptions struct {
+	// CheckInterval is the interval between the checks of the pending txs,
+	// and the initial backoff between two rebroadcasts of the same tx,
+	// doubled at every attempt.
+	CheckInterval time.Duration
+	// MaxAttempts is the number of rebroadcasts after which a tx still
+	// missing is dropped.
+	MaxAttempts uint32
+}
+
+func (o BroadcastTrackerOptions) validate() error {
+	if o.CheckInterval < 0 {
+		return fmt.Errorf("check interval must not be a negative duration")
+	}
+	return nil
+}
+
+func (o BroadcastTrackerOptions) checkInterval() time.Duration {
+	if o.CheckInterval == 0 {
+		return defaultBroadcastCheckInterval
+	}
+	return o.CheckInterval
+}
+
+func (o BroadcastTrackerOptions) maxAttempts() uint32 {
+	if o.MaxAttempts == 0 {
+		return defaultBroadcastMaxAttempts
+	}
+	return o.MaxAttempts
+}
+
+// backoff returns how long to wait before checking a tx rebroadcasted the
+// given number of times.
+func (o BroadcastTrackerOptions) backoff(attempts uint32) time.Duration {
+	backoff := o.checkInterval()
+	for i := uint32(1); i < attempts && backoff < maxBroadcastBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBroadcastBackoff {
+		return maxBroadcastBackoff
+	}
+	return backoff
+}
+
+// GetBroadcast returns the broadcast of the given tx.
+func (ts *TransactionService) GetBroadcast(
+	ctx context.Context, txid string,
+) (_ *domain.Broadcast, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.GetBroadcast")
+	defer func() { endSpan(span, err) }()
+
+	return ts.repoManager.BroadcastRepository().GetBroadcast(ctx, txid)
+}
+
+// StartBroadcastTracker spawns a job that periodically checks whether the
+// txs broadcasted by the service are in mempool or blockchain.
+// Confirmed txs stop being tracked, while missing ones are rebroadcasted
+// with exponential backoff, and eventually dropped once the max number of
+// attempts is reached. Txs whose inputs are spent by other ones are marked as
+// conflicted.
+func (ts *TransactionService) StartBroadcastTracker(
+	opts BroadcastTrackerOptions,
+) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	ts.broadcastTrackerLock.Lock()
+	defer ts.broadcastTrackerLock.Unlock()
+
+	if ts.broadcastTrackerQuit != nil {
+		return fmt.Errorf("broadcast tracker already started")
+	}
+	quit := make(chan struct{})
+	ts.broadcastTrackerQuit = quit
+
+	go func() {
+		t := time.NewTicker(opts.checkInterval())
+		defer t.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-t.C:
+				ts.checkBroadcasts(opts)
+			}
+		}
+	}()
+	return nil
+}
+
+// StopBroadcastTracker stops the job spawned by StartBroadcastTracker, if
+// any.
+func (ts *TransactionService) StopBroadcastTracker() {
+	ts.broadcastTrackerLock.Lock()
+	defer ts.broadcastTrackerLock.Unlock()
+
+	if ts.broadcastTrackerQuit != nil {
+		close(ts.broadcastTrackerQuit)
+		ts.broadcastTrackerQuit = nil
+	}
+}
+
+// broadcast publishes the given tx and starts tracking it until confirmed.
+func (ts *TransactionService) broadcast(
+	ctx context.Context, txHex string,
+) (string, error) {
+	txid, err := ts.bcScanner.BroadcastTransaction(ctx, txHex)
+	if err != nil {
+		return "", err
+	}
+
+	broadcast, err := domain.NewBroadcast(txid, txHex, time.Now().Unix())
+	if err != nil {
+		ts.warn(err, "failed to track broadcast of tx %s", txid)
+		return txid, nil
+	}
+	if _, err := ts.repoManager.BroadcastRepository().AddBroadcast(
+		ctx, broadcast,
+	); err != nil {
+		ts.warn(err, "failed to track broadcast of tx %s", txid)
+	}
+	return txid, nil
+}
+
+// checkBroadcasts checks all the pending txs due for a check and persists
+// their updated status.
+func (ts *TransactionService) checkBroadcasts(opts BroadcastTrackerOptions) {
+	ctx := context.Background()
+	broadcastRepo := ts.repoManager.BroadcastRepository()
+	broadcasts, err := broadcastRepo.GetPendingBroadcasts(ctx)
+	if err != nil {
+		ts.warn(err, "failed to get pending broadcasts")
+		return
+	}
+
+	now := time.Now()
+	isConnected := ts.bcScanner.GetStatus().IsConnected
+	updatedBroadcasts := make([]domain.Broadcast, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		if !b.IsDue(now.Unix()) {
+			continue
+		}
+		if err := ts.checkBroadcast(ctx, &b, now, isConnected, opts); err != nil {
+			ts.warn(err, "failed to check broadcast of tx %s", b.Txid)
+			continue
+		}
+		updatedBroadcasts = append(updatedBroadcasts, b)
+	}
+	if len(updatedBroadcasts) <= 0 {
+		return
+	}
+
+	if err := broadcastRepo.UpdateBroadcasts(
+		ctx, updatedBroadcasts,
+	); err != nil {
+		ts.warn(err, "failed to update pending broadcasts")
+	}
+}
+
+// checkBroadcast updates the status of the given pending tx by looking it up
+// in mempool and blockchain, and rebroadcasts it if missing.
+func (ts *TransactionService) checkBroadcast(
+	ctx context.Context, b *domain.Broadcast, now time.Time, isConnected bool,
+	opts BroadcastTrackerOptions,
+) error {
+	if txid, replaced := ts.findConflictingTx(
+		ctx, b.Txid, b.TxHex,
+	); txid != "" {
+		ts.log("tx %s conflicts with tx %s", b.Txid, txid)
+		if err := b.Conflict(txid); err != nil {
+			return err
+		}
+		// The inputs of a replaced tx are still spent by its replacement.
+		if !replaced {
+			ts.releaseTxInputs(ctx, b.Txid, b.TxHex)
+		}
+		return nil
+	}
+
+	// Nothing can be said about a tx while the scanner is disconnected.
+	if !isConnected {
+		return b.Reschedule(now.Add(opts.checkInterval()).Unix())
+	}
+
+	txs, err := ts.bcScanner.GetTransactions(ctx, []string{b.Txid})
+	// Only a tx reported as not found counts as missing, any other error
+	// doesn't tell anything about it.
+	if err != nil && !errors.Is(err, ports.ErrTransactionNotFound) {
+		ts.warn(err, "failed to look up tx %s, retrying later", b.Txid)
+		return b.Reschedule(now.Add(opts.checkInterval()).Unix())
+	}
+	if len(txs) > 0 {
+		if txs[0].IsConfirmed() {
+			ts.log("tx %s confirmed", b.Txid)
+			return b.Confirm()
+		}
+		return b.Reschedule(now.Add(opts.checkInterval()).Unix())
+	}
+
+	if b.Attempts >= opts.maxAttempts() {
+		ts.log(
+			"dropped tx %s still missing after %d rebroadcasts",
+			b.Txid, b.Attempts,
+		)
+		if err := b.Drop(); err != nil {
+			return err
+		}
+		ts.releaseTxInputs(ctx, b.Txid, b.TxHex)
+		return nil
+	}
+
+	if _, err := ts.bcScanner.BroadcastTransaction(ctx, b.TxHex); err != nil {
+		ts.warn(err, "failed to rebroadcast tx %s", b.Txid)
+	} else {
+		ts.log("rebroadcasted missing tx %s", b.Txid)
+	}
+	return b.Rebroadcast(now.Add(opts.backoff(b.Attempts + 1)).Unix())
+}
+
+// findConflictingTx returns the hash of the tx, if any, spending any of the
+// wallet inputs of the given one, or replacing it. The returned flag tells
+// whether the given tx has been replaced with a fee bump.
+// Inputs still marked as spent by a tx replaced with the given one are not
+// considered conflicting.
+func (ts *TransactionService) findConflictingTx(
+	ctx context.Context, txid, txHex string,
+) (string, bool) {
+	txRepo := ts.repoManager.TransactionRepository()
+	tx, err := txRepo.GetTransaction(ctx, txid)
+	if err == nil && tx.IsEvicted() {
+		return tx.EvictedBy, true
+	}
+
+	keys, err := utxoKeysFromRawTx(txHex)
+	if err != nil {
+		return "", false
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return "", false
+	}
+	for _, u := range utxos {
+		if !u.IsSpent() || u.SpentStatus.Txid == txid {
+			continue
+		}
+		spender, err := txRepo.GetTransaction(ctx, u.SpentStatus.Txid)
+		if err == nil && spender.IsEvicted() && spender.EvictedBy == txid {
+			continue
+		}
+		return u.SpentStatus.Txid, false
+	}
+	return "", false
+}
+
+// releaseTxInputs unlocks the wallet inputs of the given tx so that they can
+// be spent again.
+func (ts *TransactionService) releaseTxInputs(
+	ctx context.Context, txid, txHex string,
+) {
+	keys, err := utxoKeysFromRawTx(txHex)
+	if err != nil {
+		return
+	}
+	count, err := ts.repoManager.UtxoRepository().ReleaseUtxos(ctx, keys, "")
+	if err != nil {
+		ts.warn(err, "failed to unlock inputs of tx %s", txid)
+		return
+	}
+	if count > 0 {
+		ts.log("unlocked %d input(s) of tx %s", count, txid)
+	}
+}
+
+// confirmBroadcast marks the broadcast of the given tx, if pending, as
+// confirmed.
+func (ts *TransactionService) confirmBroadcast(txid string) {
+	ctx := context.Background()
+	broadcastRepo := ts.repoManager.BroadcastRepository()
+	b, err := broadcastRepo.GetBroadcast(ctx, txid)
+	if err != nil {
+		return
+	}
+	if err := b.Confirm(); err != nil {
+		return
+	}
+
+	if err := broadcastRepo.UpdateBroadcasts(
+		ctx, []domain.Broadcast{*b},
+	); err != nil {
+		ts.warn(err, "failed to confirm broadcast of tx %s", txid)
+	}
+}
+
+// pendingBroadcastInputs returns the inputs of the pending txs, which must
+// stay locked until the txs are either confirmed, dropped or conflicted.
+func (ts *TransactionService) pendingBroadcastInputs(
+	ctx context.Context,
+) (map[domain.UtxoKey]struct{}, error) {
+	broadcasts, err := ts.repoManager.BroadcastRepository().GetPendingBroadcasts(
+		ctx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[domain.UtxoKey]struct{})
+	for _, b := range broadcasts {
+		keys, err := utxoKeysFromRawTx(b.TxHex)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			inputs[key] = struct{}{}
+		}
+	}
+	return inputs, nil
+}
@@ -0,0 +1,312 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const (
+	// minConsolidationInputs is the min number of utxos worth merging.
+	minConsolidationInputs = 2
+	// defaultConsolidationMaxInputs caps the inputs of every consolidation tx
+	// if not specified.
+	defaultConsolidationMaxInputs = 50
+	// consolidationConfirmationTarget is the number of blocks for which the
+	// network fee rate is estimated before a periodic consolidation.
+	consolidationConfirmationTarget = 6
+)
+
+// ConsolidationOptions define which utxos are merged by a consolidation and
+// at what fee rate.
+type ConsolidationOptions struct {
+	// ValueThreshold is the value below which utxos are merged.
+	ValueThreshold uint64
+	// MaxInputs caps the number of inputs of every consolidation tx.
+	MaxInputs uint32
+	// MillisatsPerByte is the fee rate of the consolidation txs.
+	MillisatsPerByte uint64
+	// MaxEstimatedMillisatsPerByte is the network fee rate estimate above
+	// which the periodic consolidation is skipped. The check is disabled if
+	// zero.
+	MaxEstimatedMillisatsPerByte uint64
+}
+
+func (o ConsolidationOptions) validate() error {
+	if o.ValueThreshold == 0 {
+		return fmt.Errorf("missing value threshold")
+	}
+	if o.MaxInputs > 0 && o.MaxInputs < minConsolidationInputs {
+		return fmt.Errorf(
+			"max inputs must be at least %d", minConsolidationInputs,
+		)
+	}
+	if o.MillisatsPerByte > 0 && o.MillisatsPerByte < MinMillisatsPerByte {
+		return fmt.Errorf(
+			"fee rate must be at least %d millisats/byte", MinMillisatsPerByte,
+		)
+	}
+	return nil
+}
+
+func (o ConsolidationOptions) maxInputs() int {
+	if o.MaxInputs == 0 {
+		return defaultConsolidationMaxInputs
+	}
+	return int(o.MaxInputs)
+}
+
+func (o ConsolidationOptions) millisatsPerByte() uint64 {
+	if o.MillisatsPerByte == 0 {
+		return MinMillisatsPerByte
+	}
+	return o.MillisatsPerByte
+}
+
+// ConsolidateUtxos merges the spendable utxos of the given asset of an
+// account, or of all its assets, whose value is below the threshold of the
+// given options, into a new one owned by the same account. Locked utxos are
+// never spent. The utxos are merged with as many txs as required to respect
+// the cap of inputs per tx, which are broadcasted right away.
+// The fees of the txs merging lbtc are paid with the merged amount, while the
+// other ones are paid with lbtc utxos not being merged.
+// It returns the hashes of the broadcasted txs.
+func (ts *TransactionService) ConsolidateUtxos(
+	ctx context.Context, accountName, asset string, opts ConsolidationOptions,
+) (_ []string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.ConsolidateUtxos")
+	defer func() { endSpan(span, err) }()
+
+	if err := opts.validate(); err != nil {
+		return nil, newError(ReasonInvalidArgument, "%s", err)
+	}
+
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	utxoRepo := ts.repoManager.UtxoRepository()
+	utxos, err := utxoRepo.GetSpendableUtxosForAccount(
+		ctx, account.Namespace, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	batches := ts.consolidationBatches(account, utxos, asset, opts)
+	txids := make([]string, 0, len(batches))
+	for _, batch := range batches {
+		// The utxos must be fetched again since those paying the fees of the
+		// previous batches, if any, are locked now.
+		utxos, err := utxoRepo.GetSpendableUtxosForAccount(
+			ctx, account.Namespace, nil,
+		)
+		if err != nil {
+			return txids, err
+		}
+		feeUtxos := getRemainingUtxos(utxos, batch)
+		batch = getRemainingUtxos(batch, getRemainingUtxos(batch, utxos))
+		if len(batch) < minConsolidationInputs {
+			continue
+		}
+
+		script, blindingKey, err := ts.deriveConsolidationAddress(ctx, account)
+		if err != nil {
+			return txids, err
+		}
+		txHex, err := ts.spendUtxos(
+			ctx, w, account, batch, feeUtxos, script, blindingKey,
+			opts.millisatsPerByte(),
+		)
+		if err != nil {
+			return txids, err
+		}
+		txid, err := ts.broadcast(ctx, txHex)
+		if err != nil {
+			return txids, err
+		}
+
+		ts.log(
+			"consolidated %d utxos of asset %s for account %s with tx %s",
+			len(batch), batch[0].Asset, account.Namespace, txid,
+		)
+		txids = append(txids, txid)
+	}
+
+	return txids, nil
+}
+
+// StartUtxoConsolidation spawns a job that periodically consolidates the
+// utxos of every asset of every account of the wallet, if unlocked,
+// according to the given options.
+func (ts *TransactionService) StartUtxoConsolidation(
+	interval time.Duration, opts ConsolidationOptions,
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("consolidation interval must be a positive duration")
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	ts.consolidationLock.Lock()
+	defer ts.consolidationLock.Unlock()
+
+	if ts.consolidationQuit != nil {
+		return fmt.Errorf("utxo consolidation already started")
+	}
+	quit := make(chan struct{})
+	ts.consolidationQuit = quit
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-t.C:
+				ts.consolidateAccounts(opts)
+			}
+		}
+	}()
+	return nil
+}
+
+// StopUtxoConsolidation stops the job spawned by StartUtxoConsolidation, if
+// any.
+func (ts *TransactionService) StopUtxoConsolidation() {
+	ts.consolidationLock.Lock()
+	defer ts.consolidationLock.Unlock()
+
+	if ts.consolidationQuit != nil {
+		close(ts.consolidationQuit)
+		ts.consolidationQuit = nil
+	}
+}
+
+func (ts *TransactionService) consolidateAccounts(opts ConsolidationOptions) {
+	ctx := context.Background()
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil || w.IsLocked() {
+		return
+	}
+
+	if maxFeeRate := opts.MaxEstimatedMillisatsPerByte; maxFeeRate > 0 {
+		feeRate, err := ts.bcScanner.EstimateFeeRate(
+			ctx, consolidationConfirmationTarget,
+		)
+		if err != nil {
+			ts.warn(err, "failed to estimate fee rate, skipping consolidation")
+			return
+		}
+		if feeRate > maxFeeRate {
+			ts.log(
+				"estimated fee rate %d millisats/byte is above %d, skipping "+
+					"consolidation", feeRate, maxFeeRate,
+			)
+			return
+		}
+	}
+
+	accounts := make([]string, 0, len(w.Accounts))
+	for namespace := range w.Accounts {
+		accounts = append(accounts, namespace)
+	}
+	sort.Strings(accounts)
+
+	for _, account := range accounts {
+		txids, err := ts.ConsolidateUtxos(ctx, account, "", opts)
+		if err != nil {
+			ts.warn(err, "failed to consolidate utxos for account %s", account)
+			continue
+		}
+		if len(txids) > 0 {
+			ts.log(
+				"consolidated utxos for account %s with %d txs", account, len(txids),
+			)
+		}
+	}
+}
+
+// consolidationBatches groups the given utxos, below the value threshold of
+// the options, by asset, and splits every group into batches not exceeding
+// the max number of inputs. The lbtc utxos costing more than their value to
+// be spent are excluded, as well as the batches with less than 2 utxos.
+func (ts *TransactionService) consolidationBatches(
+	account *domain.Account, utxos []*domain.Utxo, asset string,
+	opts ConsolidationOptions,
+) [][]*domain.Utxo {
+	costs := ts.coinSelectionCosts(account, utxos, opts.millisatsPerByte())
+	utxosByAsset := make(map[string][]*domain.Utxo)
+	for _, u := range utxos {
+		if asset != "" && u.Asset != asset {
+			continue
+		}
+		if u.Value >= opts.ValueThreshold {
+			continue
+		}
+		if u.Asset == ts.network.AssetID && costs.EffectiveValue(u) <= 0 {
+			continue
+		}
+		utxosByAsset[u.Asset] = append(utxosByAsset[u.Asset], u)
+	}
+
+	assets := make([]string, 0, len(utxosByAsset))
+	for asset := range utxosByAsset {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+
+	maxInputs := opts.maxInputs()
+	batches := make([][]*domain.Utxo, 0)
+	for _, asset := range assets {
+		assetUtxos := utxosByAsset[asset]
+		// Merge the smallest utxos first.
+		sort.SliceStable(assetUtxos, func(i, j int) bool {
+			return assetUtxos[i].Value < assetUtxos[j].Value
+		})
+		for len(assetUtxos) >= minConsolidationInputs {
+			size := maxInputs
+			if len(assetUtxos) < size {
+				size = len(assetUtxos)
+			}
+			batches = append(batches, assetUtxos[:size])
+			assetUtxos = assetUtxos[size:]
+		}
+	}
+	return batches
+}
+
+// deriveConsolidationAddress returns the script and the blinding key of a new
+// internal address of the account receiving the merged utxos.
+func (ts *TransactionService) deriveConsolidationAddress(
+	ctx context.Context, account *domain.Account,
+) ([]byte, []byte, error) {
+	addressesInfo, err := ts.repoManager.WalletRepository().
+		DeriveNextInternalAddressesForAccount(ctx, account.Namespace, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	script, _ := hex.DecodeString(addressesInfo[0].Script)
+	var blindingKey []byte
+	if !account.Unconf {
+		addr, _ := address.FromConfidential(addressesInfo[0].Address)
+		blindingKey = addr.BlindingKey
+	}
+	return script, blindingKey, nil
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+// ErrorReason is the machine-readable reason of a failure of the application
+// services. Unlike error messages, reasons are part of the API contract and
+// must never change.
+type ErrorReason string
+
+const (
+	ReasonUnknown                  ErrorReason = ""
+	ReasonInvalidArgument          ErrorReason = "INVALID_ARGUMENT"
+	ReasonNotFound                 ErrorReason = "NOT_FOUND"
+	ReasonInsufficientFunds        ErrorReason = "INSUFFICIENT_FUNDS"
+	ReasonWalletNotInitialized     ErrorReason = "WALLET_NOT_INITIALIZED"
+	ReasonWalletAlreadyInitialized ErrorReason = "WALLET_ALREADY_INITIALIZED"
+	ReasonWalletLocked             ErrorReason = "WALLET_LOCKED"
+	ReasonWalletUnlocked           ErrorReason = "WALLET_UNLOCKED"
+	ReasonInvalidPassword          ErrorReason = "INVALID_PASSWORD"
+	ReasonMaxAccountsReached       ErrorReason = "MAX_ACCOUNTS_REACHED"
+	ReasonAccountNotEmpty          ErrorReason = "ACCOUNT_NOT_EMPTY"
+	ReasonUtxoLocked               ErrorReason = "UTXO_LOCKED"
+	ReasonUtxoNotLocked            ErrorReason = "UTXO_NOT_LOCKED"
+	ReasonUtxoFrozen               ErrorReason = "UTXO_FROZEN"
+	ReasonUtxoLockNotOwned         ErrorReason = "UTXO_LOCK_NOT_OWNED"
+	ReasonInvalidTransaction       ErrorReason = "INVALID_TRANSACTION"
+	ReasonInvalidPset              ErrorReason = "INVALID_PSET"
+	ReasonInvalidBackup            ErrorReason = "INVALID_BACKUP"
+	ReasonSpendApprovalRequired    ErrorReason = "SPEND_APPROVAL_REQUIRED"
+	ReasonSpendingPolicyViolation  ErrorReason = "SPENDING_POLICY_VIOLATION"
+	ReasonIdempotencyKeyMismatch   ErrorReason = "IDEMPOTENCY_KEY_MISMATCH"
+	ReasonIdempotencyKeyPending    ErrorReason = "IDEMPOTENCY_KEY_PENDING"
+	ReasonTransactionNotBumpable   ErrorReason = "TRANSACTION_NOT_BUMPABLE"
+	ReasonTransactionNotScheduled  ErrorReason = "TRANSACTION_NOT_SCHEDULED"
+	ReasonCallerNotAuthenticated   ErrorReason = "CALLER_NOT_AUTHENTICATED"
+)
+
+// Metadata keys of the errors returned by the application services.
+const (
+	ErrorMetadataAccount    = "account"
+	ErrorMetadataAsset      = "asset"
+	ErrorMetadataAmount     = "amount"
+	ErrorMetadataApprovalId = "approval_id"
+)
+
+// reasonsBySentinel maps the sentinel errors of the domain and of the
+// application services to their reason.
+var reasonsBySentinel = []struct {
+	err    error
+	reason ErrorReason
+}{
+	{domain.ErrWalletMissingMnemonic, ReasonInvalidArgument},
+	{domain.ErrWalletMissingPassword, ReasonInvalidArgument},
+	{domain.ErrWalletMissingNetwork, ReasonInvalidArgument},
+	{domain.ErrWalletMissingBirthdayBlock, ReasonInvalidArgument},
+	{domain.ErrWalletInvalidNetwork, ReasonInvalidArgument},
+	{domain.ErrPolicyMissingAccount, ReasonInvalidArgument},
+	{domain.ErrPolicyInvalidWindow, ReasonInvalidArgument},
+	{domain.ErrAuditEventMissingMethod, ReasonInvalidArgument},
+	{domain.ErrIdempotencyKeyMissingKey, ReasonInvalidArgument},
+	{domain.ErrIdempotencyKeyMissingMethod, ReasonInvalidArgument},
+	{domain.ErrIdempotencyKeyInvalidTTL, ReasonInvalidArgument},
+	{domain.ErrPaymentMissingAccount, ReasonInvalidArgument},
+	{domain.ErrPaymentMissingAsset, ReasonInvalidArgument},
+	{domain.ErrPaymentMissingScript, ReasonInvalidArgument},
+	{domain.ErrPaymentInvalidAmount, ReasonInvalidArgument},
+	{domain.ErrPaymentInvalidDeadline, ReasonInvalidArgument},
+	{domain.ErrScheduledTxMissingTxid, ReasonInvalidArgument},
+	{domain.ErrScheduledTxMissingTxHex, ReasonInvalidArgument},
+	{domain.ErrScheduledTxInvalidBroadcastAt, ReasonInvalidArgument},
+	{ErrInvalidWalletID, ReasonInvalidArgument},
+	{wallet.ErrMissingInputs, ReasonInvalidArgument},
+	{wallet.ErrInputMissingTxid, ReasonInvalidArgument},
+	{wallet.ErrInputInvalidTxid, ReasonInvalidArgument},
+	{wallet.ErrOutputMissingAsset, ReasonInvalidArgument},
+	{wallet.ErrOutputInvalidAsset, ReasonInvalidArgument},
+	{wallet.ErrOutputInvalidScript, ReasonInvalidArgument},
+	{wallet.ErrOutputInvalidBlindingKey, ReasonInvalidArgument},
+	{wallet.ErrLocktimeDisabled, ReasonInvalidArgument},
+	{wallet.ErrInvalidSequences, ReasonInvalidArgument},
+	{domain.ErrAccountNotFound, ReasonNotFound},
+	{domain.ErrPolicyNotFound, ReasonNotFound},
+	{domain.ErrSpendApprovalNotFound, ReasonNotFound},
+	{domain.ErrAuditEventNotFound, ReasonNotFound},
+	{domain.ErrIdempotencyKeyNotFound, ReasonNotFound},
+	{domain.ErrPaymentNotFound, ReasonNotFound},
+	{domain.ErrBroadcastNotFound, ReasonNotFound},
+	{domain.ErrScheduledTxNotFound, ReasonNotFound},
+	{ErrWalletNotFound, ReasonNotFound},
+	{ports.ErrTargetAmountNotReached, ReasonInsufficientFunds},
+	{ErrWalletIDTaken, ReasonWalletAlreadyInitialized},
+	{ErrCallerNotAuthenticated, ReasonCallerNotAuthenticated},
+	{domain.ErrWalletLocked, ReasonWalletLocked},
+	{domain.ErrWalletUnlocked, ReasonWalletUnlocked},
+	{domain.ErrWalletInvalidPassword, ReasonInvalidPassword},
+	{domain.ErrWalletMaxAccountNumberReached, ReasonMaxAccountsReached},
+	{domain.ErrUtxoAlreadyLocked, ReasonUtxoLocked},
+	{ErrForbiddenUnlockedInputs, ReasonUtxoNotLocked},
+	{domain.ErrUtxoFrozen, ReasonUtxoFrozen},
+	{domain.ErrUtxoLockNotOwned, ReasonUtxoLockNotOwned},
+	{wallet.ErrMissingPset, ReasonInvalidPset},
+	{wallet.ErrInvalidSignatures, ReasonInvalidPset},
+	{wallet.ErrBlindInvalidInputIndex, ReasonInvalidPset},
+	{ErrBackupMalformed, ReasonInvalidBackup},
+	{ErrBackupUnsupportedVersion, ReasonInvalidBackup},
+	{ErrBackupNetworkMismatch, ReasonInvalidBackup},
+	{ErrSpendApprovalRequired, ReasonSpendApprovalRequired},
+	{domain.ErrPolicySpendLimitExceeded, ReasonSpendingPolicyViolation},
+	{domain.ErrPolicyDestinationForbidden, ReasonSpendingPolicyViolation},
+	{domain.ErrPolicyFeeRateTooHigh, ReasonSpendingPolicyViolation},
+	{domain.ErrSpendApprovalSameCaller, ReasonSpendingPolicyViolation},
+	{domain.ErrScheduledTxNotScheduled, ReasonTransactionNotScheduled},
+}
+
+// Error is an error returned by the application services, with a
+// machine-readable reason and optional metadata, like the account or the
+// asset involved.
+type Error struct {
+	Reason   ErrorReason
+	Metadata map[string]string
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// ErrorReasonOf returns the reason and the metadata of the given error, either
+// an Error or one of the known sentinel errors, even if wrapped.
+// ReasonUnknown is returned for any other error.
+func ErrorReasonOf(err error) (ErrorReason, map[string]string) {
+	if err == nil {
+		return ReasonUnknown, nil
+	}
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Reason, appErr.Metadata
+	}
+	for _, s := range reasonsBySentinel {
+		if errors.Is(err, s.err) {
+			return s.reason, nil
+		}
+	}
+	return ReasonUnknown, nil
+}
+
+// newError returns an Error with the given reason, formatting the message
+// like fmt.Errorf.
+func newError(reason ErrorReason, format string, a ...interface{}) *Error {
+	return &Error{Reason: reason, err: fmt.Errorf(format, a...)}
+}
+
+// withMetadata adds the given key-value pair to the metadata of the error.
+func (e *Error) withMetadata(key, value string) *Error {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]string)
+	}
+	e.Metadata[key] = value
+	return e
+}
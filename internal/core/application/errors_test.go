@@ -0,0 +1,58 @@
+package application_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+func TestErrorReasonOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		reason application.ErrorReason
+	}{
+		{
+			name:   "nil",
+			err:    nil,
+			reason: application.ReasonUnknown,
+		},
+		{
+			name:   "unknown",
+			err:    fmt.Errorf("something went wrong"),
+			reason: application.ReasonUnknown,
+		},
+		{
+			name:   "domain sentinel",
+			err:    domain.ErrWalletLocked,
+			reason: application.ReasonWalletLocked,
+		},
+		{
+			name:   "wrapped domain sentinel",
+			err:    fmt.Errorf("account test: %w", domain.ErrPolicyFeeRateTooHigh),
+			reason: application.ReasonSpendingPolicyViolation,
+		},
+		{
+			name:   "port sentinel",
+			err:    ports.ErrTargetAmountNotReached,
+			reason: application.ReasonInsufficientFunds,
+		},
+		{
+			name:   "application sentinel",
+			err:    application.ErrBackupMalformed,
+			reason: application.ReasonInvalidBackup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, metadata := application.ErrorReasonOf(tt.err)
+			require.Equal(t, tt.reason, reason)
+			require.Nil(t, metadata)
+		})
+	}
+}
@@ -0,0 +1,553 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/vulpemventures/go-elements/confidential"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/transaction"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+	singlesig "github.com/vulpemventures/ocean/pkg/wallet/single-sig"
+)
+
+const (
+	// maxReplaceableSequence is the highest input sequence signaling the
+	// replaceability of a tx (BIP125).
+	maxReplaceableSequence = 0xfffffffd
+)
+
+// BumpFeeMethod is the way the fee of a tx has been bumped.
+type BumpFeeMethod int
+
+const (
+	BumpFeeMethodUnspecified BumpFeeMethod = iota
+	// BumpFeeMethodReplacement replaces the tx with one spending the same
+	// inputs with higher fees.
+	BumpFeeMethodReplacement
+	// BumpFeeMethodChildPaysForParent spends the outputs of the tx owned by
+	// the wallet with a child tx paying the fees for both.
+	BumpFeeMethodChildPaysForParent
+)
+
+// BumpedTx is the tx broadcasted to bump the fee of another one.
+type BumpedTx struct {
+	Txid   string
+	TxHex  string
+	Method BumpFeeMethod
+}
+
+// BumpFee raises the fee rate of the given unconfirmed tx to the given one.
+// If the tx signals replaceability and all its inputs belong to the same
+// account, it's replaced with one spending the same inputs, whose extra fee
+// is subtracted from the lbtc change of the account. The replaced tx and its
+// outputs owned by the wallet are marked as evicted.
+// Otherwise, the outputs of the tx owned by the wallet are spent with a
+// child tx paying the fees for both, parent and child, at the given rate.
+func (ts *TransactionService) BumpFee(
+	ctx context.Context, txid string, millisatsPerByte uint64,
+) (_ *BumpedTx, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.BumpFee")
+	defer func() { endSpan(span, err) }()
+
+	if millisatsPerByte < MinMillisatsPerByte {
+		return nil, newError(
+			ReasonInvalidArgument, "fee rate must be at least %d millisats/byte",
+			MinMillisatsPerByte,
+		)
+	}
+
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ts.repoManager.TransactionRepository().GetTransaction(ctx, txid)
+	if err != nil {
+		return nil, newError(ReasonNotFound, "transaction %s not found", txid)
+	}
+	if tx.IsConfirmed() {
+		return nil, newError(
+			ReasonTransactionNotBumpable, "transaction %s is already confirmed",
+			txid,
+		)
+	}
+	if tx.IsEvicted() {
+		return nil, newError(
+			ReasonTransactionNotBumpable,
+			"transaction %s has been replaced by %s", txid, tx.EvictedBy,
+		)
+	}
+
+	rawTx, err := transaction.NewTxFromHex(tx.TxHex)
+	if err != nil {
+		return nil, newError(
+			ReasonInvalidTransaction, "invalid transaction %s: %s", txid, err,
+		)
+	}
+	vsize := uint64(rawTx.VirtualSize())
+	fee := feeFromTx(rawTx)
+	if millisatsPerByte*vsize <= fee*1000 {
+		return nil, newError(
+			ReasonInvalidArgument,
+			"fee rate must be higher than the current one of %d millisats/byte",
+			fee*1000/vsize,
+		)
+	}
+
+	inputs, err := ts.getReplaceableInputs(ctx, rawTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) > 0 {
+		return ts.replaceByFee(ctx, w, rawTx, inputs, fee, millisatsPerByte)
+	}
+	return ts.childPaysForParent(ctx, w, rawTx, fee, millisatsPerByte)
+}
+
+// getReplaceableInputs returns the wallet utxos spent by the given tx, in
+// order, if it signals replaceability and all of them belong to the same
+// account and are revealed. Otherwise, it returns an empty list.
+func (ts *TransactionService) getReplaceableInputs(
+	ctx context.Context, tx *transaction.Transaction,
+) ([]*domain.Utxo, error) {
+	replaceable := false
+	for _, in := range tx.Inputs {
+		if in.Sequence <= maxReplaceableSequence {
+			replaceable = true
+			break
+		}
+	}
+	if !replaceable {
+		return nil, nil
+	}
+
+	keys := make([]domain.UtxoKey, 0, len(tx.Inputs))
+	for _, in := range tx.Inputs {
+		keys = append(keys, domain.UtxoKey{
+			TxID: elementsutil.TxIDFromBytes(in.Hash),
+			VOut: in.Index,
+		})
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	utxosByKey := make(map[domain.UtxoKey]*domain.Utxo)
+	for _, u := range utxos {
+		utxosByKey[u.Key()] = u
+	}
+
+	inputs := make([]*domain.Utxo, 0, len(keys))
+	for _, key := range keys {
+		u, ok := utxosByKey[key]
+		if !ok || u.AccountName != utxos[0].AccountName {
+			return nil, nil
+		}
+		if u.IsConfidential() && !u.IsRevealed() {
+			return nil, nil
+		}
+		inputs = append(inputs, u)
+	}
+	return inputs, nil
+}
+
+// replaceByFee replaces the given tx with one spending the same inputs and
+// paying the same external outputs, whose extra fee is subtracted from the
+// biggest lbtc output owned by the account of the inputs.
+// Since the external outputs are not re-blinded, the blinding factors of
+// their commitments are given to the blinder as a scalar offset so that the
+// blinded outputs of the account balance the inputs.
+func (ts *TransactionService) replaceByFee(
+	ctx context.Context, w *singlesig.Wallet, tx *transaction.Transaction,
+	inputs []*domain.Utxo, fee, millisatsPerByte uint64,
+) (*BumpedTx, error) {
+	lbtc := ts.network.AssetID
+	txid := tx.TxHash().String()
+	accountName := inputs[0].AccountName
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]domain.UtxoKey, 0, len(tx.Outputs))
+	for i, out := range tx.Outputs {
+		if len(out.Script) > 0 {
+			keys = append(keys, domain.UtxoKey{TxID: txid, VOut: uint32(i)})
+		}
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	ownOutputs := make(map[uint32]*domain.Utxo)
+	changeIndex := -1
+	for _, u := range utxos {
+		if u.AccountName != accountName {
+			continue
+		}
+		if u.IsSpent() {
+			return nil, newError(
+				ReasonTransactionNotBumpable,
+				"output %d of transaction %s is already spent", u.VOut, txid,
+			)
+		}
+		ownOutputs[u.VOut] = u
+		if u.Asset == lbtc &&
+			(changeIndex < 0 || u.Value > ownOutputs[uint32(changeIndex)].Value) {
+			changeIndex = int(u.VOut)
+		}
+	}
+
+	vsize := uint64(tx.VirtualSize())
+	newFee := feeForSize(vsize, millisatsPerByte)
+	if minFee := fee + feeForSize(vsize, MinMillisatsPerByte); newFee < minFee {
+		newFee = minFee
+	}
+	extraFee := newFee - fee
+	if changeIndex < 0 ||
+		ownOutputs[uint32(changeIndex)].Value < extraFee+ts.dustAmount {
+		return nil, newError(
+			ReasonInsufficientFunds,
+			"lbtc change of transaction %s can't cover extra fee amount %d",
+			txid, extraFee,
+		).withMetadata(ErrorMetadataAccount, accountName).
+			withMetadata(ErrorMetadataAsset, lbtc)
+	}
+
+	ins := make([]wallet.Input, 0, len(inputs))
+	insByIndex := make(map[uint32]wallet.Input)
+	var inScalar []byte
+	for i, u := range inputs {
+		input := wallet.Input{
+			TxID:            u.TxID,
+			TxIndex:         u.VOut,
+			Value:           u.Value,
+			Asset:           u.Asset,
+			Script:          u.Script,
+			ValueBlinder:    u.ValueBlinder,
+			AssetBlinder:    u.AssetBlinder,
+			ValueCommitment: u.ValueCommitment,
+			AssetCommitment: u.AssetCommitment,
+			Nonce:           u.Nonce,
+			Sequence:        tx.Inputs[i].Sequence,
+		}
+		ins = append(ins, input)
+		insByIndex[uint32(i)] = input
+		if inScalar, err = confidential.ComputeAndAddToScalarOffset(
+			inScalar, u.Value, u.AssetBlinder, u.ValueBlinder,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	outs := make([]wallet.Output, 0, len(tx.Outputs))
+	externalOutputs := make(map[int]*transaction.TxOutput)
+	var ownScalar []byte
+	hasConfidentialOwnOutput := false
+	feeAdded := false
+	for i, out := range tx.Outputs {
+		if u, ok := ownOutputs[uint32(i)]; ok {
+			value := u.Value
+			if i == changeIndex {
+				value -= extraFee
+			}
+			output := wallet.Output{
+				Asset:  u.Asset,
+				Amount: value,
+				Script: u.Script,
+			}
+			if u.IsConfidential() {
+				_, pubkey, err := w.DeriveBlindingKeyPair(
+					singlesig.DeriveBlindingKeyPairArgs{Script: u.Script},
+				)
+				if err != nil {
+					return nil, err
+				}
+				output.BlindingKey = pubkey.SerializeCompressed()
+				hasConfidentialOwnOutput = true
+				if ownScalar, err = confidential.ComputeAndAddToScalarOffset(
+					ownScalar, u.Value, u.AssetBlinder, u.ValueBlinder,
+				); err != nil {
+					return nil, err
+				}
+			}
+			outs = append(outs, output)
+			continue
+		}
+
+		if out.IsConfidential() {
+			// Placeholder replaced with the blinded output once the pset is
+			// created.
+			outs = append(outs, wallet.Output{Asset: lbtc})
+			externalOutputs[i] = out
+			continue
+		}
+
+		value, _ := elementsutil.ValueFromBytes(out.Value)
+		if len(out.Script) <= 0 && !feeAdded {
+			value += extraFee
+			feeAdded = true
+		}
+		outs = append(outs, wallet.Output{
+			Asset:  elementsutil.AssetHashFromBytes(out.Asset),
+			Amount: value,
+			Script: out.Script,
+		})
+	}
+
+	psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:  ins,
+		Outputs: outs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(externalOutputs) > 0 {
+		if !hasConfidentialOwnOutput {
+			return nil, newError(
+				ReasonTransactionNotBumpable,
+				"transaction %s has no confidential output owned by account %s "+
+					"to balance its blinded external outputs", txid, accountName,
+			)
+		}
+
+		ptx, _ := psetv2.NewPsetFromBase64(psetBase64)
+		for i, out := range externalOutputs {
+			ptx.Outputs[i] = psetv2.Output{
+				Script:               out.Script,
+				ValueCommitment:      out.Value,
+				AssetCommitment:      out.Asset,
+				ValueRangeproof:      out.RangeProof,
+				AssetSurjectionProof: out.SurjectionProof,
+				EcdhPubkey:           out.Nonce,
+			}
+		}
+		externalScalar, err := confidential.SubtractScalars(inScalar, ownScalar)
+		if err != nil {
+			return nil, err
+		}
+		ptx.Global.Scalars = append(ptx.Global.Scalars, externalScalar)
+		if psetBase64, err = ptx.ToBase64(); err != nil {
+			return nil, err
+		}
+	}
+
+	blindedPtx, err := wallet.BlindPsetWithOwnedInputs(
+		wallet.BlindPsetWithOwnedInputsArgs{
+			PsetBase64:         psetBase64,
+			OwnedInputsByIndex: insByIndex,
+			LastBlinder:        true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the extra fee counts as spent, the rest has already been accounted
+	// when the replaced tx was signed.
+	info := &spendInfo{
+		amountsByAccount: map[string]map[string]uint64{
+			accountName: {lbtc: extraFee},
+		},
+		millisatsPerByte: millisatsPerByte,
+	}
+	requiresApproval, err := ts.checkSpendingPolicies(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	if requiresApproval {
+		return nil, newError(
+			ReasonSpendingPolicyViolation,
+			"fee bump of transaction %s requires an approval", txid,
+		).withMetadata(ErrorMetadataAccount, accountName)
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        blindedPtx,
+		DerivationPathMap: account.DerivationPathByScript,
+	})
+	if err != nil {
+		return nil, err
+	}
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionArgs{PsetBase64: signedPtx},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	newTxid, err := ts.broadcast(ctx, txHex)
+	if err != nil {
+		return nil, err
+	}
+	ts.registerSpends(ctx, info)
+
+	ts.evictReplacedTx(
+		ctx, txid, newTxid, Utxos(inputs).Keys(), Utxos(utxos).Keys(),
+	)
+	ts.log("replaced tx %s with %s paying %d more fees", txid, newTxid, extraFee)
+
+	return &BumpedTx{
+		Txid:   newTxid,
+		TxHex:  txHex,
+		Method: BumpFeeMethodReplacement,
+	}, nil
+}
+
+// childPaysForParent spends the spendable outputs of the given tx owned by
+// one account of the wallet, preferably with lbtc, with a child tx sending
+// them to a new internal address of the same account and paying the fees
+// for both parent and child at the given rate.
+func (ts *TransactionService) childPaysForParent(
+	ctx context.Context, w *singlesig.Wallet, tx *transaction.Transaction,
+	fee, millisatsPerByte uint64,
+) (*BumpedTx, error) {
+	lbtc := ts.network.AssetID
+	txid := tx.TxHash().String()
+
+	keys := make([]domain.UtxoKey, 0, len(tx.Outputs))
+	for i, out := range tx.Outputs {
+		if len(out.Script) > 0 {
+			keys = append(keys, domain.UtxoKey{TxID: txid, VOut: uint32(i)})
+		}
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	utxosByAccount := make(map[string][]*domain.Utxo)
+	accountName := ""
+	for _, u := range utxos {
+		if u.IsSpent() || u.IsLocked() || u.IsFrozen() || u.IsEvicted() {
+			continue
+		}
+		if u.IsConfidential() && !u.IsRevealed() {
+			continue
+		}
+		utxosByAccount[u.AccountName] = append(utxosByAccount[u.AccountName], u)
+		if accountName == "" || u.Asset == lbtc {
+			accountName = u.AccountName
+		}
+	}
+	if accountName == "" {
+		return nil, newError(
+			ReasonTransactionNotBumpable,
+			"transaction %s has no spendable output owned by the wallet", txid,
+		)
+	}
+
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	childUtxos := utxosByAccount[accountName]
+	spendableUtxos, err := ts.repoManager.UtxoRepository().
+		GetSpendableUtxosForAccount(ctx, account.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	feeUtxos := getRemainingUtxos(spendableUtxos, childUtxos)
+
+	// The fee rate of the child is estimated without change, therefore it
+	// might be slightly higher than required if the fee is paid with other
+	// utxos of the account.
+	script, blindingKey, err := ts.deriveConsolidationAddress(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	ins := make([]wallet.Input, 0, len(childUtxos))
+	assets := make(map[string]struct{})
+	for _, u := range childUtxos {
+		ins = append(ins, wallet.Input{Script: u.Script})
+		assets[u.Asset] = struct{}{}
+	}
+	outs := make([]wallet.Output, 0, len(assets)+1)
+	for asset := range assets {
+		outs = append(outs, wallet.Output{
+			Asset: asset, Script: script, BlindingKey: blindingKey,
+		})
+	}
+	outs = append(outs, wallet.Output{Asset: lbtc})
+	childVsize := wallet.EstimateTxSize(ins, outs)
+	// The rate is known to be higher than the one of the parent, therefore
+	// the fee of the package is always higher than the parent's.
+	childFee := feeForSize(
+		uint64(tx.VirtualSize())+childVsize, millisatsPerByte,
+	) - fee
+	childMillisatsPerByte := (childFee*1000 + childVsize - 1) / childVsize
+	if childMillisatsPerByte < millisatsPerByte {
+		childMillisatsPerByte = millisatsPerByte
+	}
+
+	txHex, err := ts.spendUtxos(
+		ctx, w, account, childUtxos, feeUtxos, script, blindingKey,
+		childMillisatsPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+	childTxid, err := ts.broadcast(ctx, txHex)
+	if err != nil {
+		return nil, err
+	}
+
+	ts.log(
+		"bumped fee of tx %s with child %s of account %s at %d millisats/byte",
+		txid, childTxid, account.Namespace, childMillisatsPerByte,
+	)
+
+	return &BumpedTx{
+		Txid:   childTxid,
+		TxHex:  txHex,
+		Method: BumpFeeMethodChildPaysForParent,
+	}, nil
+}
+
+// evictReplacedTx marks the given tx as replaced by the new one, its inputs
+// as spent by the replacement and its outputs owned by the wallet as evicted.
+// Failing to do so is not considered critical since the replacement is
+// already broadcasted.
+func (ts *TransactionService) evictReplacedTx(
+	ctx context.Context, txid, newTxid string,
+	inputKeys, outputKeys []domain.UtxoKey,
+) {
+	if _, err := ts.repoManager.TransactionRepository().EvictTransaction(
+		ctx, txid, newTxid,
+	); err != nil {
+		ts.warn(err, "failed to mark tx %s as evicted", txid)
+	}
+	if _, err := ts.repoManager.UtxoRepository().RespendUtxos(
+		ctx, inputKeys, newTxid,
+	); err != nil {
+		ts.warn(err, "failed to mark inputs of tx %s as spent by %s", txid, newTxid)
+	}
+	if len(outputKeys) <= 0 {
+		return
+	}
+	if _, err := ts.repoManager.UtxoRepository().EvictUtxos(
+		ctx, outputKeys, time.Now().Unix(),
+	); err != nil {
+		ts.warn(err, "failed to mark outputs of tx %s as evicted", txid)
+	}
+}
+
+// feeFromTx returns the sum of the explicit fee outputs of the given tx.
+func feeFromTx(tx *transaction.Transaction) uint64 {
+	fee := uint64(0)
+	for _, out := range tx.Outputs {
+		if len(out.Script) > 0 || out.IsConfidential() {
+			continue
+		}
+		value, _ := elementsutil.ValueFromBytes(out.Value)
+		fee += value
+	}
+	return fee
+}
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const (
+	HealthCheckDb       = "db"
+	HealthCheckScanner  = "scanner"
+	HealthCheckTip      = "tip"
+	HealthCheckAccounts = "accounts"
+)
+
+var (
+	// MaxBlocksBehind is the max number of blocks the scanner, or the scan of
+	// an account, can lag behind the backend to still be considered in sync.
+	MaxBlocksBehind = uint32(1)
+	// DbPingTimeout is the max time to wait for the db to answer a ping.
+	DbPingTimeout = 5 * time.Second
+)
+
+// HealthService is responsible for reporting about the health of the daemon:
+//   - Get the sync status of the blockchain scanner and of the scan of every
+//     account.
+//   - Check whether the daemon is ready to serve requests, meaning that db
+//     and scanner backend are reachable and the wallet is in sync with the
+//     blockchain.
+type HealthService struct {
+	repoManager ports.RepoManager
+	bcScanner   ports.BlockchainScanner
+	scannerType string
+
+	log func(format string, a ...interface{})
+}
+
+func NewHealthService(
+	repoManager ports.RepoManager, bcScanner ports.BlockchainScanner,
+	scannerType string,
+) *HealthService {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("health service: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &HealthService{repoManager, bcScanner, scannerType, logFn}
+}
+
+// GetSyncStatus returns the tip of the scanner compared with that of its
+// backend, along with the accounts whose scan is lagging behind.
+func (hs *HealthService) GetSyncStatus(_ context.Context) SyncStatus {
+	return hs.getSyncStatus(hs.bcScanner.GetStatus())
+}
+
+// CheckReadiness checks the connection with the db and with the scanner
+// backend, and whether both the scanner and every account are in sync with
+// the blockchain.
+func (hs *HealthService) CheckReadiness(ctx context.Context) Readiness {
+	status := hs.getSyncStatus(hs.bcScanner.GetStatus())
+
+	ctx, cancel := context.WithTimeout(ctx, DbPingTimeout)
+	defer cancel()
+
+	checks := make([]HealthCheck, 0, 4)
+	dbCheck := HealthCheck{Name: HealthCheckDb, IsHealthy: true}
+	if err := hs.repoManager.Ping(ctx); err != nil {
+		dbCheck = HealthCheck{Name: HealthCheckDb, Reason: err.Error()}
+	}
+	checks = append(checks, dbCheck)
+
+	scannerCheck := HealthCheck{Name: HealthCheckScanner, IsHealthy: true}
+	if !status.IsScannerConnected {
+		scannerCheck = HealthCheck{
+			Name:   HealthCheckScanner,
+			Reason: fmt.Sprintf("%s backend is not reachable", status.ScannerType),
+		}
+	}
+	checks = append(checks, scannerCheck)
+
+	tipCheck := HealthCheck{Name: HealthCheckTip, IsHealthy: true}
+	if status.TipHeight+MaxBlocksBehind < status.BackendHeight {
+		tipCheck = HealthCheck{
+			Name: HealthCheckTip,
+			Reason: fmt.Sprintf(
+				"tip %d is %d blocks behind backend", status.TipHeight,
+				status.BackendHeight-status.TipHeight,
+			),
+		}
+	}
+	checks = append(checks, tipCheck)
+
+	accountsCheck := HealthCheck{Name: HealthCheckAccounts, IsHealthy: true}
+	if len(status.LaggingAccounts) > 0 {
+		accountNames := make([]string, 0, len(status.LaggingAccounts))
+		for _, a := range status.LaggingAccounts {
+			accountNames = append(accountNames, a.AccountName)
+		}
+		accountsCheck = HealthCheck{
+			Name:   HealthCheckAccounts,
+			Reason: fmt.Sprintf("accounts %v are not in sync", accountNames),
+		}
+	}
+	checks = append(checks, accountsCheck)
+
+	isReady := true
+	for _, c := range checks {
+		if !c.IsHealthy {
+			hs.log("readiness check %s failed: %s", c.Name, c.Reason)
+			isReady = false
+		}
+	}
+	return Readiness{isReady, checks}
+}
+
+func (hs *HealthService) getSyncStatus(
+	status ports.BlockchainScannerStatus,
+) SyncStatus {
+	backendHeight := status.BackendHeight
+	if status.TipHeight > backendHeight {
+		backendHeight = status.TipHeight
+	}
+
+	var lastBlockAge time.Duration
+	if status.TipTimestamp > 0 {
+		lastBlockAge = time.Since(time.Unix(status.TipTimestamp, 0))
+	}
+
+	laggingAccounts := make([]AccountSyncStatus, 0)
+	for accountName, height := range status.AccountsHeight {
+		if height+MaxBlocksBehind >= backendHeight {
+			continue
+		}
+		laggingAccounts = append(laggingAccounts, AccountSyncStatus{
+			AccountName:  accountName,
+			BlockHeight:  height,
+			BlocksBehind: backendHeight - height,
+		})
+	}
+	sort.SliceStable(laggingAccounts, func(i, j int) bool {
+		return laggingAccounts[i].AccountName < laggingAccounts[j].AccountName
+	})
+
+	return SyncStatus{
+		ScannerType:        hs.scannerType,
+		IsScannerConnected: status.IsConnected,
+		TipHeight:          status.TipHeight,
+		BackendHeight:      status.BackendHeight,
+		LastBlockAge:       lastBlockAge,
+		LaggingAccounts:    laggingAccounts,
+	}
+}
@@ -0,0 +1,85 @@
+package application_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestHealthService(t *testing.T) {
+	tipTimestamp := time.Now().Add(-time.Minute).Unix()
+
+	t.Run("ready", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected:   true,
+			TipHeight:     100,
+			TipTimestamp:  tipTimestamp,
+			BackendHeight: 100,
+			AccountsHeight: map[string]uint32{
+				"bip84-account0": 100,
+				"bip84-account1": 99,
+			},
+		})
+		svc := application.NewHealthService(
+			inmemory.NewRepoManager(), mockedBcScanner, "electrum",
+		)
+
+		status := svc.GetSyncStatus(ctx)
+		require.Equal(t, "electrum", status.ScannerType)
+		require.True(t, status.IsScannerConnected)
+		require.Equal(t, uint32(100), status.TipHeight)
+		require.Equal(t, uint32(100), status.BackendHeight)
+		require.GreaterOrEqual(t, status.LastBlockAge, time.Minute)
+		require.Empty(t, status.LaggingAccounts)
+
+		readiness := svc.CheckReadiness(ctx)
+		require.True(t, readiness.IsReady)
+		require.Len(t, readiness.Checks, 4)
+		for _, check := range readiness.Checks {
+			require.True(t, check.IsHealthy)
+			require.Empty(t, check.Reason)
+		}
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected:   true,
+			TipHeight:     90,
+			TipTimestamp:  tipTimestamp,
+			BackendHeight: 100,
+			AccountsHeight: map[string]uint32{
+				"bip84-account0": 100,
+				"bip84-account1": 50,
+			},
+		})
+		svc := application.NewHealthService(
+			inmemory.NewRepoManager(), mockedBcScanner, "neutrino",
+		)
+
+		status := svc.GetSyncStatus(ctx)
+		require.Len(t, status.LaggingAccounts, 1)
+		require.Equal(t, "bip84-account1", status.LaggingAccounts[0].AccountName)
+		require.Equal(t, uint32(50), status.LaggingAccounts[0].BlockHeight)
+		require.Equal(t, uint32(50), status.LaggingAccounts[0].BlocksBehind)
+
+		readiness := svc.CheckReadiness(ctx)
+		require.False(t, readiness.IsReady)
+		healthyByName := make(map[string]bool)
+		for _, check := range readiness.Checks {
+			healthyByName[check.Name] = check.IsHealthy
+			if !check.IsHealthy {
+				require.NotEmpty(t, check.Reason)
+			}
+		}
+		require.True(t, healthyByName[application.HealthCheckDb])
+		require.True(t, healthyByName[application.HealthCheckScanner])
+		require.False(t, healthyByName[application.HealthCheckTip])
+		require.False(t, healthyByName[application.HealthCheckAccounts])
+	})
+}
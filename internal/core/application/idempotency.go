@@ -0,0 +1,273 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+var (
+	// DefaultIdempotencyKeyTTL is the default time the result of a request is
+	// kept for the retries with the same idempotency key.
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+
+	// idempotencyPurgeInterval is the minimum interval between two purges of
+	// the expired keys.
+	idempotencyPurgeInterval = time.Hour
+)
+
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of the given context carrying the
+// idempotency key of a request.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of a request, if any.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// idempotencyManager makes sure that a request carrying an idempotency key is
+// executed at most once within the ttl of the key: retries get back the
+// stored result, or wait for it if the first request is still in flight.
+// Failed requests are not stored, therefore they can be retried with the same
+// key. The only exception are requests waiting for a spend approval, whose
+// retries get back the same approval id instead of queuing a new spend.
+type idempotencyManager struct {
+	repoManager ports.RepoManager
+	ttl         time.Duration
+
+	lock      *sync.Mutex
+	inFlight  map[string]*inFlightRequest
+	lastPurge time.Time
+
+	warn func(err error, format string, a ...interface{})
+}
+
+type inFlightRequest struct {
+	method      string
+	requestHash string
+	done        chan struct{}
+	result      string
+	err         error
+}
+
+func newIdempotencyManager(
+	repoManager ports.RepoManager, ttl time.Duration,
+	warnFn func(err error, format string, a ...interface{}),
+) *idempotencyManager {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyKeyTTL
+	}
+	return &idempotencyManager{
+		repoManager: repoManager,
+		ttl:         ttl,
+		lock:        &sync.Mutex{},
+		inFlight:    make(map[string]*inFlightRequest),
+		lastPurge:   time.Now(),
+		warn:        warnFn,
+	}
+}
+
+// do executes the given request, identified by method and args, unless the
+// context carries an idempotency key already used for it. In that case, the
+// result of the first request is returned instead, once available.
+func (m *idempotencyManager) do(
+	ctx context.Context, method string, args interface{},
+	fn func() (string, error),
+) (string, error) {
+	key := IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return fn()
+	}
+
+	requestHash, err := hashRequest(CallerFromContext(ctx), method, args)
+	if err != nil {
+		return "", err
+	}
+
+	req, isOwner, result, err := m.begin(ctx, key, method, requestHash)
+	if err != nil {
+		return "", err
+	}
+	// The request has already been completed.
+	if req == nil {
+		return result, nil
+	}
+	// The request is in flight, wait for it to complete.
+	if !isOwner {
+		select {
+		case <-req.done:
+			return req.result, req.err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	req.result, req.err = fn()
+	m.complete(ctx, key, req)
+	return req.result, req.err
+}
+
+// begin returns the result of the request if already completed, otherwise the
+// request in flight for the given key, either the one of a previous call or a
+// new one that the caller is in charge of executing.
+func (m *idempotencyManager) begin(
+	ctx context.Context, key, method, requestHash string,
+) (*inFlightRequest, bool, string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.purgeExpiredKeys(ctx)
+
+	if req, ok := m.inFlight[key]; ok {
+		if req.method != method || req.requestHash != requestHash {
+			return nil, false, "", errIdempotencyKeyMismatch(key)
+		}
+		return req, false, "", nil
+	}
+
+	repo := m.repoManager.IdempotencyKeyRepository()
+	storedKey, err := repo.GetKey(ctx, key)
+	if err != nil && !errors.Is(err, domain.ErrIdempotencyKeyNotFound) {
+		return nil, false, "", err
+	}
+	if storedKey != nil {
+		if !storedKey.IsExpired(time.Now().Unix()) {
+			if !storedKey.Matches(method, requestHash) {
+				return nil, false, "", errIdempotencyKeyMismatch(key)
+			}
+			if storedKey.IsCompleted() {
+				return nil, false, storedKey.Result, nil
+			}
+			if storedKey.IsApprovalRequired() {
+				return nil, false, "", errSpendApprovalRequired(storedKey.Result)
+			}
+			// The key is pending but the request is not in flight: the daemon
+			// stopped before completing it, and it's not possible to tell whether
+			// it succeeded.
+			return nil, false, "", errIdempotencyKeyPending(key)
+		}
+		if err := repo.DeleteKey(ctx, key); err != nil {
+			return nil, false, "", err
+		}
+	}
+
+	newKey, err := domain.NewIdempotencyKey(key, method, requestHash, m.ttl)
+	if err != nil {
+		return nil, false, "", err
+	}
+	added, err := repo.AddKey(ctx, newKey)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if !added {
+		return nil, false, "", errIdempotencyKeyPending(key)
+	}
+
+	req := &inFlightRequest{
+		method:      method,
+		requestHash: requestHash,
+		done:        make(chan struct{}),
+	}
+	m.inFlight[key] = req
+	return req, true, "", nil
+}
+
+// complete stores the result of the given request, or the approval id if it
+// requires one, otherwise drops its key if it failed, and wakes up the retries
+// waiting for it.
+func (m *idempotencyManager) complete(
+	ctx context.Context, key string, req *inFlightRequest,
+) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// The client may have gone away meanwhile, the outcome of the request must
+	// be persisted anyway.
+	ctx = context.WithoutCancel(ctx)
+	repo := m.repoManager.IdempotencyKeyRepository()
+	storedKey := &domain.IdempotencyKey{Key: key}
+	switch approvalId := approvalIdOf(req.err); {
+	case req.err == nil:
+		storedKey.Complete(req.result)
+		if err := repo.UpdateKey(ctx, storedKey); err != nil {
+			m.warn(err, "failed to store result for idempotency key %s", key)
+		}
+	case approvalId != "":
+		storedKey.RequireApproval(approvalId)
+		if err := repo.UpdateKey(ctx, storedKey); err != nil {
+			m.warn(err, "failed to store approval id for idempotency key %s", key)
+		}
+	default:
+		if err := repo.DeleteKey(ctx, key); err != nil {
+			m.warn(err, "failed to delete idempotency key %s", key)
+		}
+	}
+
+	delete(m.inFlight, key)
+	close(req.done)
+}
+
+func (m *idempotencyManager) purgeExpiredKeys(ctx context.Context) {
+	now := time.Now()
+	if now.Sub(m.lastPurge) < idempotencyPurgeInterval {
+		return
+	}
+	m.lastPurge = now
+
+	if _, err := m.repoManager.IdempotencyKeyRepository().DeleteExpiredKeys(
+		ctx, now.Unix(),
+	); err != nil {
+		m.warn(err, "failed to purge expired idempotency keys")
+	}
+}
+
+// hashRequest returns the hash of the given request, made of its caller,
+// method and arguments.
+func hashRequest(caller, method string, args interface{}) (string, error) {
+	buf, err := json.Marshal(struct {
+		Caller string      `json:"caller"`
+		Method string      `json:"method"`
+		Args   interface{} `json:"args"`
+	}{caller, method, args})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(buf)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// approvalIdOf returns the id of the spend approval the given error refers to,
+// if any.
+func approvalIdOf(err error) string {
+	if !errors.Is(err, ErrSpendApprovalRequired) {
+		return ""
+	}
+	_, metadata := ErrorReasonOf(err)
+	return metadata[ErrorMetadataApprovalId]
+}
+
+func errIdempotencyKeyMismatch(key string) error {
+	return newError(
+		ReasonIdempotencyKeyMismatch,
+		"idempotency key %s already used for a different request", key,
+	)
+}
+
+func errIdempotencyKeyPending(key string) error {
+	return newError(
+		ReasonIdempotencyKeyPending,
+		"request with idempotency key %s is still pending", key,
+	)
+}
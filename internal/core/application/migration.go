@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+// MigrationStats holds the number of entities stored by a repo manager, used
+// to verify that a migration copied all of them.
+type MigrationStats struct {
+	Accounts         int
+	DerivationPaths  int
+	ExternalScripts  int
+	SpendingPolicies int
+	Utxos            int
+	SpentUtxos       int
+	ConfirmedUtxos   int
+	LockedUtxos      int
+	Transactions     int
+	AuditEvents      int
+}
+
+func (s MigrationStats) String() string {
+	return fmt.Sprintf(
+		"accounts: %d, derivation paths: %d, external scripts: %d, "+
+			"spending policies: %d, utxos: %d (spent: %d, confirmed: %d, "+
+			"locked: %d), transactions: %d, audit events: %d",
+		s.Accounts, s.DerivationPaths, s.ExternalScripts, s.SpendingPolicies,
+		s.Utxos, s.SpentUtxos, s.ConfirmedUtxos, s.LockedUtxos, s.Transactions,
+		s.AuditEvents,
+	)
+}
+
+// MigrateData copies the whole state of the daemon, including utxos,
+// transactions and audit log, from a repo manager to another one, regardless
+// of their concrete types. The destination must not contain any wallet.
+// Once copied, the data is verified by comparing the number of entities and
+// the balances of every account stored by both repo managers.
+func MigrateData(
+	ctx context.Context, from, to ports.RepoManager,
+) (*MigrationStats, error) {
+	if w, _ := to.WalletRepository().GetWallet(ctx); w != nil {
+		return nil, fmt.Errorf("destination database already contains a wallet")
+	}
+
+	payload, err := exportBackupPayload(ctx, from, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source database: %s", err)
+	}
+	events, err := getAllAuditEvents(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source audit log: %s", err)
+	}
+
+	if err := importBackupPayload(ctx, to, payload); err != nil {
+		return nil, err
+	}
+	for i := range events {
+		if _, err := to.AuditEventRepository().AddEvent(ctx, &events[i]); err != nil {
+			return nil, fmt.Errorf("failed to import audit log: %s", err)
+		}
+	}
+
+	return verifyMigration(ctx, from, to)
+}
+
+func verifyMigration(
+	ctx context.Context, from, to ports.RepoManager,
+) (*MigrationStats, error) {
+	srcStats, srcBalances, err := getMigrationStats(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify source database: %s", err)
+	}
+	dstStats, dstBalances, err := getMigrationStats(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify destination database: %s", err)
+	}
+
+	if *srcStats != *dstStats {
+		return nil, fmt.Errorf(
+			"migrated data mismatch, source: {%s}, destination: {%s}",
+			srcStats, dstStats,
+		)
+	}
+
+	for accountName, srcBalance := range srcBalances {
+		dstBalance := dstBalances[accountName]
+		assets := make(map[string]struct{})
+		for asset := range srcBalance {
+			assets[asset] = struct{}{}
+		}
+		for asset := range dstBalance {
+			assets[asset] = struct{}{}
+		}
+		for asset := range assets {
+			src, dst := domain.Balance{}, domain.Balance{}
+			if b := srcBalance[asset]; b != nil {
+				src = *b
+			}
+			if b := dstBalance[asset]; b != nil {
+				dst = *b
+			}
+			if src != dst {
+				return nil, fmt.Errorf(
+					"migrated balance mismatch for account %s and asset %s, "+
+						"source: %+v, destination: %+v", accountName, asset, src, dst,
+				)
+			}
+		}
+	}
+
+	return dstStats, nil
+}
+
+// getMigrationStats returns the number of entities and the balances of every
+// account stored by the given repo manager.
+func getMigrationStats(
+	ctx context.Context, repoManager ports.RepoManager,
+) (*MigrationStats, map[string]map[string]*domain.Balance, error) {
+	payload, err := exportBackupPayload(ctx, repoManager, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := getAllAuditEvents(ctx, repoManager)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := domain.VerifyAuditEvents(nil, events); err != nil {
+		return nil, nil, err
+	}
+
+	stats := &MigrationStats{
+		Accounts:         len(payload.Wallet.Accounts),
+		ExternalScripts:  len(payload.ExternalScripts),
+		SpendingPolicies: len(payload.SpendingPolicies),
+		Utxos:            len(payload.Utxos),
+		Transactions:     len(payload.Transactions),
+		AuditEvents:      len(events),
+	}
+	for _, u := range payload.Utxos {
+		if u.IsSpent() {
+			stats.SpentUtxos++
+		}
+		if u.IsConfirmed() {
+			stats.ConfirmedUtxos++
+		}
+		if u.IsLocked() {
+			stats.LockedUtxos++
+		}
+	}
+
+	balances := make(map[string]map[string]*domain.Balance)
+	for accountName, account := range payload.Wallet.Accounts {
+		stats.DerivationPaths += len(account.DerivationPathByScript)
+
+		balance, err := repoManager.UtxoRepository().GetBalanceForAccount(
+			ctx, accountName,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		balances[accountName] = balance
+	}
+
+	return stats, balances, nil
+}
+
+func getAllAuditEvents(
+	ctx context.Context, repoManager ports.RepoManager,
+) ([]domain.AuditEvent, error) {
+	events := make([]domain.AuditEvent, 0)
+	fromSequence := uint64(1)
+	for {
+		page, err := repoManager.AuditEventRepository().GetEvents(
+			ctx, fromSequence, MaxAuditEventsPageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+		if uint64(len(page)) < MaxAuditEventsPageSize {
+			return events, nil
+		}
+		fromSequence = page[len(page)-1].Sequence + 1
+	}
+}
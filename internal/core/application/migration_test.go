@@ -0,0 +1,49 @@
+package application_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestMigrateData(t *testing.T) {
+	domain.MnemonicStore = newInMemoryMnemonicStore()
+
+	from, err := newRepoManagerForTxService()
+	require.NoError(t, err)
+
+	_, err = from.ExternalScriptRepository().AddScript(ctx, domain.AddressInfo{
+		Account: "test", Script: hex.EncodeToString(randomBytes(22)),
+	})
+	require.NoError(t, err)
+
+	event, err := domain.NewAuditEvent(
+		nil, "test", "/ocean.v1.WalletService/Unlock", "", nil, nil, "", "",
+	)
+	require.NoError(t, err)
+	_, err = from.AuditEventRepository().AddEvent(ctx, event)
+	require.NoError(t, err)
+
+	to := inmemory.NewRepoManager()
+
+	stats, err := application.MigrateData(ctx, from, to)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	require.Equal(t, 1, stats.Accounts)
+	require.Equal(t, 2, stats.DerivationPaths)
+	require.Equal(t, 1, stats.ExternalScripts)
+	require.Equal(t, 2, stats.Utxos)
+	require.Equal(t, 1, stats.AuditEvents)
+
+	balance, err := to.UtxoRepository().GetBalanceForAccount(ctx, accountNamespace)
+	require.NoError(t, err)
+	require.NotEmpty(t, balance)
+
+	stats, err = application.MigrateData(ctx, from, to)
+	require.Error(t, err)
+	require.Nil(t, stats)
+}
@@ -1,8 +1,10 @@
 package application_test
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strings"
 	"sync"
@@ -11,6 +13,7 @@ import (
 	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/ocean/internal/core/application"
 	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
 )
 
 // ports.BlockchainScanner
@@ -102,7 +105,9 @@ func (m *mockBcScanner) GetTxChannel(accountName string) chan *domain.Transactio
 	return m.chTxs
 }
 
-func (m *mockBcScanner) GetLatestBlock() ([]byte, uint32, error) {
+func (m *mockBcScanner) GetLatestBlock(
+	_ context.Context,
+) ([]byte, uint32, error) {
 	args := m.Called()
 	var res []byte
 	if a := args.Get(0); a != nil {
@@ -115,7 +120,9 @@ func (m *mockBcScanner) GetLatestBlock() ([]byte, uint32, error) {
 	return res, res1, args.Error(2)
 }
 
-func (m *mockBcScanner) GetBlockHash(height uint32) ([]byte, error) {
+func (m *mockBcScanner) GetBlockHash(
+	_ context.Context, height uint32,
+) ([]byte, error) {
 	args := m.Called(height)
 	var res []byte
 	if a := args.Get(0); a != nil {
@@ -133,7 +140,9 @@ func (m *mockBcScanner) GetBlockHeight(hash []byte) (uint32, error) {
 	return res, args.Error(1)
 }
 
-func (m *mockBcScanner) GetUtxos(utxos []domain.Utxo) ([]domain.Utxo, error) {
+func (m *mockBcScanner) GetUtxos(
+	_ context.Context, utxos []domain.Utxo,
+) ([]domain.Utxo, error) {
 	args := m.Called(utxos)
 	var res []domain.Utxo
 	if a := args.Get(0); a != nil {
@@ -142,7 +151,9 @@ func (m *mockBcScanner) GetUtxos(utxos []domain.Utxo) ([]domain.Utxo, error) {
 	return res, args.Error(1)
 }
 
-func (m *mockBcScanner) GetUtxosForAddresses(addresses []domain.AddressInfo) ([]*domain.Utxo, error) {
+func (m *mockBcScanner) GetUtxosForAddresses(
+	_ context.Context, addresses []domain.AddressInfo,
+) ([]*domain.Utxo, error) {
 	args := m.Called(addresses)
 	var res []*domain.Utxo
 	if a := args.Get(0); a != nil {
@@ -151,7 +162,9 @@ func (m *mockBcScanner) GetUtxosForAddresses(addresses []domain.AddressInfo) ([]
 	return res, args.Error(1)
 }
 
-func (m *mockBcScanner) BroadcastTransaction(txHex string) (string, error) {
+func (m *mockBcScanner) BroadcastTransaction(
+	_ context.Context, txHex string,
+) (string, error) {
 	args := m.Called(txHex)
 	var res string
 	if a := args.Get(0); a != nil {
@@ -160,7 +173,9 @@ func (m *mockBcScanner) BroadcastTransaction(txHex string) (string, error) {
 	return res, args.Error(1)
 }
 
-func (m *mockBcScanner) GetTransactions(txids []string) ([]domain.Transaction, error) {
+func (m *mockBcScanner) GetTransactions(
+	_ context.Context, txids []string,
+) ([]domain.Transaction, error) {
 	args := m.Called(txids)
 	var res []domain.Transaction
 	if a := args.Get(0); a != nil {
@@ -169,6 +184,26 @@ func (m *mockBcScanner) GetTransactions(txids []string) ([]domain.Transaction, e
 	return res, args.Error(1)
 }
 
+func (m *mockBcScanner) EstimateFeeRate(
+	_ context.Context, numOfBlocks uint32,
+) (uint64, error) {
+	args := m.Called(numOfBlocks)
+	var res uint64
+	if a := args.Get(0); a != nil {
+		res = a.(uint64)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockBcScanner) GetStatus() ports.BlockchainScannerStatus {
+	args := m.Called()
+	var res ports.BlockchainScannerStatus
+	if a := args.Get(0); a != nil {
+		res = a.(ports.BlockchainScannerStatus)
+	}
+	return res
+}
+
 // domain.MnemonicStore
 type inMemoryMnemonicStore struct {
 	mnemonic []string
@@ -238,6 +273,32 @@ func (m *mockMnemonicCypher) Decrypt(
 	return res, args.Error(1)
 }
 
+// xorMnemonicCypher is a fast, reversible domain.IMnemonicCypher used where
+// the encrypted data must be actually decrypted back.
+type xorMnemonicCypher struct{}
+
+func (c xorMnemonicCypher) Encrypt(mnemonic, password []byte) ([]byte, error) {
+	return c.xor(append(append([]byte{}, password...), mnemonic...), password), nil
+}
+
+func (c xorMnemonicCypher) Decrypt(
+	encryptedMnemonic, password []byte,
+) ([]byte, error) {
+	buf := c.xor(encryptedMnemonic, password)
+	if len(buf) < len(password) || string(buf[:len(password)]) != string(password) {
+		return nil, fmt.Errorf("invalid password")
+	}
+	return buf[len(password):], nil
+}
+
+func (c xorMnemonicCypher) xor(data, key []byte) []byte {
+	out := make([]byte, len(data))
+	for i := range data {
+		out[i] = data[i] ^ key[i%len(key)]
+	}
+	return out
+}
+
 func randomUtxos(accountName string, addresses []string) []*domain.Utxo {
 	utxos := make([]*domain.Utxo, 0, len(addresses))
 	for _, addr := range addresses {
@@ -14,16 +14,21 @@ import (
 )
 
 // Notification service has the very simple task of making the event channels
-// of the used domain.TransactionRepository and domain.UtxoRepository
-// accessible by external clients so that they can get real-time updates on the
-// status of the internal wallet.
+// of the used domain.TransactionRepository, domain.UtxoRepository,
+// domain.PaymentRepository and domain.BroadcastRepository accessible by
+// external clients so that they can get real-time updates on the status of the
+// internal wallet, of its queued payments and of its broadcasted txs.
 type NotificationService struct {
-	repoManager ports.RepoManager
-	bcScanner   ports.BlockchainScanner
-	chUtxos     chan domain.UtxoEvent
-	chTxs       chan domain.TransactionEvent
-	utxoLock    *sync.Mutex
-	txLock      *sync.Mutex
+	repoManager   ports.RepoManager
+	bcScanner     ports.BlockchainScanner
+	chUtxos       chan domain.UtxoEvent
+	chTxs         chan domain.TransactionEvent
+	chPayments    chan domain.PaymentEvent
+	chBroadcasts  chan domain.BroadcastEvent
+	utxoLock      *sync.Mutex
+	txLock        *sync.Mutex
+	paymentLock   *sync.Mutex
+	broadcastLock *sync.Mutex
 
 	log func(format string, a ...interface{})
 }
@@ -37,15 +42,22 @@ func NewNotificationService(
 	}
 	chUtxos := make(chan domain.UtxoEvent)
 	chTxs := make(chan domain.TransactionEvent)
+	chPayments := make(chan domain.PaymentEvent)
+	chBroadcasts := make(chan domain.BroadcastEvent)
 	utxoLock := &sync.Mutex{}
 	txLock := &sync.Mutex{}
+	paymentLock := &sync.Mutex{}
+	broadcastLock := &sync.Mutex{}
 
 	svc := &NotificationService{
-		repoManager, bcScanner, chUtxos, chTxs, utxoLock, txLock, logFn,
+		repoManager, bcScanner, chUtxos, chTxs, chPayments, chBroadcasts,
+		utxoLock, txLock, paymentLock, broadcastLock, logFn,
 	}
 	svc.registerHandlersForExternalScripts()
 	go svc.listenToInternalTxs()
 	go svc.listenToInternalUtxos()
+	go svc.listenToPayments()
+	go svc.listenToBroadcasts()
 
 	return svc
 }
@@ -62,12 +74,24 @@ func (ns *NotificationService) GetUtxoChannel(
 	return ns.chUtxos, nil
 }
 
+func (ns *NotificationService) GetPaymentChannel(
+	ctx context.Context,
+) (chan domain.PaymentEvent, error) {
+	return ns.chPayments, nil
+}
+
+func (ns *NotificationService) GetBroadcastChannel(
+	ctx context.Context,
+) (chan domain.BroadcastEvent, error) {
+	return ns.chBroadcasts, nil
+}
+
 func (ns *NotificationService) WatchScript(
 	ctx context.Context, scriptHex, blindingKey string,
 ) (string, error) {
 	script, err := hex.DecodeString(scriptHex)
 	if err != nil {
-		return "", fmt.Errorf("invalid script: must be in hex format")
+		return "", newError(ReasonInvalidArgument, "invalid script: must be in hex format")
 	}
 	var key []byte
 	if len(blindingKey) > 0 {
@@ -125,6 +149,20 @@ func (ns *NotificationService) listenToInternalUtxos() {
 	}
 }
 
+func (ns *NotificationService) listenToPayments() {
+	chPayments := ns.repoManager.PaymentRepository().GetEventChannel()
+	for event := range chPayments {
+		go ns.publishPayment(event)
+	}
+}
+
+func (ns *NotificationService) listenToBroadcasts() {
+	chBroadcasts := ns.repoManager.BroadcastRepository().GetEventChannel()
+	for event := range chBroadcasts {
+		go ns.publishBroadcast(event)
+	}
+}
+
 func (ns *NotificationService) publishUtxo(event domain.UtxoEvent) {
 	ns.utxoLock.Lock()
 	defer ns.utxoLock.Unlock()
@@ -139,6 +177,20 @@ func (ns *NotificationService) publishTx(event domain.TransactionEvent) {
 	ns.chTxs <- event
 }
 
+func (ns *NotificationService) publishPayment(event domain.PaymentEvent) {
+	ns.paymentLock.Lock()
+	defer ns.paymentLock.Unlock()
+
+	ns.chPayments <- event
+}
+
+func (ns *NotificationService) publishBroadcast(event domain.BroadcastEvent) {
+	ns.broadcastLock.Lock()
+	defer ns.broadcastLock.Unlock()
+
+	ns.chBroadcasts <- event
+}
+
 func (ns *NotificationService) registerHandlersForExternalScripts() {
 	// Start watching external scripts as soon as they are persisted.
 	ns.repoManager.RegisterHandlerForExternalScriptEvent(
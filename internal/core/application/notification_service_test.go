@@ -24,6 +24,10 @@ func TestNotificationService(t *testing.T) {
 	testGetUtxoChannel(t)
 
 	testGetTxChannel(t)
+
+	testGetPaymentChannel(t)
+
+	testGetBroadcastChannel(t)
 }
 
 func testGetUtxoChannel(t *testing.T) {
@@ -45,7 +49,7 @@ func testGetUtxoChannel(t *testing.T) {
 	time.Sleep(time.Second)
 
 	keys := application.Utxos(utxos).Keys()
-	repoManager.UtxoRepository().LockUtxos(ctx, keys, time.Now().Unix(), 0)
+	repoManager.UtxoRepository().LockUtxos(ctx, keys, time.Now().Unix(), 0, "")
 
 	time.Sleep(time.Second)
 
@@ -97,6 +101,76 @@ func testGetTxChannel(t *testing.T) {
 	)
 }
 
+func testGetPaymentChannel(t *testing.T) {
+	repoManager, err := newRepoManagerForNotificationService()
+	require.NoError(t, err)
+	require.NotNil(t, repoManager)
+
+	svc := application.NewNotificationService(repoManager, nil)
+
+	chEvents, err := svc.GetPaymentChannel(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, chEvents)
+
+	// Give the service time to start listening to the repository events.
+	time.Sleep(100 * time.Millisecond)
+
+	payment, err := domain.NewPayment(
+		accountName, regtest.AssetID, 10000, randomHex(22), "", 0,
+	)
+	require.NoError(t, err)
+	repoManager.PaymentRepository().AddPayment(ctx, payment)
+
+	event := <-chEvents
+	require.Equal(t, domain.PaymentQueued, event.EventType)
+	require.Len(t, event.Payments, 1)
+	require.Equal(t, payment.ID, event.Payments[0].ID)
+
+	payment.Broadcast(randomHex(32), 0)
+	repoManager.PaymentRepository().UpdatePayments(
+		ctx, []domain.Payment{*payment},
+	)
+
+	event = <-chEvents
+	require.Equal(t, domain.PaymentBroadcasted, event.EventType)
+	require.Len(t, event.Payments, 1)
+	require.Equal(t, payment.Txid, event.Payments[0].Txid)
+}
+
+func testGetBroadcastChannel(t *testing.T) {
+	repoManager, err := newRepoManagerForNotificationService()
+	require.NoError(t, err)
+	require.NotNil(t, repoManager)
+
+	svc := application.NewNotificationService(repoManager, nil)
+
+	chEvents, err := svc.GetBroadcastChannel(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, chEvents)
+
+	// Give the service time to start listening to the repository events.
+	time.Sleep(100 * time.Millisecond)
+
+	broadcast, err := domain.NewBroadcast(randomHex(32), randomHex(100), 0)
+	require.NoError(t, err)
+	repoManager.BroadcastRepository().AddBroadcast(ctx, broadcast)
+
+	event := <-chEvents
+	require.Equal(t, domain.BroadcastPending, event.EventType)
+	require.Len(t, event.Broadcasts, 1)
+	require.Equal(t, broadcast.Txid, event.Broadcasts[0].Txid)
+
+	broadcast.Drop()
+	repoManager.BroadcastRepository().UpdateBroadcasts(
+		ctx, []domain.Broadcast{*broadcast},
+	)
+
+	event = <-chEvents
+	require.Equal(t, domain.BroadcastDropped, event.EventType)
+	require.Len(t, event.Broadcasts, 1)
+	require.Equal(t, broadcast.Txid, event.Broadcasts[0].Txid)
+}
+
 func listenToUtxoEvents(t *testing.T, chEvents chan domain.UtxoEvent) {
 	for event := range chEvents {
 		time.Sleep(time.Millisecond)
@@ -0,0 +1,537 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	multiasset_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/multi-asset"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+const (
+	// defaultPaymentBatchSize caps the outputs of every batch tx if not
+	// specified.
+	defaultPaymentBatchSize = 100
+	// defaultPaymentMaxWaitTime is the max time a payment waits in the queue
+	// if not specified.
+	defaultPaymentMaxWaitTime = 10 * time.Minute
+	// paymentRetryInterval is the min time the batcher waits before retrying
+	// to flush the payments of an account after a failure.
+	paymentRetryInterval = 30 * time.Second
+)
+
+// PaymentBatcherOptions define when the queued payments of an account are
+// flushed into a single transaction, and at what fee rate.
+// The payments are flushed as soon as any of the following occurs:
+//   - the number of queued payments reaches MaxBatchSize.
+//   - the oldest queued payment has been waiting for MaxWaitTime.
+//   - the deadline of any queued payment is reached.
+type PaymentBatcherOptions struct {
+	// MaxBatchSize is the max number of payments of every batch tx.
+	MaxBatchSize uint32
+	// MaxWaitTime is the max time a payment waits in the queue.
+	MaxWaitTime time.Duration
+	// MillisatsPerByte is the fee rate of the batch txs.
+	MillisatsPerByte uint64
+}
+
+func (o PaymentBatcherOptions) validate() error {
+	if o.MaxWaitTime < 0 {
+		return fmt.Errorf("payment max wait time must not be negative")
+	}
+	if o.MillisatsPerByte > 0 && o.MillisatsPerByte < MinMillisatsPerByte {
+		return fmt.Errorf(
+			"fee rate must be at least %d millisats/byte", MinMillisatsPerByte,
+		)
+	}
+	return nil
+}
+
+func (o PaymentBatcherOptions) maxBatchSize() int {
+	if o.MaxBatchSize == 0 {
+		return defaultPaymentBatchSize
+	}
+	return int(o.MaxBatchSize)
+}
+
+func (o PaymentBatcherOptions) maxWaitTime() time.Duration {
+	if o.MaxWaitTime == 0 {
+		return defaultPaymentMaxWaitTime
+	}
+	return o.MaxWaitTime
+}
+
+func (o PaymentBatcherOptions) millisatsPerByte() uint64 {
+	if o.MillisatsPerByte == 0 {
+		return MinMillisatsPerByte
+	}
+	return o.MillisatsPerByte
+}
+
+// flushAt returns the unix time by which the given payment must be flushed.
+func (o PaymentBatcherOptions) flushAt(p domain.Payment) int64 {
+	flushAt := p.CreatedAt + int64(o.maxWaitTime().Seconds())
+	if p.Deadline > 0 && p.Deadline < flushAt {
+		return p.Deadline
+	}
+	return flushAt
+}
+
+// EnqueuePayment adds the given output to the payout queue of the account.
+// The payment is flushed into a tx along with the other ones of the same
+// account by the batcher, and at the latest by the given deadline, if any.
+// It returns the id of the payment, mapping to the output of the tx paying it
+// once broadcasted.
+func (ts *TransactionService) EnqueuePayment(
+	ctx context.Context, accountName string, output Output, deadline int64,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.EnqueuePayment")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "EnqueuePayment", []interface{}{accountName, output, deadline},
+		func() (string, error) {
+			return ts.enqueuePayment(ctx, accountName, output, deadline)
+		},
+	)
+}
+
+func (ts *TransactionService) enqueuePayment(
+	ctx context.Context, accountName string, output Output, deadline int64,
+) (string, error) {
+	if err := output.Validate(); err != nil {
+		return "", newError(ReasonInvalidArgument, "invalid output: %s", err)
+	}
+	if output.Asset == ts.network.AssetID && output.Amount < ts.dustAmount {
+		return "", newError(
+			ReasonInvalidArgument, "lbtc output amount must not be dust",
+		)
+	}
+	if deadline > 0 && deadline <= time.Now().Unix() {
+		return "", newError(
+			ReasonInvalidArgument, "payment deadline must be in the future",
+		)
+	}
+
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	payment, err := domain.NewPayment(
+		account.Namespace, output.Asset, output.Amount,
+		hex.EncodeToString(output.Script), hex.EncodeToString(output.BlindingKey),
+		deadline,
+	)
+	if err != nil {
+		return "", err
+	}
+	if _, err := ts.repoManager.PaymentRepository().AddPayment(
+		ctx, payment,
+	); err != nil {
+		return "", err
+	}
+	ts.log("queued payment %s for account %s", payment.ID, account.Namespace)
+
+	// Wake up the batcher, if running, without blocking.
+	select {
+	case ts.chPaymentQueued <- struct{}{}:
+	default:
+	}
+
+	return payment.ID, nil
+}
+
+// GetPayment returns the payment identified by the given id.
+func (ts *TransactionService) GetPayment(
+	ctx context.Context, id string,
+) (_ *domain.Payment, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.GetPayment")
+	defer func() { endSpan(span, err) }()
+
+	return ts.repoManager.PaymentRepository().GetPayment(ctx, id)
+}
+
+// FlushPayments flushes right away all the queued payments of the given
+// account, or of all accounts if not specified, with as many txs as required
+// to respect the max batch size of the given options.
+// It returns the hashes of the broadcasted txs.
+func (ts *TransactionService) FlushPayments(
+	ctx context.Context, accountName string, opts PaymentBatcherOptions,
+) (_ []string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.FlushPayments")
+	defer func() { endSpan(span, err) }()
+
+	if err := opts.validate(); err != nil {
+		return nil, newError(ReasonInvalidArgument, "%s", err)
+	}
+	if accountName != "" {
+		account, err := ts.getAccount(ctx, accountName)
+		if err != nil {
+			return nil, err
+		}
+		accountName = account.Namespace
+	}
+
+	txids, _, err := ts.flushPayments(ctx, accountName, opts, true)
+	return txids, err
+}
+
+// StartPaymentBatcher spawns a job that flushes the queued payments of every
+// account of the wallet, if unlocked, according to the given options.
+func (ts *TransactionService) StartPaymentBatcher(
+	opts PaymentBatcherOptions,
+) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	ts.paymentBatcherLock.Lock()
+	defer ts.paymentBatcherLock.Unlock()
+
+	if ts.paymentBatcherQuit != nil {
+		return fmt.Errorf("payment batcher already started")
+	}
+	quit := make(chan struct{})
+	ts.paymentBatcherQuit = quit
+
+	go func() {
+		for {
+			wait := ts.flushDuePayments(opts)
+			t := time.NewTimer(wait)
+			select {
+			case <-quit:
+				t.Stop()
+				return
+			case <-ts.chPaymentQueued:
+				t.Stop()
+			case <-t.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// StopPaymentBatcher stops the job spawned by StartPaymentBatcher, if any.
+func (ts *TransactionService) StopPaymentBatcher() {
+	ts.paymentBatcherLock.Lock()
+	defer ts.paymentBatcherLock.Unlock()
+
+	if ts.paymentBatcherQuit != nil {
+		close(ts.paymentBatcherQuit)
+		ts.paymentBatcherQuit = nil
+	}
+}
+
+// flushDuePayments flushes the queued payments of those accounts for which
+// any trigger of the given options occurred, and returns how long to wait
+// before the next trigger.
+func (ts *TransactionService) flushDuePayments(
+	opts PaymentBatcherOptions,
+) time.Duration {
+	ctx := context.Background()
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil || w.IsLocked() {
+		return paymentRetryInterval
+	}
+
+	_, nextFlushAt, err := ts.flushPayments(ctx, "", opts, false)
+	if err != nil {
+		ts.warn(err, "failed to flush queued payments")
+	}
+
+	wait := opts.maxWaitTime()
+	if nextFlushAt > 0 {
+		wait = time.Until(time.Unix(nextFlushAt, 0))
+	}
+	if err != nil && wait < paymentRetryInterval {
+		wait = paymentRetryInterval
+	}
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return wait
+}
+
+// flushPayments flushes the queued payments of the given account, or of all
+// accounts if not specified, into txs with up to the max batch size of
+// outputs. Unless forced, only the payments of those accounts for which any
+// trigger occurred are flushed.
+// It returns the hashes of the broadcasted txs and the unix time at which the
+// next trigger occurs for the payments left in the queue, if any.
+func (ts *TransactionService) flushPayments(
+	ctx context.Context, accountName string, opts PaymentBatcherOptions,
+	force bool,
+) ([]string, int64, error) {
+	ts.paymentFlushLock.Lock()
+	defer ts.paymentFlushLock.Unlock()
+
+	payments, err := ts.repoManager.PaymentRepository().GetQueuedPayments(
+		ctx, accountName,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	paymentsByAccount := make(map[string][]domain.Payment)
+	for _, p := range payments {
+		paymentsByAccount[p.AccountName] = append(
+			paymentsByAccount[p.AccountName], p,
+		)
+	}
+	accounts := make([]string, 0, len(paymentsByAccount))
+	for account := range paymentsByAccount {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	maxBatchSize := opts.maxBatchSize()
+	txids := make([]string, 0)
+	var nextFlushAt int64
+	var flushErr error
+	for _, account := range accounts {
+		queue := paymentsByAccount[account]
+		// The payments to be flushed first are at the front of the queue.
+		sort.SliceStable(queue, func(i, j int) bool {
+			return opts.flushAt(queue[i]) < opts.flushAt(queue[j])
+		})
+
+		for len(queue) > 0 {
+			now := time.Now().Unix()
+			isDue := force || len(queue) >= maxBatchSize ||
+				opts.flushAt(queue[0]) <= now
+			if !isDue {
+				break
+			}
+
+			size := maxBatchSize
+			if len(queue) < size {
+				size = len(queue)
+			}
+			txid, err := ts.payBatch(
+				ctx, account, queue[:size], opts.millisatsPerByte(),
+			)
+			if err != nil {
+				flushErr = err
+				break
+			}
+			txids = append(txids, txid)
+			queue = queue[size:]
+		}
+
+		if len(queue) > 0 {
+			flushAt := opts.flushAt(queue[0])
+			if nextFlushAt == 0 || flushAt < nextFlushAt {
+				nextFlushAt = flushAt
+			}
+		}
+	}
+
+	return txids, nextFlushAt, flushErr
+}
+
+// payBatch broadcasts a tx paying all the given payments of the account, in
+// the same order, with the fees and the eventual change after them.
+// The payments are bound to their outputs of the tx, and persisted as pending,
+// before broadcasting it so that they're never paid twice. If the tx requires
+// a spend approval, they stay pending until the spending is approved or
+// rejected.
+// Unlike transfers, the fee amount is never subtracted from the outputs.
+func (ts *TransactionService) payBatch(
+	ctx context.Context, accountName string, payments []domain.Payment,
+	millisatsPerByte uint64,
+) (string, error) {
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	outs := make([]wallet.Output, 0, len(payments)+2)
+	targetAmounts := make(map[string]uint64)
+	for _, p := range payments {
+		script, _ := hex.DecodeString(p.Script)
+		blindingKey, _ := hex.DecodeString(p.BlindingKey)
+		outs = append(outs, wallet.Output{
+			Asset:       p.Asset,
+			Amount:      p.Amount,
+			Script:      script,
+			BlindingKey: blindingKey,
+		})
+		targetAmounts[p.Asset] += p.Amount
+	}
+
+	utxos, err := ts.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+		ctx, account.Namespace, nil,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	costs := ts.coinSelectionCosts(account, utxos, millisatsPerByte)
+	coinSelector := multiasset_selector.NewMultiAssetCoinSelector(
+		DefaultCoinSelector, costs,
+	)
+	baseFee := feeForSize(wallet.EstimateTxSize(nil, outs), millisatsPerByte)
+	selectedUtxos, changeByAsset, feeAmount, err := selectUtxosForTargets(
+		ctx, coinSelector, utxos, targetAmounts, baseFee,
+	)
+	if err != nil {
+		return "", newError(
+			ReasonInsufficientFunds,
+			"not enough funds to pay %d queued payments: %s", len(payments), err,
+		).withMetadata(ErrorMetadataAccount, account.Namespace)
+	}
+
+	changeOutputs, err := ts.deriveChangeOutputs(ctx, account, changeByAsset)
+	if err != nil {
+		return "", err
+	}
+	outs = append(outs, changeOutputs...)
+	outs = append(outs, wallet.Output{
+		Asset:  ts.network.AssetID,
+		Amount: feeAmount,
+	})
+
+	paymentRepo := ts.repoManager.PaymentRepository()
+	txHex, err := ts.signTransfer(
+		ctx, w, account, selectedUtxos, outs, millisatsPerByte, 0, nil,
+	)
+	if err != nil {
+		// The approval id is the hash of the tx that pays the payments once
+		// approved.
+		if errors.Is(err, ErrSpendApprovalRequired) {
+			_, metadata := ErrorReasonOf(err)
+			approvalId := metadata[ErrorMetadataApprovalId]
+			if err := pendPayments(payments, approvalId); err != nil {
+				return "", err
+			}
+			if err := paymentRepo.UpdatePayments(ctx, payments); err != nil {
+				return "", err
+			}
+			ts.log(
+				"%d queued payments for account %s waiting for approval %s",
+				len(payments), account.Namespace, approvalId,
+			)
+		}
+		return "", err
+	}
+
+	hash, err := txHash(txHex)
+	if err != nil {
+		return "", err
+	}
+	if err := pendPayments(payments, hash); err != nil {
+		return "", err
+	}
+	if err := paymentRepo.UpdatePayments(ctx, payments); err != nil {
+		return "", err
+	}
+
+	txid, err := ts.broadcast(ctx, txHex)
+	if err != nil {
+		// The tx has been rejected, therefore the payments can be paid by
+		// another one.
+		for i := range payments {
+			payments[i].Requeue()
+		}
+		if err := paymentRepo.UpdatePayments(ctx, payments); err != nil {
+			ts.warn(err, "failed to requeue payments of tx %s", hash)
+		}
+		return "", err
+	}
+
+	ts.markPaymentsBroadcasted(ctx, payments, txid)
+
+	ts.log(
+		"paid %d queued payments for account %s with tx %s",
+		len(payments), account.Namespace, txid,
+	)
+	return txid, nil
+}
+
+// markPaymentsBroadcasted marks as broadcasted the given payments, pending
+// until now. Failing to do so is not critical since the payments can't be
+// paid twice anyway, and they're marked as confirmed along with the tx.
+func (ts *TransactionService) markPaymentsBroadcasted(
+	ctx context.Context, payments []domain.Payment, txid string,
+) {
+	for i := range payments {
+		payments[i].Broadcast(txid, payments[i].OutputIndex)
+	}
+	if err := ts.repoManager.PaymentRepository().UpdatePayments(
+		ctx, payments,
+	); err != nil {
+		ts.warn(err, "failed to mark payments of tx %s as broadcasted", txid)
+	}
+}
+
+// pendingPaymentsOfTx returns the payments bound to the given tx not yet
+// broadcasted.
+func (ts *TransactionService) pendingPaymentsOfTx(
+	ctx context.Context, txid string,
+) ([]domain.Payment, error) {
+	payments, err := ts.repoManager.PaymentRepository().GetPaymentsByTxid(
+		ctx, txid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pendingPayments := make([]domain.Payment, 0, len(payments))
+	for _, p := range payments {
+		if p.IsPending() {
+			pendingPayments = append(pendingPayments, p)
+		}
+	}
+	return pendingPayments, nil
+}
+
+// confirmPayments marks as confirmed the payments paid by the given tx, if
+// any.
+func (ts *TransactionService) confirmPayments(txid string) {
+	ctx := context.Background()
+	paymentRepo := ts.repoManager.PaymentRepository()
+	payments, err := paymentRepo.GetPaymentsByTxid(ctx, txid)
+	if err != nil {
+		ts.warn(err, "failed to get payments of tx %s", txid)
+		return
+	}
+
+	confirmedPayments := make([]domain.Payment, 0, len(payments))
+	for _, p := range payments {
+		if err := p.Confirm(); err != nil {
+			continue
+		}
+		confirmedPayments = append(confirmedPayments, p)
+	}
+	if len(confirmedPayments) <= 0 {
+		return
+	}
+
+	if err := paymentRepo.UpdatePayments(ctx, confirmedPayments); err != nil {
+		ts.warn(err, "failed to confirm payments of tx %s", txid)
+		return
+	}
+	ts.log("confirmed %d payments of tx %s", len(confirmedPayments), txid)
+}
+
+// pendPayments binds the given payments, in order, to the outputs of the tx.
+func pendPayments(payments []domain.Payment, txid string) error {
+	for i := range payments {
+		if err := payments[i].Pend(txid, uint32(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/vulpemventures/ocean/internal/core/application")
+
+func startSpan(
+	ctx context.Context, name string, opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, opts...)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// selectUtxos runs the given coin selector within a dedicated span.
+func selectUtxos(
+	ctx context.Context, coinSelector ports.CoinSelector,
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) (selectedUtxos []*domain.Utxo, change uint64, err error) {
+	_, span := startSpan(
+		ctx, "CoinSelector.SelectUtxos", trace.WithAttributes(
+			attribute.String("asset", targetAsset),
+			attribute.Int64("target_amount", int64(targetAmount)),
+			attribute.Int("candidate_utxos", len(utxos)),
+		),
+	)
+	defer func() {
+		span.SetAttributes(attribute.Int("selected_utxos", len(selectedUtxos)))
+		endSpan(span, err)
+	}()
+
+	return coinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
+}
+
+// selectUtxosForTargets runs the given multi-asset coin selector within a
+// dedicated span.
+func selectUtxosForTargets(
+	ctx context.Context, coinSelector ports.MultiAssetCoinSelector,
+	utxos []*domain.Utxo, targetAmounts map[string]uint64, baseFee uint64,
+) (
+	selectedUtxos []*domain.Utxo, changeByAsset map[string]uint64,
+	feeAmount uint64, err error,
+) {
+	_, span := startSpan(
+		ctx, "CoinSelector.SelectUtxosForTargets", trace.WithAttributes(
+			attribute.Int("target_assets", len(targetAmounts)),
+			attribute.Int64("base_fee", int64(baseFee)),
+			attribute.Int("candidate_utxos", len(utxos)),
+		),
+	)
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("selected_utxos", len(selectedUtxos)),
+			attribute.Int("change_outputs", len(changeByAsset)),
+			attribute.Int64("fee_amount", int64(feeAmount)),
+		)
+		endSpan(span, err)
+	}()
+
+	return coinSelector.SelectUtxosForTargets(utxos, targetAmounts, baseFee)
+}
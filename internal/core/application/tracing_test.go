@@ -0,0 +1,75 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	traceddb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/traced"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { _ = provider.Shutdown(ctx) })
+
+	domain.MnemonicStore = newInMemoryMnemonicStore()
+	mockedBcScanner := newMockedBcScanner()
+	mockedBcScanner.On("GetLatestBlock").Return(birthdayBlockHash, birthdayBlockHeight, nil)
+	rm, err := newRepoManagerForAccountService()
+	require.NoError(t, err)
+	repoManager := traceddb.NewRepoManager(rm, "badger")
+
+	svc := application.NewAccountService(repoManager, mockedBcScanner)
+
+	t.Run("valid", func(t *testing.T) {
+		exporter.Reset()
+
+		_, err := svc.CreateAccountBIP44(ctx, accountName, false)
+		require.NoError(t, err)
+
+		exporter.Reset()
+
+		_, err = svc.GetBalanceForAccount(ctx, accountName)
+		require.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		svcSpan := findSpan(spans, "AccountService.GetBalanceForAccount")
+		require.NotNil(t, svcSpan)
+		require.Equal(t, codes.Unset, svcSpan.Status.Code)
+
+		repoSpan := findSpan(spans, "UtxoRepository.GetBalanceForAccount")
+		require.NotNil(t, repoSpan)
+		require.Equal(t, svcSpan.SpanContext.TraceID(), repoSpan.SpanContext.TraceID())
+		require.Equal(t, svcSpan.SpanContext.SpanID(), repoSpan.Parent.SpanID())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		exporter.Reset()
+
+		_, err := svc.DeriveAddressesForAccount(ctx, "unknown", 1)
+		require.Error(t, err)
+
+		svcSpan := findSpan(
+			exporter.GetSpans(), "AccountService.DeriveAddressesForAccount",
+		)
+		require.NotNil(t, svcSpan)
+		require.Equal(t, codes.Error, svcSpan.Status.Code)
+		require.Equal(t, err.Error(), svcSpan.Status.Description)
+	})
+}
+
+func findSpan(spans tracetest.SpanStubs, name string) *tracetest.SpanStub {
+	for i := range spans {
+		if spans[i].Name == name {
+			return &spans[i]
+		}
+	}
+	return nil
+}
@@ -5,9 +5,13 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/txscript"
@@ -20,6 +24,7 @@ import (
 	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
+	multiasset_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/multi-asset"
 	wallet "github.com/vulpemventures/ocean/pkg/wallet"
 	singlesig "github.com/vulpemventures/ocean/pkg/wallet/single-sig"
 )
@@ -30,12 +35,16 @@ var (
 			"wallet's coin selection so that they can be temporary locked and " +
 			"prevent to accidentally double spending them",
 	)
+	ErrSpendApprovalRequired = fmt.Errorf(
+		"spending exceeds the approval threshold of the account policy",
+	)
 )
 
 // TransactionService is responsible for operations related to one or more
 // accounts:
 //   - Get info about a wallet-related transaction.
 //   - Select a subset of the utxos of an existing account to cover a target amount. The selected utxos will be temporary locked to prevent double spending them.
+//   - Lock, or extend the lock of, utxos on behalf of the owner of a lock token, or release them before the lock expires.
 //   - Estimate the fee amount for a transation composed by X inputs and Y outputs. It is required that the inputs owned by the wallet are locked utxos.
 //   - Sign a raw transaction (in hex format). It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Broadcast a raw transaction (in hex format). It is required that the inputs of the tx owned by the wallet are locked utxos.
@@ -44,49 +53,105 @@ var (
 //   - Blind a partial transaction (v2) either as non-last or last blinder. It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Sign a partial transaction (v2). It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Craft a finalized transaction to transfer some funds from an existing account to somewhere else, given a list of outputs.
+//   - List, approve or reject the transactions waiting for an approval because they exceed the threshold of the spending policy of one or more accounts.
+//   - Consolidate the small utxos of an account, on demand or periodically.
+//   - Bump the fees of an unconfirmed transaction by replacing it, or with a child spending its outputs owned by the wallet.
+//   - Queue payments to be batched into a single transaction per account, flushed when the queue is big or old enough, or a payment deadline is reached.
+//   - Track the broadcasted transactions until confirmed, by periodically rebroadcasting those missing from mempool, and eventually dropping them, or by detecting those conflicting with others.
+//   - Hold pre-signed transactions, optionally time-locked, until their locktime or the requested time is reached, and broadcast them.
+//
+// Transfers, broadcasts and the operations building partial transactions
+// accept an optional idempotency key through the request context: retrying
+// one of them with the same key returns the result of the first call, even if
+// still in progress, instead of executing it again.
+//
+// Before signing any transaction, the service makes sure it respects the
+// spending policy of every account owning one or more of its inputs. Those
+// requiring an approval are queued until approved by a caller other than the
+// requester.
 //
 // The service registers 1 handler for the following utxo event:
-//   - domain.UtxoLocked - whenever one or more utxos are locked, the service spawns a so-called unlocker, a goroutine wating for X seconds before unlocking them if necessary. The operation is just skipped if the utxos have been spent meanwhile.
+//   - domain.UtxoLocked - whenever one or more utxos are locked, the service wakes up the so-called unlocker to reschedule the next unlocking.
+//
+// The service registers 1 handler for the following tx event:
+//   - domain.TransactionConfirmed - whenever a tx paying one or more queued payments is confirmed, the service marks them as confirmed. The same goes for the broadcast of the tx, if tracked.
 //
 // The service guarantees that any locked utxo is eventually unlocked ASAP
-// after the waiting time expires.
-// Therefore, at startup, it makes sure to unlock any still-locked utxo that
-// can be unlocked, and to spawn the required numnber of unlockers for those
-// whose waiting time didn't expire yet.
+// after its lock expires, unless spent by a tracked tx not yet confirmed,
+// dropped or conflicted, or by a scheduled tx not yet broadcasted, cancelled
+// or failed.
+// Therefore, once the wallet is unlocked, it starts the unlocker, a single
+// goroutine that unlocks the utxos whose lock expired and sleeps until the
+// next expiration. Since expirations are persisted along with the utxos, no
+// lock outlives its expiration across restarts.
 type TransactionService struct {
 	repoManager        ports.RepoManager
 	bcScanner          ports.BlockchainScanner
 	network            *network.Network
 	utxoExpiryDuration time.Duration
 	dustAmount         uint64
+	spendLock          *sync.Mutex
+	idempotency        *idempotencyManager
+
+	consolidationLock *sync.Mutex
+	consolidationQuit chan struct{}
+
+	paymentBatcherLock *sync.Mutex
+	paymentBatcherQuit chan struct{}
+	paymentFlushLock   *sync.Mutex
+	chPaymentQueued    chan struct{}
+
+	broadcastTrackerLock *sync.Mutex
+	broadcastTrackerQuit chan struct{}
+
+	txSchedulerLock *sync.Mutex
+	txSchedulerQuit chan struct{}
 
-	log func(format string, a ...interface{})
+	unlockerLock  *sync.Mutex
+	unlockerQuit  chan struct{}
+	chUtxosLocked chan struct{}
+
+	log  func(format string, a ...interface{})
+	warn func(err error, format string, a ...interface{})
 }
 
 func NewTransactionService(
 	repoManager ports.RepoManager, bcScanner ports.BlockchainScanner,
 	net *network.Network, utxoExpiryDuration time.Duration, dustAmount uint64,
+	idempotencyKeyTTL time.Duration,
 ) *TransactionService {
 	logFn := func(format string, a ...interface{}) {
 		format = fmt.Sprintf("transaction service: %s", format)
 		log.Debugf(format, a...)
 	}
+	warnFn := func(err error, format string, a ...interface{}) {
+		format = fmt.Sprintf("transaction service: %s", format)
+		log.WithError(err).Warnf(format, a...)
+	}
 
 	svc := &TransactionService{
-		repoManager, bcScanner, net, utxoExpiryDuration, dustAmount, logFn,
+		repoManager, bcScanner, net, utxoExpiryDuration, dustAmount,
+		&sync.Mutex{}, newIdempotencyManager(repoManager, idempotencyKeyTTL, warnFn),
+		&sync.Mutex{}, nil, &sync.Mutex{}, nil, &sync.Mutex{},
+		make(chan struct{}, 1), &sync.Mutex{}, nil, &sync.Mutex{}, nil,
+		&sync.Mutex{}, nil, make(chan struct{}, 1), logFn, warnFn,
 	}
 	svc.registerHandlerForUtxoEvents()
 	svc.registerHandlerForWalletEvents()
+	svc.registerHandlerForTxEvents()
 
 	return svc
 }
 
 func (ts *TransactionService) GetTransactionInfo(
 	ctx context.Context, txid string,
-) (*TransactionInfo, error) {
+) (_ *TransactionInfo, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.GetTransactionInfo")
+	defer func() { endSpan(span, err) }()
+
 	tx, err := ts.repoManager.TransactionRepository().GetTransaction(ctx, txid)
 	if err != nil {
-		res, err := ts.bcScanner.GetTransactions([]string{txid})
+		res, err := ts.bcScanner.GetTransactions(ctx, []string{txid})
 		if err != nil {
 			return nil, err
 		}
@@ -95,79 +160,150 @@ func (ts *TransactionService) GetTransactionInfo(
 	return (*TransactionInfo)(tx), nil
 }
 
+// SelectUtxos selects and locks the utxos of an account to cover the target
+// amount. The lock lasts for the given duration, or the default one if zero,
+// and can be extended or released only with the returned token.
 func (ts *TransactionService) SelectUtxos(
 	ctx context.Context, accountName, targetAsset string, targetAmount uint64,
-	coinSelectionStrategy int,
-) (Utxos, uint64, int64, error) {
+	coinSelectionStrategy int, millisatsPerByte uint64,
+	lockDuration time.Duration,
+) (_ Utxos, _ uint64, _ *UtxosLock, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.SelectUtxos")
+	defer func() { endSpan(span, err) }()
+
 	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
 
 	utxos, err := ts.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
 		ctx, account.Namespace, nil,
 	)
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
 
-	coinSelector := DefaultCoinSelector
-	if factory, ok := coinSelectorByType[coinSelectionStrategy]; ok {
-		coinSelector = factory()
+	coinSelector := ts.coinSelector(
+		coinSelectionStrategy, account, utxos, millisatsPerByte,
+	)
+	utxos, change, err := selectUtxos(ctx, coinSelector, utxos, targetAmount, targetAsset)
+	if err != nil {
+		return nil, 0, nil, err
 	}
 
-	utxos, change, err := coinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
+	owner, err := domain.NewUtxoLockOwner()
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration).Unix()
-	keys := Utxos(utxos).Keys()
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration,
+	lock, err := ts.lockUtxosFor(
+		ctx, account.Namespace, Utxos(utxos).Keys(), owner, lockDuration,
 	)
 	if err != nil {
-		return nil, 0, -1, err
-	}
-	if count > 0 {
-		ts.log(
-			"locked %d utxo(s) for account %s (%s)",
-			count, account.Namespace, UtxoKeys(keys),
-		)
+		return nil, 0, nil, err
 	}
 
-	return utxos, change, lockExpiration, nil
+	return utxos, change, lock, nil
 }
 
+// LockUtxos locks the given utxos of an account for the given duration, or
+// the default one if zero.
+// If a lock token is given, the lock of the utxos already locked with it is
+// extended, otherwise a new token is returned. Utxos already locked by others
+// are skipped, unless a token is given, in which case an error is returned.
 func (ts *TransactionService) LockUtxos(
-	ctx context.Context, accountName string, ins Inputs,
-) (int64, error) {
+	ctx context.Context, accountName string, ins Inputs, lockToken string,
+	lockDuration time.Duration,
+) (_ *UtxosLock, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.LockUtxos")
+	defer func() { endSpan(span, err) }()
+
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := ins.Keys()
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range utxos {
+		if u.IsFrozen() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUtxoFrozen, u.Key())
+		}
+		if len(lockToken) > 0 && !u.CanLock(lockToken) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUtxoLockNotOwned, u.Key())
+		}
+	}
+
+	owner := lockToken
+	if len(owner) <= 0 {
+		if owner, err = domain.NewUtxoLockOwner(); err != nil {
+			return nil, err
+		}
+	}
+	return ts.lockUtxosFor(ctx, account.Namespace, keys, owner, lockDuration)
+}
+
+// UnlockUtxos releases the lock of the given utxos of an account before it
+// expires. Only the owner of the lock token, or an admin, can release it.
+func (ts *TransactionService) UnlockUtxos(
+	ctx context.Context, accountName string, ins Inputs, lockToken string,
+) (_ int, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.UnlockUtxos")
+	defer func() { endSpan(span, err) }()
+
 	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
 		return -1, err
 	}
 
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration).Unix()
+	isAdmin := HasAdminRights(ctx)
+	if len(lockToken) <= 0 && !isAdmin {
+		return -1, newError(ReasonInvalidArgument, "missing lock token")
+	}
+
 	keys := ins.Keys()
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration,
-	)
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return -1, err
+	}
+	for _, u := range utxos {
+		if u.AccountName != account.Namespace {
+			return -1, newError(
+				ReasonNotFound, "utxo %s not found for account %s",
+				u.Key(), accountName,
+			).withMetadata(ErrorMetadataAccount, account.Namespace)
+		}
+		if u.IsLocked() && !u.IsLockedBy(lockToken) && !isAdmin {
+			return -1, fmt.Errorf("%w: %s", domain.ErrUtxoLockNotOwned, u.Key())
+		}
+	}
+
+	// An admin releases the locks regardless of their owner.
+	owner := lockToken
+	if isAdmin {
+		owner = ""
+	}
+	count, err := ts.repoManager.UtxoRepository().ReleaseUtxos(ctx, keys, owner)
 	if err != nil {
 		return -1, err
 	}
 	if count > 0 {
 		ts.log(
-			"locked %d utxo(s) for account %s (%s)",
+			"unlocked %d utxo(s) for account %s (%s)",
 			count, account.Namespace, UtxoKeys(keys),
 		)
 	}
-	return lockExpiration, nil
+	return count, nil
 }
 
 func (ts *TransactionService) EstimateFees(
 	ctx context.Context, ins Inputs, outs Outputs, millisatsPerByte uint64,
-) (uint64, error) {
+) (_ uint64, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.EstimateFees")
+	defer func() { endSpan(span, err) }()
+
 	if _, err := ts.getWallet(ctx); err != nil {
 		return 0, err
 	}
@@ -177,7 +313,7 @@ func (ts *TransactionService) EstimateFees(
 	if err != nil {
 		return 0, err
 	}
-	externalInputs, err := ts.getExternalInputs(walletInputs, ins)
+	externalInputs, err := ts.getExternalInputs(ctx, walletInputs, ins)
 	if err != nil {
 		return 0, err
 	}
@@ -194,7 +330,13 @@ func (ts *TransactionService) EstimateFees(
 
 func (ts *TransactionService) SignTransaction(
 	ctx context.Context, txHex string, sighashType uint32,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.SignTransaction")
+	defer func() { endSpan(span, err) }()
+
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
 	w, err := ts.getWallet(ctx)
 	if err != nil {
 		return "", err
@@ -205,19 +347,44 @@ func (ts *TransactionService) SignTransaction(
 		return "", err
 	}
 
-	return w.SignTransaction(singlesig.SignTransactionArgs{
+	info, err := ts.enforceSpendingPolicies(ctx, txHex, sighashType, 0)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := w.SignTransaction(singlesig.SignTransactionArgs{
 		TxHex:        txHex,
 		InputsToSign: inputs,
 		SigHashType:  txscript.SigHashType(sighashType),
 	})
+	if err != nil {
+		return "", err
+	}
+
+	ts.registerSpends(ctx, info)
+	return signedTx, nil
 }
 
 func (ts *TransactionService) BroadcastTransaction(
 	ctx context.Context, txHex string,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.BroadcastTransaction")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "BroadcastTransaction", []interface{}{txHex},
+		func() (string, error) {
+			return ts.broadcastTransaction(ctx, txHex)
+		},
+	)
+}
+
+func (ts *TransactionService) broadcastTransaction(
+	ctx context.Context, txHex string,
 ) (string, error) {
 	keys, err := utxoKeysFromRawTx(txHex)
 	if err != nil {
-		return "", fmt.Errorf("invalid tx: %s", err)
+		return "", newError(ReasonInvalidTransaction, "invalid tx: %s", err)
 	}
 	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
 	if err != nil {
@@ -226,18 +393,33 @@ func (ts *TransactionService) BroadcastTransaction(
 	if len(utxos) > 0 {
 		for _, u := range utxos {
 			if !u.IsLocked() {
-				return "", fmt.Errorf(
+				return "", newError(
+					ReasonUtxoNotLocked,
 					"cannot broadcast transaction containing unlocked utxos",
 				)
 			}
 		}
 	}
 
-	return ts.bcScanner.BroadcastTransaction(txHex)
+	return ts.broadcast(ctx, txHex)
 }
 
 func (ts *TransactionService) CreatePset(
 	ctx context.Context, inputs Inputs, outputs Outputs,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.CreatePset")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "CreatePset", []interface{}{inputs, outputs},
+		func() (string, error) {
+			return ts.createPset(ctx, inputs, outputs)
+		},
+	)
+}
+
+func (ts *TransactionService) createPset(
+	ctx context.Context, inputs Inputs, outputs Outputs,
 ) (string, error) {
 	if _, err := ts.getWallet(ctx); err != nil {
 		return "", err
@@ -249,7 +431,7 @@ func (ts *TransactionService) CreatePset(
 		return "", err
 	}
 	if len(walletInputs) == 0 {
-		return "", fmt.Errorf("no utxos found with given keys")
+		return "", newError(ReasonNotFound, "no utxos found with given keys")
 	}
 
 	return wallet.CreatePset(wallet.CreatePsetArgs{
@@ -260,6 +442,20 @@ func (ts *TransactionService) CreatePset(
 
 func (ts *TransactionService) UpdatePset(
 	ctx context.Context, ptx string, inputs Inputs, outputs Outputs,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.UpdatePset")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "UpdatePset", []interface{}{ptx, inputs, outputs},
+		func() (string, error) {
+			return ts.updatePset(ctx, ptx, inputs, outputs)
+		},
+	)
+}
+
+func (ts *TransactionService) updatePset(
+	ctx context.Context, ptx string, inputs Inputs, outputs Outputs,
 ) (string, error) {
 	if _, err := ts.getWallet(ctx); err != nil {
 		return "", err
@@ -271,7 +467,7 @@ func (ts *TransactionService) UpdatePset(
 		return "", err
 	}
 	if len(walletInputs) == 0 {
-		return "", fmt.Errorf("no utxos found with given keys")
+		return "", newError(ReasonNotFound, "no utxos found with given keys")
 	}
 
 	return wallet.UpdatePset(wallet.UpdatePsetArgs{
@@ -284,6 +480,21 @@ func (ts *TransactionService) UpdatePset(
 func (ts *TransactionService) BlindPset(
 	ctx context.Context,
 	ptx string, extraUnblindedInputs []UnblindedInput, lastBlinder bool,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.BlindPset")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "BlindPset", []interface{}{ptx, extraUnblindedInputs, lastBlinder},
+		func() (string, error) {
+			return ts.blindPset(ctx, ptx, extraUnblindedInputs, lastBlinder)
+		},
+	)
+}
+
+func (ts *TransactionService) blindPset(
+	ctx context.Context,
+	ptx string, extraUnblindedInputs []UnblindedInput, lastBlinder bool,
 ) (string, error) {
 	if _, err := ts.getWallet(ctx); err != nil {
 		return "", err
@@ -332,7 +543,24 @@ func (ts *TransactionService) BlindPset(
 
 func (ts *TransactionService) SignPset(
 	ctx context.Context, ptx string, sighashType uint32,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.SignPset")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "SignPset", []interface{}{ptx, sighashType},
+		func() (string, error) {
+			return ts.signPset(ctx, ptx, sighashType)
+		},
+	)
+}
+
+func (ts *TransactionService) signPset(
+	ctx context.Context, ptx string, sighashType uint32,
 ) (string, error) {
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
 	w, err := ts.getWallet(ctx)
 	if err != nil {
 		return "", err
@@ -342,28 +570,76 @@ func (ts *TransactionService) SignPset(
 	if err != nil {
 		return "", err
 	}
-	derivationPaths := make(map[string]string)
-	for _, in := range walletInputs {
-		script := hex.EncodeToString(in.Script)
-		derivationPaths[script] = in.DerivationPath
+
+	info, err := ts.enforceSpendingPolicies(ctx, ptx, sighashType, 0)
+	if err != nil {
+		return "", err
 	}
 
-	return w.SignPset(singlesig.SignPsetArgs{
-		PsetBase64:        ptx,
-		DerivationPathMap: derivationPaths,
-		SigHashType:       txscript.SigHashType(sighashType),
-	})
+	signedPtx, err := signPset(w, ptx, walletInputs, sighashType)
+	if err != nil {
+		return "", err
+	}
+
+	ts.registerSpends(ctx, info)
+	return signedPtx, nil
 }
 
+// Transfer sends funds from the given account to the given outputs. If
+// subtractFeeFromOutputs is set, the fees are paid by the lbtc outputs, in
+// equal parts, rather than added to the amounts spent.
+// The optional locktime, either a block height or a unix timestamp, is set as
+// nLockTime of the tx, while the optional sequences are set for the selected
+// inputs, either one per input or a single one for all of them. If only the
+// locktime is set, the inputs are made non-final to enforce it.
 func (ts *TransactionService) Transfer(
 	ctx context.Context, accountName string, outputs Outputs,
-	millisatsPerByte uint64,
+	millisatsPerByte uint64, coinSelectionStrategy int,
+	subtractFeeFromOutputs bool, locktime uint32, sequences []uint32,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.Transfer")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "Transfer",
+		[]interface{}{
+			accountName, outputs, millisatsPerByte, coinSelectionStrategy,
+			subtractFeeFromOutputs, locktime, sequences,
+		},
+		func() (string, error) {
+			return ts.transfer(
+				ctx, accountName, outputs, millisatsPerByte, coinSelectionStrategy,
+				subtractFeeFromOutputs, locktime, sequences,
+			)
+		},
+	)
+}
+
+func (ts *TransactionService) transfer(
+	ctx context.Context, accountName string, outputs Outputs,
+	millisatsPerByte uint64, coinSelectionStrategy int,
+	subtractFeeFromOutputs bool, locktime uint32, sequences []uint32,
 ) (string, error) {
+	// The inputs are selected by the wallet, therefore the caller can't know
+	// how many they are, nor their order.
+	if len(sequences) > 1 {
+		return "", newError(
+			ReasonInvalidArgument,
+			"only one sequence for all the selected inputs can be given, got %d",
+			len(sequences),
+		)
+	}
+
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
 	// Ensure lbtc outs are not dust.
 	for _, out := range outputs {
 		if out.Asset == ts.network.AssetID {
 			if out.Amount < ts.dustAmount {
-				return "", fmt.Errorf("lbtc output amount must not be dust")
+				return "", newError(
+					ReasonInvalidArgument, "lbtc output amount must not be dust",
+				)
 			}
 		}
 	}
@@ -378,20 +654,24 @@ func (ts *TransactionService) Transfer(
 	}
 
 	utxoRepo := ts.repoManager.UtxoRepository()
-	walletRepo := ts.repoManager.WalletRepository()
 
 	balance, err := utxoRepo.GetBalanceForAccount(ctx, account.Namespace)
 	if err != nil {
 		return "", err
 	}
 	if len(balance) <= 0 {
-		return "", fmt.Errorf("account %s has 0 balance", accountName)
+		return "", newError(
+			ReasonInsufficientFunds, "account %s has 0 balance", accountName,
+		).withMetadata(ErrorMetadataAccount, accountName)
 	}
 	for asset, amount := range outputs.totalAmountByAsset() {
 		if (balance[asset].Confirmed + balance[asset].Unconfirmed) < amount {
-			return "", fmt.Errorf(
+			return "", newError(
+				ReasonInsufficientFunds,
 				"not enough funds to cover amount %d of asset %s", amount, asset,
-			)
+			).withMetadata(ErrorMetadataAccount, accountName).
+				withMetadata(ErrorMetadataAsset, asset).
+				withMetadata(ErrorMetadataAmount, strconv.FormatUint(amount, 10))
 		}
 	}
 
@@ -402,277 +682,249 @@ func (ts *TransactionService) Transfer(
 		return "", err
 	}
 	if len(utxos) == 0 {
-		return "", fmt.Errorf("no utxos found for account %s", accountName)
-	}
-
-	changeByAsset := make(map[string]uint64)
-	selectedUtxos := make([]*domain.Utxo, 0)
-	lbtc := ts.network.AssetID
-	dust := uint64(0)
-	for targetAsset, targetAmount := range outputs.totalAmountByAsset() {
-		utxos, change, err := DefaultCoinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
-		if err != nil {
-			return "", err
-		}
-		selectedUtxos = append(selectedUtxos, utxos...)
-		if change > 0 {
-			// If the lbtc change is dust, it is added as fee amount.
-			if targetAsset == lbtc && change < ts.dustAmount {
-				dust = change
-			} else {
-				changeByAsset[targetAsset] = change
-			}
-		}
-	}
-
-	inputs := make([]wallet.Input, 0, len(utxos))
-	inputsByIndex := make(map[uint32]wallet.Input)
-	for i, u := range selectedUtxos {
-		input := wallet.Input{
-			TxID:            u.TxID,
-			TxIndex:         u.VOut,
-			Value:           u.Value,
-			Asset:           u.Asset,
-			Script:          u.Script,
-			ValueBlinder:    u.ValueBlinder,
-			AssetBlinder:    u.AssetBlinder,
-			ValueCommitment: u.ValueCommitment,
-			AssetCommitment: u.AssetCommitment,
-			Nonce:           u.Nonce,
-		}
-		inputs = append(inputs, input)
-		inputsByIndex[uint32(i)] = input
-	}
-
-	changeOutputs := make([]wallet.Output, 0)
-	if len(changeByAsset) > 0 {
-		addressesInfo, err := walletRepo.DeriveNextInternalAddressesForAccount(
-			ctx, account.Namespace, uint64(len(changeByAsset)),
-		)
-		if err != nil {
-			return "", err
-		}
-
-		i := 0
-		for asset, amount := range changeByAsset {
-			script, _ := hex.DecodeString(addressesInfo[i].Script)
-			var blindingKey []byte
-			if !account.Unconf {
-				addr, _ := address.FromConfidential(addressesInfo[i].Address)
-				blindingKey = addr.BlindingKey
-			}
-			changeOutputs = append(changeOutputs, wallet.Output{
-				Asset:       asset,
-				Amount:      amount,
-				Script:      script,
-				BlindingKey: blindingKey,
-			})
-			i++
-		}
+		return "", newError(
+			ReasonInsufficientFunds, "no utxos found for account %s", accountName,
+		).withMetadata(ErrorMetadataAccount, accountName)
 	}
 
 	outs := outputs.toWalletOutputs()
-	feeAmount := wallet.EstimateFees(
-		inputs, append(outs, changeOutputs...), millisatsPerByte,
+	selectedUtxos, changeByAsset, feeAmount, outs, err := ts.selectUtxosForTransfer(
+		ctx, account, utxos, outs, millisatsPerByte, coinSelectionStrategy,
+		subtractFeeFromOutputs,
 	)
-	if dust >= feeAmount {
-		// If the dust amount covers the fee amount we are done as the dust
-		// just pays for the tx fees.
-		feeAmount = dust
-	} else {
-		// If lbtc change covers the fee amount, we subtract the latter from the
-		// former. The remaining lbtc change can be become fees if it end up being
-		// dust.
-		if feeAmount <= changeByAsset[lbtc] {
-			var outIndex int
-			for i, out := range changeOutputs {
-				if out.Asset == lbtc {
-					outIndex = i
-					changeOutputs[i].Amount -= feeAmount
-					break
-				}
-			}
-			changeAmount := changeOutputs[outIndex].Amount
-			if changeAmount < ts.dustAmount {
-				changeOutputs = append(
-					changeOutputs[:outIndex], changeOutputs[outIndex+1:]...,
-				)
-				feeAmount += changeAmount
-			}
-		}
-
-		// If feeAmount is greater than the lbtc change, another coin-selection
-		// round is required, but only in case the user is not trasferring the
-		// whole balance.
-		if feeAmount > changeByAsset[lbtc] {
-			if changeByAsset[lbtc] == 0 {
-				// If there's no dust it means no change was actually produced during
-				// the first coin selection. In this case, the fee amount is subtracted
-				// from the output of the same asset with the biggest amount.
-				if dust == 0 {
-					outIndex := 0
-					outAmount := uint64(0)
-					for i, out := range outputs {
-						if out.Asset == lbtc {
-							if out.Amount >= outAmount {
-								outIndex = i
-								outAmount = out.Amount
-							}
-						}
-					}
-					if outs[outIndex].Amount-feeAmount >= ts.dustAmount {
-						outs[outIndex] = wallet.Output{
-							Asset:        outs[outIndex].Asset,
-							Amount:       outs[outIndex].Amount - feeAmount,
-							Script:       outs[outIndex].Script,
-							BlindingKey:  outs[outIndex].BlindingKey,
-							BlinderIndex: outs[outIndex].BlinderIndex,
-						}
-					} else {
-						// Otherwise the target output becomes fees.
-						feeAmount = outs[outIndex].Amount
-						outs = append(
-							outs[:outIndex], outs[outIndex+1:]...,
-						)
-					}
-				}
-			} else {
-				targetAsset := lbtc
-				targetAmount := changeByAsset[lbtc] - feeAmount
-
-				// Coin-selection must be done over remaining utxos.
-				remainingUtxos := getRemainingUtxos(utxos, selectedUtxos)
-				selectedUtxos, _, err := DefaultCoinSelector.SelectUtxos(
-					remainingUtxos, targetAmount, targetAsset,
-				)
-				if err != nil {
-					return "", err
-				}
-
-				for _, u := range selectedUtxos {
-					input := wallet.Input{
-						TxID:            u.TxID,
-						TxIndex:         u.VOut,
-						Value:           u.Value,
-						Asset:           u.Asset,
-						Script:          u.Script,
-						ValueBlinder:    u.ValueBlinder,
-						AssetBlinder:    u.AssetBlinder,
-						ValueCommitment: u.ValueCommitment,
-						AssetCommitment: u.AssetCommitment,
-						Nonce:           u.Nonce,
-					}
-					inputs = append(inputs, input)
-					inputsByIndex[uint32(len(inputs))] = input
-				}
-
-				// Now that we have all inputs and outputs, estimate the real fee amount.
-				feeAmount := wallet.EstimateFees(
-					inputs, append(outs, changeOutputs...), millisatsPerByte,
-				)
-
-				outAmount := uint64(0)
-				for _, out := range outs {
-					if out.Asset == lbtc {
-						outAmount += out.Amount
-					}
-				}
-				inAmount := uint64(0)
-				for _, in := range inputs {
-					if in.Asset == lbtc {
-						inAmount += in.Value
-					}
-				}
-
-				// The change is calculated as:
-				// total in amount - (total out amount + fee amount)
-				changeAmount := inAmount - outAmount - feeAmount
-				changeIndex := 0
-				for i, out := range changeOutputs {
-					if out.Asset == lbtc {
-						changeIndex = i
-						break
-					}
-				}
-
-				// The change output is updated and eventually removed if became dust,
-				changeOutputs[changeIndex].Amount = changeAmount
-				if changeAmount < ts.dustAmount {
-					feeAmount += changeAmount
-					changeOutputs = append(
-						changeOutputs[:changeIndex], changeOutputs[changeIndex+1:]...,
-					)
-				}
-			}
-		}
+	if err != nil {
+		return "", err
 	}
 
+	changeOutputs, err := ts.deriveChangeOutputs(ctx, account, changeByAsset)
+	if err != nil {
+		return "", err
+	}
 	outs = append(outs, changeOutputs...)
 	outs = append(outs, wallet.Output{
 		Asset:  ts.network.AssetID,
 		Amount: feeAmount,
 	})
 
-	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
-		Inputs:  inputs,
-		Outputs: outs,
-	})
-	if err != nil {
-		return "", err
-	}
+	return ts.signTransfer(
+		ctx, w, account, selectedUtxos, outs, millisatsPerByte, locktime,
+		sequences,
+	)
+}
 
-	blindedPtx, err := wallet.BlindPsetWithOwnedInputs(
-		wallet.BlindPsetWithOwnedInputsArgs{
-			PsetBase64:         ptx,
-			OwnedInputsByIndex: inputsByIndex,
-			LastBlinder:        true,
+func (ts *TransactionService) SweepAccount(
+	ctx context.Context, accountName string, assets []string,
+	script, blindingKey []byte, millisatsPerByte uint64,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.SweepAccount")
+	defer func() { endSpan(span, err) }()
+
+	return ts.idempotency.do(
+		ctx, "SweepAccount",
+		[]interface{}{
+			accountName, assets, script, blindingKey, millisatsPerByte,
+		},
+		func() (string, error) {
+			return ts.sweepAccount(
+				ctx, accountName, assets, script, blindingKey, millisatsPerByte,
+			)
 		},
 	)
-	if err != nil {
-		return "", err
-	}
+}
 
-	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
-		PsetBase64:        blindedPtx,
-		DerivationPathMap: account.DerivationPathByScript,
-	})
+// sweepAccount sends all the spendable utxos of the given assets, or of all
+// assets if none is given, to the given destination, with one output per
+// asset and no change. The fee amount is subtracted from the lbtc output. If
+// lbtc is not swept, the fees are paid with a selection of lbtc utxos that
+// might produce change instead.
+func (ts *TransactionService) sweepAccount(
+	ctx context.Context, accountName string, assets []string,
+	script, blindingKey []byte, millisatsPerByte uint64,
+) (string, error) {
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	w, err := ts.getWallet(ctx)
 	if err != nil {
 		return "", err
 	}
-
-	txHex, _, err := wallet.FinalizeAndExtractTransaction(wallet.FinalizeAndExtractTransactionArgs{
-		PsetBase64: signedPtx,
-	})
+	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
 		return "", err
 	}
 
-	keys := Utxos(selectedUtxos).Keys()
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration)
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration.Unix(),
+	utxos, err := ts.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+		ctx, account.Namespace, nil,
 	)
 	if err != nil {
 		return "", err
 	}
-	if count > 0 {
-		ts.log(
-			"locked %d utxo(s) for account %s (%s) ",
-			count, account.Namespace, UtxoKeys(keys),
-		)
+
+	sweptAssets := make(map[string]bool)
+	for _, asset := range assets {
+		sweptAssets[asset] = true
+	}
+	sweptUtxos := make([]*domain.Utxo, 0, len(utxos))
+	feeUtxos := make([]*domain.Utxo, 0, len(utxos))
+	for _, u := range utxos {
+		if len(sweptAssets) > 0 && !sweptAssets[u.Asset] {
+			feeUtxos = append(feeUtxos, u)
+			continue
+		}
+		sweptUtxos = append(sweptUtxos, u)
+	}
+	if len(sweptUtxos) <= 0 {
+		return "", newError(
+			ReasonInsufficientFunds, "no utxos to sweep for account %s",
+			accountName,
+		).withMetadata(ErrorMetadataAccount, accountName)
 	}
 
-	return txHex, nil
+	return ts.spendUtxos(
+		ctx, w, account, sweptUtxos, feeUtxos, script, blindingKey,
+		millisatsPerByte,
+	)
 }
 
-func (ts *TransactionService) SignPsetWithSchnorrKey(
-	ctx context.Context, tx string, sighashType uint32,
+// spendUtxos sends the whole amount of the given utxos to the given
+// destination, with one output per asset and no change. The fee amount is
+// subtracted from the lbtc output if any, otherwise it's paid with a
+// selection of the given lbtc fee utxos that might produce change instead.
+func (ts *TransactionService) spendUtxos(
+	ctx context.Context, w *singlesig.Wallet, account *domain.Account,
+	utxos, feeUtxos []*domain.Utxo, script, blindingKey []byte,
+	millisatsPerByte uint64,
 ) (string, error) {
-	wallet, err := ts.repoManager.WalletRepository().GetWallet(ctx)
-	if err != nil {
-		return "", err
-	}
+	lbtc := ts.network.AssetID
+	amountByAsset := make(map[string]uint64)
+	for _, u := range utxos {
+		amountByAsset[u.Asset] += u.Value
+	}
+	assets := make([]string, 0, len(amountByAsset))
+	for asset := range amountByAsset {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+
+	outs := make([]wallet.Output, 0, len(assets)+2)
+	lbtcIndex := -1
+	for _, asset := range assets {
+		if asset == lbtc {
+			lbtcIndex = len(outs)
+		}
+		outs = append(outs, wallet.Output{
+			Asset:       asset,
+			Amount:      amountByAsset[asset],
+			Script:      script,
+			BlindingKey: blindingKey,
+		})
+	}
+
+	inputs := make([]wallet.Input, 0, len(utxos))
+	for _, u := range utxos {
+		inputs = append(inputs, wallet.Input{Script: u.Script})
+	}
+	feeAmount := feeForSize(
+		wallet.EstimateTxSize(inputs, outs), millisatsPerByte,
+	)
+
+	selectedUtxos := utxos
+	if lbtcIndex >= 0 {
+		if outs[lbtcIndex].Amount < feeAmount+ts.dustAmount {
+			return "", newError(
+				ReasonInsufficientFunds,
+				"lbtc amount %d can't cover fee amount %d",
+				outs[lbtcIndex].Amount, feeAmount,
+			).withMetadata(ErrorMetadataAccount, account.Namespace).
+				withMetadata(ErrorMetadataAsset, lbtc)
+		}
+		outs[lbtcIndex].Amount -= feeAmount
+	} else {
+		lbtcUtxos := make([]*domain.Utxo, 0, len(feeUtxos))
+		for _, u := range feeUtxos {
+			if u.Asset == lbtc {
+				lbtcUtxos = append(lbtcUtxos, u)
+			}
+		}
+		costs := ts.coinSelectionCosts(account, lbtcUtxos, millisatsPerByte)
+		coinSelector := multiasset_selector.NewMultiAssetCoinSelector(
+			DefaultCoinSelector, costs,
+		)
+		lbtcUtxos, changeByAsset, fee, err := selectUtxosForTargets(
+			ctx, coinSelector, lbtcUtxos, nil, feeAmount,
+		)
+		if err != nil {
+			return "", err
+		}
+		changeOutputs, err := ts.deriveChangeOutputs(ctx, account, changeByAsset)
+		if err != nil {
+			return "", err
+		}
+		selectedUtxos = append(selectedUtxos, lbtcUtxos...)
+		outs = append(outs, changeOutputs...)
+		feeAmount = fee
+	}
+	outs = append(outs, wallet.Output{
+		Asset:  lbtc,
+		Amount: feeAmount,
+	})
+
+	return ts.signTransfer(
+		ctx, w, account, selectedUtxos, outs, millisatsPerByte, 0, nil,
+	)
+}
+
+// SignPsetWithSchnorrKey signs the taproot inputs of the given pset owned by
+// the wallet. Like for SignPset, the wallet inputs must be locked and the tx
+// must respect the spending policies of the accounts owning them.
+func (ts *TransactionService) SignPsetWithSchnorrKey(
+	ctx context.Context, tx string, sighashType uint32,
+) (_ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.SignPsetWithSchnorrKey")
+	defer func() { endSpan(span, err) }()
+
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	keys, err := utxoKeysFromTx(tx)
+	if err != nil {
+		return "", err
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return "", err
+	}
+	for _, u := range utxos {
+		if !u.IsLocked() {
+			return "", newError(
+				ReasonUtxoNotLocked, "cannot sign transaction containing unlocked utxos",
+			)
+		}
+	}
+
+	info, err := ts.enforceSpendingPolicies(ctx, tx, sighashType, 0)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := ts.signPsetWithSchnorrKey(ctx, tx, sighashType)
+	if err != nil {
+		return "", err
+	}
+
+	ts.registerSpends(ctx, info)
+	return signedTx, nil
+}
+
+// signPsetWithSchnorrKey signs the taproot inputs of the given pset whose
+// derivation paths point to accounts of the wallet.
+func (ts *TransactionService) signPsetWithSchnorrKey(
+	ctx context.Context, tx string, sighashType uint32,
+) (string, error) {
+	wallet, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return "", err
+	}
 	mnemonic, err := wallet.GetMnemonic()
 	if err != nil {
 		return "", err
@@ -690,7 +942,7 @@ func (ts *TransactionService) SignPsetWithSchnorrKey(
 		return "", err
 	}
 	if len(ptx.Global.Xpubs) < 1 {
-		return "", fmt.Errorf("missing pset global xpubs")
+		return "", newError(ReasonInvalidPset, "missing pset global xpubs")
 	}
 
 	// For each global xpub, retrieve account info if it belongs to the wallet.
@@ -747,144 +999,217 @@ func (ts *TransactionService) SignPsetWithSchnorrKey(
 	})
 }
 
-func (ts *TransactionService) registerHandlerForWalletEvents() {
-	ts.repoManager.RegisterHandlerForWalletEvent(
-		domain.WalletUnlocked, func(_ domain.WalletEvent) {
-			ts.scheduleUtxoUnlocker()
-		},
-	)
-}
+func (ts *TransactionService) ListSpendApprovals(
+	ctx context.Context,
+) (_ []domain.SpendApproval, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.ListSpendApprovals")
+	defer func() { endSpan(span, err) }()
 
-func (ts *TransactionService) registerHandlerForUtxoEvents() {
-	ts.repoManager.RegisterHandlerForUtxoEvent(
-		domain.UtxoLocked, func(event domain.UtxoEvent) {
-			keys := UtxosInfo(event.Utxos).Keys()
-			ts.spawnUtxoUnlocker(keys)
-		},
-	)
+	return ts.repoManager.SpendingPolicyRepository().GetAllApprovals(ctx)
 }
 
-// scheduleUtxoUnlocker waits 5 seconds before whether unlocking or spawning an
-// unlocker for all the locked utxos of each account.
-// Since this method is called when the service is istantiated, the idea is to
-// to give the account service enough time to receive notification from the
-// blockchain scanner and spend the locked utxos.
-func (ts *TransactionService) scheduleUtxoUnlocker() {
-	time.Sleep(5 * time.Second)
+func (ts *TransactionService) ApproveSpend(
+	ctx context.Context, id string,
+) (_ string, _ string, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.ApproveSpend")
+	defer func() { endSpan(span, err) }()
 
-	ctx := context.Background()
-	utxoRepo := ts.repoManager.UtxoRepository()
-	w, _ := ts.repoManager.WalletRepository().GetWallet(ctx)
+	ts.spendLock.Lock()
+	defer ts.spendLock.Unlock()
+
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	approval, err := policyRepo.GetApproval(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+	if err := canBeApprovedBy(ctx, approval); err != nil {
+		return "", "", err
+	}
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", "", err
+	}
 
-	for accountName := range w.Accounts {
-		utxos, _ := utxoRepo.GetLockedUtxosForAccount(
-			ctx, accountName, nil,
+	// The inputs must not have been spent or locked by someone else while
+	// waiting for approval. Those unlocked meanwhile are locked again to be
+	// sure nobody else can spend them.
+	keys, err := utxoKeysFromTx(approval.Tx)
+	if err != nil {
+		return "", "", err
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return "", "", err
+	}
+	for _, u := range utxos {
+		if u.IsSpent() {
+			return "", "", newError(
+				ReasonInvalidTransaction,
+				"cannot approve spend of already spent utxo %s", u.Key(),
+			)
+		}
+		if u.IsLocked() && u.LockTimestamp > approval.Timestamp {
+			return "", "", newError(
+				ReasonUtxoLockNotOwned,
+				"cannot approve spend of utxo %s locked by someone else", u.Key(),
+			)
+		}
+	}
+	if _, err := ts.repoManager.UtxoRepository().LockUtxos(
+		ctx, keys, time.Now().Unix(),
+		time.Now().Add(ts.utxoExpiryDuration).Unix(), "",
+	); err != nil {
+		return "", "", err
+	}
+
+	inputs, err := ts.findLockedInputs(ctx, approval.Tx)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Spending limits must be verified again since other transactions might
+	// have been signed meanwhile.
+	info, err := getSpendInfo(ctx, ts.repoManager, approval.Tx, 0)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := ts.checkSpendingPolicies(ctx, info); err != nil {
+		return "", "", err
+	}
+
+	var signedTx, txHex string
+	if isTaprootPset(approval.Tx) {
+		signedTx, err = ts.signPsetWithSchnorrKey(
+			ctx, approval.Tx, approval.SighashType,
 		)
-		if len(utxos) > 0 {
-			utxosToUnlock := make([]domain.UtxoKey, 0, len(utxos))
-			utxosToSpawnUnlocker := make([]domain.UtxoKey, 0, len(utxos))
-			for _, u := range utxos {
-				if u.CanUnlock() {
-					utxosToUnlock = append(utxosToUnlock, u.Key())
-				} else {
-					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, u.Key())
-				}
-			}
+		if err != nil {
+			return "", "", err
+		}
+		txHex, _, _ = wallet.FinalizeAndExtractTransaction(
+			wallet.FinalizeAndExtractTransactionArgs{PsetBase64: signedTx},
+		)
+	} else if isRawTx(approval.Tx) {
+		signedTx, err = w.SignTransaction(singlesig.SignTransactionArgs{
+			TxHex:        approval.Tx,
+			InputsToSign: inputs,
+			SigHashType:  txscript.SigHashType(approval.SighashType),
+		})
+		if err != nil {
+			return "", "", err
+		}
+		txHex = signedTx
+	} else {
+		signedTx, err = signPset(w, approval.Tx, inputs, approval.SighashType)
+		if err != nil {
+			return "", "", err
+		}
+		// The pset might still require other signatures, therefore failing to
+		// finalize it is not an error.
+		txHex, _, _ = wallet.FinalizeAndExtractTransaction(
+			wallet.FinalizeAndExtractTransactionArgs{PsetBase64: signedTx},
+		)
+	}
 
-			if len(utxosToUnlock) > 0 {
-				count, err := utxoRepo.UnlockUtxos(ctx, utxosToUnlock)
-				if err != nil {
-					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, utxosToUnlock...)
-				}
-				if count > 0 {
-					ts.log(
-						"unlocked %d utxo(s) for account %s (%s)",
-						count, accountName, UtxoKeys(utxosToUnlock),
-					)
-				}
-			}
-			if len(utxosToSpawnUnlocker) > 0 {
-				ts.spawnUtxoUnlocker(utxosToSpawnUnlocker)
-			}
+	// The tx paying queued payments is broadcasted right away since the
+	// payments are bound to it.
+	payments, err := ts.pendingPaymentsOfTx(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+	if len(payments) > 0 {
+		txid, err := ts.broadcast(ctx, txHex)
+		if err != nil {
+			return "", "", err
 		}
+		ts.markPaymentsBroadcasted(ctx, payments, txid)
+		ts.log("paid %d queued payments with tx %s", len(payments), txid)
 	}
+
+	ts.registerSpends(ctx, info)
+
+	if _, err := policyRepo.DeleteApproval(ctx, id); err != nil {
+		ts.warn(err, "failed to delete approved spend %s", id)
+	}
+	ts.log("spend %s approved", id)
+
+	return signedTx, txHex, nil
 }
 
-// spawnUtxoUnlocker groups the locked utxos identified by the given keys by their
-// locking timestamps, and then creates a goroutine for each group in order to unlock
-// the utxos if they are still locked when their expiration time comes.
-func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
-	ctx := context.Background()
-	utxos, _ := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, utxoKeys)
+func (ts *TransactionService) RejectSpend(
+	ctx context.Context, id string,
+) (err error) {
+	ctx, span := startSpan(ctx, "TransactionService.RejectSpend")
+	defer func() { endSpan(span, err) }()
 
-	utxosByLockTimestamp := make(map[int64][]domain.UtxoKey)
-	for _, u := range utxos {
-		utxosByLockTimestamp[u.LockTimestamp] = append(
-			utxosByLockTimestamp[u.LockTimestamp], u.Key(),
-		)
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	approval, err := policyRepo.GetApproval(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := canBeApprovedBy(ctx, approval); err != nil {
+		return err
 	}
 
-	for timestamp := range utxosByLockTimestamp {
-		keys := utxosByLockTimestamp[timestamp]
-		unlockTime := ts.utxoExpiryDuration - time.Since(time.Unix(timestamp, 0))
-		t := time.NewTicker(unlockTime)
-		quitChan := make(chan struct{})
-		go func(keys []domain.UtxoKey, t *time.Ticker, quitChan chan struct{}) {
-			ts.log("spawning unlocker for utxo(s) %s", UtxoKeys(keys))
-			ts.log(fmt.Sprintf(
-				"utxo(s) will be eventually unlocked in ~%.0f seconds",
-				math.Round(unlockTime.Seconds()/10)*10,
-			))
-
-			for {
-				select {
-				case <-quitChan:
-					return
-				case <-t.C:
-					utxos, _ := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
-					utxosToUnlock := make([]domain.UtxoKey, 0, len(utxos))
-					spentUtxos := make([]domain.UtxoKey, 0, len(utxos))
-					for _, u := range utxos {
-						if u.IsSpent() {
-							spentUtxos = append(spentUtxos, u.Key())
-
-						} else if u.IsLocked() {
-							utxosToUnlock = append(utxosToUnlock, u.Key())
-						}
-					}
+	if _, err := policyRepo.DeleteApproval(ctx, id); err != nil {
+		return err
+	}
+	ts.log("spend %s rejected", id)
 
-					if len(utxosToUnlock) > 0 {
-						// In case of errors here, the ticker is possibly reset to a shortest
-						// duration to keep retring to unlock the locked utxos as soon as
-						// possible.
-						count, err := ts.repoManager.UtxoRepository().UnlockUtxos(
-							ctx, utxosToUnlock,
-						)
-						if err != nil {
-							shortDuration := 5 * time.Second
-							if shortDuration < unlockTime {
-								t.Reset(shortDuration)
-							}
-							continue
-						}
-
-						if count > 0 {
-							ts.log("unlocked %d utxo(s) %s", count, UtxoKeys(keys))
-						}
-						t.Stop()
-					}
-					if len(spentUtxos) > 0 {
-						ts.log(
-							"utxo(s) %s have been spent, skipping unlocking",
-							UtxoKeys(spentUtxos),
-						)
-						t.Stop()
-						quitChan <- struct{}{}
-					}
-				}
-			}
-		}(keys, t, quitChan)
+	// The queued payments bound to the rejected tx go back to the queue.
+	payments, err := ts.pendingPaymentsOfTx(ctx, id)
+	if err != nil {
+		return err
 	}
+	if len(payments) > 0 {
+		for i := range payments {
+			payments[i].Requeue()
+		}
+		if err := ts.repoManager.PaymentRepository().UpdatePayments(
+			ctx, payments,
+		); err != nil {
+			return err
+		}
+		ts.log("requeued %d payments of rejected spend %s", len(payments), id)
+	}
+
+	keys, err := utxoKeysFromTx(approval.Tx)
+	if err != nil {
+		return err
+	}
+	count, err := ts.repoManager.UtxoRepository().UnlockUtxos(ctx, keys)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		ts.log("unlocked %d utxo(s) (%s)", count, UtxoKeys(keys))
+	}
+	return nil
+}
+
+func (ts *TransactionService) registerHandlerForWalletEvents() {
+	ts.repoManager.RegisterHandlerForWalletEvent(
+		domain.WalletUnlocked, func(_ domain.WalletEvent) {
+			ts.startUtxoUnlocker()
+		},
+	)
+}
+
+func (ts *TransactionService) registerHandlerForTxEvents() {
+	ts.repoManager.RegisterHandlerForTxEvent(
+		domain.TransactionConfirmed, func(event domain.TransactionEvent) {
+			ts.confirmPayments(event.Transaction.TxID)
+			ts.confirmBroadcast(event.Transaction.TxID)
+		},
+	)
+}
+
+func (ts *TransactionService) registerHandlerForUtxoEvents() {
+	ts.repoManager.RegisterHandlerForUtxoEvent(
+		domain.UtxoLocked, func(_ domain.UtxoEvent) {
+			ts.rescheduleUtxoUnlocker()
+		},
+	)
 }
 
 func (ts *TransactionService) getWallet(
@@ -933,6 +1258,9 @@ func (ts *TransactionService) getWalletInputs(
 		if wantsLocked && !u.IsLocked() {
 			return nil, ErrForbiddenUnlockedInputs
 		}
+		if u.IsFrozen() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUtxoFrozen, u.Key())
+		}
 
 		account, _ := w.GetAccount(u.AccountName)
 		script := hex.EncodeToString(u.Script)
@@ -969,7 +1297,7 @@ func (ts *TransactionService) findLockedInputs(
 		var err error
 		keys, err = utxoKeysFromPartialTx(tx)
 		if err != nil {
-			return nil, fmt.Errorf("invalid partial transaction: %s", err)
+			return nil, newError(ReasonInvalidPset, "invalid partial transaction: %s", err)
 		}
 	}
 
@@ -978,7 +1306,9 @@ func (ts *TransactionService) findLockedInputs(
 		return nil, err
 	}
 	if len(utxos) == 0 {
-		return nil, fmt.Errorf("no wallet utxos found in given transaction")
+		return nil, newError(
+			ReasonInvalidPset, "no wallet utxos found in given transaction",
+		)
 	}
 
 	w, _ := ts.repoManager.WalletRepository().GetWallet(ctx)
@@ -986,9 +1316,10 @@ func (ts *TransactionService) findLockedInputs(
 	inputs := make(map[uint32]wallet.Input)
 	for _, u := range utxos {
 		if !u.IsLocked() {
-			return nil, fmt.Errorf(
-				"cannot use unlocked utxos. The utxos used within 'external' " +
-					"transactions must be coming from a coin selection so that they " +
+			return nil, newError(
+				ReasonUtxoNotLocked,
+				"cannot use unlocked utxos. The utxos used within 'external' "+
+					"transactions must be coming from a coin selection so that they "+
 					"can be locked to prevent double spending them",
 			)
 
@@ -1014,7 +1345,7 @@ func (ts *TransactionService) findLockedInputs(
 }
 
 func (ts *TransactionService) getExternalInputs(
-	walletIns []wallet.Input, txIns Inputs,
+	ctx context.Context, walletIns []wallet.Input, txIns Inputs,
 ) ([]wallet.Input, error) {
 	isExternalInput := func(in Input) bool {
 		for _, walletIn := range walletIns {
@@ -1035,7 +1366,7 @@ func (ts *TransactionService) getExternalInputs(
 		return nil, nil
 	}
 
-	utxos, err := ts.bcScanner.GetUtxos(externalUtxos)
+	utxos, err := ts.bcScanner.GetUtxos(ctx, externalUtxos)
 	if err != nil {
 		return nil, err
 	}
@@ -1055,6 +1386,286 @@ func (ts *TransactionService) getExternalInputs(
 	return externalInputs, nil
 }
 
+func (ts *TransactionService) lockUtxos(
+	ctx context.Context, accountName string, keys []domain.UtxoKey,
+) error {
+	_, err := ts.lockUtxosFor(ctx, accountName, keys, "", 0)
+	return err
+}
+
+// lockUtxosFor locks the given utxos on behalf of the given owner for the
+// given duration, or the default one if zero.
+func (ts *TransactionService) lockUtxosFor(
+	ctx context.Context, accountName string, keys []domain.UtxoKey,
+	owner string, lockDuration time.Duration,
+) (*UtxosLock, error) {
+	if lockDuration <= 0 {
+		lockDuration = ts.utxoExpiryDuration
+	}
+	now := time.Now()
+	lockExpiration := now.Add(lockDuration).Unix()
+	count, err := ts.repoManager.UtxoRepository().LockUtxos(
+		ctx, keys, now.Unix(), lockExpiration, owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		ts.log(
+			"locked %d utxo(s) for account %s (%s)",
+			count, accountName, UtxoKeys(keys),
+		)
+	}
+	return &UtxosLock{Token: owner, ExpirationDate: lockExpiration}, nil
+}
+
+// enforceSpendingPolicies makes sure the given transaction respects the
+// spending policies of the accounts owning its inputs.
+// If the fee rate is not given, it's estimated from the fee output of the tx.
+// In case one or more policies require an approval, the tx is queued and
+// ErrSpendApprovalRequired is returned along with the approval id.
+func (ts *TransactionService) enforceSpendingPolicies(
+	ctx context.Context, tx string, sighashType uint32,
+	millisatsPerByte uint64,
+) (*spendInfo, error) {
+	info, err := getSpendInfo(ctx, ts.repoManager, tx, millisatsPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	requiresApproval, err := ts.checkSpendingPolicies(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	if !requiresApproval {
+		return info, nil
+	}
+
+	id, err := txHash(tx)
+	if err != nil {
+		return nil, err
+	}
+	approval := &domain.SpendApproval{
+		ID:          id,
+		Tx:          tx,
+		SighashType: sighashType,
+		RequestedBy: CallerFromContext(ctx),
+		Timestamp:   time.Now().Unix(),
+	}
+	if _, err := ts.repoManager.SpendingPolicyRepository().AddApproval(
+		ctx, approval,
+	); err != nil {
+		return nil, err
+	}
+	ts.log("spend %s queued for approval", id)
+
+	return nil, errSpendApprovalRequired(id)
+}
+
+func errSpendApprovalRequired(approvalId string) error {
+	return newError(
+		ReasonSpendApprovalRequired,
+		"%w, approval id: %s", ErrSpendApprovalRequired, approvalId,
+	).withMetadata(ErrorMetadataApprovalId, approvalId)
+}
+
+// canBeApprovedBy returns whether the caller of the given request is allowed
+// to approve or reject the given spending. Only callers with a verified
+// identity are allowed, those identified by their address can't be told
+// apart from the requester of the spending.
+func canBeApprovedBy(ctx context.Context, approval *domain.SpendApproval) error {
+	caller := AuthenticatedCallerFromContext(ctx)
+	if caller == "" {
+		return ErrCallerNotAuthenticated
+	}
+	return approval.CanBeApprovedBy(caller)
+}
+
+// pendingApprovalInputs returns the inputs of the txs waiting for approval,
+// which must stay locked until the spending is either approved or rejected.
+func (ts *TransactionService) pendingApprovalInputs(
+	ctx context.Context,
+) (map[domain.UtxoKey]struct{}, error) {
+	approvals, err := ts.repoManager.SpendingPolicyRepository().
+		GetAllApprovals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[domain.UtxoKey]struct{})
+	for _, approval := range approvals {
+		keys, err := utxoKeysFromTx(approval.Tx)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			inputs[key] = struct{}{}
+		}
+	}
+	return inputs, nil
+}
+
+// checkSpendingPolicies returns whether any of the policies of the accounts
+// spending funds requires an approval, or an error if any is violated.
+func (ts *TransactionService) checkSpendingPolicies(
+	ctx context.Context, info *spendInfo,
+) (bool, error) {
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	now := time.Now()
+
+	requiresApproval := false
+	for accountName, amounts := range info.amountsByAccount {
+		policy, err := policyRepo.GetPolicy(ctx, accountName)
+		if err != nil {
+			if errors.Is(err, domain.ErrPolicyNotFound) {
+				continue
+			}
+			return false, err
+		}
+
+		needsApproval, err := policy.Check(
+			amounts, info.destinations, info.millisatsPerByte, now,
+		)
+		if err != nil {
+			return false, fmt.Errorf("account %s: %w", accountName, err)
+		}
+		requiresApproval = requiresApproval || needsApproval
+	}
+	return requiresApproval, nil
+}
+
+// registerSpends records the amounts spent by each account with a policy.
+// Failing to do so is not considered critical since the tx is already signed.
+func (ts *TransactionService) registerSpends(
+	ctx context.Context, info *spendInfo,
+) {
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	now := time.Now()
+
+	for accountName, amounts := range info.amountsByAccount {
+		if err := policyRepo.UpdatePolicy(
+			ctx, accountName,
+			func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error) {
+				p.AddSpends(amounts, now)
+				return p, nil
+			},
+		); err != nil && !errors.Is(err, domain.ErrPolicyNotFound) {
+			ts.warn(
+				err, "failed to register spends for account %s", accountName,
+			)
+		}
+	}
+}
+
+func signPset(
+	w *singlesig.Wallet, ptx string, inputs map[uint32]wallet.Input,
+	sighashType uint32,
+) (string, error) {
+	derivationPaths := make(map[string]string)
+	for _, in := range inputs {
+		script := hex.EncodeToString(in.Script)
+		derivationPaths[script] = in.DerivationPath
+	}
+
+	return w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        ptx,
+		DerivationPathMap: derivationPaths,
+		SigHashType:       txscript.SigHashType(sighashType),
+	})
+}
+
+func isRawTx(tx string) bool {
+	rawTx, _ := transaction.NewTxFromHex(tx)
+	return rawTx != nil
+}
+
+func utxoKeysFromTx(tx string) ([]domain.UtxoKey, error) {
+	if isRawTx(tx) {
+		return utxoKeysFromRawTx(tx)
+	}
+
+	keys, err := utxoKeysFromPartialTx(tx)
+	if err != nil {
+		return nil, newError(ReasonInvalidPset, "invalid partial transaction: %s", err)
+	}
+	return keys, nil
+}
+
+// isTaprootPset returns whether the given tx is a pset with taproot inputs,
+// to be signed with schnorr keys.
+func isTaprootPset(tx string) bool {
+	ptx, err := psetv2.NewPsetFromBase64(tx)
+	if err != nil {
+		return false
+	}
+	for _, in := range ptx.Inputs {
+		if len(in.TapBip32Derivation) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// txHash returns the hash of the given raw or partial tx, not including
+// witnesses so that it doesn't change once signed.
+func txHash(tx string) (string, error) {
+	if isRawTx(tx) {
+		rawTx, _ := transaction.NewTxFromHex(tx)
+		return rawTx.TxHash().String(), nil
+	}
+
+	ptx, err := psetv2.NewPsetFromBase64(tx)
+	if err != nil {
+		return "", newError(ReasonInvalidPset, "invalid partial transaction: %s", err)
+	}
+	rawTx, err := ptx.UnsignedTx()
+	if err != nil {
+		return "", err
+	}
+	return rawTx.TxHash().String(), nil
+}
+
+func outputsFromTx(tx string) ([]txOutput, error) {
+	if isRawTx(tx) {
+		rawTx, _ := transaction.NewTxFromHex(tx)
+		outputs := make([]txOutput, 0, len(rawTx.Outputs))
+		for _, out := range rawTx.Outputs {
+			if out.IsConfidential() {
+				outputs = append(outputs, txOutput{
+					script:         out.Script,
+					isConfidential: true,
+					isUnknown:      true,
+				})
+				continue
+			}
+			value, _ := elementsutil.ValueFromBytes(out.Value)
+			outputs = append(outputs, txOutput{
+				script: out.Script,
+				asset:  elementsutil.AssetHashFromBytes(out.Asset),
+				amount: value,
+			})
+		}
+		return outputs, nil
+	}
+
+	ptx, err := psetv2.NewPsetFromBase64(tx)
+	if err != nil {
+		return nil, newError(ReasonInvalidPset, "invalid partial transaction: %s", err)
+	}
+	outputs := make([]txOutput, 0, len(ptx.Outputs))
+	for _, out := range ptx.Outputs {
+		outputs = append(outputs, txOutput{
+			script: out.Script,
+			asset: elementsutil.AssetHashFromBytes(
+				append([]byte{0x01}, out.Asset...),
+			),
+			amount:         out.Value,
+			isConfidential: len(out.BlindingPubkey) > 0,
+		})
+	}
+	return outputs, nil
+}
+
 func utxoKeysFromRawTx(txHex string) ([]domain.UtxoKey, error) {
 	tx, err := transaction.NewTxFromHex(txHex)
 	if err != nil {
@@ -1155,3 +1766,428 @@ func getRemainingUtxos(utxos, selectedUtxos []*domain.Utxo) []*domain.Utxo {
 	}
 	return remainingUtxos
 }
+
+// selectUtxosForTransfer selects the utxos of the account covering the
+// amounts of the given outputs and the network fees at once, and returns them
+// along with the change and fee amounts.
+// If the utxos cover the amounts but not also the fees, like when
+// transferring the whole balance, the fee amount is subtracted from the
+// biggest lbtc output, which is paid entirely as fees if it would become dust
+// otherwise. The eventually updated outputs are returned as well.
+// If subtractFeeFromOutputs is set, the utxos cover only the amounts of the
+// outputs and the fee amount is always subtracted from the lbtc ones.
+func (ts *TransactionService) selectUtxosForTransfer(
+	ctx context.Context, account *domain.Account, utxos []*domain.Utxo,
+	outs []wallet.Output, millisatsPerByte uint64, coinSelectionStrategy int,
+	subtractFeeFromOutputs bool,
+) ([]*domain.Utxo, map[string]uint64, uint64, []wallet.Output, error) {
+	lbtc := ts.network.AssetID
+	targetAmounts := make(map[string]uint64)
+	for _, out := range outs {
+		targetAmounts[out.Asset] += out.Amount
+	}
+	costs := ts.coinSelectionCosts(account, utxos, millisatsPerByte)
+	baseFee := feeForSize(wallet.EstimateTxSize(nil, outs), millisatsPerByte)
+
+	if subtractFeeFromOutputs {
+		return ts.selectUtxosSubtractingFee(
+			ctx, account, utxos, outs, targetAmounts, costs, baseFee,
+			coinSelectionStrategy,
+		)
+	}
+
+	coinSelector := multiasset_selector.NewMultiAssetCoinSelector(
+		ts.coinSelector(coinSelectionStrategy, account, utxos, millisatsPerByte),
+		costs,
+	)
+	selectedUtxos, changeByAsset, feeAmount, err := selectUtxosForTargets(
+		ctx, coinSelector, utxos, targetAmounts, baseFee,
+	)
+	if err == nil || !errors.Is(err, ports.ErrTargetAmountNotReached) {
+		return selectedUtxos, changeByAsset, feeAmount, outs, err
+	}
+
+	outIndex := -1
+	for i, out := range outs {
+		if out.Asset == lbtc {
+			if outIndex < 0 || out.Amount >= outs[outIndex].Amount {
+				outIndex = i
+			}
+		}
+	}
+	if outIndex < 0 {
+		return nil, nil, 0, nil, err
+	}
+
+	// Select the utxos without fees, any lbtc change goes to fees.
+	coinSelector = multiasset_selector.NewMultiAssetCoinSelector(
+		ts.coinSelector(coinSelectionStrategy, account, utxos, 0),
+		ports.CoinSelectionCosts{FeeAsset: lbtc, DustAmount: ts.dustAmount},
+	)
+	selectedUtxos, changeByAsset, _, err = selectUtxosForTargets(
+		ctx, coinSelector, utxos, targetAmounts, 0,
+	)
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+	lbtcChange := changeByAsset[lbtc]
+	delete(changeByAsset, lbtc)
+
+	feeAmount = baseFee + uint64(len(selectedUtxos))*costs.InputFee +
+		uint64(len(changeByAsset))*costs.ChangeOutputFee
+	if lbtcChange >= feeAmount {
+		return selectedUtxos, changeByAsset, lbtcChange, outs, nil
+	}
+
+	missingFee := feeAmount - lbtcChange
+	if outs[outIndex].Amount < missingFee {
+		return nil, nil, 0, nil, ports.ErrTargetAmountNotReached
+	}
+	if outs[outIndex].Amount-missingFee < ts.dustAmount {
+		feeAmount = lbtcChange + outs[outIndex].Amount
+		outs = append(outs[:outIndex], outs[outIndex+1:]...)
+		return selectedUtxos, changeByAsset, feeAmount, outs, nil
+	}
+	outs[outIndex].Amount -= missingFee
+	return selectedUtxos, changeByAsset, feeAmount, outs, nil
+}
+
+// selectUtxosSubtractingFee selects the utxos covering only the amounts of
+// the given outputs and splits the fee amount equally among the lbtc ones.
+// The lbtc change is kept only if not dust, otherwise it's paid as fees.
+func (ts *TransactionService) selectUtxosSubtractingFee(
+	ctx context.Context, account *domain.Account, utxos []*domain.Utxo,
+	outs []wallet.Output, targetAmounts map[string]uint64,
+	costs ports.CoinSelectionCosts, baseFee uint64, coinSelectionStrategy int,
+) ([]*domain.Utxo, map[string]uint64, uint64, []wallet.Output, error) {
+	lbtc := ts.network.AssetID
+	lbtcOutIndexes := make([]int, 0, len(outs))
+	for i, out := range outs {
+		if out.Asset == lbtc {
+			lbtcOutIndexes = append(lbtcOutIndexes, i)
+		}
+	}
+	if len(lbtcOutIndexes) <= 0 {
+		return nil, nil, 0, nil, newError(
+			ReasonInvalidArgument,
+			"at least one lbtc output is required to subtract fees from",
+		)
+	}
+
+	coinSelector := multiasset_selector.NewMultiAssetCoinSelector(
+		ts.coinSelector(coinSelectionStrategy, account, utxos, 0),
+		ports.CoinSelectionCosts{FeeAsset: lbtc, DustAmount: ts.dustAmount},
+	)
+	selectedUtxos, changeByAsset, excess, err := selectUtxosForTargets(
+		ctx, coinSelector, utxos, targetAmounts, 0,
+	)
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+
+	feeAmount := baseFee + uint64(len(selectedUtxos))*costs.InputFee +
+		uint64(len(changeByAsset))*costs.ChangeOutputFee
+	if excess >= feeAmount {
+		return selectedUtxos, changeByAsset, excess, outs, nil
+	}
+
+	missingFee := feeAmount - excess
+	feeShare := missingFee / uint64(len(lbtcOutIndexes))
+	remainder := missingFee % uint64(len(lbtcOutIndexes))
+	for n, i := range lbtcOutIndexes {
+		share := feeShare
+		if n == 0 {
+			share += remainder
+		}
+		if outs[i].Amount < share+ts.dustAmount {
+			return nil, nil, 0, nil, newError(
+				ReasonInsufficientFunds,
+				"lbtc output amount %d can't cover fee share %d",
+				outs[i].Amount, share,
+			).withMetadata(ErrorMetadataAsset, lbtc)
+		}
+		outs[i].Amount -= share
+	}
+	return selectedUtxos, changeByAsset, feeAmount, outs, nil
+}
+
+// coinSelector returns the coin selector for the given strategy, or the
+// default one if not supported. Fee-aware selectors take into account the
+// costs of spending the given utxos of the account at the given fee rate.
+func (ts *TransactionService) coinSelector(
+	coinSelectionStrategy int, account *domain.Account, utxos []*domain.Utxo,
+	millisatsPerByte uint64,
+) ports.CoinSelector {
+	factory, ok := coinSelectorByType[coinSelectionStrategy]
+	if !ok {
+		return DefaultCoinSelector
+	}
+	return factory(ts.coinSelectionCosts(account, utxos, millisatsPerByte))
+}
+
+// coinSelectionCosts returns the costs of spending the given utxos of the
+// account at the given fee rate. The script of any utxo is good to estimate
+// the size of inputs and change outputs since all those of an account are of
+// the same type.
+func (ts *TransactionService) coinSelectionCosts(
+	account *domain.Account, utxos []*domain.Utxo, millisatsPerByte uint64,
+) ports.CoinSelectionCosts {
+	costs := ports.CoinSelectionCosts{
+		FeeAsset:   ts.network.AssetID,
+		DustAmount: ts.dustAmount,
+	}
+	if len(utxos) <= 0 {
+		return costs
+	}
+
+	var blindingKey []byte
+	if !account.Unconf {
+		blindingKey = make([]byte, 33)
+	}
+	ins := []wallet.Input{{Script: utxos[0].Script}}
+	outs := []wallet.Output{{Script: utxos[0].Script, BlindingKey: blindingKey}}
+	emptyTxSize := wallet.EstimateTxSize(nil, nil)
+	inputSize := wallet.EstimateTxSize(ins, nil) - emptyTxSize
+	outputSize := wallet.EstimateTxSize(nil, outs) - emptyTxSize
+
+	costs.InputFee = feeForSize(inputSize, millisatsPerByte)
+	costs.LongTermInputFee = feeForSize(inputSize, LongTermMillisatsPerByte)
+	costs.ChangeOutputFee = feeForSize(outputSize, millisatsPerByte)
+	costs.ChangeCost = costs.ChangeOutputFee + costs.LongTermInputFee
+	costs.DustAmount = ts.dustAmount
+	return costs
+}
+
+// deriveChangeOutputs returns an output for every given change amount,
+// each sending funds to a new internal address of the account.
+func (ts *TransactionService) deriveChangeOutputs(
+	ctx context.Context, account *domain.Account,
+	changeByAsset map[string]uint64,
+) ([]wallet.Output, error) {
+	changeOutputs := make([]wallet.Output, 0, len(changeByAsset))
+	if len(changeByAsset) <= 0 {
+		return changeOutputs, nil
+	}
+
+	addressesInfo, err := ts.repoManager.WalletRepository().
+		DeriveNextInternalAddressesForAccount(
+			ctx, account.Namespace, uint64(len(changeByAsset)),
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	i := 0
+	for asset, amount := range changeByAsset {
+		script, _ := hex.DecodeString(addressesInfo[i].Script)
+		var blindingKey []byte
+		if !account.Unconf {
+			addr, _ := address.FromConfidential(addressesInfo[i].Address)
+			blindingKey = addr.BlindingKey
+		}
+		changeOutputs = append(changeOutputs, wallet.Output{
+			Asset:       asset,
+			Amount:      amount,
+			Script:      script,
+			BlindingKey: blindingKey,
+		})
+		i++
+	}
+	return changeOutputs, nil
+}
+
+// signTransfer creates, blinds and signs a transaction spending the given
+// utxos of the account to the given outputs, fee one included, after making
+// sure it respects the spending policies. The utxos are locked before
+// checking the policies so that they are held also while the tx is waiting
+// for approval, and released in case of any other failure.
+func (ts *TransactionService) signTransfer(
+	ctx context.Context, w *singlesig.Wallet, account *domain.Account,
+	selectedUtxos []*domain.Utxo, outs []wallet.Output, millisatsPerByte uint64,
+	locktime uint32, sequences []uint32,
+) (_ string, err error) {
+	inputs := make([]wallet.Input, 0, len(selectedUtxos))
+	inputsByIndex := make(map[uint32]wallet.Input)
+	for i, u := range selectedUtxos {
+		input := wallet.Input{
+			TxID:            u.TxID,
+			TxIndex:         u.VOut,
+			Value:           u.Value,
+			Asset:           u.Asset,
+			Script:          u.Script,
+			ValueBlinder:    u.ValueBlinder,
+			AssetBlinder:    u.AssetBlinder,
+			ValueCommitment: u.ValueCommitment,
+			AssetCommitment: u.AssetCommitment,
+			Nonce:           u.Nonce,
+		}
+		inputs = append(inputs, input)
+		inputsByIndex[uint32(i)] = input
+	}
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:    inputs,
+		Outputs:   outs,
+		Locktime:  locktime,
+		Sequences: sequences,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	blindedPtx, err := wallet.BlindPsetWithOwnedInputs(
+		wallet.BlindPsetWithOwnedInputsArgs{
+			PsetBase64:         ptx,
+			OwnedInputsByIndex: inputsByIndex,
+			LastBlinder:        true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	keys := Utxos(selectedUtxos).Keys()
+	if err := ts.lockUtxos(ctx, account.Namespace, keys); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err == nil || errors.Is(err, ErrSpendApprovalRequired) {
+			return
+		}
+		if _, err := ts.repoManager.UtxoRepository().ReleaseUtxos(
+			ctx, keys, "",
+		); err != nil {
+			ts.warn(err, "failed to release utxos (%s)", UtxoKeys(keys))
+		}
+	}()
+
+	info, err := ts.enforceSpendingPolicies(
+		ctx, blindedPtx, 0, millisatsPerByte,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        blindedPtx,
+		DerivationPathMap: account.DerivationPathByScript,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(wallet.FinalizeAndExtractTransactionArgs{
+		PsetBase64: signedPtx,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ts.registerSpends(ctx, info)
+	return txHex, nil
+}
+
+// feeForSize returns the fee amount for the given virtual size at the given
+// fee rate, rounded up so that the fees of the parts of a tx cover the fee of
+// the whole.
+func feeForSize(size, millisatsPerByte uint64) uint64 {
+	return (size*millisatsPerByte + 999) / 1000
+}
+
+// getSpendInfo returns the amounts spent by every wallet account within the
+// given tx, excluding the change sent back to the same account, and the list
+// of destination scripts not owned by them.
+func getSpendInfo(
+	ctx context.Context, repoManager ports.RepoManager, tx string,
+	millisatsPerByte uint64,
+) (*spendInfo, error) {
+	keys, err := utxoKeysFromTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := outputsFromTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	w, err := repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amountsByAccount := make(map[string]map[string]uint64)
+	ownerByScript := make(map[string]string)
+	for _, u := range utxos {
+		if _, ok := amountsByAccount[u.AccountName]; !ok {
+			amountsByAccount[u.AccountName] = make(map[string]uint64)
+			if account, _ := w.GetAccount(u.AccountName); account != nil {
+				for script := range account.DerivationPathByScript {
+					ownerByScript[script] = u.AccountName
+				}
+			}
+		}
+		amountsByAccount[u.AccountName][u.Asset] += u.Value
+	}
+
+	inputs := make([]wallet.Input, 0, len(keys))
+	for range keys {
+		inputs = append(inputs, wallet.Input{})
+	}
+	// Wallet inputs are replaced with the utxos in order to estimate the size
+	// of the tx based on the input script types.
+	for i, u := range utxos {
+		inputs[i] = wallet.Input{Script: u.Script}
+	}
+
+	destinations := make([]string, 0)
+	outs := make([]wallet.Output, 0, len(outputs))
+	feeAmount := uint64(0)
+	for _, out := range outputs {
+		if len(out.script) <= 0 {
+			feeAmount += out.amount
+			continue
+		}
+
+		var blindingKey []byte
+		if out.isConfidential {
+			blindingKey = make([]byte, 33)
+		}
+		outs = append(outs, wallet.Output{
+			Script:      out.script,
+			BlindingKey: blindingKey,
+		})
+
+		script := hex.EncodeToString(out.script)
+		accountName, ok := ownerByScript[script]
+		if !ok {
+			destinations = append(destinations, script)
+			continue
+		}
+		// The amount of a change output is subtracted from those spent by the
+		// owner account. For confidential outputs of raw transactions this is not
+		// possible, therefore the whole inputs amount is counted as spent.
+		if !out.isUnknown {
+			spent := amountsByAccount[accountName][out.asset]
+			if out.amount > spent {
+				spent = out.amount
+			}
+			amountsByAccount[accountName][out.asset] = spent - out.amount
+		}
+	}
+
+	if millisatsPerByte == 0 && feeAmount > 0 {
+		txSize := wallet.EstimateTxSize(inputs, outs)
+		millisatsPerByte = feeAmount * 1000 / txSize
+	}
+
+	return &spendInfo{
+		tx:               tx,
+		amountsByAccount: amountsByAccount,
+		destinations:     destinations,
+		millisatsPerByte: millisatsPerByte,
+	}, nil
+}
@@ -1,13 +1,19 @@
 package application_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/application"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
@@ -29,12 +35,420 @@ var (
 	}
 	utxoExpiryDuration = 2 * time.Minute
 	dustAmount         = uint64(450)
+	idempotencyKeyTTL  = time.Hour
 )
 
 func TestTransactionService(t *testing.T) {
 	testInternalTransaction(t)
 
 	testExternalTransaction(t)
+
+	testUtxosLock(t)
+
+	testTransactionWithSpendingPolicy(t)
+
+	testTransactionWithIdempotencyKey(t)
+
+	testUtxoConsolidation(t)
+
+	testPaymentQueue(t)
+
+	testFeeBumping(t)
+
+	testBroadcastTracker(t)
+
+	testTxScheduler(t)
+}
+
+func testPaymentQueue(t *testing.T) {
+	t.Run("enqueue_and_flush_payments", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		deadline := time.Now().Add(time.Minute).Unix()
+		paymentIds := make([]string, 0, 3)
+		for i, deadline := range []int64{0, 0, deadline} {
+			output := outputs[0]
+			output.Amount = uint64(i+1) * 10000
+			id, err := svc.EnqueuePayment(ctx, accountName, output, deadline)
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+			paymentIds = append(paymentIds, id)
+		}
+
+		payment, err := svc.GetPayment(ctx, paymentIds[0])
+		require.NoError(t, err)
+		require.True(t, payment.IsQueued())
+
+		dustOutput := outputs[0]
+		dustOutput.Amount = dustAmount - 1
+		_, err = svc.EnqueuePayment(ctx, accountName, dustOutput, 0)
+		require.Error(t, err)
+		_, err = svc.EnqueuePayment(
+			ctx, accountName, outputs[0], time.Now().Add(-time.Hour).Unix(),
+		)
+		require.Error(t, err)
+		_, err = svc.EnqueuePayment(ctx, "unknown", outputs[0], 0)
+		require.Error(t, err)
+
+		txids, err := svc.FlushPayments(
+			ctx, accountName, application.PaymentBatcherOptions{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{txid}, txids)
+
+		// The payment with the closest deadline is paid by the first output.
+		outIndexes := make(map[uint32]bool)
+		for _, id := range paymentIds {
+			payment, err := svc.GetPayment(ctx, id)
+			require.NoError(t, err)
+			require.False(t, payment.IsQueued())
+			require.Equal(t, txid, payment.Txid)
+			outIndexes[payment.OutputIndex] = true
+		}
+		require.Len(t, outIndexes, 3)
+		payment, err = svc.GetPayment(ctx, paymentIds[2])
+		require.NoError(t, err)
+		require.Zero(t, payment.OutputIndex)
+
+		// No payment is left in the queue.
+		txids, err = svc.FlushPayments(
+			ctx, accountName, application.PaymentBatcherOptions{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, txids)
+
+		// Payments are confirmed along with the tx paying them.
+		tx := randomTx(txid, accountName)
+		tx.BlockHash = ""
+		tx.BlockHeight = 0
+		_, err = repoManager.TransactionRepository().AddTransaction(ctx, tx)
+		require.NoError(t, err)
+		_, err = repoManager.TransactionRepository().ConfirmTransaction(
+			ctx, txid, randomHex(32), 100, time.Now().Unix(),
+		)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			for _, id := range paymentIds {
+				payment, err := svc.GetPayment(ctx, id)
+				if err != nil || !payment.IsConfirmed() {
+					return false
+				}
+			}
+			return true
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("payment_batcher", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		opts := application.PaymentBatcherOptions{
+			MaxBatchSize: 2,
+			MaxWaitTime:  time.Hour,
+		}
+		err = svc.StartPaymentBatcher(opts)
+		require.NoError(t, err)
+		defer svc.StopPaymentBatcher()
+
+		err = svc.StartPaymentBatcher(opts)
+		require.Error(t, err)
+
+		isBroadcasted := func(id string) func() bool {
+			return func() bool {
+				payment, err := svc.GetPayment(ctx, id)
+				return err == nil && !payment.IsQueued()
+			}
+		}
+
+		// The first payment waits for the batch to be full.
+		firstId, err := svc.EnqueuePayment(ctx, accountName, outputs[0], 0)
+		require.NoError(t, err)
+		time.Sleep(500 * time.Millisecond)
+		payment, err := svc.GetPayment(ctx, firstId)
+		require.NoError(t, err)
+		require.True(t, payment.IsQueued())
+
+		secondId, err := svc.EnqueuePayment(ctx, accountName, outputs[0], 0)
+		require.NoError(t, err)
+		require.Eventually(t, isBroadcasted(firstId), 5*time.Second, 100*time.Millisecond)
+		require.Eventually(t, isBroadcasted(secondId), 5*time.Second, 100*time.Millisecond)
+
+		// A payment is flushed alone once its deadline is reached.
+		deadline := time.Now().Add(time.Second).Unix() + 1
+		id, err := svc.EnqueuePayment(ctx, accountName, outputs[0], deadline)
+		require.NoError(t, err)
+		require.Eventually(t, isBroadcasted(id), 10*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("requeue_payments_on_broadcast_failure", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(
+			"", fmt.Errorf("tx rejected"),
+		)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		output := application.Output{
+			Asset:       regtest.AssetID,
+			Amount:      10000,
+			Script:      receiverAddrInfo.Script,
+			BlindingKey: receiverAddrInfo.BlindingKey,
+		}
+		id, err := svc.EnqueuePayment(ctx, accountName, output, 0)
+		require.NoError(t, err)
+
+		txids, err := svc.FlushPayments(
+			ctx, accountName, application.PaymentBatcherOptions{},
+		)
+		require.Error(t, err)
+		require.Empty(t, txids)
+
+		payment, err := svc.GetPayment(ctx, id)
+		require.NoError(t, err)
+		require.True(t, payment.IsQueued())
+		require.Empty(t, payment.Txid)
+	})
+
+	t.Run("pay_payments_with_spend_approval", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		policy, err := domain.NewSpendingPolicy(
+			accountNamespace, []domain.AssetLimit{
+				{
+					Asset:             regtest.AssetID,
+					ApprovalThreshold: 15000,
+				},
+			}, nil, 0,
+		)
+		require.NoError(t, err)
+		err = repoManager.SpendingPolicyRepository().SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		aliceCtx := application.ContextWithCaller(ctx, "alice")
+		bobCtx := application.ContextWithAuthenticatedCaller(ctx, "bob")
+		// Unconfidential outputs with different amounts, so that the output
+		// paying each payment can be told apart.
+		paymentIds := make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			output := application.Output{
+				Asset:  regtest.AssetID,
+				Amount: uint64(10000 + i*1000),
+				Script: receiverAddrInfo.Script,
+			}
+			id, err := svc.EnqueuePayment(ctx, accountName, output, 0)
+			require.NoError(t, err)
+			paymentIds = append(paymentIds, id)
+		}
+
+		_, err = svc.FlushPayments(
+			aliceCtx, accountName, application.PaymentBatcherOptions{},
+		)
+		require.ErrorIs(t, err, application.ErrSpendApprovalRequired)
+		_, metadata := application.ErrorReasonOf(err)
+		approvalId := metadata[application.ErrorMetadataApprovalId]
+
+		// The payments are bound to the tx waiting for approval and can't be
+		// flushed again.
+		for _, id := range paymentIds {
+			payment, err := svc.GetPayment(ctx, id)
+			require.NoError(t, err)
+			require.True(t, payment.IsPending())
+			require.Equal(t, approvalId, payment.Txid)
+		}
+		txids, err := svc.FlushPayments(
+			aliceCtx, accountName, application.PaymentBatcherOptions{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, txids)
+		approvals, err := svc.ListSpendApprovals(ctx)
+		require.NoError(t, err)
+		require.Len(t, approvals, 1)
+		mockedBcScanner.AssertNotCalled(t, "BroadcastTransaction", mock.Anything)
+
+		// Approving the spending broadcasts the tx paying them.
+		_, txHex, err := svc.ApproveSpend(bobCtx, approvalId)
+		require.NoError(t, err)
+		mockedBcScanner.AssertCalled(t, "BroadcastTransaction", txHex)
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		outputIndexes := make(map[uint32]bool)
+		for _, id := range paymentIds {
+			payment, err := svc.GetPayment(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, domain.PaymentStatusBroadcasted, payment.Status)
+			require.Equal(t, txid, payment.Txid)
+			outputIndexes[payment.OutputIndex] = true
+
+			// Every payment points to the output paying it.
+			require.Less(t, int(payment.OutputIndex), len(tx.Outputs))
+			out := tx.Outputs[payment.OutputIndex]
+			require.Equal(t, payment.Script, hex.EncodeToString(out.Script))
+			value, err := elementsutil.ValueFromBytes(out.Value)
+			require.NoError(t, err)
+			require.Equal(t, payment.Amount, value)
+		}
+		require.Len(t, outputIndexes, len(paymentIds))
+	})
+}
+
+func testUtxoConsolidation(t *testing.T) {
+	t.Run("consolidate_utxos", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		// No utxo is below the threshold.
+		txids, err := svc.ConsolidateUtxos(
+			ctx, accountName, "", application.ConsolidationOptions{
+				ValueThreshold: 1000,
+			},
+		)
+		require.NoError(t, err)
+		require.Empty(t, txids)
+
+		txids, err = svc.ConsolidateUtxos(
+			ctx, accountName, regtest.AssetID, application.ConsolidationOptions{
+				ValueThreshold: 2 * 100000000,
+				MaxInputs:      2,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, txids, 1)
+
+		// The merged utxos are locked and can't be consolidated again.
+		txids, err = svc.ConsolidateUtxos(
+			ctx, accountName, "", application.ConsolidationOptions{
+				ValueThreshold: 2 * 100000000,
+			},
+		)
+		require.NoError(t, err)
+		require.Empty(t, txids)
+
+		_, err = svc.ConsolidateUtxos(
+			ctx, accountName, "", application.ConsolidationOptions{},
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("consolidate_utxos_periodically", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		opts := application.ConsolidationOptions{ValueThreshold: 1000}
+		err = svc.StartUtxoConsolidation(0, opts)
+		require.Error(t, err)
+
+		err = svc.StartUtxoConsolidation(time.Hour, opts)
+		require.NoError(t, err)
+		err = svc.StartUtxoConsolidation(time.Hour, opts)
+		require.Error(t, err)
+
+		svc.StopUtxoConsolidation()
+		err = svc.StartUtxoConsolidation(time.Hour, opts)
+		require.NoError(t, err)
+		svc.StopUtxoConsolidation()
+	})
+
+	t.Run("skip_consolidation_if_fees_are_high", func(t *testing.T) {
+		chHighFees := make(chan struct{})
+		chLowFees := make(chan struct{})
+		chBroadcasted := make(chan struct{}, 1)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("EstimateFeeRate", mock.Anything).
+			Run(func(mock.Arguments) { close(chHighFees) }).
+			Return(uint64(1000), nil).Once()
+		mockedBcScanner.On("EstimateFeeRate", mock.Anything).
+			Run(func(mock.Arguments) { <-chLowFees }).
+			Return(uint64(100), nil)
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).
+			Run(func(mock.Arguments) {
+				select {
+				case chBroadcasted <- struct{}{}:
+				default:
+				}
+			}).
+			Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		opts := application.ConsolidationOptions{
+			ValueThreshold:               2 * 100000000,
+			MaxEstimatedMillisatsPerByte: 200,
+		}
+		err = svc.StartUtxoConsolidation(10*time.Millisecond, opts)
+		require.NoError(t, err)
+		defer svc.StopUtxoConsolidation()
+
+		// Nothing is broadcasted while the estimate is above the threshold.
+		select {
+		case <-chHighFees:
+		case <-time.After(5 * time.Second):
+			t.Fatal("fee rate not estimated")
+		}
+		require.Empty(t, chBroadcasted)
+
+		close(chLowFees)
+		select {
+		case <-chBroadcasted:
+		case <-time.After(5 * time.Second):
+			t.Fatal("utxos not consolidated")
+		}
+	})
 }
 
 func testExternalTransaction(t *testing.T) {
@@ -48,14 +462,18 @@ func testExternalTransaction(t *testing.T) {
 
 		svc := application.NewTransactionService(
 			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
 		)
 
-		selectedUtxos, change, expirationDate, err := svc.SelectUtxos(
+		selectedUtxos, change, lock, err := svc.SelectUtxos(
 			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy,
+			application.MinMillisatsPerByte, 0,
 		)
 		require.NoError(t, err)
 		require.NotEmpty(t, selectedUtxos)
-		require.NotEmpty(t, expirationDate)
+		require.NotNil(t, lock)
+		require.NotEmpty(t, lock.ExpirationDate)
+		require.NotEmpty(t, lock.Token)
 
 		inputs := make(application.Inputs, 0, len(selectedUtxos))
 		for _, u := range selectedUtxos {
@@ -119,6 +537,72 @@ func testExternalTransaction(t *testing.T) {
 	})
 }
 
+func testUtxosLock(t *testing.T) {
+	t.Run("lock_and_unlock_utxos", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("GetUtxos", mock.Anything).Return(nil, nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		selectedUtxos, _, lock, err := svc.SelectUtxos(
+			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy,
+			application.MinMillisatsPerByte, time.Hour,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, selectedUtxos)
+		require.NotNil(t, lock)
+		require.NotEmpty(t, lock.Token)
+		require.GreaterOrEqual(
+			t, lock.ExpirationDate, time.Now().Add(59*time.Minute).Unix(),
+		)
+
+		inputs := make(application.Inputs, 0, len(selectedUtxos))
+		for _, u := range selectedUtxos {
+			inputs = append(inputs, application.Input{TxID: u.TxID, VOut: u.VOut})
+		}
+
+		otherLock, err := svc.LockUtxos(ctx, accountName, inputs, "", 0)
+		require.NoError(t, err)
+		require.NotEqual(t, lock.Token, otherLock.Token)
+
+		_, err = svc.LockUtxos(ctx, accountName, inputs, otherLock.Token, 0)
+		require.ErrorIs(t, err, domain.ErrUtxoLockNotOwned)
+
+		_, err = svc.UnlockUtxos(ctx, accountName, inputs, "")
+		require.Error(t, err)
+
+		_, err = svc.UnlockUtxos(ctx, accountName, inputs, otherLock.Token)
+		require.ErrorIs(t, err, domain.ErrUtxoLockNotOwned)
+
+		extendedLock, err := svc.LockUtxos(
+			ctx, accountName, inputs, lock.Token, 2*time.Hour,
+		)
+		require.NoError(t, err)
+		require.Equal(t, lock.Token, extendedLock.Token)
+		require.Greater(t, extendedLock.ExpirationDate, lock.ExpirationDate)
+
+		count, err := svc.UnlockUtxos(ctx, accountName, inputs, lock.Token)
+		require.NoError(t, err)
+		require.Equal(t, len(inputs), count)
+
+		lock, err = svc.LockUtxos(ctx, accountName, inputs, "", 0)
+		require.NoError(t, err)
+		require.NotEmpty(t, lock.Token)
+
+		count, err = svc.UnlockUtxos(
+			application.ContextWithAdminRights(ctx), accountName, inputs, "",
+		)
+		require.NoError(t, err)
+		require.Equal(t, len(inputs), count)
+	})
+}
+
 func testInternalTransaction(t *testing.T) {
 	t.Run("craft_transaction_internally", func(t *testing.T) {
 		mockedBcScanner := newMockedBcScanner()
@@ -129,12 +613,1152 @@ func testInternalTransaction(t *testing.T) {
 
 		svc := application.NewTransactionService(
 			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
 		)
 
-		txid, err := svc.Transfer(ctx, accountName, outputs, 0)
+		txid, err := svc.Transfer(ctx, accountName, outputs, 0, coinSelectionStrategy, false, 0, nil)
 		require.NoError(t, err)
 		require.NotEmpty(t, txid)
 	})
+
+	t.Run("craft_transaction_internally_with_sequence", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		// The given sequence applies to all the selected inputs.
+		sequence := uint32(0xfffffffd)
+		txHex, err := svc.Transfer(
+			ctx, accountName, outputs, 0, coinSelectionStrategy, false, 0,
+			[]uint32{sequence},
+		)
+		require.NoError(t, err)
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		for _, in := range tx.Inputs {
+			require.Equal(t, sequence, in.Sequence)
+		}
+
+		// The caller can't know how many inputs are selected, and in which
+		// order, therefore one sequence per input is not allowed.
+		_, err = svc.Transfer(
+			ctx, accountName, outputs, 0, coinSelectionStrategy, false, 0,
+			[]uint32{sequence, sequence},
+		)
+		require.Error(t, err)
+		reason, _ := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonInvalidArgument, reason)
+	})
+
+	t.Run("craft_transaction_internally_sending_whole_balance", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		// The fees are subtracted from the output since the utxos can't cover
+		// them too.
+		balance, err := repoManager.UtxoRepository().GetBalanceForAccount(
+			ctx, accountNamespace,
+		)
+		require.NoError(t, err)
+		amount := balance[regtest.AssetID].Confirmed +
+			balance[regtest.AssetID].Unconfirmed
+		outputs := []application.Output{
+			{
+				Asset:       regtest.AssetID,
+				Amount:      amount,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+		}
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, outputs, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, nil,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+	})
+
+	t.Run("craft_transaction_internally_subtracting_fee", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, outputs, application.MinMillisatsPerByte,
+			coinSelectionStrategy, true, 0, nil,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// The change covers only the amount of the receiver, that pays the fees.
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Outputs, 3)
+	})
+
+	t.Run("sweep_account", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		txHex, err := svc.SweepAccount(
+			ctx, accountName, nil, receiverAddrInfo.Script,
+			receiverAddrInfo.BlindingKey, application.MinMillisatsPerByte,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// All utxos are spent to a single output plus the fee one.
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Inputs, 2)
+		require.Len(t, tx.Outputs, 2)
+
+		utxos, err := repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+			ctx, accountNamespace, nil,
+		)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		_, err = svc.SweepAccount(
+			ctx, accountName, nil, receiverAddrInfo.Script,
+			receiverAddrInfo.BlindingKey, application.MinMillisatsPerByte,
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("craft_transaction_internally_with_fee_aware_selection", func(t *testing.T) {
+		strategies := []int{
+			application.CoinSelectionStrategyBranchAndBound,
+			application.CoinSelectionStrategyKnapsack,
+		}
+		for _, strategy := range strategies {
+			mockedBcScanner := newMockedBcScanner()
+			repoManager, err := newRepoManagerForTxService()
+			require.NoError(t, err)
+			require.NotNil(t, repoManager)
+
+			svc := application.NewTransactionService(
+				repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+				idempotencyKeyTTL,
+			)
+
+			txHex, err := svc.Transfer(
+				ctx, accountName, outputs, application.MinMillisatsPerByte, strategy,
+				false, 0, nil,
+			)
+			require.NoError(t, err)
+			require.NotEmpty(t, txHex)
+		}
+	})
+}
+
+func testTransactionWithSpendingPolicy(t *testing.T) {
+	t.Run("craft_transaction_with_spending_policy", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		policy, err := domain.NewSpendingPolicy(
+			accountNamespace, []domain.AssetLimit{
+				{
+					Asset:             regtest.AssetID,
+					SpendLimit:        1500000,
+					ApprovalThreshold: 500000,
+				},
+			}, nil, 0,
+		)
+		require.NoError(t, err)
+		err = repoManager.SpendingPolicyRepository().SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		aliceCtx := application.ContextWithAuthenticatedCaller(ctx, "alice")
+		bobCtx := application.ContextWithAuthenticatedCaller(ctx, "bob")
+		// Callers identified only by their address can't approve nor reject.
+		unauthenticatedCtx := application.ContextWithCaller(ctx, "10.0.0.1")
+
+		txHex, err := svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		require.ErrorIs(t, err, application.ErrSpendApprovalRequired)
+		require.Empty(t, txHex)
+		reason, metadata := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonSpendApprovalRequired, reason)
+
+		approvals, err := svc.ListSpendApprovals(ctx)
+		require.NoError(t, err)
+		require.Len(t, approvals, 1)
+		require.Equal(t, "alice", approvals[0].RequestedBy)
+		require.Equal(
+			t, approvals[0].ID, metadata[application.ErrorMetadataApprovalId],
+		)
+
+		_, _, err = svc.ApproveSpend(aliceCtx, approvals[0].ID)
+		require.ErrorIs(t, err, domain.ErrSpendApprovalSameCaller)
+
+		_, _, err = svc.ApproveSpend(unauthenticatedCtx, approvals[0].ID)
+		require.ErrorIs(t, err, application.ErrCallerNotAuthenticated)
+		reason, _ = application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonCallerNotAuthenticated, reason)
+
+		err = svc.RejectSpend(unauthenticatedCtx, approvals[0].ID)
+		require.ErrorIs(t, err, application.ErrCallerNotAuthenticated)
+
+		signedTx, txHex, err := svc.ApproveSpend(bobCtx, approvals[0].ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedTx)
+		require.NotEmpty(t, txHex)
+
+		approvals, err = svc.ListSpendApprovals(ctx)
+		require.NoError(t, err)
+		require.Empty(t, approvals)
+
+		// The funds spent with the approved tx count towards the spend limit.
+		txHex, err = svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		require.ErrorIs(t, err, domain.ErrPolicySpendLimitExceeded)
+		require.Empty(t, txHex)
+	})
+
+	t.Run("approve_spend_fails", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		policy, err := domain.NewSpendingPolicy(
+			accountNamespace, []domain.AssetLimit{
+				{
+					Asset:             regtest.AssetID,
+					ApprovalThreshold: 500000,
+				},
+			}, nil, 0,
+		)
+		require.NoError(t, err)
+		err = repoManager.SpendingPolicyRepository().SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		aliceCtx := application.ContextWithCaller(ctx, "alice")
+		bobCtx := application.ContextWithAuthenticatedCaller(ctx, "bob")
+		receivers := []application.Output{
+			{
+				Asset:       regtest.AssetID,
+				Amount:      1000000,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+		}
+
+		// requestSpend returns the approval of a new transfer along with the
+		// keys of its inputs, locked while waiting for approval.
+		requestSpend := func(t *testing.T) (string, []domain.UtxoKey) {
+			_, err := svc.Transfer(
+				aliceCtx, accountName, receivers, 0, coinSelectionStrategy, false,
+				0, nil,
+			)
+			require.ErrorIs(t, err, application.ErrSpendApprovalRequired)
+			_, metadata := application.ErrorReasonOf(err)
+			id := metadata[application.ErrorMetadataApprovalId]
+
+			approval, err := repoManager.SpendingPolicyRepository().GetApproval(
+				ctx, id,
+			)
+			require.NoError(t, err)
+			tx, err := psetv2.NewPsetFromBase64(approval.Tx)
+			require.NoError(t, err)
+			keys := make([]domain.UtxoKey, 0, len(tx.Inputs))
+			for _, in := range tx.Inputs {
+				keys = append(keys, domain.UtxoKey{
+					TxID: elementsutil.TxIDFromBytes(in.PreviousTxid),
+					VOut: in.PreviousTxIndex,
+				})
+			}
+			utxos, err := repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+			require.NoError(t, err)
+			for _, u := range utxos {
+				require.True(t, u.IsLocked())
+			}
+			return id, keys
+		}
+
+		// Inputs spent by another tx while waiting for approval.
+		id, keys := requestSpend(t)
+		_, err = repoManager.UtxoRepository().SpendUtxos(
+			ctx, keys, randomHex(32),
+		)
+		require.NoError(t, err)
+
+		_, _, err = svc.ApproveSpend(bobCtx, id)
+		require.Error(t, err)
+		reason, _ := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonInvalidTransaction, reason)
+
+		// Inputs released and locked by someone else while waiting for
+		// approval.
+		id, keys = requestSpend(t)
+		_, err = repoManager.UtxoRepository().ReleaseUtxos(ctx, keys, "")
+		require.NoError(t, err)
+		now := time.Now().Add(time.Second)
+		_, err = repoManager.UtxoRepository().LockUtxos(
+			ctx, keys, now.Unix(), now.Add(time.Minute).Unix(), "owner",
+		)
+		require.NoError(t, err)
+
+		_, _, err = svc.ApproveSpend(bobCtx, id)
+		require.Error(t, err)
+		reason, _ = application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonUtxoLockNotOwned, reason)
+	})
+}
+
+func testTransactionWithIdempotencyKey(t *testing.T) {
+	t.Run("craft_transaction_with_idempotency_key", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		aliceCtx := application.ContextWithIdempotencyKey(
+			application.ContextWithCaller(ctx, "alice"), randomHex(16),
+		)
+
+		// Concurrent retries wait for the first call to complete and get back
+		// the same tx, instead of spending other utxos.
+		wg := &sync.WaitGroup{}
+		txs := make([]string, 3)
+		errs := make([]error, 3)
+		for i := range txs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				txs[i], errs[i] = svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range txs {
+			require.NoError(t, errs[i])
+			require.Equal(t, txs[0], txs[i])
+		}
+
+		txHex, err := svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, txs[0], txHex)
+
+		// Reusing the key for a different request is not allowed.
+		_, err = svc.Transfer(aliceCtx, accountName, outputs[:1], 100, coinSelectionStrategy, false, 0, nil)
+		reason, _ := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonIdempotencyKeyMismatch, reason)
+
+		bobCtx := application.ContextWithIdempotencyKey(
+			application.ContextWithCaller(ctx, "bob"),
+			application.IdempotencyKeyFromContext(aliceCtx),
+		)
+		_, err = svc.Transfer(bobCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		reason, _ = application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonIdempotencyKeyMismatch, reason)
+
+		broadcastCtx := application.ContextWithIdempotencyKey(ctx, randomHex(16))
+		txid, err := svc.BroadcastTransaction(broadcastCtx, txHex)
+		require.NoError(t, err)
+		require.NotEmpty(t, txid)
+
+		retriedTxid, err := svc.BroadcastTransaction(broadcastCtx, txHex)
+		require.NoError(t, err)
+		require.Equal(t, txid, retriedTxid)
+		mockedBcScanner.AssertNumberOfCalls(t, "BroadcastTransaction", 1)
+	})
+
+	t.Run("retry_transfer_waiting_for_approval", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		policy, err := domain.NewSpendingPolicy(
+			accountNamespace, []domain.AssetLimit{
+				{
+					Asset:             regtest.AssetID,
+					ApprovalThreshold: 500000,
+				},
+			}, nil, 0,
+		)
+		require.NoError(t, err)
+		err = repoManager.SpendingPolicyRepository().SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		aliceCtx := application.ContextWithIdempotencyKey(
+			application.ContextWithCaller(ctx, "alice"), randomHex(16),
+		)
+
+		_, err = svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		require.ErrorIs(t, err, application.ErrSpendApprovalRequired)
+		_, metadata := application.ErrorReasonOf(err)
+		id := metadata[application.ErrorMetadataApprovalId]
+		require.NotEmpty(t, id)
+
+		// Retries get back the same approval instead of queuing a new spend.
+		_, err = svc.Transfer(aliceCtx, accountName, outputs[:1], 0, coinSelectionStrategy, false, 0, nil)
+		require.ErrorIs(t, err, application.ErrSpendApprovalRequired)
+		reason, metadata := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonSpendApprovalRequired, reason)
+		require.Equal(t, id, metadata[application.ErrorMetadataApprovalId])
+
+		approvals, err := svc.ListSpendApprovals(ctx)
+		require.NoError(t, err)
+		require.Len(t, approvals, 1)
+		require.Equal(t, id, approvals[0].ID)
+	})
+}
+
+func testFeeBumping(t *testing.T) {
+	receivers := []application.Output{
+		{
+			Asset:       regtest.AssetID,
+			Amount:      1000000,
+			Script:      receiverAddrInfo.Script,
+			BlindingKey: receiverAddrInfo.BlindingKey,
+		},
+	}
+
+	t.Run("bump_fee_with_child_pays_for_parent", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, nil,
+		)
+		require.NoError(t, err)
+		txid, changeKey := addUnconfirmedTx(t, repoManager, txHex, false)
+
+		// The fee rate must be higher than the current one.
+		_, err = svc.BumpFee(ctx, txid, application.MinMillisatsPerByte)
+		require.Error(t, err)
+
+		bumpedTx, err := svc.BumpFee(ctx, txid, 1000)
+		require.NoError(t, err)
+		require.NotNil(t, bumpedTx)
+		require.Equal(
+			t, application.BumpFeeMethodChildPaysForParent, bumpedTx.Method,
+		)
+
+		childTx, err := transaction.NewTxFromHex(bumpedTx.TxHex)
+		require.NoError(t, err)
+		spendsChange := false
+		for _, in := range childTx.Inputs {
+			key := domain.UtxoKey{
+				TxID: elementsutil.TxIDFromBytes(in.Hash), VOut: in.Index,
+			}
+			spendsChange = spendsChange || key == changeKey
+		}
+		require.True(t, spendsChange)
+
+		// The change is locked now, therefore there's nothing left to spend.
+		_, err = svc.BumpFee(ctx, txid, 2000)
+		require.Error(t, err)
+	})
+
+	t.Run("bump_fee_with_replacement", func(t *testing.T) {
+		replacementTxid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(replacementTxid, nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, nil,
+		)
+		require.NoError(t, err)
+		txid, changeKey := addUnconfirmedTx(t, repoManager, txHex, true)
+
+		bumpedTx, err := svc.BumpFee(ctx, txid, 1000)
+		require.NoError(t, err)
+		require.NotNil(t, bumpedTx)
+		require.Equal(t, replacementTxid, bumpedTx.Txid)
+		require.Equal(t, application.BumpFeeMethodReplacement, bumpedTx.Method)
+
+		// The replacement pays the same receiver with the same inputs.
+		oldTx, _ := transaction.NewTxFromHex(txHex)
+		newTx, err := transaction.NewTxFromHex(bumpedTx.TxHex)
+		require.NoError(t, err)
+		require.Len(t, newTx.Inputs, len(oldTx.Inputs))
+		require.Len(t, newTx.Outputs, len(oldTx.Outputs))
+
+		tx, err := repoManager.TransactionRepository().GetTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.True(t, tx.IsEvicted())
+		require.Equal(t, replacementTxid, tx.EvictedBy)
+
+		utxos, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{changeKey},
+		)
+		require.NoError(t, err)
+		require.Len(t, utxos, 1)
+		require.True(t, utxos[0].IsEvicted())
+
+		// The inputs are now spent by the replacement.
+		utxos, err = repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, inputKeys(oldTx),
+		)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(oldTx.Inputs))
+		for _, u := range utxos {
+			require.Equal(t, replacementTxid, u.SpentStatus.Txid)
+		}
+
+		// An evicted tx can't be bumped again.
+		_, err = svc.BumpFee(ctx, txid, 2000)
+		require.Error(t, err)
+	})
+
+	t.Run("bump_fee_fails", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		confirmedTx := randomTx(randomHex(32), accountName)
+		_, err = repoManager.TransactionRepository().AddTransaction(
+			ctx, confirmedTx,
+		)
+		require.NoError(t, err)
+
+		tests := []struct {
+			name             string
+			txid             string
+			millisatsPerByte uint64
+			reason           application.ErrorReason
+		}{
+			{
+				name:             "fee rate too low",
+				txid:             confirmedTx.TxID,
+				millisatsPerByte: 10,
+				reason:           application.ReasonInvalidArgument,
+			},
+			{
+				name:             "unknown tx",
+				txid:             randomHex(32),
+				millisatsPerByte: 1000,
+				reason:           application.ReasonNotFound,
+			},
+			{
+				name:             "confirmed tx",
+				txid:             confirmedTx.TxID,
+				millisatsPerByte: 1000,
+				reason:           application.ReasonTransactionNotBumpable,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := svc.BumpFee(ctx, tt.txid, tt.millisatsPerByte)
+				require.Error(t, err)
+				reason, _ := application.ErrorReasonOf(err)
+				require.Equal(t, tt.reason, reason)
+			})
+		}
+	})
+}
+
+func testBroadcastTracker(t *testing.T) {
+	receivers := []application.Output{
+		{
+			Asset:       regtest.AssetID,
+			Amount:      1000000,
+			Script:      receiverAddrInfo.Script,
+			BlindingKey: receiverAddrInfo.BlindingKey,
+		},
+	}
+	opts := application.BroadcastTrackerOptions{
+		CheckInterval: 100 * time.Millisecond,
+		MaxAttempts:   2,
+	}
+
+	// broadcastTx broadcasts a new wallet tx with the given service and
+	// returns its hash and the keys of its inputs.
+	broadcastTx := func(
+		t *testing.T, svc *application.TransactionService,
+	) (string, []domain.UtxoKey) {
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, nil,
+		)
+		require.NoError(t, err)
+		txid, err := svc.BroadcastTransaction(ctx, txHex)
+		require.NoError(t, err)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+
+		broadcast, err := svc.GetBroadcast(ctx, txid)
+		require.NoError(t, err)
+		require.True(t, broadcast.IsPending())
+		return txid, inputKeys(tx)
+	}
+	hasStatus := func(
+		svc *application.TransactionService, txid string,
+		status domain.BroadcastStatus,
+	) func() bool {
+		return func() bool {
+			broadcast, err := svc.GetBroadcast(ctx, txid)
+			return err == nil && broadcast.Status == status
+		}
+	}
+
+	t.Run("confirm_broadcast", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected: true,
+		})
+		mockedBcScanner.On("GetTransactions", mock.Anything).Return(
+			[]domain.Transaction{{TxID: txid, BlockHash: randomHex(32)}}, nil,
+		)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		err = svc.StartBroadcastTracker(opts)
+		require.NoError(t, err)
+		defer svc.StopBroadcastTracker()
+
+		err = svc.StartBroadcastTracker(opts)
+		require.Error(t, err)
+
+		broadcastTx(t, svc)
+		require.Eventually(
+			t, hasStatus(svc, txid, domain.BroadcastStatusConfirmed),
+			5*time.Second, 100*time.Millisecond,
+		)
+		mockedBcScanner.AssertNumberOfCalls(t, "BroadcastTransaction", 1)
+	})
+
+	t.Run("drop_missing_broadcast", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected: true,
+		})
+		mockedBcScanner.On("GetTransactions", mock.Anything).Return(
+			nil, ports.ErrTransactionNotFound,
+		)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		err = svc.StartBroadcastTracker(opts)
+		require.NoError(t, err)
+		defer svc.StopBroadcastTracker()
+
+		_, keys := broadcastTx(t, svc)
+		require.Eventually(
+			t, hasStatus(svc, txid, domain.BroadcastStatusDropped),
+			10*time.Second, 100*time.Millisecond,
+		)
+
+		// The tx has been rebroadcasted max attempts times before being
+		// dropped, and its inputs can be spent again.
+		broadcast, err := svc.GetBroadcast(ctx, txid)
+		require.NoError(t, err)
+		require.Equal(t, opts.MaxAttempts, broadcast.Attempts)
+		mockedBcScanner.AssertNumberOfCalls(
+			t, "BroadcastTransaction", int(opts.MaxAttempts)+1,
+		)
+		utxos, err := repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.False(t, u.IsLocked())
+		}
+	})
+
+	t.Run("retry_broadcast_on_scanner_error", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected: true,
+		})
+		mockedBcScanner.On("GetTransactions", mock.Anything).Return(
+			nil, fmt.Errorf("connection reset by peer"),
+		)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		err = svc.StartBroadcastTracker(opts)
+		require.NoError(t, err)
+		defer svc.StopBroadcastTracker()
+
+		broadcastTx(t, svc)
+
+		// A failing lookup is not enough to rebroadcast or drop the tx.
+		require.Never(t, func() bool {
+			broadcast, err := svc.GetBroadcast(ctx, txid)
+			return err != nil || !broadcast.IsPending() || broadcast.Attempts > 0
+		}, time.Second, 100*time.Millisecond)
+		mockedBcScanner.AssertCalled(t, "GetTransactions", mock.Anything)
+		mockedBcScanner.AssertNumberOfCalls(t, "BroadcastTransaction", 1)
+	})
+
+	t.Run("conflict_broadcast", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected: true,
+		})
+		mockedBcScanner.On("GetTransactions", mock.Anything).Return(
+			nil, ports.ErrTransactionNotFound,
+		)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		_, keys := broadcastTx(t, svc)
+
+		// Another tx spends one of the inputs of the broadcasted one.
+		conflictingTxid := randomHex(32)
+		_, err = repoManager.UtxoRepository().SpendUtxos(
+			ctx, keys[:1], conflictingTxid,
+		)
+		require.NoError(t, err)
+
+		err = svc.StartBroadcastTracker(opts)
+		require.NoError(t, err)
+		defer svc.StopBroadcastTracker()
+
+		require.Eventually(
+			t, hasStatus(svc, txid, domain.BroadcastStatusConflicted),
+			5*time.Second, 100*time.Millisecond,
+		)
+		broadcast, err := svc.GetBroadcast(ctx, txid)
+		require.NoError(t, err)
+		require.Equal(t, conflictingTxid, broadcast.ConflictingTxid)
+		mockedBcScanner.AssertNotCalled(t, "GetTransactions", mock.Anything)
+	})
+
+	t.Run("replaced_broadcast", func(t *testing.T) {
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		mockedBcScanner := newMockedBcScanner()
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, []uint32{0xfffffffd},
+		)
+		require.NoError(t, err)
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		txid := tx.TxHash().String()
+
+		replacementTxid := randomHex(32)
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).
+			Return(txid, nil).Once()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).
+			Return(replacementTxid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected: true,
+		})
+		mockedBcScanner.On("GetTransactions", mock.Anything).Return(
+			[]domain.Transaction{{TxID: replacementTxid}}, nil,
+		)
+
+		_, err = svc.BroadcastTransaction(ctx, txHex)
+		require.NoError(t, err)
+		addUnconfirmedTx(t, repoManager, txHex, false)
+
+		bumpedTx, err := svc.BumpFee(ctx, txid, 1000)
+		require.NoError(t, err)
+		require.Equal(t, application.BumpFeeMethodReplacement, bumpedTx.Method)
+
+		err = svc.StartBroadcastTracker(opts)
+		require.NoError(t, err)
+		defer svc.StopBroadcastTracker()
+
+		// The replaced tx conflicts with its replacement, not the other way
+		// around.
+		require.Eventually(
+			t, hasStatus(svc, txid, domain.BroadcastStatusConflicted),
+			5*time.Second, 100*time.Millisecond,
+		)
+		broadcast, err := svc.GetBroadcast(ctx, txid)
+		require.NoError(t, err)
+		require.Equal(t, replacementTxid, broadcast.ConflictingTxid)
+
+		require.Never(
+			t, hasStatus(svc, replacementTxid, domain.BroadcastStatusConflicted),
+			time.Second, 100*time.Millisecond,
+		)
+		broadcast, err = svc.GetBroadcast(ctx, replacementTxid)
+		require.NoError(t, err)
+		require.True(t, broadcast.IsPending())
+	})
+}
+
+func testTxScheduler(t *testing.T) {
+	receivers := []application.Output{
+		{
+			Asset:       regtest.AssetID,
+			Amount:      1000000,
+			Script:      receiverAddrInfo.Script,
+			BlindingKey: receiverAddrInfo.BlindingKey,
+		},
+	}
+	tipHeight := uint32(1000)
+	opts := application.TxSchedulerOptions{
+		CheckInterval: 100 * time.Millisecond,
+	}
+
+	newService := func(t *testing.T, txid string) (
+		*application.TransactionService, ports.RepoManager, *mockBcScanner,
+	) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		mockedBcScanner.On("GetStatus").Return(ports.BlockchainScannerStatus{
+			IsConnected:  true,
+			TipHeight:    tipHeight,
+			TipTimestamp: time.Now().Unix(),
+		})
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			idempotencyKeyTTL,
+		)
+		return svc, repoManager, mockedBcScanner
+	}
+	// transferWithLocktime returns a new wallet tx with the given locktime
+	// and the keys of its inputs.
+	transferWithLocktime := func(
+		t *testing.T, svc *application.TransactionService, locktime uint32,
+	) (string, []domain.UtxoKey) {
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, locktime, nil,
+		)
+		require.NoError(t, err)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Equal(t, locktime, tx.Locktime)
+		keys := make([]domain.UtxoKey, 0, len(tx.Inputs))
+		for _, in := range tx.Inputs {
+			require.Equal(t, uint32(transaction.DefaultSequence-1), in.Sequence)
+			keys = append(keys, domain.UtxoKey{
+				TxID: elementsutil.TxIDFromBytes(in.Hash), VOut: in.Index,
+			})
+		}
+		return txHex, keys
+	}
+	hasStatus := func(
+		svc *application.TransactionService, txid string,
+		status domain.ScheduledTxStatus,
+	) func() bool {
+		return func() bool {
+			tx, err := svc.GetScheduledTransaction(ctx, txid)
+			return err == nil && tx.Status == status
+		}
+	}
+
+	t.Run("broadcast_scheduled_transaction", func(t *testing.T) {
+		svc, _, mockedBcScanner := newService(t, randomHex(32))
+
+		dueTxHex, _ := transferWithLocktime(t, svc, tipHeight)
+		dueTx, err := svc.ScheduleTransaction(ctx, dueTxHex, 0)
+		require.NoError(t, err)
+		require.NotNil(t, dueTx)
+		require.True(t, dueTx.IsScheduled())
+
+		_, err = svc.ScheduleTransaction(ctx, dueTxHex, 0)
+		require.Error(t, err)
+
+		notDueTxHex, _ := transferWithLocktime(t, svc, tipHeight+1)
+		notDueTx, err := svc.ScheduleTransaction(ctx, notDueTxHex, 0)
+		require.NoError(t, err)
+		require.NotNil(t, notDueTx)
+
+		txs, err := svc.ListScheduledTransactions(ctx)
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+
+		err = svc.StartTxScheduler(opts)
+		require.NoError(t, err)
+		defer svc.StopTxScheduler()
+
+		err = svc.StartTxScheduler(opts)
+		require.Error(t, err)
+
+		require.Eventually(
+			t, hasStatus(svc, dueTx.Txid, domain.ScheduledTxStatusBroadcasted),
+			5*time.Second, 100*time.Millisecond,
+		)
+		mockedBcScanner.AssertCalled(t, "BroadcastTransaction", dueTxHex)
+		mockedBcScanner.AssertNotCalled(t, "BroadcastTransaction", notDueTxHex)
+
+		tx, err := svc.GetScheduledTransaction(ctx, notDueTx.Txid)
+		require.NoError(t, err)
+		require.True(t, tx.IsScheduled())
+	})
+
+	t.Run("cancel_scheduled_transaction", func(t *testing.T) {
+		svc, repoManager, _ := newService(t, randomHex(32))
+
+		txHex, keys := transferWithLocktime(t, svc, tipHeight+1)
+		tx, err := svc.ScheduleTransaction(ctx, txHex, 0)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+
+		tx, err = svc.CancelScheduledTransaction(ctx, tx.Txid)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScheduledTxStatusCancelled, tx.Status)
+
+		utxos, err := repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.False(t, u.IsLocked())
+		}
+
+		_, err = svc.CancelScheduledTransaction(ctx, tx.Txid)
+		require.Error(t, err)
+		reason, _ := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonTransactionNotScheduled, reason)
+	})
+
+	t.Run("fail_conflicting_scheduled_transaction", func(t *testing.T) {
+		svc, repoManager, mockedBcScanner := newService(t, randomHex(32))
+
+		txHex, keys := transferWithLocktime(t, svc, tipHeight+1)
+		tx, err := svc.ScheduleTransaction(ctx, txHex, 0)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+
+		// Another tx spends one of the inputs of the scheduled one.
+		conflictingTxid := randomHex(32)
+		_, err = repoManager.UtxoRepository().SpendUtxos(
+			ctx, keys[:1], conflictingTxid,
+		)
+		require.NoError(t, err)
+
+		err = svc.StartTxScheduler(opts)
+		require.NoError(t, err)
+		defer svc.StopTxScheduler()
+
+		require.Eventually(
+			t, hasStatus(svc, tx.Txid, domain.ScheduledTxStatusFailed),
+			5*time.Second, 100*time.Millisecond,
+		)
+		tx, err = svc.GetScheduledTransaction(ctx, tx.Txid)
+		require.NoError(t, err)
+		require.Equal(t, conflictingTxid, tx.ConflictingTxid)
+		mockedBcScanner.AssertNotCalled(t, "BroadcastTransaction", mock.Anything)
+	})
+
+	t.Run("schedule_transaction_fails", func(t *testing.T) {
+		svc, _, _ := newService(t, randomHex(32))
+
+		_, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, tipHeight,
+			[]uint32{transaction.DefaultSequence},
+		)
+		require.Error(t, err)
+		reason, _ := application.ErrorReasonOf(err)
+		require.Equal(t, application.ReasonInvalidArgument, reason)
+
+		txHex, err := svc.Transfer(
+			ctx, accountName, receivers, application.MinMillisatsPerByte,
+			coinSelectionStrategy, false, 0, nil,
+		)
+		require.NoError(t, err)
+
+		tests := []struct {
+			name   string
+			txHex  string
+			reason application.ErrorReason
+		}{
+			{
+				name:   "invalid_tx",
+				txHex:  "invalid",
+				reason: application.ReasonInvalidTransaction,
+			},
+			{
+				name:   "missing_locktime_and_broadcast_time",
+				txHex:  txHex,
+				reason: application.ReasonInvalidArgument,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := svc.ScheduleTransaction(ctx, tt.txHex, 0)
+				require.Error(t, err)
+				reason, _ := application.ErrorReasonOf(err)
+				require.Equal(t, tt.reason, reason)
+			})
+		}
+	})
+}
+
+// addUnconfirmedTx adds the given tx, optionally made replaceable, to the
+// repository along with its lbtc change owned by the account, like the
+// blockchain scanner would do. It returns the hash of the tx and the key of
+// the change.
+func addUnconfirmedTx(
+	t *testing.T, repoManager ports.RepoManager, txHex string, replaceable bool,
+) (string, domain.UtxoKey) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	require.NoError(t, err)
+	if replaceable {
+		for _, in := range tx.Inputs {
+			in.Sequence = 0xfffffffd
+		}
+		txHex, err = tx.ToHex()
+		require.NoError(t, err)
+	}
+	txid := tx.TxHash().String()
+
+	_, err = repoManager.TransactionRepository().AddTransaction(
+		ctx, &domain.Transaction{
+			TxID:     txid,
+			TxHex:    txHex,
+			Accounts: map[string]struct{}{accountNamespace: {}},
+		},
+	)
+	require.NoError(t, err)
+
+	var change *domain.Utxo
+	for i, out := range tx.Outputs {
+		if len(out.Script) <= 0 || bytes.Equal(out.Script, receiverAddrInfo.Script) {
+			continue
+		}
+		change = &domain.Utxo{
+			UtxoKey:         domain.UtxoKey{TxID: txid, VOut: uint32(i)},
+			Value:           100000,
+			Asset:           regtest.AssetID,
+			ValueCommitment: randomValueCommitment(),
+			AssetCommitment: randomAssetCommitment(),
+			ValueBlinder:    randomBytes(32),
+			AssetBlinder:    randomBytes(32),
+			Script:          out.Script,
+			Nonce:           out.Nonce,
+			AccountName:     accountNamespace,
+		}
+	}
+	require.NotNil(t, change)
+	_, err = repoManager.UtxoRepository().AddUtxos(ctx, []*domain.Utxo{change})
+	require.NoError(t, err)
+
+	// The inputs are spent by the tx, like the scanner would do.
+	_, err = repoManager.UtxoRepository().SpendUtxos(ctx, inputKeys(tx), txid)
+	require.NoError(t, err)
+
+	return txid, change.Key()
+}
+
+func inputKeys(tx *transaction.Transaction) []domain.UtxoKey {
+	keys := make([]domain.UtxoKey, 0, len(tx.Inputs))
+	for _, in := range tx.Inputs {
+		keys = append(keys, domain.UtxoKey{
+			TxID: elementsutil.TxIDFromBytes(in.Hash), VOut: in.Index,
+		})
+	}
+	return keys
 }
 
 func newRepoManagerForTxService() (ports.RepoManager, error) {
@@ -0,0 +1,295 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vulpemventures/go-elements/transaction"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+// defaultTxSchedulerCheckInterval is the interval between the checks of the
+// scheduled txs if not specified.
+const defaultTxSchedulerCheckInterval = 30 * time.Second
+
+// TxSchedulerOptions define how often the scheduled txs are checked.
+type TxSchedulerOptions struct {
+	// CheckInterval is the interval between the checks of the scheduled txs,
+	// and therefore the max delay of a broadcast once the tx is due.
+	CheckInterval time.Duration
+}
+
+func (o TxSchedulerOptions) validate() error {
+	if o.CheckInterval < 0 {
+		return fmt.Errorf("check interval must not be a negative duration")
+	}
+	return nil
+}
+
+func (o TxSchedulerOptions) checkInterval() time.Duration {
+	if o.CheckInterval == 0 {
+		return defaultTxSchedulerCheckInterval
+	}
+	return o.CheckInterval
+}
+
+// ScheduleTransaction holds the given signed tx until its locktime and the
+// given unix time, if any, are reached, and then broadcasts it.
+// Like for BroadcastTransaction, the wallet inputs of the tx must be locked,
+// and they stay locked until the tx is broadcasted, cancelled or failed.
+func (ts *TransactionService) ScheduleTransaction(
+	ctx context.Context, txHex string, broadcastAt int64,
+) (_ *domain.ScheduledTx, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.ScheduleTransaction")
+	defer func() { endSpan(span, err) }()
+
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		return nil, newError(ReasonInvalidTransaction, "invalid tx: %s", err)
+	}
+	if tx.Locktime == 0 && broadcastAt == 0 {
+		return nil, newError(
+			ReasonInvalidArgument,
+			"tx without locktime must be scheduled at a given time",
+		)
+	}
+
+	keys, err := utxoKeysFromRawTx(txHex)
+	if err != nil {
+		return nil, newError(ReasonInvalidTransaction, "invalid tx: %s", err)
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range utxos {
+		if u.IsSpent() {
+			return nil, newError(
+				ReasonInvalidTransaction,
+				"cannot schedule transaction spending already spent utxo %s",
+				u.Key(),
+			)
+		}
+		if !u.IsLocked() {
+			return nil, newError(
+				ReasonUtxoNotLocked,
+				"cannot schedule transaction containing unlocked utxos",
+			)
+		}
+	}
+
+	scheduledTx, err := domain.NewScheduledTx(
+		tx.TxHash().String(), txHex, tx.Locktime, broadcastAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	done, err := ts.repoManager.ScheduledTxRepository().AddScheduledTx(
+		ctx, scheduledTx,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !done {
+		return nil, newError(
+			ReasonInvalidArgument, "transaction %s is already scheduled",
+			scheduledTx.Txid,
+		)
+	}
+
+	ts.log(
+		"scheduled tx %s with locktime %d and broadcast time %d",
+		scheduledTx.Txid, scheduledTx.Locktime, scheduledTx.BroadcastAt,
+	)
+	return scheduledTx, nil
+}
+
+// GetScheduledTransaction returns the scheduled tx with the given hash.
+func (ts *TransactionService) GetScheduledTransaction(
+	ctx context.Context, txid string,
+) (_ *domain.ScheduledTx, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.GetScheduledTransaction")
+	defer func() { endSpan(span, err) }()
+
+	return ts.repoManager.ScheduledTxRepository().GetScheduledTx(ctx, txid)
+}
+
+// ListScheduledTransactions returns all the scheduled txs, whatever their
+// status, sorted by creation time.
+func (ts *TransactionService) ListScheduledTransactions(
+	ctx context.Context,
+) (_ []domain.ScheduledTx, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.ListScheduledTransactions")
+	defer func() { endSpan(span, err) }()
+
+	return ts.repoManager.ScheduledTxRepository().GetAllScheduledTxs(ctx)
+}
+
+// CancelScheduledTransaction prevents the given scheduled tx from being
+// broadcasted and unlocks its wallet inputs.
+func (ts *TransactionService) CancelScheduledTransaction(
+	ctx context.Context, txid string,
+) (_ *domain.ScheduledTx, err error) {
+	ctx, span := startSpan(ctx, "TransactionService.CancelScheduledTransaction")
+	defer func() { endSpan(span, err) }()
+
+	scheduledTxRepo := ts.repoManager.ScheduledTxRepository()
+	scheduledTx, err := scheduledTxRepo.GetScheduledTx(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+	if err := scheduledTx.Cancel(); err != nil {
+		return nil, err
+	}
+	if err := scheduledTxRepo.UpdateScheduledTx(ctx, scheduledTx); err != nil {
+		return nil, err
+	}
+
+	ts.releaseTxInputs(ctx, scheduledTx.Txid, scheduledTx.TxHex)
+	ts.log("cancelled scheduled tx %s", txid)
+	return scheduledTx, nil
+}
+
+// StartTxScheduler spawns a job that periodically broadcasts the scheduled
+// txs whose locktime and broadcast time are reached. Txs whose inputs are
+// spent by other ones are marked as failed and their inputs unlocked.
+// Since scheduled txs are persisted, those due while the daemon was down are
+// broadcasted at the first check.
+func (ts *TransactionService) StartTxScheduler(opts TxSchedulerOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	ts.txSchedulerLock.Lock()
+	defer ts.txSchedulerLock.Unlock()
+
+	if ts.txSchedulerQuit != nil {
+		return fmt.Errorf("tx scheduler already started")
+	}
+	quit := make(chan struct{})
+	ts.txSchedulerQuit = quit
+
+	go func() {
+		t := time.NewTicker(opts.checkInterval())
+		defer t.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-t.C:
+				ts.checkScheduledTxs()
+			}
+		}
+	}()
+	return nil
+}
+
+// StopTxScheduler stops the job spawned by StartTxScheduler, if any.
+func (ts *TransactionService) StopTxScheduler() {
+	ts.txSchedulerLock.Lock()
+	defer ts.txSchedulerLock.Unlock()
+
+	if ts.txSchedulerQuit != nil {
+		close(ts.txSchedulerQuit)
+		ts.txSchedulerQuit = nil
+	}
+}
+
+// checkScheduledTxs broadcasts the scheduled txs that are due, and persists
+// the updated status of those broadcasted or failed.
+func (ts *TransactionService) checkScheduledTxs() {
+	ctx := context.Background()
+	scheduledTxRepo := ts.repoManager.ScheduledTxRepository()
+	scheduledTxs, err := scheduledTxRepo.GetPendingScheduledTxs(ctx)
+	if err != nil {
+		ts.warn(err, "failed to get scheduled txs")
+		return
+	}
+	if len(scheduledTxs) <= 0 {
+		return
+	}
+
+	// Nothing can be broadcasted while the scanner is disconnected.
+	status := ts.bcScanner.GetStatus()
+	if !status.IsConnected {
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, tx := range scheduledTxs {
+		updated, err := ts.checkScheduledTx(
+			ctx, &tx, status.TipHeight, status.TipTimestamp, now,
+		)
+		if err != nil {
+			ts.warn(err, "failed to check scheduled tx %s", tx.Txid)
+			continue
+		}
+		if !updated {
+			continue
+		}
+		if err := scheduledTxRepo.UpdateScheduledTx(ctx, &tx); err != nil {
+			ts.warn(err, "failed to update scheduled tx %s", tx.Txid)
+		}
+	}
+}
+
+// checkScheduledTx broadcasts the given scheduled tx if due, and returns
+// whether its status changed.
+func (ts *TransactionService) checkScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx, tipHeight uint32,
+	tipTimestamp, now int64,
+) (bool, error) {
+	if txid, _ := ts.findConflictingTx(ctx, tx.Txid, tx.TxHex); txid != "" {
+		ts.log("scheduled tx %s conflicts with tx %s", tx.Txid, txid)
+		if err := tx.Fail(txid); err != nil {
+			return false, err
+		}
+		ts.releaseTxInputs(ctx, tx.Txid, tx.TxHex)
+		return true, nil
+	}
+
+	// The tx might have been broadcasted by someone else meanwhile.
+	if _, err := ts.repoManager.TransactionRepository().GetTransaction(
+		ctx, tx.Txid,
+	); err == nil {
+		return true, tx.Broadcast()
+	}
+
+	if !tx.IsDue(tipHeight, tipTimestamp, now) {
+		return false, nil
+	}
+
+	// The broadcast might be rejected if the locktime is a timestamp not yet
+	// reached by the median time of the latest blocks, in which case it's
+	// retried at the next check.
+	if _, err := ts.broadcast(ctx, tx.TxHex); err != nil {
+		return false, err
+	}
+	ts.log("broadcasted scheduled tx %s", tx.Txid)
+	return true, tx.Broadcast()
+}
+
+// pendingScheduledTxInputs returns the inputs of the scheduled txs, which
+// must stay locked until the txs are either broadcasted, cancelled or failed.
+func (ts *TransactionService) pendingScheduledTxInputs(
+	ctx context.Context,
+) (map[domain.UtxoKey]struct{}, error) {
+	scheduledTxs, err := ts.repoManager.ScheduledTxRepository().
+		GetPendingScheduledTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[domain.UtxoKey]struct{})
+	for _, tx := range scheduledTxs {
+		keys, err := utxoKeysFromRawTx(tx.TxHex)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			inputs[key] = struct{}{}
+		}
+	}
+	return inputs, nil
+}
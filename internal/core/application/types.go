@@ -1,30 +1,48 @@
 package application
 
 import (
+	"context"
 	"encoding/hex"
-	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
+	bnb_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/branch-bound"
+	knapsack_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/knapsack"
 	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
 	wallet "github.com/vulpemventures/ocean/pkg/wallet"
 )
 
 const (
 	CoinSelectionStrategySmallestSubset = iota
+	// CoinSelectionStrategyBranchAndBound looks for a selection that doesn't
+	// require any change, or falls back to the knapsack one, picking the one
+	// with the lowest waste.
+	CoinSelectionStrategyBranchAndBound
+	// CoinSelectionStrategyKnapsack looks for the selection closest to the
+	// target amount.
+	CoinSelectionStrategyKnapsack
 )
 
 var (
 	coinSelectorByType = map[int]CoinSelectorFactory{
-		CoinSelectionStrategySmallestSubset: ss_selector.NewSmallestSubsetCoinSelector,
+		CoinSelectionStrategySmallestSubset: func(ports.CoinSelectionCosts) ports.CoinSelector {
+			return ss_selector.NewSmallestSubsetCoinSelector()
+		},
+		CoinSelectionStrategyBranchAndBound: bnb_selector.NewBranchAndBoundCoinSelector,
+		CoinSelectionStrategyKnapsack:       knapsack_selector.NewKnapsackCoinSelector,
 	}
 
 	DefaultCoinSelector = ss_selector.NewSmallestSubsetCoinSelector()
 	MinMillisatsPerByte = uint64(100)
+	// LongTermMillisatsPerByte is the fee rate at which the fee-aware coin
+	// selectors expect the wallet's utxos could be spent by waiting.
+	LongTermMillisatsPerByte = MinMillisatsPerByte
 )
 
 type WalletStatus struct {
@@ -33,6 +51,39 @@ type WalletStatus struct {
 	IsSynced      bool
 }
 
+// SyncStatus contains info about how far the wallet is in sync with the
+// blockchain.
+type SyncStatus struct {
+	ScannerType        string
+	IsScannerConnected bool
+	TipHeight          uint32
+	BackendHeight      uint32
+	LastBlockAge       time.Duration
+	LaggingAccounts    []AccountSyncStatus
+}
+
+// AccountSyncStatus contains info about the scan progress of an account.
+type AccountSyncStatus struct {
+	AccountName  string
+	BlockHeight  uint32
+	BlocksBehind uint32
+}
+
+// Readiness is the result of the checks made to find out whether the daemon
+// is ready to serve requests.
+type Readiness struct {
+	IsReady bool
+	Checks  []HealthCheck
+}
+
+// HealthCheck is the result of a single readiness check. Reason is set only
+// if the check failed.
+type HealthCheck struct {
+	Name      string
+	IsHealthy bool
+	Reason    string
+}
+
 type WalletInfo struct {
 	Network             string
 	NativeAsset         string
@@ -57,6 +108,55 @@ type BuildInfo struct {
 type UtxoInfo struct {
 	Spendable Utxos
 	Locked    Utxos
+	Frozen    Utxos
+}
+
+// FrozenFilter tells whether frozen utxos must be listed along with the
+// others, exclusively or not at all.
+type FrozenFilter int
+
+const (
+	FrozenFilterAll FrozenFilter = iota
+	FrozenFilterOnly
+	FrozenFilterNone
+)
+
+// UtxoFilter narrows the list of utxos of an account. Any zero-value field
+// doesn't filter anything.
+type UtxoFilter struct {
+	Label            string
+	Asset            string
+	MinConfirmations uint32
+	Frozen           FrozenFilter
+}
+
+func (f UtxoFilter) match(u *domain.Utxo, tipHeight uint32) bool {
+	if len(f.Label) > 0 && !u.HasLabel(f.Label) {
+		return false
+	}
+	if len(f.Asset) > 0 && u.Asset != f.Asset {
+		return false
+	}
+	if f.MinConfirmations > 0 {
+		if !u.IsConfirmed() {
+			return false
+		}
+		height := uint32(u.ConfirmedStatus.BlockHeight)
+		if height > tipHeight || tipHeight-height+1 < f.MinConfirmations {
+			return false
+		}
+	}
+	return true
+}
+
+func (f UtxoFilter) apply(utxos Utxos, tipHeight uint32) Utxos {
+	filtered := make(Utxos, 0, len(utxos))
+	for _, u := range utxos {
+		if f.match(u, tipHeight) {
+			filtered = append(filtered, u)
+		}
+	}
+	return filtered
 }
 
 type TransactionInfo domain.Transaction
@@ -185,7 +285,9 @@ func (i Inputs) Keys() []domain.UtxoKey {
 
 type Outputs []Output
 
-type CoinSelectorFactory func() ports.CoinSelector
+// CoinSelectorFactory returns a coin selector for the given costs, that only
+// fee-aware selectors take into account.
+type CoinSelectorFactory func(costs ports.CoinSelectionCosts) ports.CoinSelector
 
 func (o Outputs) totalAmountByAsset() map[string]uint64 {
 	totAmount := make(map[string]uint64)
@@ -203,6 +305,119 @@ func (o Outputs) toWalletOutputs() []wallet.Output {
 	return outs
 }
 
+type callerContextKey struct{}
+
+// ContextWithCaller returns a copy of the given context carrying the identity
+// of the caller of a request.
+func ContextWithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext returns the identity of the caller of a request, if any.
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerContextKey{}).(string)
+	return caller
+}
+
+type authenticatedCallerContextKey struct{}
+
+// ContextWithAuthenticatedCaller returns a copy of the given context carrying
+// the identity of the caller of a request, verified for example with a TLS
+// client certificate.
+func ContextWithAuthenticatedCaller(
+	ctx context.Context, caller string,
+) context.Context {
+	ctx = ContextWithCaller(ctx, caller)
+	return context.WithValue(ctx, authenticatedCallerContextKey{}, caller)
+}
+
+// AuthenticatedCallerFromContext returns the identity of the caller of a
+// request, if verified. Unlike CallerFromContext, it never returns an
+// identity made up of the address of the caller, shared for example by
+// clients behind the same NAT.
+func AuthenticatedCallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(authenticatedCallerContextKey{}).(string)
+	return caller
+}
+
+type adminContextKey struct{}
+
+// ContextWithAdminRights returns a copy of the given context marking the
+// caller of a request as an admin, allowed for example to release the locks
+// held by others.
+func ContextWithAdminRights(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminContextKey{}, true)
+}
+
+// HasAdminRights returns whether the caller of a request is an admin.
+func HasAdminRights(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminContextKey{}).(bool)
+	return admin
+}
+
+// UtxosLock holds the token of the owner of a lock of utxos, required to
+// extend or release it, and its expiration date.
+type UtxosLock struct {
+	Token          string
+	ExpirationDate int64
+}
+
+// spendInfo holds info about the funds spent by the wallet accounts within a
+// transaction.
+type spendInfo struct {
+	tx               string
+	amountsByAccount map[string]map[string]uint64
+	destinations     []string
+	millisatsPerByte uint64
+}
+
+// totalAmounts returns the amounts spent within the tx by all accounts.
+func (i *spendInfo) totalAmounts() map[string]uint64 {
+	amounts := make(map[string]uint64)
+	for _, amountsByAsset := range i.amountsByAccount {
+		for asset, amount := range amountsByAsset {
+			amounts[asset] += amount
+		}
+	}
+	return amounts
+}
+
+// accountNames returns the sorted list of accounts spending funds within the
+// tx, comma separated.
+func (i *spendInfo) accountNames() string {
+	names := make([]string, 0, len(i.amountsByAccount))
+	for name := range i.amountsByAccount {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// AuditEventInfo holds the info about a state-changing operation to be
+// recorded in the audit log.
+// Tx is an optional raw or partial transaction from which amounts,
+// destinations, accounts and txid are extracted if not explicitly given.
+type AuditEventInfo struct {
+	Method       string
+	AccountName  string
+	Tx           string
+	Amounts      map[string]uint64
+	Destinations []string
+	Txid         string
+	Err          error
+}
+
+// txOutput is a common representation for the outputs of both raw and partial
+// transactions. For confidential outputs of raw transactions, asset and
+// amount are not known.
+type txOutput struct {
+	script         []byte
+	asset          string
+	amount         uint64
+	isConfidential bool
+	isUnknown      bool
+}
+
 type transactionQueue struct {
 	lock                *sync.RWMutex
 	transactions        []*domain.Transaction
@@ -257,14 +472,14 @@ func (q *transactionQueue) pop() []*domain.Transaction {
 
 func validateAsset(asset string) error {
 	if asset == "" {
-		return fmt.Errorf("missing asset")
+		return newError(ReasonInvalidArgument, "missing asset")
 	}
 	buf, err := hex.DecodeString(asset)
 	if err != nil {
-		return fmt.Errorf("asset is not in hex format")
+		return newError(ReasonInvalidArgument, "asset is not in hex format")
 	}
 	if len(buf) != 32 {
-		return fmt.Errorf("invalid asset length")
+		return newError(ReasonInvalidArgument, "invalid asset length")
 	}
 	return nil
 }
@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+// unlockerRetryInterval is the time the unlocker waits before retrying in
+// case of failure.
+const unlockerRetryInterval = 5 * time.Second
+
+// startUtxoUnlocker spawns the unlocker, if not already running.
+// The unlocker unlocks the utxos whose lock expired and then sleeps until the
+// earliest expiration among the still locked ones, or until new utxos are
+// locked. Since expirations are read from the utxo repository, the unlocker
+// resumes exactly where it left off after a restart.
+func (ts *TransactionService) startUtxoUnlocker() {
+	ts.unlockerLock.Lock()
+	defer ts.unlockerLock.Unlock()
+
+	if ts.unlockerQuit != nil {
+		return
+	}
+	quit := make(chan struct{})
+	ts.unlockerQuit = quit
+
+	go func() {
+		for {
+			wait, ok := ts.unlockExpiredUtxos()
+			var t *time.Timer
+			var chExpiry <-chan time.Time
+			if ok {
+				t = time.NewTimer(wait)
+				chExpiry = t.C
+			}
+			select {
+			case <-quit:
+				if t != nil {
+					t.Stop()
+				}
+				return
+			case <-ts.chUtxosLocked:
+				if t != nil {
+					t.Stop()
+				}
+			case <-chExpiry:
+			}
+		}
+	}()
+}
+
+// StopUtxoUnlocker stops the unlocker, if running.
+func (ts *TransactionService) StopUtxoUnlocker() {
+	ts.unlockerLock.Lock()
+	defer ts.unlockerLock.Unlock()
+
+	if ts.unlockerQuit != nil {
+		close(ts.unlockerQuit)
+		ts.unlockerQuit = nil
+	}
+}
+
+// rescheduleUtxoUnlocker wakes up the unlocker to take into account the
+// expiration of newly locked utxos.
+func (ts *TransactionService) rescheduleUtxoUnlocker() {
+	select {
+	case ts.chUtxosLocked <- struct{}{}:
+	default:
+	}
+}
+
+// unlockExpiredUtxos unlocks the utxos of every account whose lock expired,
+// and returns how long to wait before the next expiration, if any.
+// The inputs of the pending broadcasted txs are skipped since they are
+// unlocked only if the txs are dropped or conflicted, and so are those of the
+// scheduled txs, unlocked only if the txs are cancelled or failed, and those
+// of the txs waiting for approval, unlocked only if the spending is rejected.
+func (ts *TransactionService) unlockExpiredUtxos() (time.Duration, bool) {
+	ctx := context.Background()
+	utxoRepo := ts.repoManager.UtxoRepository()
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return unlockerRetryInterval, true
+	}
+	pendingInputs, err := ts.pendingBroadcastInputs(ctx)
+	if err != nil {
+		ts.warn(err, "failed to get inputs of pending broadcasts")
+		return unlockerRetryInterval, true
+	}
+	scheduledInputs, err := ts.pendingScheduledTxInputs(ctx)
+	if err != nil {
+		ts.warn(err, "failed to get inputs of scheduled txs")
+		return unlockerRetryInterval, true
+	}
+	for key := range scheduledInputs {
+		pendingInputs[key] = struct{}{}
+	}
+	approvalInputs, err := ts.pendingApprovalInputs(ctx)
+	if err != nil {
+		ts.warn(err, "failed to get inputs of spends waiting for approval")
+		return unlockerRetryInterval, true
+	}
+	for key := range approvalInputs {
+		pendingInputs[key] = struct{}{}
+	}
+
+	var nextExpiry time.Time
+	for accountName := range w.Accounts {
+		utxos, err := utxoRepo.GetLockedUtxosForAccount(ctx, accountName, nil)
+		if err != nil {
+			ts.warn(err, "failed to get locked utxos for account %s", accountName)
+			return unlockerRetryInterval, true
+		}
+
+		expiredUtxos := make([]domain.UtxoKey, 0, len(utxos))
+		for _, u := range utxos {
+			if _, ok := pendingInputs[u.Key()]; ok {
+				continue
+			}
+			if u.CanUnlock() {
+				expiredUtxos = append(expiredUtxos, u.Key())
+				continue
+			}
+			expiry := time.Unix(u.LockExpiryTimestamp, 0)
+			if nextExpiry.IsZero() || expiry.Before(nextExpiry) {
+				nextExpiry = expiry
+			}
+		}
+		if len(expiredUtxos) <= 0 {
+			continue
+		}
+
+		count, err := utxoRepo.UnlockUtxos(ctx, expiredUtxos)
+		if err != nil {
+			ts.warn(
+				err, "failed to unlock utxos for account %s, retrying in %s",
+				accountName, unlockerRetryInterval,
+			)
+			return unlockerRetryInterval, true
+		}
+		if count > 0 {
+			ts.log(
+				"unlocked %d utxo(s) for account %s (%s)",
+				count, accountName, UtxoKeys(expiredUtxos),
+			)
+		}
+	}
+
+	if nextExpiry.IsZero() {
+		return 0, false
+	}
+	// Expirations have a precision of seconds, therefore the unlocker waits
+	// until the second after the expiration.
+	return time.Until(nextExpiry.Add(time.Second)), true
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// DefaultWalletID identifies the wallet of the requests that don't select any.
+// Its data is stored where a single-wallet daemon stores it, so that upgrading
+// doesn't require any migration.
+const DefaultWalletID = "default"
+
+var (
+	ErrInvalidWalletID = fmt.Errorf(
+		"invalid wallet id, must be 1 to 48 lowercase alphanumeric chars, " +
+			"dashes or underscores",
+	)
+	ErrWalletNotFound         = fmt.Errorf("wallet not found")
+	ErrWalletIDTaken          = fmt.Errorf("wallet id already taken")
+	ErrCallerNotAuthenticated = fmt.Errorf(
+		"caller must be authenticated with a client certificate",
+	)
+
+	walletIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,47}$`)
+)
+
+// ValidateWalletID returns an error if the given wallet id is malformed.
+// Wallet ids are used to namespace the storage of the wallets, therefore
+// only a restricted set of chars is allowed.
+func ValidateWalletID(walletID string) error {
+	if !walletIDRegexp.MatchString(walletID) {
+		return ErrInvalidWalletID
+	}
+	return nil
+}
+
+type walletIDContextKey struct{}
+
+// ContextWithWalletID returns a copy of the given context carrying the id of
+// the wallet a request refers to.
+func ContextWithWalletID(ctx context.Context, walletID string) context.Context {
+	return context.WithValue(ctx, walletIDContextKey{}, walletID)
+}
+
+// WalletIDFromContext returns the id of the wallet a request refers to,
+// DefaultWalletID if not set.
+func WalletIDFromContext(ctx context.Context) string {
+	walletID, _ := ctx.Value(walletIDContextKey{}).(string)
+	if walletID == "" {
+		return DefaultWalletID
+	}
+	return walletID
+}
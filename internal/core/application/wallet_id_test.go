@@ -0,0 +1,40 @@
+package application_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+)
+
+func TestValidateWalletID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		ids := []string{"default", "vault-1", "customer_42", strings.Repeat("a", 48)}
+		for _, id := range ids {
+			require.NoError(t, application.ValidateWalletID(id), id)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ids := []string{
+			"", "Vault", "-vault", "_vault", "vault/1", "../vault", "vault 1",
+			strings.Repeat("a", 49),
+		}
+		for _, id := range ids {
+			err := application.ValidateWalletID(id)
+			require.ErrorIs(t, err, application.ErrInvalidWalletID, id)
+		}
+	})
+}
+
+func TestWalletIDFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(
+		t, application.DefaultWalletID, application.WalletIDFromContext(ctx),
+	)
+
+	ctx = application.ContextWithWalletID(ctx, "vault-1")
+	require.Equal(t, "vault-1", application.WalletIDFromContext(ctx))
+}
@@ -30,6 +30,7 @@ const (
 //   - Change the wallet password. It requires the wallet to be locked.
 //   - Get the status of the wallet (initialized, unlocked, inSync).
 //   - Get non-sensiive (network, native asset) and possibly sensitive info (root path, master blinding key and basic accounts' info) about the wallet. Sensitive info are returned only if the wallet is unlocked.
+//   - Export an encrypted backup of the whole state of the daemon, and import it, possibly into a daemon with a different type of repo manager.
 //
 // This service doesn't register any handler for wallet events, rather it
 // allows its users to register their handler to manage situations like the
@@ -90,10 +91,10 @@ func (ws *WalletService) CreateWallet(
 	}()
 
 	if ws.isInitialized() {
-		return fmt.Errorf("wallet is already initialized")
+		return newError(ReasonWalletAlreadyInitialized, "wallet is already initialized")
 	}
 
-	_, birthdayBlockHeight, err := ws.bcScanner.GetLatestBlock()
+	_, birthdayBlockHeight, err := ws.bcScanner.GetLatestBlock(ctx)
 	if err != nil {
 		return
 	}
@@ -184,7 +185,7 @@ func (ws *WalletService) RestoreWallet(
 
 	if ws.isInitialized() {
 		sendMessage(canceled, chMessages, WalletRestoreMessage{
-			Err: fmt.Errorf("wallet is already initialized"),
+			Err: newError(ReasonWalletAlreadyInitialized, "wallet is already initialized"),
 		})
 		return
 	}
@@ -383,7 +384,7 @@ func (ws *WalletService) RestoreWallet(
 	for _, accountAddresses := range addressesByAccount {
 		addresses = append(addresses, accountAddresses...)
 	}
-	utxos, err := ws.bcScanner.GetUtxosForAddresses(addresses)
+	utxos, err := ws.bcScanner.GetUtxosForAddresses(ctx, addresses)
 	if err != nil {
 		sendMessage(canceled, chMessages, WalletRestoreMessage{Err: err})
 		return
@@ -442,7 +443,7 @@ func (ws *WalletService) GetInfo(ctx context.Context) (*WalletInfo, error) {
 		}, nil
 	}
 
-	birthdayBlock, _ := ws.bcScanner.GetBlockHash(w.BirthdayBlockHeight)
+	birthdayBlock, _ := ws.bcScanner.GetBlockHash(ctx, w.BirthdayBlockHeight)
 	accounts := make([]AccountInfo, 0, len(w.Accounts))
 	for _, a := range w.Accounts {
 		accounts = append(accounts, AccountInfo{a.AccountInfo})
@@ -470,6 +471,66 @@ func (ws *WalletService) Auth(
 	return wallet.IsValidPassword(password), nil
 }
 
+// ExportBackup returns a backup of the wallet, external scripts and spending
+// policies, encrypted with the wallet password. If withCache is true, also
+// utxos and transactions are included.
+func (ws *WalletService) ExportBackup(
+	ctx context.Context, password string, withCache bool,
+) ([]byte, error) {
+	if !ws.isInitialized() {
+		return nil, newError(ReasonWalletNotInitialized, "wallet is not initialized")
+	}
+
+	w, err := ws.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !w.IsValidPassword(password) {
+		return nil, domain.ErrWalletInvalidPassword
+	}
+
+	payload, err := exportBackupPayload(ctx, ws.repoManager, withCache)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.log(
+		"exported backup with %d accounts, %d utxos and %d txs",
+		len(payload.Wallet.Accounts), len(payload.Utxos),
+		len(payload.Transactions),
+	)
+	return encodeBackup(payload, password)
+}
+
+// ImportBackup restores the state of the daemon from the given backup,
+// decrypted with the given password. The wallet must not be initialized and
+// is locked once imported.
+func (ws *WalletService) ImportBackup(
+	ctx context.Context, backup []byte, password string,
+) error {
+	if ws.isInitialized() {
+		return newError(ReasonWalletAlreadyInitialized, "wallet is already initialized")
+	}
+
+	payload, err := decodeBackup(backup, password, ws.network.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := importBackupPayload(ctx, ws.repoManager, payload); err != nil {
+		return err
+	}
+
+	ws.setInitialized()
+	ws.setSynced()
+	ws.log(
+		"imported backup with %d accounts, %d utxos and %d txs",
+		len(payload.Wallet.Accounts), len(payload.Utxos),
+		len(payload.Transactions),
+	)
+	return nil
+}
+
 func (ws *WalletService) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -52,6 +53,8 @@ func TestWalletService(t *testing.T) {
 	testInitWalletFromScratch(t)
 
 	testInitWalletFromRestart(t)
+
+	testExportImportBackup(t)
 }
 
 func testInitWalletFromScratch(t *testing.T) {
@@ -167,6 +170,91 @@ func testInitWalletFromRestart(t *testing.T) {
 	})
 }
 
+func testExportImportBackup(t *testing.T) {
+	t.Run("export_import_backup", func(t *testing.T) {
+		// The backup payload must be really encrypted to be decrypted back.
+		mockedMnemonicCypher := domain.MnemonicCypher
+		domain.MnemonicCypher = xorMnemonicCypher{}
+		defer func() { domain.MnemonicCypher = mockedMnemonicCypher }()
+
+		domain.MnemonicStore = newInMemoryMnemonicStore()
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("GetBlockHash", mock.Anything).Return(birthdayBlockHash, nil)
+		repoManager, err := newRepoManagerForExistingWallet()
+		require.NoError(t, err)
+
+		script := domain.AddressInfo{
+			Account: "test", Script: hex.EncodeToString(randomBytes(22)),
+		}
+		_, err = repoManager.ExternalScriptRepository().AddScript(ctx, script)
+		require.NoError(t, err)
+
+		policy, err := domain.NewSpendingPolicy(
+			accountNamespace, []domain.AssetLimit{
+				{Asset: regtest.AssetID, SpendLimit: 100000},
+			}, nil, 0,
+		)
+		require.NoError(t, err)
+		err = repoManager.SpendingPolicyRepository().SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		svc := application.NewWalletService(
+			repoManager, mockedBcScanner, rootPath, regtest, buildInfo,
+		)
+
+		backup, err := svc.ExportBackup(ctx, newPassword, false)
+		require.EqualError(t, err, domain.ErrWalletInvalidPassword.Error())
+		require.Nil(t, backup)
+
+		backup, err = svc.ExportBackup(ctx, password, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, backup)
+
+		newRepoManager, err := newRepoManagerForNewWallet()
+		require.NoError(t, err)
+
+		newSvc := application.NewWalletService(
+			newRepoManager, mockedBcScanner, rootPath, regtest, buildInfo,
+		)
+
+		err = newSvc.ImportBackup(ctx, backup, newPassword)
+		require.EqualError(t, err, domain.ErrWalletInvalidPassword.Error())
+
+		mainnetSvc := application.NewWalletService(
+			newRepoManager, mockedBcScanner, rootPath, &network.Liquid, buildInfo,
+		)
+		err = mainnetSvc.ImportBackup(ctx, backup, password)
+		require.ErrorIs(t, err, application.ErrBackupNetworkMismatch)
+
+		err = newSvc.ImportBackup(ctx, backup, password)
+		require.NoError(t, err)
+
+		status := newSvc.GetStatus(ctx)
+		require.True(t, status.IsInitialized)
+		require.False(t, status.IsUnlocked)
+
+		err = newSvc.ImportBackup(ctx, backup, password)
+		require.Error(t, err)
+
+		wallet, err := repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		importedWallet, err := newRepoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		require.Equal(t, wallet, importedWallet)
+
+		scripts, err := newRepoManager.ExternalScriptRepository().GetAllScripts(ctx)
+		require.NoError(t, err)
+		require.Len(t, scripts, 1)
+		require.Equal(t, script.Script, scripts[0].Script)
+
+		importedPolicy, err := newRepoManager.SpendingPolicyRepository().GetPolicy(
+			ctx, accountNamespace,
+		)
+		require.NoError(t, err)
+		require.Equal(t, policy.AssetLimits, importedPolicy.AssetLimits)
+	})
+}
+
 // TODO: uncomment this test once supporting restring a wallet.
 // (Changes might be required)
 // func testInitWalletFromRestore(t *testing.T) {
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// AddressLabelRepository is the abstraction for any kind of database intended
+// to persist the labels of the addresses derived for the wallet accounts,
+// identified by their output script.
+type AddressLabelRepository interface {
+	// SetAddressLabels overwrites the labels of the given output script (hex
+	// encoded) of an account. An empty list removes all the labels.
+	SetAddressLabels(
+		ctx context.Context, accountName, script string, labels []string,
+	) error
+	// GetAddressLabelsForAccount returns the labels of the addresses of the
+	// given account, by output script.
+	GetAddressLabelsForAccount(
+		ctx context.Context, accountName string,
+	) (map[string][]string, error)
+	// DeleteAddressLabelsForAccount deletes the labels of all the addresses of
+	// the given account.
+	DeleteAddressLabelsForAccount(ctx context.Context, accountName string) error
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrAuditEventNotFound      = fmt.Errorf("audit event not found")
+	ErrAuditEventMissingMethod = fmt.Errorf("missing audit event method")
+	ErrAuditEventBrokenChain   = fmt.Errorf("audit event chain is broken")
+	ErrAuditEventInvalidHash   = fmt.Errorf("audit event hash mismatch")
+	ErrAuditEventInvalidIndex  = fmt.Errorf("audit event sequence mismatch")
+)
+
+// AuditEvent is the data structure representing an entry of the
+// append-only audit log of the state-changing operations.
+// Every event commits to the previous one by including its hash, so that
+// tampering with any entry of the log breaks the chain from that point on.
+// The first event of the log has sequence 1 and empty PrevHash.
+type AuditEvent struct {
+	Sequence     uint64
+	Timestamp    int64
+	Caller       string
+	Method       string
+	AccountName  string
+	Amounts      map[string]uint64
+	Destinations []string
+	Txid         string
+	Error        string
+	PrevHash     string
+	Hash         string
+}
+
+// NewAuditEvent returns a new event chained to the given previous one,
+// which is nil if the log is empty.
+func NewAuditEvent(
+	prev *AuditEvent, caller, method, accountName string,
+	amounts map[string]uint64, destinations []string, txid, errMsg string,
+) (*AuditEvent, error) {
+	if method == "" {
+		return nil, ErrAuditEventMissingMethod
+	}
+
+	sequence, prevHash := uint64(1), ""
+	if prev != nil {
+		sequence, prevHash = prev.Sequence+1, prev.Hash
+	}
+	if amounts == nil {
+		amounts = make(map[string]uint64)
+	}
+	dests := append([]string{}, destinations...)
+	sort.Strings(dests)
+
+	event := &AuditEvent{
+		Sequence:     sequence,
+		Timestamp:    time.Now().Unix(),
+		Caller:       caller,
+		Method:       method,
+		AccountName:  accountName,
+		Amounts:      amounts,
+		Destinations: dests,
+		Txid:         txid,
+		Error:        errMsg,
+		PrevHash:     prevHash,
+	}
+	event.Hash = event.ComputeHash()
+	return event, nil
+}
+
+// ComputeHash returns the hex encoded sha256 hash of the event, committing
+// to all its fields but the hash itself.
+func (e *AuditEvent) ComputeHash() string {
+	assets := make([]string, 0, len(e.Amounts))
+	for asset := range e.Amounts {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+
+	h := sha256.New()
+	writeUint64 := func(n uint64) {
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, n)
+		h.Write(buf)
+	}
+	// Strings are length-prefixed to prevent ambiguous concatenations.
+	writeString := func(s string) {
+		writeUint64(uint64(len(s)))
+		h.Write([]byte(s))
+	}
+
+	writeUint64(e.Sequence)
+	writeUint64(uint64(e.Timestamp))
+	writeString(e.Caller)
+	writeString(e.Method)
+	writeString(e.AccountName)
+	writeUint64(uint64(len(assets)))
+	for _, asset := range assets {
+		writeString(asset)
+		writeUint64(e.Amounts[asset])
+	}
+	writeUint64(uint64(len(e.Destinations)))
+	for _, dest := range e.Destinations {
+		writeString(dest)
+	}
+	writeString(e.Txid)
+	writeString(e.Error)
+	writeString(e.PrevHash)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifyAuditEvents makes sure the given contiguous list of events, sorted by
+// sequence, is a valid chain. The previous event of the first one of the list
+// is required unless the list starts from the beginning of the log.
+func VerifyAuditEvents(prev *AuditEvent, events []AuditEvent) error {
+	for i, e := range events {
+		if e.ComputeHash() != e.Hash {
+			return fmt.Errorf("%w: event %d", ErrAuditEventInvalidHash, e.Sequence)
+		}
+
+		if prev == nil {
+			if i == 0 && e.Sequence == 1 && e.PrevHash == "" {
+				ev := e
+				prev = &ev
+				continue
+			}
+			return fmt.Errorf("%w: event %d", ErrAuditEventBrokenChain, e.Sequence)
+		}
+		if e.Sequence != prev.Sequence+1 {
+			return fmt.Errorf(
+				"%w: got %d, expected %d",
+				ErrAuditEventInvalidIndex, e.Sequence, prev.Sequence+1,
+			)
+		}
+		if e.PrevHash != prev.Hash {
+			return fmt.Errorf("%w: event %d", ErrAuditEventBrokenChain, e.Sequence)
+		}
+		ev := e
+		prev = &ev
+	}
+	return nil
+}
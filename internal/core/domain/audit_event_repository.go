@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+// AuditEventRepository is the abstraction for any kind of database intended
+// to persist the append-only log of AuditEvents.
+type AuditEventRepository interface {
+	// AddEvent appends the given event to the log. It returns false if an
+	// event with the same sequence already exists.
+	AddEvent(ctx context.Context, event *AuditEvent) (bool, error)
+	// GetLastEvent returns the most recent event of the log.
+	GetLastEvent(ctx context.Context) (*AuditEvent, error)
+	// GetEvents returns at most limit events of the log, sorted by sequence,
+	// starting from the given one included.
+	GetEvents(
+		ctx context.Context, fromSequence, limit uint64,
+	) ([]AuditEvent, error)
+}
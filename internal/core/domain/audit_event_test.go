@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	t.Parallel()
+
+	first, err := domain.NewAuditEvent(
+		nil, "alice", "Transfer", "test", map[string]uint64{testAsset: 1000},
+		[]string{testScript}, "", "",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, first)
+	require.Equal(t, uint64(1), first.Sequence)
+	require.Empty(t, first.PrevHash)
+	require.Equal(t, first.ComputeHash(), first.Hash)
+
+	second, err := domain.NewAuditEvent(
+		first, "bob", "Lock", "", nil, nil, "", "",
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), second.Sequence)
+	require.Equal(t, first.Hash, second.PrevHash)
+	require.NotEqual(t, first.Hash, second.Hash)
+
+	event, err := domain.NewAuditEvent(nil, "alice", "", "", nil, nil, "", "")
+	require.EqualError(t, err, domain.ErrAuditEventMissingMethod.Error())
+	require.Nil(t, event)
+}
+
+func TestVerifyAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	events := make([]domain.AuditEvent, 0, 3)
+	var prev *domain.AuditEvent
+	for i := 0; i < 3; i++ {
+		event, err := domain.NewAuditEvent(
+			prev, "alice", "SignPset", "test", map[string]uint64{testAsset: 1000},
+			[]string{testScript}, "", "",
+		)
+		require.NoError(t, err)
+		events = append(events, *event)
+		prev = event
+	}
+
+	require.NoError(t, domain.VerifyAuditEvents(nil, events))
+	require.NoError(t, domain.VerifyAuditEvents(&events[0], events[1:]))
+
+	err := domain.VerifyAuditEvents(nil, events[1:])
+	require.ErrorIs(t, err, domain.ErrAuditEventBrokenChain)
+
+	err = domain.VerifyAuditEvents(&events[0], events[2:])
+	require.ErrorIs(t, err, domain.ErrAuditEventInvalidIndex)
+
+	tampered := append([]domain.AuditEvent{}, events...)
+	tampered[1].Amounts = map[string]uint64{testAsset: 1}
+	err = domain.VerifyAuditEvents(nil, tampered)
+	require.ErrorIs(t, err, domain.ErrAuditEventInvalidHash)
+
+	// Recomputing the hash of a tampered event breaks the chain anyway.
+	tampered[1].Hash = tampered[1].ComputeHash()
+	err = domain.VerifyAuditEvents(nil, tampered)
+	require.ErrorIs(t, err, domain.ErrAuditEventBrokenChain)
+}
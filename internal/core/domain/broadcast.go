@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	ErrBroadcastNotFound     = fmt.Errorf("broadcast not found")
+	ErrBroadcastMissingTxid  = fmt.Errorf("missing broadcast txid")
+	ErrBroadcastMissingTxHex = fmt.Errorf("missing broadcast tx hex")
+	ErrBroadcastNotPending   = fmt.Errorf("broadcast is not pending")
+)
+
+// BroadcastStatus is the status of a Broadcast.
+type BroadcastStatus int
+
+const (
+	BroadcastStatusPending BroadcastStatus = iota
+	BroadcastStatusConfirmed
+	BroadcastStatusDropped
+	BroadcastStatusConflicted
+)
+
+var (
+	broadcastStatusString = map[BroadcastStatus]string{
+		BroadcastStatusPending:    "pending",
+		BroadcastStatusConfirmed:  "confirmed",
+		BroadcastStatusDropped:    "dropped",
+		BroadcastStatusConflicted: "conflicted",
+	}
+)
+
+func (s BroadcastStatus) String() string {
+	return broadcastStatusString[s]
+}
+
+// Broadcast is the data structure representing a tx broadcasted by the
+// wallet, tracked until it's included in the blockchain.
+// While pending, the tx is periodically looked up in mempool and blockchain,
+// at NextCheckAt, and rebroadcasted if missing. Attempts counts the
+// rebroadcasts.
+// A broadcast is dropped once the tx is still missing after the max number of
+// attempts, or it's conflicted if another tx, identified by ConflictingTxid,
+// spends any of its inputs. Both are terminal statuses, like confirmed.
+type Broadcast struct {
+	Txid            string
+	TxHex           string
+	Status          BroadcastStatus
+	Attempts        uint32
+	NextCheckAt     int64
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
+// NewBroadcast returns a new pending broadcast for the given tx, to be
+// checked at the given unix time.
+func NewBroadcast(txid, txHex string, nextCheckAt int64) (*Broadcast, error) {
+	if txid == "" {
+		return nil, ErrBroadcastMissingTxid
+	}
+	if txHex == "" {
+		return nil, ErrBroadcastMissingTxHex
+	}
+
+	return &Broadcast{
+		Txid:        txid,
+		TxHex:       txHex,
+		Status:      BroadcastStatusPending,
+		NextCheckAt: nextCheckAt,
+		CreatedAt:   time.Now().Unix(),
+	}, nil
+}
+
+// IsPending returns whether the tx is still waiting to be confirmed.
+func (b *Broadcast) IsPending() bool {
+	return b.Status == BroadcastStatusPending
+}
+
+// IsDue returns whether the pending tx must be checked at the given unix
+// time.
+func (b *Broadcast) IsDue(now int64) bool {
+	return b.IsPending() && now >= b.NextCheckAt
+}
+
+// Reschedule sets the unix time of the next check of the pending tx.
+func (b *Broadcast) Reschedule(nextCheckAt int64) error {
+	if !b.IsPending() {
+		return ErrBroadcastNotPending
+	}
+	b.NextCheckAt = nextCheckAt
+	return nil
+}
+
+// Rebroadcast records a new attempt to publish the pending tx and sets the
+// unix time of the next check.
+func (b *Broadcast) Rebroadcast(nextCheckAt int64) error {
+	if !b.IsPending() {
+		return ErrBroadcastNotPending
+	}
+	b.Attempts++
+	b.NextCheckAt = nextCheckAt
+	return nil
+}
+
+// Confirm marks the broadcast as confirmed.
+func (b *Broadcast) Confirm() error {
+	if !b.IsPending() {
+		return ErrBroadcastNotPending
+	}
+	b.Status = BroadcastStatusConfirmed
+	return nil
+}
+
+// Drop marks the broadcast as dropped.
+func (b *Broadcast) Drop() error {
+	if !b.IsPending() {
+		return ErrBroadcastNotPending
+	}
+	b.Status = BroadcastStatusDropped
+	return nil
+}
+
+// Conflict marks the broadcast as conflicted with the given tx.
+func (b *Broadcast) Conflict(txid string) error {
+	if !b.IsPending() {
+		return ErrBroadcastNotPending
+	}
+	b.Status = BroadcastStatusConflicted
+	b.ConflictingTxid = txid
+	return nil
+}
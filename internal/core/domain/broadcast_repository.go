@@ -0,0 +1,64 @@
+package domain
+
+import "context"
+
+const (
+	BroadcastPending BroadcastEventType = iota
+	BroadcastConfirmed
+	BroadcastDropped
+	BroadcastConflicted
+)
+
+var (
+	broadcastTypeString = map[BroadcastEventType]string{
+		BroadcastPending:    "BroadcastPending",
+		BroadcastConfirmed:  "BroadcastConfirmed",
+		BroadcastDropped:    "BroadcastDropped",
+		BroadcastConflicted: "BroadcastConflicted",
+	}
+	broadcastEventByStatus = map[BroadcastStatus]BroadcastEventType{
+		BroadcastStatusPending:    BroadcastPending,
+		BroadcastStatusConfirmed:  BroadcastConfirmed,
+		BroadcastStatusDropped:    BroadcastDropped,
+		BroadcastStatusConflicted: BroadcastConflicted,
+	}
+)
+
+type BroadcastEventType int
+
+func (t BroadcastEventType) String() string {
+	return broadcastTypeString[t]
+}
+
+// BroadcastEventTypeFromStatus returns the type of the event generated when
+// a broadcast moves to the given status.
+func BroadcastEventTypeFromStatus(status BroadcastStatus) BroadcastEventType {
+	return broadcastEventByStatus[status]
+}
+
+// BroadcastEvent holds info about an event occured within the repository.
+type BroadcastEvent struct {
+	EventType  BroadcastEventType
+	Broadcasts []Broadcast
+}
+
+// BroadcastRepository is the abstraction for any kind of database intended
+// to persist the Broadcasts of the txs tracked until confirmed.
+type BroadcastRepository interface {
+	// AddBroadcast adds the given broadcast to the repository by preventing
+	// duplicates.
+	// Generates a BroadcastPending event if successful.
+	AddBroadcast(ctx context.Context, broadcast *Broadcast) (bool, error)
+	// GetBroadcast returns the broadcast of the given tx.
+	GetBroadcast(ctx context.Context, txid string) (*Broadcast, error)
+	// GetPendingBroadcasts returns the broadcasts of the txs not yet
+	// confirmed, dropped or conflicted, sorted by creation time.
+	GetPendingBroadcasts(ctx context.Context) ([]Broadcast, error)
+	// UpdateBroadcasts overwrites the status, the attempts, the next check
+	// time and the conflicting txid of the given broadcasts.
+	// Generates an event for every status the broadcasts are moved to,
+	// excluding those whose status didn't change.
+	UpdateBroadcasts(ctx context.Context, broadcasts []Broadcast) error
+	// GetEventChannel returns the channel of BroadcastEvents.
+	GetEventChannel() chan BroadcastEvent
+}
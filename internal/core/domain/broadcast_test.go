@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+func TestBroadcast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		broadcast, err := domain.NewBroadcast("txid", "txhex", 100)
+		require.NoError(t, err)
+		require.NotNil(t, broadcast)
+		require.True(t, broadcast.IsPending())
+		require.False(t, broadcast.IsDue(99))
+		require.True(t, broadcast.IsDue(100))
+
+		err = broadcast.Reschedule(200)
+		require.NoError(t, err)
+		require.False(t, broadcast.IsDue(100))
+		require.Zero(t, broadcast.Attempts)
+
+		err = broadcast.Rebroadcast(300)
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), broadcast.Attempts)
+		require.Equal(t, int64(300), broadcast.NextCheckAt)
+
+		err = broadcast.Confirm()
+		require.NoError(t, err)
+		require.False(t, broadcast.IsPending())
+		require.False(t, broadcast.IsDue(300))
+		require.Equal(t, domain.BroadcastStatusConfirmed, broadcast.Status)
+
+		err = broadcast.Drop()
+		require.ErrorIs(t, err, domain.ErrBroadcastNotPending)
+		err = broadcast.Rebroadcast(400)
+		require.ErrorIs(t, err, domain.ErrBroadcastNotPending)
+
+		broadcast, err = domain.NewBroadcast("txid", "txhex", 100)
+		require.NoError(t, err)
+		err = broadcast.Drop()
+		require.NoError(t, err)
+		require.Equal(t, domain.BroadcastStatusDropped, broadcast.Status)
+
+		broadcast, err = domain.NewBroadcast("txid", "txhex", 100)
+		require.NoError(t, err)
+		err = broadcast.Conflict("othertxid")
+		require.NoError(t, err)
+		require.Equal(t, domain.BroadcastStatusConflicted, broadcast.Status)
+		require.Equal(t, "othertxid", broadcast.ConflictingTxid)
+
+		err = broadcast.Confirm()
+		require.ErrorIs(t, err, domain.ErrBroadcastNotPending)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			txid  string
+			txHex string
+			err   error
+		}{
+			{"missing_txid", "", "txhex", domain.ErrBroadcastMissingTxid},
+			{"missing_tx_hex", "txid", "", domain.ErrBroadcastMissingTxHex},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				broadcast, err := domain.NewBroadcast(tt.txid, tt.txHex, 0)
+				require.EqualError(t, err, tt.err.Error())
+				require.Nil(t, broadcast)
+			})
+		}
+	})
+}
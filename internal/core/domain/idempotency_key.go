@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyNotFound      = fmt.Errorf("idempotency key not found")
+	ErrIdempotencyKeyMissingKey    = fmt.Errorf("missing idempotency key")
+	ErrIdempotencyKeyMissingMethod = fmt.Errorf("missing idempotency key method")
+	ErrIdempotencyKeyInvalidTTL    = fmt.Errorf(
+		"idempotency key ttl must be a positive duration",
+	)
+)
+
+// IdempotencyKeyStatus is the status of the request associated with an
+// IdempotencyKey.
+type IdempotencyKeyStatus int
+
+const (
+	IdempotencyKeyPending IdempotencyKeyStatus = iota
+	IdempotencyKeyCompleted
+	IdempotencyKeyApprovalRequired
+)
+
+// IdempotencyKey is the data structure representing the client-provided key
+// of a non-idempotent request, like a transfer, bound to the request it was
+// first used with and, once completed, to its result.
+// Retries of the same request with the same key get back the stored Result
+// until the key expires, instead of being executed again.
+type IdempotencyKey struct {
+	Key         string
+	Method      string
+	RequestHash string
+	Status      IdempotencyKeyStatus
+	Result      string
+	CreatedAt   int64
+	ExpiresAt   int64
+}
+
+// NewIdempotencyKey returns a new pending key for the given request that
+// expires after ttl.
+func NewIdempotencyKey(
+	key, method, requestHash string, ttl time.Duration,
+) (*IdempotencyKey, error) {
+	if key == "" {
+		return nil, ErrIdempotencyKeyMissingKey
+	}
+	if method == "" {
+		return nil, ErrIdempotencyKeyMissingMethod
+	}
+	if ttl <= 0 {
+		return nil, ErrIdempotencyKeyInvalidTTL
+	}
+
+	now := time.Now()
+	return &IdempotencyKey{
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		Status:      IdempotencyKeyPending,
+		CreatedAt:   now.Unix(),
+		ExpiresAt:   now.Add(ttl).Unix(),
+	}, nil
+}
+
+// IsCompleted returns whether the request associated with the key has been
+// completed and its result stored.
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.Status == IdempotencyKeyCompleted
+}
+
+// IsApprovalRequired returns whether the request associated with the key
+// ended up waiting for a spend approval, whose id is stored as result.
+func (k *IdempotencyKey) IsApprovalRequired() bool {
+	return k.Status == IdempotencyKeyApprovalRequired
+}
+
+// IsExpired returns whether the key is expired at the given unix time.
+func (k *IdempotencyKey) IsExpired(now int64) bool {
+	return now >= k.ExpiresAt
+}
+
+// Matches returns whether the key was first used for the given request.
+func (k *IdempotencyKey) Matches(method, requestHash string) bool {
+	return k.Method == method && k.RequestHash == requestHash
+}
+
+// Complete stores the result of the request associated with the key.
+func (k *IdempotencyKey) Complete(result string) {
+	k.Status = IdempotencyKeyCompleted
+	k.Result = result
+}
+
+// RequireApproval stores the id of the spend approval the request associated
+// with the key is waiting for.
+func (k *IdempotencyKey) RequireApproval(approvalId string) {
+	k.Status = IdempotencyKeyApprovalRequired
+	k.Result = approvalId
+}
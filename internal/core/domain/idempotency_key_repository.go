@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+// IdempotencyKeyRepository is the abstraction for any kind of database
+// intended to persist IdempotencyKeys.
+type IdempotencyKeyRepository interface {
+	// AddKey stores the given key. It returns false if the key already
+	// exists.
+	AddKey(ctx context.Context, key *IdempotencyKey) (bool, error)
+	// GetKey returns the key with the given value.
+	GetKey(ctx context.Context, key string) (*IdempotencyKey, error)
+	// UpdateKey overwrites the status and the result of the given key.
+	UpdateKey(ctx context.Context, key *IdempotencyKey) error
+	// DeleteKey deletes the key with the given value, if existing.
+	DeleteKey(ctx context.Context, key string) error
+	// DeleteExpiredKeys deletes all keys expired at the given unix time and
+	// returns how many were deleted.
+	DeleteExpiredKeys(ctx context.Context, now int64) (int, error)
+}
@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := domain.NewIdempotencyKey("key", "Transfer", "hash", time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, key)
+		require.False(t, key.IsCompleted())
+		require.False(t, key.IsExpired(key.CreatedAt))
+		require.True(t, key.IsExpired(key.ExpiresAt))
+		require.True(t, key.Matches("Transfer", "hash"))
+		require.False(t, key.Matches("Transfer", "otherhash"))
+		require.False(t, key.Matches("BroadcastTransaction", "hash"))
+
+		key.Complete("result")
+		require.True(t, key.IsCompleted())
+		require.Equal(t, "result", key.Result)
+	})
+
+	t.Run("approval_required", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := domain.NewIdempotencyKey("key", "Transfer", "hash", time.Hour)
+		require.NoError(t, err)
+
+		key.RequireApproval("approvalid")
+		require.True(t, key.IsApprovalRequired())
+		require.False(t, key.IsCompleted())
+		require.Equal(t, "approvalid", key.Result)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			key    string
+			method string
+			ttl    time.Duration
+			err    error
+		}{
+			{"missing_key", "", "Transfer", time.Hour, domain.ErrIdempotencyKeyMissingKey},
+			{"missing_method", "key", "", time.Hour, domain.ErrIdempotencyKeyMissingMethod},
+			{"invalid_ttl", "key", "Transfer", 0, domain.ErrIdempotencyKeyInvalidTTL},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				key, err := domain.NewIdempotencyKey(tt.key, tt.method, "hash", tt.ttl)
+				require.EqualError(t, err, tt.err.Error())
+				require.Nil(t, key)
+			})
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrPaymentNotFound        = fmt.Errorf("payment not found")
+	ErrPaymentMissingAccount  = fmt.Errorf("missing payment account name")
+	ErrPaymentMissingAsset    = fmt.Errorf("missing payment asset")
+	ErrPaymentMissingScript   = fmt.Errorf("missing payment script")
+	ErrPaymentInvalidAmount   = fmt.Errorf("payment amount must be positive")
+	ErrPaymentInvalidDeadline = fmt.Errorf("payment deadline must not be negative")
+	ErrPaymentNotQueued       = fmt.Errorf("payment is not queued")
+	ErrPaymentNotBroadcasted  = fmt.Errorf("payment is not broadcasted")
+	ErrPaymentNotPending      = fmt.Errorf("payment is not pending")
+)
+
+// PaymentStatus is the status of a Payment.
+type PaymentStatus int
+
+const (
+	PaymentStatusQueued PaymentStatus = iota
+	PaymentStatusBroadcasted
+	PaymentStatusConfirmed
+	PaymentStatusPending
+)
+
+var (
+	paymentStatusString = map[PaymentStatus]string{
+		PaymentStatusQueued:      "queued",
+		PaymentStatusBroadcasted: "broadcasted",
+		PaymentStatusConfirmed:   "confirmed",
+		PaymentStatusPending:     "pending",
+	}
+)
+
+func (s PaymentStatus) String() string {
+	return paymentStatusString[s]
+}
+
+// Payment is the data structure representing an output, paid by an account,
+// waiting in a queue to be batched with others into a single transaction.
+// Once broadcasted, Txid and OutputIndex point to the output of the
+// transaction paying it. A payment is pending while bound to a transaction
+// not yet broadcasted, either about to be or waiting for spend approval, so
+// that it's never paid twice.
+// Deadline is the optional unix time within which the payment must be
+// broadcasted.
+type Payment struct {
+	ID          string
+	AccountName string
+	Asset       string
+	Amount      uint64
+	Script      string
+	BlindingKey string
+	Deadline    int64
+	Status      PaymentStatus
+	Txid        string
+	OutputIndex uint32
+	CreatedAt   int64
+}
+
+// NewPayment returns a new queued payment with a random id.
+func NewPayment(
+	accountName, asset string, amount uint64, script, blindingKey string,
+	deadline int64,
+) (*Payment, error) {
+	if accountName == "" {
+		return nil, ErrPaymentMissingAccount
+	}
+	if asset == "" {
+		return nil, ErrPaymentMissingAsset
+	}
+	if amount == 0 {
+		return nil, ErrPaymentInvalidAmount
+	}
+	if script == "" {
+		return nil, ErrPaymentMissingScript
+	}
+	if deadline < 0 {
+		return nil, ErrPaymentInvalidDeadline
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &Payment{
+		ID:          hex.EncodeToString(id),
+		AccountName: accountName,
+		Asset:       asset,
+		Amount:      amount,
+		Script:      script,
+		BlindingKey: blindingKey,
+		Deadline:    deadline,
+		Status:      PaymentStatusQueued,
+		CreatedAt:   time.Now().Unix(),
+	}, nil
+}
+
+// IsQueued returns whether the payment is still waiting to be broadcasted.
+func (p *Payment) IsQueued() bool {
+	return p.Status == PaymentStatusQueued
+}
+
+// IsPending returns whether the payment is bound to a transaction not yet
+// broadcasted.
+func (p *Payment) IsPending() bool {
+	return p.Status == PaymentStatusPending
+}
+
+// IsConfirmed returns whether the transaction paying the payment is
+// confirmed.
+func (p *Payment) IsConfirmed() bool {
+	return p.Status == PaymentStatusConfirmed
+}
+
+// IsDue returns whether the deadline of the payment, if any, is reached at
+// the given unix time.
+func (p *Payment) IsDue(now int64) bool {
+	return p.Deadline > 0 && now >= p.Deadline
+}
+
+// Pend binds the queued payment to the output of the transaction paying it,
+// before the transaction is broadcasted.
+func (p *Payment) Pend(txid string, outputIndex uint32) error {
+	if !p.IsQueued() {
+		return ErrPaymentNotQueued
+	}
+	p.Status = PaymentStatusPending
+	p.Txid = txid
+	p.OutputIndex = outputIndex
+	return nil
+}
+
+// Requeue puts back in the queue the pending payment whose transaction could
+// not be broadcasted.
+func (p *Payment) Requeue() error {
+	if !p.IsPending() {
+		return ErrPaymentNotPending
+	}
+	p.Status = PaymentStatusQueued
+	p.Txid = ""
+	p.OutputIndex = 0
+	return nil
+}
+
+// Broadcast binds the payment to the output of the broadcasted transaction
+// paying it.
+func (p *Payment) Broadcast(txid string, outputIndex uint32) error {
+	if !p.IsQueued() && !p.IsPending() {
+		return ErrPaymentNotQueued
+	}
+	p.Status = PaymentStatusBroadcasted
+	p.Txid = txid
+	p.OutputIndex = outputIndex
+	return nil
+}
+
+// Confirm marks the payment as confirmed. A pending payment can be confirmed
+// as well in case its transaction was broadcasted without being marked so.
+func (p *Payment) Confirm() error {
+	if p.Status != PaymentStatusBroadcasted && !p.IsPending() {
+		return ErrPaymentNotBroadcasted
+	}
+	p.Status = PaymentStatusConfirmed
+	return nil
+}
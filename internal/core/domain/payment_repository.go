@@ -0,0 +1,68 @@
+package domain
+
+import "context"
+
+const (
+	PaymentQueued PaymentEventType = iota
+	PaymentBroadcasted
+	PaymentConfirmed
+	PaymentPending
+)
+
+var (
+	paymentTypeString = map[PaymentEventType]string{
+		PaymentQueued:      "PaymentQueued",
+		PaymentBroadcasted: "PaymentBroadcasted",
+		PaymentConfirmed:   "PaymentConfirmed",
+		PaymentPending:     "PaymentPending",
+	}
+	paymentEventByStatus = map[PaymentStatus]PaymentEventType{
+		PaymentStatusQueued:      PaymentQueued,
+		PaymentStatusBroadcasted: PaymentBroadcasted,
+		PaymentStatusConfirmed:   PaymentConfirmed,
+		PaymentStatusPending:     PaymentPending,
+	}
+)
+
+type PaymentEventType int
+
+func (t PaymentEventType) String() string {
+	return paymentTypeString[t]
+}
+
+// PaymentEventTypeFromStatus returns the type of the event generated when a
+// payment moves to the given status.
+func PaymentEventTypeFromStatus(status PaymentStatus) PaymentEventType {
+	return paymentEventByStatus[status]
+}
+
+// PaymentEvent holds info about an event occured within the repository.
+type PaymentEvent struct {
+	EventType PaymentEventType
+	Payments  []Payment
+}
+
+// PaymentRepository is the abstraction for any kind of database intended to
+// persist the Payments of the payout queue.
+type PaymentRepository interface {
+	// AddPayment adds the given payment to the repository by preventing
+	// duplicates.
+	// Generates a PaymentQueued event if successful.
+	AddPayment(ctx context.Context, payment *Payment) (bool, error)
+	// GetPayment returns the payment identified by the given id.
+	GetPayment(ctx context.Context, id string) (*Payment, error)
+	// GetQueuedPayments returns the payments of the given account waiting to
+	// be broadcasted, or those of all accounts if not specified, sorted by
+	// creation time.
+	GetQueuedPayments(
+		ctx context.Context, accountName string,
+	) ([]Payment, error)
+	// GetPaymentsByTxid returns the payments paid by the given transaction.
+	GetPaymentsByTxid(ctx context.Context, txid string) ([]Payment, error)
+	// UpdatePayments overwrites the status, the txid and the output index of
+	// the given payments.
+	// Generates an event for every status the payments are moved to.
+	UpdatePayments(ctx context.Context, payments []Payment) error
+	// GetEventChannel returns the channel of PaymentEvents.
+	GetEventChannel() chan PaymentEvent
+}
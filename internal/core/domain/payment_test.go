@@ -0,0 +1,105 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+func TestPayment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		payment, err := domain.NewPayment(
+			"account", "asset", 1000, "script", "", 0,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, payment)
+		require.NotEmpty(t, payment.ID)
+		require.True(t, payment.IsQueued())
+		require.False(t, payment.IsDue(payment.CreatedAt))
+
+		err = payment.Confirm()
+		require.ErrorIs(t, err, domain.ErrPaymentNotBroadcasted)
+
+		err = payment.Broadcast("txid", 2)
+		require.NoError(t, err)
+		require.False(t, payment.IsQueued())
+		require.Equal(t, "txid", payment.Txid)
+		require.Equal(t, uint32(2), payment.OutputIndex)
+
+		err = payment.Broadcast("txid", 2)
+		require.ErrorIs(t, err, domain.ErrPaymentNotQueued)
+
+		err = payment.Confirm()
+		require.NoError(t, err)
+		require.True(t, payment.IsConfirmed())
+
+		payment, err = domain.NewPayment(
+			"account", "asset", 1000, "script", "", 0,
+		)
+		require.NoError(t, err)
+
+		err = payment.Requeue()
+		require.ErrorIs(t, err, domain.ErrPaymentNotPending)
+
+		err = payment.Pend("txid", 1)
+		require.NoError(t, err)
+		require.True(t, payment.IsPending())
+		require.Equal(t, "txid", payment.Txid)
+
+		err = payment.Pend("txid", 1)
+		require.ErrorIs(t, err, domain.ErrPaymentNotQueued)
+
+		err = payment.Requeue()
+		require.NoError(t, err)
+		require.True(t, payment.IsQueued())
+		require.Empty(t, payment.Txid)
+
+		err = payment.Pend("txid", 1)
+		require.NoError(t, err)
+		err = payment.Broadcast("txid", 1)
+		require.NoError(t, err)
+		require.False(t, payment.IsPending())
+
+		payment, err = domain.NewPayment(
+			"account", "asset", 1000, "script", "", 100,
+		)
+		require.NoError(t, err)
+		require.False(t, payment.IsDue(99))
+		require.True(t, payment.IsDue(100))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name        string
+			accountName string
+			asset       string
+			amount      uint64
+			script      string
+			deadline    int64
+			err         error
+		}{
+			{"missing_account", "", "asset", 1000, "script", 0, domain.ErrPaymentMissingAccount},
+			{"missing_asset", "account", "", 1000, "script", 0, domain.ErrPaymentMissingAsset},
+			{"invalid_amount", "account", "asset", 0, "script", 0, domain.ErrPaymentInvalidAmount},
+			{"missing_script", "account", "asset", 1000, "", 0, domain.ErrPaymentMissingScript},
+			{"invalid_deadline", "account", "asset", 1000, "script", -1, domain.ErrPaymentInvalidDeadline},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				payment, err := domain.NewPayment(
+					tt.accountName, tt.asset, tt.amount, tt.script, "", tt.deadline,
+				)
+				require.EqualError(t, err, tt.err.Error())
+				require.Nil(t, payment)
+			})
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// LocktimeThreshold is the value below which a tx locktime is interpreted as
+// a block height, and above which as a unix timestamp.
+const LocktimeThreshold = 500000000
+
+var (
+	ErrScheduledTxNotFound           = fmt.Errorf("scheduled tx not found")
+	ErrScheduledTxMissingTxid        = fmt.Errorf("missing scheduled tx txid")
+	ErrScheduledTxMissingTxHex       = fmt.Errorf("missing scheduled tx hex")
+	ErrScheduledTxInvalidBroadcastAt = fmt.Errorf(
+		"scheduled tx broadcast time must not be negative",
+	)
+	ErrScheduledTxNotScheduled = fmt.Errorf("tx is not scheduled")
+)
+
+// ScheduledTxStatus is the status of a ScheduledTx.
+type ScheduledTxStatus int
+
+const (
+	ScheduledTxStatusScheduled ScheduledTxStatus = iota
+	ScheduledTxStatusBroadcasted
+	ScheduledTxStatusCancelled
+	ScheduledTxStatusFailed
+)
+
+var (
+	scheduledTxStatusString = map[ScheduledTxStatus]string{
+		ScheduledTxStatusScheduled:   "scheduled",
+		ScheduledTxStatusBroadcasted: "broadcasted",
+		ScheduledTxStatusCancelled:   "cancelled",
+		ScheduledTxStatusFailed:      "failed",
+	}
+)
+
+func (s ScheduledTxStatus) String() string {
+	return scheduledTxStatusString[s]
+}
+
+// ScheduledTx is the data structure representing a pre-signed tx held by the
+// wallet until it can be broadcasted.
+// Locktime is the nLockTime of the tx, either a block height or a unix
+// timestamp depending on whether it's below LocktimeThreshold, while
+// BroadcastAt is the optional unix time before which the tx must not be
+// broadcasted. The tx is broadcasted once both are reached.
+// A scheduled tx can be cancelled before being broadcasted, or it fails if
+// its inputs are spent by another tx, identified by ConflictingTxid.
+type ScheduledTx struct {
+	Txid            string
+	TxHex           string
+	Locktime        uint32
+	BroadcastAt     int64
+	Status          ScheduledTxStatus
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
+// NewScheduledTx returns a new scheduled tx for the given tx, to be
+// broadcasted once its locktime and the given unix time are reached.
+func NewScheduledTx(
+	txid, txHex string, locktime uint32, broadcastAt int64,
+) (*ScheduledTx, error) {
+	if txid == "" {
+		return nil, ErrScheduledTxMissingTxid
+	}
+	if txHex == "" {
+		return nil, ErrScheduledTxMissingTxHex
+	}
+	if broadcastAt < 0 {
+		return nil, ErrScheduledTxInvalidBroadcastAt
+	}
+
+	return &ScheduledTx{
+		Txid:        txid,
+		TxHex:       txHex,
+		Locktime:    locktime,
+		BroadcastAt: broadcastAt,
+		Status:      ScheduledTxStatusScheduled,
+		CreatedAt:   time.Now().Unix(),
+	}, nil
+}
+
+// IsScheduled returns whether the tx is still waiting to be broadcasted.
+func (s *ScheduledTx) IsScheduled() bool {
+	return s.Status == ScheduledTxStatusScheduled
+}
+
+// IsHeightLocked returns whether the locktime of the tx is a block height.
+func (s *ScheduledTx) IsHeightLocked() bool {
+	return s.Locktime > 0 && s.Locktime < LocktimeThreshold
+}
+
+// IsDue returns whether the scheduled tx can be broadcasted given the height
+// and the timestamp of the latest block, and the current unix time.
+func (s *ScheduledTx) IsDue(tipHeight uint32, tipTimestamp, now int64) bool {
+	if !s.IsScheduled() || now < s.BroadcastAt {
+		return false
+	}
+	if s.Locktime == 0 {
+		return true
+	}
+	// A tx can be included in the next block if its locktime is lower than
+	// the height or the time of the block.
+	if s.IsHeightLocked() {
+		return tipHeight >= s.Locktime
+	}
+	return tipTimestamp >= int64(s.Locktime)
+}
+
+// Broadcast marks the scheduled tx as broadcasted.
+func (s *ScheduledTx) Broadcast() error {
+	if !s.IsScheduled() {
+		return ErrScheduledTxNotScheduled
+	}
+	s.Status = ScheduledTxStatusBroadcasted
+	return nil
+}
+
+// Cancel marks the scheduled tx as cancelled.
+func (s *ScheduledTx) Cancel() error {
+	if !s.IsScheduled() {
+		return ErrScheduledTxNotScheduled
+	}
+	s.Status = ScheduledTxStatusCancelled
+	return nil
+}
+
+// Fail marks the scheduled tx as failed because conflicting with the given
+// tx.
+func (s *ScheduledTx) Fail(conflictingTxid string) error {
+	if !s.IsScheduled() {
+		return ErrScheduledTxNotScheduled
+	}
+	s.Status = ScheduledTxStatusFailed
+	s.ConflictingTxid = conflictingTxid
+	return nil
+}
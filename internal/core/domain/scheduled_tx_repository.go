@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+// ScheduledTxRepository is the abstraction for any kind of database intended
+// to persist the ScheduledTxs held by the wallet until they can be
+// broadcasted.
+type ScheduledTxRepository interface {
+	// AddScheduledTx adds the given scheduled tx to the repository by
+	// preventing duplicates.
+	AddScheduledTx(ctx context.Context, tx *ScheduledTx) (bool, error)
+	// GetScheduledTx returns the scheduled tx with the given hash.
+	GetScheduledTx(ctx context.Context, txid string) (*ScheduledTx, error)
+	// GetAllScheduledTxs returns all the scheduled txs, whatever their
+	// status, sorted by creation time.
+	GetAllScheduledTxs(ctx context.Context) ([]ScheduledTx, error)
+	// GetPendingScheduledTxs returns the scheduled txs not yet broadcasted,
+	// cancelled or failed, sorted by creation time.
+	GetPendingScheduledTxs(ctx context.Context) ([]ScheduledTx, error)
+	// UpdateScheduledTx overwrites the status and the conflicting txid of the
+	// given scheduled tx.
+	UpdateScheduledTx(ctx context.Context, tx *ScheduledTx) error
+}
@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+func TestScheduledTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := domain.NewScheduledTx("txid", "txhex", 0, 100)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		require.True(t, tx.IsScheduled())
+		require.False(t, tx.IsHeightLocked())
+		require.False(t, tx.IsDue(0, 0, 99))
+		require.True(t, tx.IsDue(0, 0, 100))
+
+		tx, err = domain.NewScheduledTx("txid", "txhex", 1000, 0)
+		require.NoError(t, err)
+		require.True(t, tx.IsHeightLocked())
+		require.False(t, tx.IsDue(999, 0, 100))
+		require.True(t, tx.IsDue(1000, 0, 100))
+
+		tx, err = domain.NewScheduledTx("txid", "txhex", 1700000000, 0)
+		require.NoError(t, err)
+		require.False(t, tx.IsHeightLocked())
+		require.False(t, tx.IsDue(1000, 1699999999, 1700000000))
+		require.True(t, tx.IsDue(1000, 1700000000, 1700000000))
+
+		err = tx.Broadcast()
+		require.NoError(t, err)
+		require.False(t, tx.IsScheduled())
+		require.False(t, tx.IsDue(1000, 1700000000, 1700000000))
+		require.Equal(t, domain.ScheduledTxStatusBroadcasted, tx.Status)
+
+		err = tx.Cancel()
+		require.ErrorIs(t, err, domain.ErrScheduledTxNotScheduled)
+
+		tx, err = domain.NewScheduledTx("txid", "txhex", 0, 0)
+		require.NoError(t, err)
+		err = tx.Cancel()
+		require.NoError(t, err)
+		require.Equal(t, domain.ScheduledTxStatusCancelled, tx.Status)
+
+		tx, err = domain.NewScheduledTx("txid", "txhex", 0, 0)
+		require.NoError(t, err)
+		err = tx.Fail("othertxid")
+		require.NoError(t, err)
+		require.Equal(t, domain.ScheduledTxStatusFailed, tx.Status)
+		require.Equal(t, "othertxid", tx.ConflictingTxid)
+
+		err = tx.Broadcast()
+		require.ErrorIs(t, err, domain.ErrScheduledTxNotScheduled)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name        string
+			txid        string
+			txHex       string
+			broadcastAt int64
+			err         error
+		}{
+			{"missing_txid", "", "txhex", 0, domain.ErrScheduledTxMissingTxid},
+			{"missing_tx_hex", "txid", "", 0, domain.ErrScheduledTxMissingTxHex},
+			{
+				"negative_broadcast_at", "txid", "txhex", -1,
+				domain.ErrScheduledTxInvalidBroadcastAt,
+			},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				tx, err := domain.NewScheduledTx(tt.txid, tt.txHex, 0, tt.broadcastAt)
+				require.EqualError(t, err, tt.err.Error())
+				require.Nil(t, tx)
+			})
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultSpendLimitWindow is the rolling window applied to an asset spend
+	// limit if not otherwise specified.
+	DefaultSpendLimitWindow = 24 * time.Hour
+)
+
+var (
+	ErrPolicyNotFound             = fmt.Errorf("spending policy not found")
+	ErrPolicyMissingAccount       = fmt.Errorf("missing policy account name")
+	ErrPolicyInvalidWindow        = fmt.Errorf("spend limit window must not be negative")
+	ErrPolicySpendLimitExceeded   = fmt.Errorf("spend limit exceeded")
+	ErrPolicyDestinationForbidden = fmt.Errorf("destination script not allowed")
+	ErrPolicyFeeRateTooHigh       = fmt.Errorf("fee rate exceeds max allowed")
+	ErrSpendApprovalNotFound      = fmt.Errorf("spend approval not found")
+	ErrSpendApprovalSameCaller    = fmt.Errorf(
+		"spend approval must come from a caller other than the requester",
+	)
+)
+
+// AssetLimit holds the restrictions applied to the amounts of a certain
+// asset spent by an account.
+// A zero SpendLimit or ApprovalThreshold means the relative restriction is
+// disabled.
+type AssetLimit struct {
+	Asset string
+	// SpendLimit is the max amount that can be spent within the rolling window.
+	SpendLimit uint64
+	// WindowSeconds is the duration of the rolling window, defaults to
+	// DefaultSpendLimitWindow if zero.
+	WindowSeconds int64
+	// ApprovalThreshold is the amount above which spending within a single
+	// transaction requires an explicit approval.
+	ApprovalThreshold uint64
+}
+
+// Window returns the rolling window of the spend limit.
+func (l AssetLimit) Window() time.Duration {
+	if l.WindowSeconds <= 0 {
+		return DefaultSpendLimitWindow
+	}
+	return time.Duration(l.WindowSeconds) * time.Second
+}
+
+// Spend represents an amount of some asset spent by an account at a given
+// time.
+type Spend struct {
+	Asset     string
+	Amount    uint64
+	Timestamp int64
+}
+
+// SpendingPolicy is the data structure representing the rules that any
+// transaction spending the funds of an account must respect before being
+// signed by the wallet.
+type SpendingPolicy struct {
+	AccountName         string
+	AssetLimits         map[string]AssetLimit
+	AllowedScripts      map[string]struct{}
+	MaxMillisatsPerByte uint64
+	Spends              []Spend
+}
+
+// NewSpendingPolicy returns a new policy for the given account with the given
+// rules.
+func NewSpendingPolicy(
+	accountName string, limits []AssetLimit, allowedScripts []string,
+	maxMillisatsPerByte uint64,
+) (*SpendingPolicy, error) {
+	if accountName == "" {
+		return nil, ErrPolicyMissingAccount
+	}
+
+	assetLimits := make(map[string]AssetLimit)
+	for _, l := range limits {
+		if l.WindowSeconds < 0 {
+			return nil, ErrPolicyInvalidWindow
+		}
+		assetLimits[l.Asset] = l
+	}
+	scripts := make(map[string]struct{})
+	for _, s := range allowedScripts {
+		scripts[s] = struct{}{}
+	}
+
+	return &SpendingPolicy{
+		AccountName:         accountName,
+		AssetLimits:         assetLimits,
+		AllowedScripts:      scripts,
+		MaxMillisatsPerByte: maxMillisatsPerByte,
+	}, nil
+}
+
+// SpentAmount returns the amount of the given asset spent within the rolling
+// window of the relative limit.
+func (p *SpendingPolicy) SpentAmount(asset string, now time.Time) uint64 {
+	window := DefaultSpendLimitWindow
+	if l, ok := p.AssetLimits[asset]; ok {
+		window = l.Window()
+	}
+	from := now.Add(-window).Unix()
+
+	amount := uint64(0)
+	for _, s := range p.Spends {
+		if s.Asset == asset && s.Timestamp > from {
+			amount += s.Amount
+		}
+	}
+	return amount
+}
+
+// Check verifies that spending the given amounts to the given destination
+// scripts (hex encoded) with the given fee rate respects the policy.
+// It returns whether the spending requires an explicit approval because one
+// or more amounts exceed the relative threshold.
+func (p *SpendingPolicy) Check(
+	amounts map[string]uint64, destinations []string, millisatsPerByte uint64,
+	now time.Time,
+) (bool, error) {
+	if p.MaxMillisatsPerByte > 0 && millisatsPerByte > p.MaxMillisatsPerByte {
+		return false, fmt.Errorf(
+			"%w: %d > %d mSat/byte",
+			ErrPolicyFeeRateTooHigh, millisatsPerByte, p.MaxMillisatsPerByte,
+		)
+	}
+
+	if len(p.AllowedScripts) > 0 {
+		for _, script := range destinations {
+			if _, ok := p.AllowedScripts[script]; !ok {
+				return false, fmt.Errorf(
+					"%w: %s", ErrPolicyDestinationForbidden, script,
+				)
+			}
+		}
+	}
+
+	requiresApproval := false
+	for asset, amount := range amounts {
+		limit, ok := p.AssetLimits[asset]
+		if !ok {
+			continue
+		}
+		if limit.SpendLimit > 0 {
+			spent := p.SpentAmount(asset, now)
+			if spent+amount > limit.SpendLimit {
+				return false, fmt.Errorf(
+					"%w: asset %s, spent %d of %d in the last %s",
+					ErrPolicySpendLimitExceeded, asset, spent, limit.SpendLimit,
+					limit.Window(),
+				)
+			}
+		}
+		if limit.ApprovalThreshold > 0 && amount > limit.ApprovalThreshold {
+			requiresApproval = true
+		}
+	}
+
+	return requiresApproval, nil
+}
+
+// AddSpends records the given amounts as spent at the given time and drops
+// those not falling anymore within the rolling window of any limit.
+func (p *SpendingPolicy) AddSpends(amounts map[string]uint64, now time.Time) {
+	window := DefaultSpendLimitWindow
+	for _, l := range p.AssetLimits {
+		if l.Window() > window {
+			window = l.Window()
+		}
+	}
+	from := now.Add(-window).Unix()
+
+	spends := make([]Spend, 0, len(p.Spends)+len(amounts))
+	for _, s := range p.Spends {
+		if s.Timestamp > from {
+			spends = append(spends, s)
+		}
+	}
+	for asset, amount := range amounts {
+		if amount == 0 {
+			continue
+		}
+		spends = append(spends, Spend{
+			Asset:     asset,
+			Amount:    amount,
+			Timestamp: now.Unix(),
+		})
+	}
+	p.Spends = spends
+}
+
+// GetAllowedScripts returns the map of allowed destination scripts as a
+// slice.
+func (p *SpendingPolicy) GetAllowedScripts() []string {
+	scripts := make([]string, 0, len(p.AllowedScripts))
+	for script := range p.AllowedScripts {
+		scripts = append(scripts, script)
+	}
+	return scripts
+}
+
+// SpendApproval is the data structure representing a transaction, either
+// raw or partial, whose signing has been suspended until approved by a caller
+// other than the one requesting it.
+type SpendApproval struct {
+	ID          string
+	Tx          string
+	SighashType uint32
+	RequestedBy string
+	Timestamp   int64
+}
+
+// CanBeApprovedBy returns whether the given caller is allowed to approve the
+// spending.
+func (a *SpendApproval) CanBeApprovedBy(caller string) error {
+	if caller == "" || caller == a.RequestedBy {
+		return ErrSpendApprovalSameCaller
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "context"
+
+// SpendingPolicyRepository is the abstraction for any kind of database
+// intended to persist the SpendingPolicies of the wallet accounts and the
+// SpendApprovals waiting to be approved.
+type SpendingPolicyRepository interface {
+	// SetPolicy adds the given policy to the repository or overwrites the one
+	// already existing for the same account.
+	SetPolicy(ctx context.Context, policy *SpendingPolicy) error
+	// GetPolicy returns the policy of the given account, if existing.
+	GetPolicy(ctx context.Context, accountName string) (*SpendingPolicy, error)
+	// UpdatePolicy allows to commit multiple changes to the same policy in a
+	// transactional way.
+	UpdatePolicy(
+		ctx context.Context, accountName string,
+		updateFn func(p *SpendingPolicy) (*SpendingPolicy, error),
+	) error
+	// DeletePolicy removes the policy of the given account from the
+	// repository.
+	DeletePolicy(ctx context.Context, accountName string) (bool, error)
+	// AddApproval adds the given spend approval to the repository by
+	// preventing duplicates.
+	AddApproval(ctx context.Context, approval *SpendApproval) (bool, error)
+	// GetApproval returns the spend approval identified by the given id.
+	GetApproval(ctx context.Context, id string) (*SpendApproval, error)
+	// GetAllApprovals returns all the spend approvals waiting to be approved.
+	GetAllApprovals(ctx context.Context) ([]SpendApproval, error)
+	// DeleteApproval removes the spend approval identified by the given id
+	// from the repository.
+	DeleteApproval(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,118 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const (
+	testAsset  = "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	testScript = "0014f2d9a0c4e1a5a3c3dc3d6b1c2c49ba6bc2e0b3f1"
+)
+
+func TestNewSpendingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := domain.NewSpendingPolicy(
+		"test", []domain.AssetLimit{{Asset: testAsset, SpendLimit: 1000}},
+		[]string{testScript}, 200,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	require.Len(t, policy.AssetLimits, 1)
+	require.Equal(t, domain.DefaultSpendLimitWindow, policy.AssetLimits[testAsset].Window())
+	require.Equal(t, []string{testScript}, policy.GetAllowedScripts())
+
+	policy, err = domain.NewSpendingPolicy("", nil, nil, 0)
+	require.EqualError(t, err, domain.ErrPolicyMissingAccount.Error())
+	require.Nil(t, policy)
+
+	policy, err = domain.NewSpendingPolicy(
+		"test", []domain.AssetLimit{{Asset: testAsset, WindowSeconds: -1}}, nil, 0,
+	)
+	require.EqualError(t, err, domain.ErrPolicyInvalidWindow.Error())
+	require.Nil(t, policy)
+}
+
+func TestCheckSpendingPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	policy, err := domain.NewSpendingPolicy(
+		"test", []domain.AssetLimit{
+			{Asset: testAsset, SpendLimit: 1000, WindowSeconds: 3600, ApprovalThreshold: 500},
+		},
+		[]string{testScript}, 200,
+	)
+	require.NoError(t, err)
+
+	requiresApproval, err := policy.Check(
+		map[string]uint64{testAsset: 400}, []string{testScript}, 100, now,
+	)
+	require.NoError(t, err)
+	require.False(t, requiresApproval)
+
+	requiresApproval, err = policy.Check(
+		map[string]uint64{testAsset: 600}, []string{testScript}, 100, now,
+	)
+	require.NoError(t, err)
+	require.True(t, requiresApproval)
+
+	_, err = policy.Check(
+		map[string]uint64{testAsset: 400}, []string{testScript}, 300, now,
+	)
+	require.ErrorIs(t, err, domain.ErrPolicyFeeRateTooHigh)
+
+	_, err = policy.Check(
+		map[string]uint64{testAsset: 400}, []string{"0014"}, 100, now,
+	)
+	require.ErrorIs(t, err, domain.ErrPolicyDestinationForbidden)
+
+	policy.AddSpends(map[string]uint64{testAsset: 800}, now.Add(-time.Minute))
+	require.Equal(t, uint64(800), policy.SpentAmount(testAsset, now))
+
+	_, err = policy.Check(
+		map[string]uint64{testAsset: 400}, []string{testScript}, 100, now,
+	)
+	require.ErrorIs(t, err, domain.ErrPolicySpendLimitExceeded)
+
+	// Spends older than the rolling window don't count anymore.
+	later := now.Add(2 * time.Hour)
+	require.Zero(t, policy.SpentAmount(testAsset, later))
+	requiresApproval, err = policy.Check(
+		map[string]uint64{testAsset: 400}, []string{testScript}, 100, later,
+	)
+	require.NoError(t, err)
+	require.False(t, requiresApproval)
+}
+
+func TestAddSpends(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	policy, err := domain.NewSpendingPolicy("test", nil, nil, 0)
+	require.NoError(t, err)
+
+	policy.AddSpends(map[string]uint64{testAsset: 100}, now.Add(-48*time.Hour))
+	require.Len(t, policy.Spends, 1)
+
+	policy.AddSpends(map[string]uint64{testAsset: 100, "": 0}, now)
+	require.Len(t, policy.Spends, 1)
+	require.Equal(t, now.Unix(), policy.Spends[0].Timestamp)
+}
+
+func TestCanApproveSpend(t *testing.T) {
+	t.Parallel()
+
+	approval := domain.SpendApproval{RequestedBy: "alice"}
+	require.ErrorIs(
+		t, approval.CanBeApprovedBy("alice"), domain.ErrSpendApprovalSameCaller,
+	)
+	require.ErrorIs(
+		t, approval.CanBeApprovedBy(""), domain.ErrSpendApprovalSameCaller,
+	)
+	require.NoError(t, approval.CanBeApprovedBy("bob"))
+}
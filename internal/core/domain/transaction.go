@@ -3,6 +3,8 @@ package domain
 // Transaction is the data structure representing an Elements tx with extra
 // info like whether it is conifirmed/unconfirmed and the name of the accounts
 // owning one or more of its inputs.
+// An evicted tx is one replaced by the tx identified by EvictedBy, therefore
+// it will never be confirmed.
 type Transaction struct {
 	TxID        string
 	TxHex       string
@@ -10,6 +12,7 @@ type Transaction struct {
 	BlockHeight uint64
 	BlockTime   int64
 	Accounts    map[string]struct{}
+	EvictedBy   string
 }
 
 // IsConfirmed returns whther the tx is included in the blockchain.
@@ -30,6 +33,19 @@ func (t *Transaction) Confirm(
 	t.BlockTime = blockTime
 }
 
+// IsEvicted returns whether the tx has been replaced by another one.
+func (t *Transaction) IsEvicted() bool {
+	return t.EvictedBy != ""
+}
+
+// Evict marks the tx as replaced by the one identified by the given txid.
+func (t *Transaction) Evict(txid string) {
+	if t.IsConfirmed() || t.IsEvicted() {
+		return
+	}
+	t.EvictedBy = txid
+}
+
 // AddAccount adds the given account to the map of those involved in the tx.
 func (t *Transaction) AddAccount(accountName string) {
 	if t.Accounts == nil {
@@ -6,6 +6,7 @@ const (
 	TransactionAdded TransactionEventType = iota
 	TransactionUnconfirmed
 	TransactionConfirmed
+	TransactionEvicted
 )
 
 var (
@@ -13,6 +14,7 @@ var (
 		TransactionAdded:       "TransactionAdded",
 		TransactionUnconfirmed: "TransactionUnconfirmed",
 		TransactionConfirmed:   "TransactionConfirmed",
+		TransactionEvicted:     "TransactionEvicted",
 	}
 )
 
@@ -42,6 +44,12 @@ type TransactionRepository interface {
 		ctx context.Context,
 		txid, blockHash string, blockheight uint64, blocktime int64,
 	) (bool, error)
+	// EvictTransaction marks the unconfirmed Transaction identified by the
+	// given txid as replaced by the one identified by replacementTxid.
+	// Generates a TransactionEvicted event if successful.
+	EvictTransaction(
+		ctx context.Context, txid, replacementTxid string,
+	) (bool, error)
 	// GetTransaction returns the Transaction identified by the given txid.
 	GetTransaction(ctx context.Context, txid string) (*Transaction, error)
 	// UpdateTransaction allows to commit multiple changes to the same
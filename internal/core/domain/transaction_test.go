@@ -16,6 +16,25 @@ func TestConfirmTransaction(t *testing.T) {
 	require.True(t, tx.IsConfirmed())
 }
 
+func TestEvictTransaction(t *testing.T) {
+	tx := &domain.Transaction{}
+	require.False(t, tx.IsEvicted())
+
+	tx.Evict("3d4e1bdb33a8d8d3c1ed1cbd07c9d1b6f9ae2a9a35ecf3c82b8a3c4c5ff9d4a1")
+	require.True(t, tx.IsEvicted())
+
+	tx.Evict("8c2f1b0e6f0d3d2ab1fa9c0c6a5a1a7c4f3e54b1b6ad1f1f7e1cb2e3d4f5a6b7")
+	require.Equal(
+		t, "3d4e1bdb33a8d8d3c1ed1cbd07c9d1b6f9ae2a9a35ecf3c82b8a3c4c5ff9d4a1",
+		tx.EvictedBy,
+	)
+
+	confirmedTx := &domain.Transaction{}
+	confirmedTx.Confirm("fa84eb6806daf1b3c495ed30554d80573a39335b2993b66b3cc1afaa53816e47", 1728312, time.Now().Unix())
+	confirmedTx.Evict("3d4e1bdb33a8d8d3c1ed1cbd07c9d1b6f9ae2a9a35ecf3c82b8a3c4c5ff9d4a1")
+	require.False(t, confirmedTx.IsEvicted())
+}
+
 func TestAddAccounts(t *testing.T) {
 	tx := &domain.Transaction{}
 	accounts := tx.GetAccounts()
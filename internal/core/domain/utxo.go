@@ -1,8 +1,10 @@
 package domain
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -10,6 +12,8 @@ import (
 
 var (
 	ErrUtxoAlreadyLocked = fmt.Errorf("utxo is already locked")
+	ErrUtxoFrozen        = fmt.Errorf("utxo is frozen")
+	ErrUtxoLockNotOwned  = fmt.Errorf("utxo is locked by another owner")
 )
 
 // UtxoKey represents the key of an Utxo, composed by its txid and vout.
@@ -40,6 +44,8 @@ type UtxoInfo struct {
 	AccountName     string
 	SpentStatus     UtxoStatus
 	ConfirmedStatus UtxoStatus
+	Frozen          bool
+	Labels          []string
 }
 
 func (i UtxoInfo) Key() UtxoKey {
@@ -51,6 +57,7 @@ type Balance struct {
 	Confirmed   uint64
 	Unconfirmed uint64
 	Locked      uint64
+	Frozen      uint64
 }
 
 func (b *Balance) Total() uint64 {
@@ -65,8 +72,14 @@ type UtxoStatus struct {
 }
 
 // Utxo is the data structure representing an Elements UTXO with extra info
-// like whether it is spent/utxo, confirmed/unconfirmed or locked/unlocked and
-// the name of the account owning it.
+// like whether it is spent/utxo, confirmed/unconfirmed, locked/unlocked or
+// frozen, the name of the account owning it and the labels set by the user.
+// A lock is held by the owner identified by LockOwner, the only one allowed to
+// extend or release it before it expires.
+// Unlike locks, that expire, a frozen utxo is never selected for spending
+// until explicitly unfrozen.
+// An evicted utxo is the output of a tx replaced by another one, therefore it
+// will never be spendable.
 type Utxo struct {
 	UtxoKey
 	Value               uint64
@@ -82,6 +95,10 @@ type Utxo struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LockOwner           string
+	FrozenTimestamp     int64
+	EvictedTimestamp    int64
+	Labels              []string
 	SpentStatus         UtxoStatus
 	ConfirmedStatus     UtxoStatus
 }
@@ -117,6 +134,46 @@ func (u *Utxo) IsLocked() bool {
 	return u.LockTimestamp > 0
 }
 
+// NewUtxoLockOwner returns a random token identifying the owner of a lock.
+func NewUtxoLockOwner() (string, error) {
+	owner := make([]byte, 16)
+	if _, err := rand.Read(owner); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(owner), nil
+}
+
+// IsLockedBy returns whether the utxo is locked by the given owner.
+func (u *Utxo) IsLockedBy(owner string) bool {
+	return u.IsLocked() && len(owner) > 0 && u.LockOwner == owner
+}
+
+// CanLock returns whether the utxo can be locked by the given owner, that is
+// if it's not locked or if the lock is already held by the same owner.
+func (u *Utxo) CanLock(owner string) bool {
+	return !u.IsLocked() || u.IsLockedBy(owner)
+}
+
+// IsFrozen returns whether the utxo is frozen.
+func (u *Utxo) IsFrozen() bool {
+	return u.FrozenTimestamp > 0
+}
+
+// IsEvicted returns whether the utxo is the output of a replaced tx.
+func (u *Utxo) IsEvicted() bool {
+	return u.EvictedTimestamp > 0
+}
+
+// HasLabel returns whether the utxo is tagged with the given label.
+func (u *Utxo) HasLabel(label string) bool {
+	for _, l := range u.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 // CanUnlock reutrns whether a locked utxo can be unlocked.
 func (u *Utxo) CanUnlock() bool {
 	if !u.IsLocked() {
@@ -134,7 +191,7 @@ func (u *Utxo) Key() UtxoKey {
 func (u *Utxo) Info() UtxoInfo {
 	return UtxoInfo{
 		u.Key(), u.Value, u.Asset, u.Script, u.ValueBlinder, u.AssetBlinder,
-		u.AccountName, u.SpentStatus, u.ConfirmedStatus,
+		u.AccountName, u.SpentStatus, u.ConfirmedStatus, u.IsFrozen(), u.Labels,
 	}
 }
 
@@ -151,6 +208,23 @@ func (u *Utxo) Spend(txid string) error {
 		Txid: txid,
 	}
 	u.LockTimestamp = 0
+	u.LockOwner = ""
+	return nil
+}
+
+// Respend moves the spent status of a spent, unconfirmed utxo to the given
+// txid, that replaces the transaction that spent it before.
+func (u *Utxo) Respend(txid string) error {
+	if !u.IsSpent() || u.IsConfirmedSpent() || u.SpentStatus.Txid == txid {
+		return nil
+	}
+
+	if len(txid) <= 0 {
+		return fmt.Errorf("missing txid")
+	}
+	u.SpentStatus = UtxoStatus{
+		Txid: txid,
+	}
 	return nil
 }
 
@@ -193,20 +267,79 @@ func (u *Utxo) Confirm(status UtxoStatus) error {
 	return nil
 }
 
-// Lock marks the current utxo as locked.
-func (u *Utxo) Lock(timestamp, expiryTimestamp int64) {
+// Lock marks the current utxo as locked by the given owner, or extends the
+// lock expiration if already held by the same owner.
+func (u *Utxo) Lock(timestamp, expiryTimestamp int64, owner string) {
 	if !u.IsLocked() {
 		u.LockTimestamp = timestamp
 		u.LockExpiryTimestamp = expiryTimestamp
+		u.LockOwner = owner
+		return
+	}
+	if u.IsLockedBy(owner) {
+		u.LockExpiryTimestamp = expiryTimestamp
 	}
 }
 
-// Unlock marks the current locked utxo as unlocked.
+// Unlock marks the current locked utxo as unlocked if the lock expired.
 func (u *Utxo) Unlock() {
 	if !u.CanUnlock() {
 		return
 	}
 
+	u.Release()
+}
+
+// Release marks the current locked utxo as unlocked regardless of the lock
+// expiration.
+func (u *Utxo) Release() {
 	u.LockTimestamp = 0
 	u.LockExpiryTimestamp = 0
+	u.LockOwner = ""
+}
+
+// Freeze marks the current utxo as frozen.
+func (u *Utxo) Freeze(timestamp int64) {
+	if !u.IsFrozen() {
+		u.FrozenTimestamp = timestamp
+	}
+}
+
+// Unfreeze marks the current frozen utxo as unfrozen.
+func (u *Utxo) Unfreeze() {
+	u.FrozenTimestamp = 0
+}
+
+// Evict marks the current utxo as evicted and releases its lock, if any.
+func (u *Utxo) Evict(timestamp int64) {
+	if u.IsEvicted() {
+		return
+	}
+	u.EvictedTimestamp = timestamp
+	u.Release()
+}
+
+// SetLabels overwrites the labels of the current utxo, skipping empty and
+// duplicated ones.
+func (u *Utxo) SetLabels(labels []string) {
+	u.Labels = NormalizeLabels(labels)
+}
+
+// NormalizeLabels returns the given list of labels without empty and
+// duplicated ones, nil if empty.
+func NormalizeLabels(labels []string) []string {
+	var list []string
+	seen := make(map[string]struct{})
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if _, ok := seen[l]; ok {
+			continue
+		}
+		seen[l] = struct{}{}
+		list = append(list, l)
+	}
+	return list
 }
@@ -11,6 +11,9 @@ const (
 	UtxoUnlocked
 	UtxoSpent
 	UtxoConfirmedSpend
+	UtxoFrozen
+	UtxoUnfrozen
+	UtxoEvicted
 )
 
 var (
@@ -21,6 +24,9 @@ var (
 		UtxoUnlocked:       "UtxoUnlocked",
 		UtxoSpent:          "UtxoSpent",
 		UtxoConfirmedSpend: "UtxoConfirmedSpend",
+		UtxoFrozen:         "UtxoFrozen",
+		UtxoUnfrozen:       "UtxoUnfrozen",
+		UtxoEvicted:        "UtxoEvicted",
 	}
 )
 
@@ -48,36 +54,64 @@ type UtxoRepository interface {
 	// GetAllUtxos returns the entire UTXO set, included those locked or
 	// already spent.
 	GetAllUtxos(ctx context.Context) ([]*Utxo, error)
-	// GetSpendableUtxos returns all unlocked utxo UTXOs.
+	// GetSpendableUtxos returns all unlocked and unfrozen utxo UTXOs.
 	GetSpendableUtxos(ctx context.Context) ([]*Utxo, error)
 	// GetAllUtxosForAccount returns the list of all utxos for the given
 	// account.
 	GetAllUtxosForAccount(ctx context.Context, account string) ([]*Utxo, error)
 	// GetSpendableUtxosForAccount returns the list of spendable utxos for the
-	// given account. The list incldues only confirmed and unlocked utxos.
+	// given account. The list incldues only confirmed, unlocked and unfrozen
+	// utxos.
 	// Can be filtered by output scripts.
 	GetSpendableUtxosForAccount(ctx context.Context, account string, scripts [][]byte) ([]*Utxo, error)
-	// GetLockedUtxosForAccount returns the list of all currently locked utxos
-	// for the given account. Can be filtered by output scripts.
+	// GetLockedUtxosForAccount returns the list of all currently locked and
+	// unfrozen utxos for the given account. Can be filtered by output scripts.
 	GetLockedUtxosForAccount(ctx context.Context, account string, scripts [][]byte) ([]*Utxo, error)
-	// GetBalanceForAccount returns the confirmed, unconfirmed and locked
-	// balances per each asset for the given account.
+	// GetFrozenUtxosForAccount returns the list of all unspent frozen utxos
+	// for the given account. Can be filtered by output scripts.
+	GetFrozenUtxosForAccount(ctx context.Context, account string, scripts [][]byte) ([]*Utxo, error)
+	// GetBalanceForAccount returns the confirmed, unconfirmed, locked and
+	// frozen balances per each asset for the given account.
 	GetBalanceForAccount(ctx context.Context, account string) (map[string]*Balance, error)
 	// SpendUtxos updates the status of the given list of utxos to "spent" by the given txid.
 	// Generates a UtxoSpent event if successfull.
 	SpendUtxos(ctx context.Context, utxoKeys []UtxoKey, txid string) (int, error)
+	// RespendUtxos updates the spender of the given list of spent, unconfirmed
+	// utxos to the given txid, that replaces the previous one.
+	// Generates a UtxoSpent event if successfull.
+	RespendUtxos(ctx context.Context, utxoKeys []UtxoKey, txid string) (int, error)
 	// ConfirmSpendUtxos updates the status of the given list of utxos to "confirmed spend".
 	// Generates a UtxoConfirmedSpend event if successfull.
 	ConfirmSpendUtxos(ctx context.Context, utxoKeys []UtxoKey, status UtxoStatus) (int, error)
 	// ConfirmUtxos updates the status of the given list of utxos to "confirmed".
 	// Generates a UtxoConfirmed event if successfull.
 	ConfirmUtxos(ctx context.Context, utxoKeys []UtxoKey, status UtxoStatus) (int, error)
-	// LockUtxos updates the status of the given list of utxos to "locked".
+	// LockUtxos updates the status of the given list of utxos to "locked" on
+	// behalf of the given owner. The lock expiration of those already locked
+	// by the same owner is extended, while those locked by others are skipped.
 	// Generates a UtxoLocked event if successfull.
-	LockUtxos(ctx context.Context, utxoKeys []UtxoKey, timestamp, expiryTimestamp int64) (int, error)
-	// UnlockUtxos updates the status of the given list of utxos to "unlocked".
+	LockUtxos(ctx context.Context, utxoKeys []UtxoKey, timestamp, expiryTimestamp int64, owner string) (int, error)
+	// UnlockUtxos updates the status of the given list of utxos to "unlocked"
+	// if their lock expired.
 	// Generates a UtxoUnlocked event if successfull.
 	UnlockUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// ReleaseUtxos updates the status of the given list of utxos locked by the
+	// given owner to "unlocked", regardless of the lock expiration. Any lock is
+	// released if the owner is empty.
+	// Generates a UtxoUnlocked event if successfull.
+	ReleaseUtxos(ctx context.Context, utxoKeys []UtxoKey, owner string) (int, error)
+	// FreezeUtxos updates the status of the given list of utxos to "frozen".
+	// Generates a UtxoFrozen event if successfull.
+	FreezeUtxos(ctx context.Context, utxoKeys []UtxoKey, timestamp int64) (int, error)
+	// UnfreezeUtxos updates the status of the given list of utxos to
+	// "unfrozen".
+	// Generates a UtxoUnfrozen event if successfull.
+	UnfreezeUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// EvictUtxos updates the status of the given list of utxos to "evicted".
+	// Generates a UtxoEvicted event if successfull.
+	EvictUtxos(ctx context.Context, utxoKeys []UtxoKey, timestamp int64) (int, error)
+	// SetUtxoLabels overwrites the labels of the given list of utxos.
+	SetUtxoLabels(ctx context.Context, utxoKeys []UtxoKey, labels []string) (int, error)
 	// DeleteUtxosForAccount deletes every utxo associated to the given account
 	// from the repository.
 	DeleteUtxosForAccount(ctx context.Context, accountName string) error
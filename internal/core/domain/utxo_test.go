@@ -20,6 +20,27 @@ func TestSpendUtxo(t *testing.T) {
 	require.True(t, u.IsSpent())
 }
 
+func TestRespendUtxo(t *testing.T) {
+	t.Parallel()
+
+	txid := hex.EncodeToString(make([]byte, 32))
+	newTxid := hex.EncodeToString(append(make([]byte, 31), 1))
+
+	u := domain.Utxo{}
+	err := u.Respend(newTxid)
+	require.NoError(t, err)
+	require.False(t, u.IsSpent())
+
+	err = u.Spend(txid)
+	require.NoError(t, err)
+	err = u.Respend(newTxid)
+	require.NoError(t, err)
+	require.Equal(t, newTxid, u.SpentStatus.Txid)
+
+	err = u.Respend("")
+	require.Error(t, err)
+}
+
 func TestConfirmSpendUtxo(t *testing.T) {
 	t.Parallel()
 
@@ -58,9 +79,88 @@ func TestLockUnlockUtxo(t *testing.T) {
 	u := domain.Utxo{}
 	require.False(t, u.IsLocked())
 
-	u.Lock(time.Now().Unix(), 0)
+	u.Lock(time.Now().Unix(), 0, "")
 	require.True(t, u.IsLocked())
 
 	u.Unlock()
 	require.False(t, u.IsLocked())
 }
+
+func TestUtxoLockOwnership(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.True(t, u.CanLock("owner"))
+
+	now := time.Now()
+	expiry := now.Add(time.Minute).Unix()
+	u.Lock(now.Unix(), expiry, "owner")
+	require.True(t, u.IsLockedBy("owner"))
+	require.False(t, u.IsLockedBy("other"))
+	require.False(t, u.IsLockedBy(""))
+	require.True(t, u.CanLock("owner"))
+	require.False(t, u.CanLock("other"))
+
+	// Only the owner can extend the lock.
+	u.Lock(now.Unix(), expiry+60, "other")
+	require.Equal(t, expiry, u.LockExpiryTimestamp)
+	u.Lock(now.Unix(), expiry+60, "owner")
+	require.Equal(t, expiry+60, u.LockExpiryTimestamp)
+
+	// The lock didn't expire yet.
+	u.Unlock()
+	require.True(t, u.IsLocked())
+
+	u.Release()
+	require.False(t, u.IsLocked())
+	require.Empty(t, u.LockOwner)
+}
+
+func TestFreezeUnfreezeUtxo(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.False(t, u.IsFrozen())
+
+	now := time.Now().Unix()
+	u.Freeze(now)
+	require.True(t, u.IsFrozen())
+
+	u.Freeze(now + 10)
+	require.Equal(t, now, u.FrozenTimestamp)
+	require.True(t, u.Info().Frozen)
+
+	u.Unfreeze()
+	require.False(t, u.IsFrozen())
+}
+
+func TestEvictUtxo(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.False(t, u.IsEvicted())
+
+	now := time.Now().Unix()
+	u.Lock(now, now+60, "owner")
+	u.Evict(now)
+	require.True(t, u.IsEvicted())
+	require.False(t, u.IsLocked())
+
+	u.Evict(now + 10)
+	require.Equal(t, now, u.EvictedTimestamp)
+}
+
+func TestSetUtxoLabels(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.False(t, u.HasLabel("compliance"))
+
+	u.SetLabels([]string{"compliance", " ", "kyc", "compliance"})
+	require.Equal(t, []string{"compliance", "kyc"}, u.Labels)
+	require.True(t, u.HasLabel("compliance"))
+	require.Equal(t, u.Labels, u.Info().Labels)
+
+	u.SetLabels(nil)
+	require.Empty(t, u.Labels)
+}
@@ -47,6 +47,7 @@ type AddressInfo struct {
 	BlindingKey    []byte
 	DerivationPath string
 	Script         string
+	Labels         []string
 }
 
 // Wallet is the data structure representing a secure HD wallet, ie. protected
@@ -1,9 +1,16 @@
 package ports
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/vulpemventures/ocean/internal/core/domain"
 )
 
+// ErrTransactionNotFound must be returned, even wrapped, by any
+// BlockchainScanner when a tx is known neither in mempool nor in blockchain.
+var ErrTransactionNotFound = fmt.Errorf("transaction not found")
+
 // BlockchainScanner is the abstraction for any kind of service representing an
 // Elements node. It gives info about txs and utxos related to one or more HD
 // accounts in a aync way (via channels), and lets broadcast transactions over
@@ -44,17 +51,42 @@ type BlockchainScanner interface {
 	GetTxChannel(accountName string) chan *domain.Transaction
 
 	// GetLatestBlock returns the header of the latest block of the blockchain.
-	GetLatestBlock() ([]byte, uint32, error)
+	GetLatestBlock(ctx context.Context) ([]byte, uint32, error)
 	// GetBlockHash returns the hash of the block identified by its height.
-	GetBlockHash(height uint32) ([]byte, error)
+	GetBlockHash(ctx context.Context, height uint32) ([]byte, error)
 	// GetUtxos is a sync function to get info about the utxos represented by
 	// given outpoints (UtxoKeys).
-	GetUtxos(utxos []domain.Utxo) ([]domain.Utxo, error)
+	GetUtxos(ctx context.Context, utxos []domain.Utxo) ([]domain.Utxo, error)
 	// GetUtxos is a sync function to get all utxos for the given list of addresses.
-	GetUtxosForAddresses(addresses []domain.AddressInfo) ([]*domain.Utxo, error)
+	GetUtxosForAddresses(
+		ctx context.Context, addresses []domain.AddressInfo,
+	) ([]*domain.Utxo, error)
 	// BroadcastTransaction sends the given raw tx (in hex string) over the
 	// network in order to be included in a later block of the Liquid blockchain.
-	BroadcastTransaction(txHex string) (string, error)
-	// GetTransactions returns info about the given txids.
-	GetTransactions(txids []string) ([]domain.Transaction, error)
+	BroadcastTransaction(ctx context.Context, txHex string) (string, error)
+	// GetTransactions returns info about the given txids. ErrTransactionNotFound
+	// is returned if any of them is unknown.
+	GetTransactions(ctx context.Context, txids []string) ([]domain.Transaction, error)
+	// EstimateFeeRate returns the fee rate, in millisats/byte, estimated for
+	// a tx to be included within the given number of blocks.
+	EstimateFeeRate(ctx context.Context, numOfBlocks uint32) (uint64, error)
+	// GetStatus returns info about the connection with the backend and the
+	// sync progress of the scanner.
+	GetStatus() BlockchainScannerStatus
+}
+
+// BlockchainScannerStatus contains info about the connection of a scanner with
+// its backend, and about how far it's in sync with the blockchain.
+type BlockchainScannerStatus struct {
+	// IsConnected is whether the backend is reachable.
+	IsConnected bool
+	// TipHeight is the height of the latest block known by the scanner.
+	TipHeight uint32
+	// TipTimestamp is the timestamp of the latest block known by the scanner.
+	TipTimestamp int64
+	// BackendHeight is the height of the latest block known by the backend.
+	BackendHeight uint32
+	// AccountsHeight maps every watched account to the height of the latest
+	// block the scanner looked into for it.
+	AccountsHeight map[string]uint32
 }
@@ -1,6 +1,16 @@
 package ports
 
-import "github.com/vulpemventures/ocean/internal/core/domain"
+import (
+	"fmt"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+// ErrTargetAmountNotReached must be returned, even wrapped, by any CoinSelector
+// when the given utxos are not enough to cover the target amount.
+var ErrTargetAmountNotReached = fmt.Errorf(
+	"not found enough utxos to cover target amount",
+)
 
 // CoinSelector is the abstraction for any kind of service intended to return a
 // subset of the given utxos with target asset hash, covering the target amount
@@ -11,3 +21,55 @@ type CoinSelector interface {
 		utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
 	) (selectedUtxos []*domain.Utxo, change uint64, err error)
 }
+
+// MultiAssetCoinSelector is the abstraction for any kind of service intended
+// to return a subset of the given utxos covering the target amounts of
+// multiple assets and the network fees, paid in a certain asset, at once.
+type MultiAssetCoinSelector interface {
+	// SelectUtxosForTargets returns the utxos covering the target amount of
+	// every asset, the resulting change amounts and the fee amount. The latter
+	// is the given base fee, paying for the parts of the tx other than inputs
+	// and change outputs, plus the fees for the selected inputs and for the
+	// change outputs.
+	SelectUtxosForTargets(
+		utxos []*domain.Utxo, targetAmounts map[string]uint64, baseFee uint64,
+	) (
+		selectedUtxos []*domain.Utxo, changeByAsset map[string]uint64,
+		feeAmount uint64, err error,
+	)
+}
+
+// CoinSelectionCosts are the costs of spending utxos that fee-aware coin
+// selectors take into account. All amounts refer to FeeAsset, the asset used
+// to pay for the network fees.
+// For the utxos of FeeAsset, a fee-aware selector covers the target amount
+// with their effective values, ie. their values minus InputFee, so that the
+// selected utxos pay also for their own fees.
+type CoinSelectionCosts struct {
+	// FeeAsset is the asset used to pay for the network fees.
+	FeeAsset string
+	// InputFee is the fee amount to spend an input at the target fee rate.
+	InputFee uint64
+	// LongTermInputFee is the fee amount to spend an input at the long-term
+	// fee rate, meaning the cheapest one could afford by waiting.
+	LongTermInputFee uint64
+	// ChangeCost is the fee amount to add a change output at the target fee
+	// rate plus the one to spend it later at the long-term fee rate.
+	ChangeCost uint64
+	// ChangeOutputFee is the fee amount to add a change output at the target
+	// fee rate.
+	ChangeOutputFee uint64
+	// DustAmount is the min amount of a change output of FeeAsset, any lower
+	// change is paid as fees.
+	DustAmount uint64
+}
+
+// EffectiveValue returns the value of the given utxo net of the fee amount to
+// spend it, if it's of the fee asset. The result is not positive for utxos
+// that cost more than they are worth.
+func (c CoinSelectionCosts) EffectiveValue(utxo *domain.Utxo) int64 {
+	if utxo.Asset != c.FeeAsset {
+		return int64(utxo.Value)
+	}
+	return int64(utxo.Value) - int64(c.InputFee)
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"context"
+
 	"github.com/vulpemventures/ocean/internal/core/domain"
 )
 
@@ -20,6 +22,22 @@ type RepoManager interface {
 	TransactionRepository() domain.TransactionRepository
 	// ExternalScriptRepository returns the external scripts repository.
 	ExternalScriptRepository() domain.ExternalScriptRepository
+	// SpendingPolicyRepository returns the spending policies repository.
+	SpendingPolicyRepository() domain.SpendingPolicyRepository
+	// AuditEventRepository returns the audit log repository.
+	AuditEventRepository() domain.AuditEventRepository
+	// IdempotencyKeyRepository returns the idempotency keys repository.
+	IdempotencyKeyRepository() domain.IdempotencyKeyRepository
+	// PaymentRepository returns the payout queue repository.
+	PaymentRepository() domain.PaymentRepository
+	// AddressLabelRepository returns the address labels repository.
+	AddressLabelRepository() domain.AddressLabelRepository
+	// BroadcastRepository returns the repository of the txs tracked until
+	// confirmed.
+	BroadcastRepository() domain.BroadcastRepository
+	// ScheduledTxRepository returns the repository of the pre-signed txs
+	// waiting to be broadcasted.
+	ScheduledTxRepository() domain.ScheduledTxRepository
 
 	// RegisterHandlerForWalletEvent registers an handler function, executed
 	// whenever the given event type occurs.
@@ -42,6 +60,8 @@ type RepoManager interface {
 		eventType domain.ExternalScriptEventType, handler ScriptEventHandler,
 	)
 
+	// Ping makes sure the connection with the db is alive.
+	Ping(ctx context.Context) error
 	// Reset brings all the repos to their initial state by deleting any persisted data.
 	Reset()
 
@@ -1,8 +1,22 @@
 package electrum_scanner
 
 import (
+	"context"
+
 	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer(
+	"github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/electrum",
+)
+
+// Transports used to connect to the electrum server, used to label the
+// client metrics.
+const (
+	transportTCP = "tcp"
+	transportWS  = "ws"
 )
 
 type electrumClient interface {
@@ -15,10 +29,15 @@ type electrumClient interface {
 	) (chan accountReport, map[string][]txInfo)
 	unsubscribeForAccount(account string)
 
+	ping() error
+	getChainTip() blockInfo
 	getLatestBlock() ([]byte, uint32, error)
-	getBlocksInfo(heights []uint32) ([]blockInfo, error)
-	getScriptHashesHistory(scriptHashes []string) (map[string][]txInfo, error)
-	getTxs(txids []string) ([]*transaction.Transaction, error)
-	getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error)
-	broadcastTx(txHex string) (string, error)
+	getBlocksInfo(ctx context.Context, heights []uint32) ([]blockInfo, error)
+	getScriptHashesHistory(
+		ctx context.Context, scriptHashes []string,
+	) (map[string][]txInfo, error)
+	getTxs(ctx context.Context, txids []string) ([]*transaction.Transaction, error)
+	getUtxos(ctx context.Context, outpoints []domain.Utxo) ([]domain.Utxo, error)
+	broadcastTx(ctx context.Context, txHex string) (string, error)
+	estimateFee(ctx context.Context, numOfBlocks uint32) (float64, error)
 }
@@ -1,8 +1,10 @@
 package electrum_scanner
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
 
@@ -177,13 +179,37 @@ func (s *service) GetTxChannel(accountName string) chan *domain.Transaction {
 	return s.getTxChannelByAccount(accountName)
 }
 
-func (s *service) GetLatestBlock() ([]byte, uint32, error) {
+func (s *service) GetLatestBlock(_ context.Context) ([]byte, uint32, error) {
 	return s.client.getLatestBlock()
 }
 
+// GetStatus returns info about the connection with the electrum server.
+// The server notifies about every new block and about the activity of the
+// accounts' scripts right away, therefore the chain tip is also the one of the
+// backend, and every watched account is considered in sync with it.
+func (s *service) GetStatus() ports.BlockchainScannerStatus {
+	tip := s.client.getChainTip()
+	tipHeight := uint32(tip.Height)
+
+	accountsHeight := make(map[string]uint32)
+	for _, accountName := range s.getWatchedAccounts() {
+		accountsHeight[accountName] = tipHeight
+	}
+
+	return ports.BlockchainScannerStatus{
+		IsConnected:    s.client.ping() == nil,
+		TipHeight:      tipHeight,
+		TipTimestamp:   tip.timestamp(),
+		BackendHeight:  tipHeight,
+		AccountsHeight: accountsHeight,
+	}
+}
+
 // GetBlockHash returns the hash of the block identified by its height.
-func (s *service) GetBlockHash(height uint32) ([]byte, error) {
-	blocks, err := s.client.getBlocksInfo([]uint32{height})
+func (s *service) GetBlockHash(
+	ctx context.Context, height uint32,
+) ([]byte, error) {
+	blocks, err := s.client.getBlocksInfo(ctx, []uint32{height})
 	if err != nil {
 		return nil, err
 	}
@@ -192,12 +218,14 @@ func (s *service) GetBlockHash(height uint32) ([]byte, error) {
 
 // GetUtxos is a sync function to get info about the utxos represented by
 // given outpoints (UtxoKeys).
-func (s *service) GetUtxos(utxos []domain.Utxo) ([]domain.Utxo, error) {
-	return s.client.getUtxos(utxos)
+func (s *service) GetUtxos(
+	ctx context.Context, utxos []domain.Utxo,
+) ([]domain.Utxo, error) {
+	return s.client.getUtxos(ctx, utxos)
 }
 
 func (s *service) GetUtxosForAddresses(
-	addresses []domain.AddressInfo,
+	ctx context.Context, addresses []domain.AddressInfo,
 ) ([]*domain.Utxo, error) {
 	// Parse addresses into script hashes.
 	scriptHashes := make([]string, 0, len(addresses))
@@ -209,7 +237,7 @@ func (s *service) GetUtxosForAddresses(
 	}
 
 	// Retrieve tx history for all addresses.
-	history, err := s.client.getScriptHashesHistory(scriptHashes)
+	history, err := s.client.getScriptHashesHistory(ctx, scriptHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +253,7 @@ func (s *service) GetUtxosForAddresses(
 		allTxs = append(allTxs, txid)
 	}
 
-	txs, err := s.client.getTxs(allTxs)
+	txs, err := s.client.getTxs(ctx, allTxs)
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +369,7 @@ func (s *service) GetUtxosForAddresses(
 	for height := range allUtxoBlocks {
 		blocks = append(blocks, height)
 	}
-	blocksInfo, err := s.client.getBlocksInfo(blocks)
+	blocksInfo, err := s.client.getBlocksInfo(ctx, blocks)
 	if err != nil {
 		return nil, err
 	}
@@ -372,13 +400,34 @@ func (s *service) GetUtxosForAddresses(
 
 // BroadcastTransaction sends the given raw tx (in hex string) over the
 // network in order to be included in a later block of the Liquid blockchain.
-func (s *service) BroadcastTransaction(txHex string) (string, error) {
-	return s.client.broadcastTx(txHex)
+func (s *service) BroadcastTransaction(
+	ctx context.Context, txHex string,
+) (string, error) {
+	return s.client.broadcastTx(ctx, txHex)
+}
+
+// EstimateFeeRate returns the fee rate, in millisats/byte, estimated for a
+// tx to be included within the given number of blocks.
+func (s *service) EstimateFeeRate(
+	ctx context.Context, numOfBlocks uint32,
+) (uint64, error) {
+	btcPerKb, err := s.client.estimateFee(ctx, numOfBlocks)
+	if err != nil {
+		return 0, err
+	}
+	// The server returns -1 if it doesn't have enough data to estimate.
+	if btcPerKb < 0 {
+		return 0, fmt.Errorf("fee rate estimate not available")
+	}
+	// 1 BTC/kB = 10^8 sats/kB = 10^8 millisats/byte.
+	return uint64(math.Round(btcPerKb * 1e8)), nil
 }
 
 // GetTransactions returns info about the given txids.
-func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error) {
-	res, err := s.client.getTxs(txids)
+func (s *service) GetTransactions(
+	ctx context.Context, txids []string,
+) ([]domain.Transaction, error) {
+	res, err := s.client.getTxs(ctx, txids)
 	if err != nil {
 		return nil, err
 	}
@@ -386,7 +435,7 @@ func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error)
 	for _, tx := range res {
 		txid := tx.TxHash().String()
 		scriptHash := calcScriptHash(hex.EncodeToString(tx.Outputs[0].Script))
-		history, _ := s.client.getScriptHashesHistory([]string{scriptHash})
+		history, _ := s.client.getScriptHashesHistory(ctx, []string{scriptHash})
 		var height int64
 		for _, tx := range history[scriptHash] {
 			if tx.Txid == txid {
@@ -404,7 +453,7 @@ func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error)
 				blockheight = info.Height
 				blocktime = info.timestamp()
 			} else {
-				info, _ := s.client.getBlocksInfo([]uint32{uint32(height)})
+				info, _ := s.client.getBlocksInfo(ctx, []uint32{uint32(height)})
 				if len(info) > 0 {
 					s.blocksByHeight[uint64(height)] = info[0]
 					blockhash = info[0].hash().String()
@@ -435,6 +484,17 @@ func (s *service) getAccountChannel(
 	return ch, ok
 }
 
+func (s *service) getWatchedAccounts() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	accounts := make([]string, 0, len(s.reportChannelByAccount))
+	for account := range s.reportChannelByAccount {
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
 func (s *service) getUtxoChannelByAccount(account string) chan []*domain.Utxo {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -463,7 +523,7 @@ func (s *service) setAccountChannels(
 func (s *service) listenToAccountChannel(chReports chan accountReport) {
 	for report := range chReports {
 		history, err := s.client.getScriptHashesHistory(
-			[]string{report.scriptHash},
+			context.Background(), []string{report.scriptHash},
 		)
 		if err != nil {
 			s.warn(
@@ -479,7 +539,7 @@ func (s *service) listenToAccountChannel(chReports chan accountReport) {
 }
 
 func (s *service) dbEventHandler(event dbEvent) {
-	txs, err := s.client.getTxs([]string{event.tx.Txid})
+	txs, err := s.client.getTxs(context.Background(), []string{event.tx.Txid})
 	if err != nil {
 		s.warn(err, "failed to fetch tx for event %+v", event)
 		return
@@ -496,7 +556,7 @@ func (s *service) dbEventHandler(event dbEvent) {
 
 	if event.tx.Height > 0 {
 		blocks, err := s.client.getBlocksInfo(
-			[]uint32{uint32(event.tx.Height)},
+			context.Background(), []uint32{uint32(event.tx.Height)},
 		)
 		if err != nil {
 			s.warn(err, "failed to fetch block %d", event.tx.Height)
@@ -739,7 +799,9 @@ func (s *service) restoreAddressesForAccount(
 			}
 		}
 
-		history, _ := s.client.getScriptHashesHistory(scriptHashes)
+		history, _ := s.client.getScriptHashesHistory(
+			context.Background(), scriptHashes,
+		)
 		if len(history) <= 0 {
 			break
 		}
@@ -762,7 +824,7 @@ func (s *service) restoreAddressesForAccount(
 }
 
 func (s *service) getPrevout(utxo domain.UtxoKey) *transaction.TxOutput {
-	res, err := s.client.getTxs([]string{utxo.TxID})
+	res, err := s.client.getTxs(context.Background(), []string{utxo.TxID})
 	if err != nil {
 		return nil
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/metrics"
 )
 
 const delim = byte('\n')
@@ -123,7 +124,7 @@ func (c *tcpClient) keepAliveConnection() {
 	for {
 		select {
 		case <-t.C:
-			if _, err := c.request("server.ping"); err != nil {
+			if _, err := c.request(context.Background(), "server.ping"); err != nil {
 				log.WithError(err).Error("scanner: failed to keep connection alive")
 			}
 		case <-c.chQuit:
@@ -133,6 +134,8 @@ func (c *tcpClient) keepAliveConnection() {
 }
 
 func (c *tcpClient) reconnect() {
+	metrics.IncScannerReconnects(transportTCP)
+
 	// stop sending ping messages.
 	c.chQuit <- struct{}{}
 
@@ -150,7 +153,7 @@ func (c *tcpClient) reconnect() {
 
 	// restore all subscriptions
 	c.log("restoring %d subscriptions...", len(c.subscriptions))
-	responses, err := c.batchRequests(c.subscriptions)
+	responses, err := c.batchRequests(context.Background(), c.subscriptions)
 	if err != nil {
 		log.WithError(err).Fatal("failed to restore subscriptions after reconnection")
 	}
@@ -171,7 +174,7 @@ func (c *tcpClient) close() {
 }
 
 func (c *tcpClient) subscribeForBlocks() {
-	resp, err := c.request("blockchain.headers.subscribe")
+	resp, err := c.request(context.Background(), "blockchain.headers.subscribe")
 	if err != nil {
 		c.warn(err, "failed to subscribe for new blocks")
 		return
@@ -227,7 +230,9 @@ func (c *tcpClient) subscribeForAccount(
 		)
 	}
 
-	history, err := c.getScriptHashesHistory(scriptHashes)
+	history, err := c.getScriptHashesHistory(
+		context.Background(), scriptHashes,
+	)
 	if err != nil {
 		c.warn(
 			err, "failed to get get tx history for watched addresses of account %s",
@@ -258,7 +263,7 @@ func (c *tcpClient) subscribeForScripts(
 		)
 	}
 
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(context.Background(), reqs)
 	if err != nil {
 		return err
 	}
@@ -275,7 +280,9 @@ func (c *tcpClient) subscribeForScripts(
 	return nil
 }
 
-func (c *tcpClient) getScriptHashesHistory(scriptHashes []string) (map[string][]txInfo, error) {
+func (c *tcpClient) getScriptHashesHistory(
+	ctx context.Context, scriptHashes []string,
+) (map[string][]txInfo, error) {
 	reqs := make([]request, 0, len(scriptHashes))
 	scriptHashById := make(map[uint64]string)
 	for _, scriptHash := range scriptHashes {
@@ -283,7 +290,7 @@ func (c *tcpClient) getScriptHashesHistory(scriptHashes []string) (map[string][]
 		reqs = append(reqs, req)
 		scriptHashById[req.Id] = scriptHash
 	}
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
@@ -305,11 +312,25 @@ func (c *tcpClient) getScriptHashesHistory(scriptHashes []string) (map[string][]
 	return allHistory, nil
 }
 
+func (c *tcpClient) ping() error {
+	_, err := c.request(context.Background(), "server.ping")
+	return err
+}
+
+func (c *tcpClient) getChainTip() blockInfo {
+	c.tipLock.RLock()
+	defer c.tipLock.RUnlock()
+
+	return c.chainTip
+}
+
 func (c *tcpClient) getLatestBlock() ([]byte, uint32, error) {
 	return c.chainTip.hash()[:], uint32(c.chainTip.Height), nil
 }
 
-func (c *tcpClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
+func (c *tcpClient) getBlocksInfo(
+	ctx context.Context, heights []uint32,
+) ([]blockInfo, error) {
 	reqs := make([]request, 0, len(heights))
 	heightByReqId := make(map[uint64]uint32)
 	for _, height := range heights {
@@ -318,7 +339,7 @@ func (c *tcpClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
 		heightByReqId[req.Id] = height
 	}
 
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
@@ -336,19 +357,21 @@ func (c *tcpClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
 	return blocks, nil
 }
 
-func (c *tcpClient) getTxs(txids []string) ([]*transaction.Transaction, error) {
+func (c *tcpClient) getTxs(
+	ctx context.Context, txids []string,
+) ([]*transaction.Transaction, error) {
 	reqs := make([]request, 0, len(txids))
 	for _, txid := range txids {
 		reqs = append(reqs, c.newJSONRequest("blockchain.transaction.get", txid))
 	}
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
 
 	txs := make([]*transaction.Transaction, 0, len(txids))
 	for _, resp := range responses {
-		if err := resp.error(); err != nil {
+		if err := resp.txError(); err != nil {
 			return nil, err
 		}
 		tx, err := transaction.NewTxFromHex(resp.Result.(string))
@@ -361,10 +384,12 @@ func (c *tcpClient) getTxs(txids []string) ([]*transaction.Transaction, error) {
 	return txs, nil
 }
 
-func (c *tcpClient) getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error) {
+func (c *tcpClient) getUtxos(
+	ctx context.Context, outpoints []domain.Utxo,
+) ([]domain.Utxo, error) {
 	utxos := make([]domain.Utxo, 0, len(outpoints))
 	for _, u := range outpoints {
-		txs, err := c.getTxs([]string{u.TxID})
+		txs, err := c.getTxs(ctx, []string{u.TxID})
 		if err != nil {
 			return nil, err
 		}
@@ -395,8 +420,8 @@ func (c *tcpClient) getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error) {
 	return utxos, nil
 }
 
-func (c *tcpClient) broadcastTx(txHex string) (string, error) {
-	resp, err := c.request("blockchain.transaction.broadcast", txHex)
+func (c *tcpClient) broadcastTx(ctx context.Context, txHex string) (string, error) {
+	resp, err := c.request(ctx, "blockchain.transaction.broadcast", txHex)
 	if err != nil {
 		return "", err
 	}
@@ -406,7 +431,35 @@ func (c *tcpClient) broadcastTx(txHex string) (string, error) {
 	return resp.Result.(string), nil
 }
 
-func (c *tcpClient) request(method string, params ...interface{}) (*response, error) {
+func (c *tcpClient) estimateFee(
+	ctx context.Context, numOfBlocks uint32,
+) (float64, error) {
+	resp, err := c.request(ctx, "blockchain.estimatefee", numOfBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if err := resp.error(); err != nil {
+		return 0, err
+	}
+	feeRate, ok := resp.Result.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid fee estimate %v", resp.Result)
+	}
+	return feeRate, nil
+}
+
+func (c *tcpClient) request(
+	ctx context.Context, method string, params ...interface{},
+) (_ *response, err error) {
+	_, span := startRequestSpan(ctx, transportTCP, method, 1)
+	start := time.Now()
+	defer func() {
+		metrics.ObserveScannerRequest(
+			transportTCP, method, time.Since(start), err != nil,
+		)
+		endRequestSpan(span, err)
+	}()
+
 	req := c.newJSONRequest(method, params...)
 	reqBytes, _ := json.Marshal(req)
 	reqBytes = append(reqBytes, delim)
@@ -429,7 +482,19 @@ func (c *tcpClient) request(method string, params ...interface{}) (*response, er
 	}
 }
 
-func (c *tcpClient) batchRequests(reqs []request) ([]response, error) {
+func (c *tcpClient) batchRequests(
+	ctx context.Context, reqs []request,
+) (_ []response, err error) {
+	method := batchMethod(reqs)
+	_, span := startRequestSpan(ctx, transportTCP, method, len(reqs))
+	start := time.Now()
+	defer func() {
+		metrics.ObserveScannerRequest(
+			transportTCP, method, time.Since(start), err != nil,
+		)
+		endRequestSpan(span, err)
+	}()
+
 	reqBytes := make([]byte, 0)
 	for _, req := range reqs {
 		buf, _ := json.Marshal(req)
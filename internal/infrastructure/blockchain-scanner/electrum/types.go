@@ -5,12 +5,18 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/vulpemventures/go-elements/block"
+	"github.com/vulpemventures/ocean/internal/core/ports"
 )
 
+// txNotFoundMessage is the error message returned by the electrum server,
+// from the underlying node, for a tx not in mempool nor in blockchain.
+const txNotFoundMessage = "no such mempool or blockchain transaction"
+
 type txInfo struct {
 	Txid   string `json:"tx_hash"`
 	Height int64  `json:"height"`
@@ -79,6 +85,19 @@ func (r response) error() error {
 	return err.Error()
 }
 
+// txError returns the error of a response to a blockchain.transaction.get
+// request, if any, wrapping ports.ErrTransactionNotFound for unknown txs.
+func (r response) txError() error {
+	err := r.error()
+	if err == nil {
+		return nil
+	}
+	if strings.Contains(strings.ToLower(err.Error()), txNotFoundMessage) {
+		return fmt.Errorf("%w: %s", ports.ErrTransactionNotFound, err)
+	}
+	return err
+}
+
 type responseErr struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -1,6 +1,7 @@
 package electrum_scanner
 
 import (
+	"context"
 	"crypto/sha256"
 	"crypto/tls"
 	"encoding/hex"
@@ -9,6 +10,9 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func calcScriptHash(script string) string {
@@ -30,3 +34,41 @@ func getConn(addr string) (net.Conn, error) {
 		return nil, fmt.Errorf("invalid address: unknown prototocol")
 	}
 }
+
+// batchMethod returns the method of the given batch of requests if they all
+// share the same, otherwise it returns "batch".
+func batchMethod(reqs []request) string {
+	if len(reqs) == 0 {
+		return "batch"
+	}
+	method := reqs[0].Method
+	for _, req := range reqs[1:] {
+		if req.Method != method {
+			return "batch"
+		}
+	}
+	return method
+}
+
+// startRequestSpan starts the span of a request, or batch of requests, to the
+// electrum server.
+func startRequestSpan(
+	ctx context.Context, transport, method string, numOfRequests int,
+) (context.Context, trace.Span) {
+	return tracer.Start(
+		ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("electrum.transport", transport),
+			attribute.Int("electrum.requests", numOfRequests),
+		),
+	)
+}
+
+// endRequestSpan records the given error, if any, and ends the span.
+func endRequestSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
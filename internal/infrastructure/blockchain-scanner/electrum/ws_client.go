@@ -16,6 +16,7 @@ import (
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/metrics"
 )
 
 type wsClient struct {
@@ -130,7 +131,7 @@ func (c *wsClient) keepAliveConnection() {
 	for {
 		select {
 		case <-t.C:
-			if _, err := c.request("server.ping"); err != nil {
+			if _, err := c.request(context.Background(), "server.ping"); err != nil {
 				log.WithError(err).Error("scanner: failed to keep connection alive")
 			}
 		case <-c.chQuit:
@@ -147,7 +148,7 @@ func (c *wsClient) close() {
 }
 
 func (c *wsClient) subscribeForBlocks() {
-	resp, err := c.request("blockchain.headers.subscribe")
+	resp, err := c.request(context.Background(), "blockchain.headers.subscribe")
 	if err != nil {
 		c.warn(err, "failed to subscribe for new blocks")
 		return
@@ -199,7 +200,9 @@ func (c *wsClient) subscribeForAccount(
 		)
 	}
 
-	history, err := c.getScriptHashesHistory(scriptHashes)
+	history, err := c.getScriptHashesHistory(
+		context.Background(), scriptHashes,
+	)
 	if err != nil {
 		c.warn(
 			err, "failed to get get tx history for watched addresses of account %s",
@@ -219,7 +222,9 @@ func (c *wsClient) unsubscribeForAccount(accountName string) {
 	delete(c.reportHandlers, accountName)
 }
 
-func (c *wsClient) getScriptHashesHistory(scriptHashes []string) (map[string][]txInfo, error) {
+func (c *wsClient) getScriptHashesHistory(
+	ctx context.Context, scriptHashes []string,
+) (map[string][]txInfo, error) {
 	reqs := make([]request, 0, len(scriptHashes))
 	scriptHashById := make(map[uint64]string)
 	for _, scriptHash := range scriptHashes {
@@ -227,7 +232,7 @@ func (c *wsClient) getScriptHashesHistory(scriptHashes []string) (map[string][]t
 		reqs = append(reqs, req)
 		scriptHashById[req.Id] = scriptHash
 	}
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
@@ -249,11 +254,25 @@ func (c *wsClient) getScriptHashesHistory(scriptHashes []string) (map[string][]t
 	return allHistory, nil
 }
 
+func (c *wsClient) ping() error {
+	_, err := c.request(context.Background(), "server.ping")
+	return err
+}
+
+func (c *wsClient) getChainTip() blockInfo {
+	c.tipLock.RLock()
+	defer c.tipLock.RUnlock()
+
+	return c.chainTip
+}
+
 func (c *wsClient) getLatestBlock() ([]byte, uint32, error) {
 	return c.chainTip.hash()[:], uint32(c.chainTip.Height), nil
 }
 
-func (c *wsClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
+func (c *wsClient) getBlocksInfo(
+	ctx context.Context, heights []uint32,
+) ([]blockInfo, error) {
 	reqs := make([]request, 0, len(heights))
 	heightByReqId := make(map[uint64]uint32)
 	for _, height := range heights {
@@ -262,7 +281,7 @@ func (c *wsClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
 		heightByReqId[req.Id] = height
 	}
 
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
@@ -280,19 +299,21 @@ func (c *wsClient) getBlocksInfo(heights []uint32) ([]blockInfo, error) {
 	return blocks, nil
 }
 
-func (c *wsClient) getTxs(txids []string) ([]*transaction.Transaction, error) {
+func (c *wsClient) getTxs(
+	ctx context.Context, txids []string,
+) ([]*transaction.Transaction, error) {
 	reqs := make([]request, 0, len(txids))
 	for _, txid := range txids {
 		reqs = append(reqs, c.newJSONRequest("blockchain.transaction.get", txid))
 	}
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(ctx, reqs)
 	if err != nil {
 		return nil, err
 	}
 
 	txs := make([]*transaction.Transaction, 0, len(txids))
 	for _, resp := range responses {
-		if err := resp.error(); err != nil {
+		if err := resp.txError(); err != nil {
 			return nil, err
 		}
 		tx, err := transaction.NewTxFromHex(resp.Result.(string))
@@ -305,10 +326,12 @@ func (c *wsClient) getTxs(txids []string) ([]*transaction.Transaction, error) {
 	return txs, nil
 }
 
-func (c *wsClient) getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error) {
+func (c *wsClient) getUtxos(
+	ctx context.Context, outpoints []domain.Utxo,
+) ([]domain.Utxo, error) {
 	utxos := make([]domain.Utxo, 0, len(outpoints))
 	for _, u := range outpoints {
-		txs, err := c.getTxs([]string{u.TxID})
+		txs, err := c.getTxs(ctx, []string{u.TxID})
 		if err != nil {
 			return nil, err
 		}
@@ -339,8 +362,8 @@ func (c *wsClient) getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error) {
 	return utxos, nil
 }
 
-func (c *wsClient) broadcastTx(txHex string) (string, error) {
-	resp, err := c.request("blockchain.transaction.broadcast", txHex)
+func (c *wsClient) broadcastTx(ctx context.Context, txHex string) (string, error) {
+	resp, err := c.request(ctx, "blockchain.transaction.broadcast", txHex)
 	if err != nil {
 		return "", err
 	}
@@ -350,6 +373,23 @@ func (c *wsClient) broadcastTx(txHex string) (string, error) {
 	return resp.Result.(string), nil
 }
 
+func (c *wsClient) estimateFee(
+	ctx context.Context, numOfBlocks uint32,
+) (float64, error) {
+	resp, err := c.request(ctx, "blockchain.estimatefee", numOfBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if err := resp.error(); err != nil {
+		return 0, err
+	}
+	feeRate, ok := resp.Result.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid fee estimate %v", resp.Result)
+	}
+	return feeRate, nil
+}
+
 func (c *wsClient) subscribeForScripts(
 	accountName string, scriptHashes []string,
 ) error {
@@ -360,7 +400,7 @@ func (c *wsClient) subscribeForScripts(
 		)
 	}
 
-	responses, err := c.batchRequests(reqs)
+	responses, err := c.batchRequests(context.Background(), reqs)
 	if err != nil {
 		return err
 	}
@@ -388,7 +428,18 @@ func (c *wsClient) subscribeForScripts(
 // 	}
 // }
 
-func (c *wsClient) request(method string, params ...interface{}) (*response, error) {
+func (c *wsClient) request(
+	ctx context.Context, method string, params ...interface{},
+) (_ *response, err error) {
+	_, span := startRequestSpan(ctx, transportWS, method, 1)
+	start := time.Now()
+	defer func() {
+		metrics.ObserveScannerRequest(
+			transportWS, method, time.Since(start), err != nil,
+		)
+		endRequestSpan(span, err)
+	}()
+
 	req := c.newJSONRequest(method, params...)
 	if err := c.conn.WriteJSON(req); err != nil {
 		c.warn(err, "failed to send request for method %s", method)
@@ -409,7 +460,19 @@ func (c *wsClient) request(method string, params ...interface{}) (*response, err
 	}
 }
 
-func (c *wsClient) batchRequests(reqs []request) ([]response, error) {
+func (c *wsClient) batchRequests(
+	ctx context.Context, reqs []request,
+) (_ []response, err error) {
+	method := batchMethod(reqs)
+	_, span := startRequestSpan(ctx, transportWS, method, len(reqs))
+	start := time.Now()
+	defer func() {
+		metrics.ObserveScannerRequest(
+			transportWS, method, time.Since(start), err != nil,
+		)
+		endRequestSpan(span, err)
+	}()
+
 	reqBytes := make([]byte, 0)
 	for _, req := range reqs {
 		buf, _ := json.Marshal(req)
@@ -1,6 +1,7 @@
 package elements_scanner
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -22,6 +23,7 @@ type scannerService struct {
 	svc                 scanner.Service
 	blindingKeys        map[string][]byte
 	startingBlockHeight uint32
+	scannedBlockHeight  uint32
 	chTxs               chan *domain.Transaction
 	chUtxos             chan []*domain.Utxo
 	lock                *sync.RWMutex
@@ -47,7 +49,6 @@ func newScannerSvc(
 	}
 	scannerSvc := &scannerService{
 		accountName:         accountName,
-		svc:                 scanner.New(filtersDb, headersDb, blockSvc, genesisHash),
 		blindingKeys:        make(map[string][]byte),
 		startingBlockHeight: startingBlockHeight,
 		chTxs:               make(chan *domain.Transaction, 10),
@@ -56,6 +57,8 @@ func newScannerSvc(
 		log:                 logFn,
 		warn:                warnFn,
 	}
+	progressDb := progressHeadersRepo{headersDb, scannerSvc.setScannedBlockHeight}
+	scannerSvc.svc = scanner.New(filtersDb, progressDb, blockSvc, genesisHash)
 	chReports, _ := scannerSvc.svc.Start()
 	go scannerSvc.listenToReports(chReports)
 	return scannerSvc
@@ -67,6 +70,27 @@ func (s *scannerService) stop() {
 	close(s.chUtxos)
 }
 
+// getScannedBlockHeight returns the height of the latest block the scanner
+// looked into for the account.
+func (s *scannerService) getScannedBlockHeight() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.scannedBlockHeight < s.startingBlockHeight {
+		return s.startingBlockHeight
+	}
+	return s.scannedBlockHeight
+}
+
+func (s *scannerService) setScannedBlockHeight(height uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if height > s.scannedBlockHeight {
+		s.scannedBlockHeight = height
+	}
+}
+
 func (s *scannerService) watchAddresses(addressesInfo []domain.AddressInfo) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -225,6 +249,24 @@ func (s *scannerService) getBlindingKey(script string) ([]byte, bool) {
 	return key, ok
 }
 
+// progressHeadersRepo wraps the block headers repository used by the scanner
+// of an account to keep track of the blocks it looks into.
+type progressHeadersRepo struct {
+	repository.BlockHeaderRepository
+	onBlock func(height uint32)
+}
+
+func (r progressHeadersRepo) GetBlockHashByHeight(
+	ctx context.Context, height uint32,
+) (*chainhash.Hash, error) {
+	hash, err := r.BlockHeaderRepository.GetBlockHashByHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	r.onBlock(height)
+	return hash, nil
+}
+
 func assetFromBytes(buf []byte) string {
 	return hex.EncodeToString(elementsutil.ReverseBytes(buf))
 }
@@ -3,6 +3,7 @@ package elements_scanner
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -127,7 +128,9 @@ func (s *service) StopWatchForAccount(accountName string) {
 	s.removeScanner(accountName)
 }
 
-func (s *service) GetUtxos(utxoList []domain.Utxo) ([]domain.Utxo, error) {
+func (s *service) GetUtxos(
+	_ context.Context, utxoList []domain.Utxo,
+) ([]domain.Utxo, error) {
 	utxos := make([]domain.Utxo, 0, len(utxoList))
 	for _, u := range utxoList {
 		key := u.UtxoKey
@@ -184,12 +187,14 @@ func (s *service) GetUtxos(utxoList []domain.Utxo) ([]domain.Utxo, error) {
 }
 
 func (s *service) GetUtxosForAddresses(
-	_ []domain.AddressInfo,
+	_ context.Context, _ []domain.AddressInfo,
 ) ([]*domain.Utxo, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (s *service) BroadcastTransaction(txHex string) (string, error) {
+func (s *service) BroadcastTransaction(
+	_ context.Context, txHex string,
+) (string, error) {
 	if _, err := transaction.NewTxFromHex(txHex); err != nil {
 		return "", fmt.Errorf("invalid tx: %s", err)
 	}
@@ -201,12 +206,34 @@ func (s *service) BroadcastTransaction(txHex string) (string, error) {
 	return txid, nil
 }
 
-func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error) {
+func (s *service) EstimateFeeRate(
+	_ context.Context, numOfBlocks uint32,
+) (uint64, error) {
+	resp, err := s.rpcClient.call("estimatesmartfee", []interface{}{numOfBlocks})
+	if err != nil {
+		return 0, err
+	}
+	// The fee rate is missing from the response if the node doesn't have
+	// enough data to estimate.
+	estimate, _ := resp.(map[string]interface{})
+	btcPerKb, ok := estimate["feerate"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("fee rate estimate not available")
+	}
+	// 1 BTC/kB = 10^8 sats/kB = 10^8 millisats/byte.
+	return uint64(math.Round(btcPerKb * 1e8)), nil
+}
+
+func (s *service) GetTransactions(
+	_ context.Context, txids []string,
+) ([]domain.Transaction, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (s *service) GetLatestBlock() ([]byte, uint32, error) {
-	block, err := s.headersRepo.ChainTip(context.Background())
+func (s *service) GetLatestBlock(
+	ctx context.Context,
+) ([]byte, uint32, error) {
+	block, err := s.headersRepo.ChainTip(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -214,14 +241,48 @@ func (s *service) GetLatestBlock() ([]byte, uint32, error) {
 	return hash.CloneBytes(), block.Height, nil
 }
 
-func (s *service) GetBlockHash(height uint32) ([]byte, error) {
-	hash, err := s.headersRepo.GetBlockHashByHeight(context.Background(), height)
+func (s *service) GetBlockHash(
+	ctx context.Context, height uint32,
+) ([]byte, error) {
+	hash, err := s.headersRepo.GetBlockHashByHeight(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 	return hash.CloneBytes(), nil
 }
 
+func (s *service) GetStatus() ports.BlockchainScannerStatus {
+	status := ports.BlockchainScannerStatus{
+		AccountsHeight: s.getAccountsHeight(),
+	}
+
+	resp, err := s.rpcClient.call("getblockcount", nil)
+	if err != nil {
+		return status
+	}
+	status.IsConnected = true
+	if height, ok := resp.(float64); ok {
+		status.BackendHeight = uint32(height)
+	}
+
+	if tip, err := s.headersRepo.ChainTip(context.Background()); err == nil {
+		status.TipHeight = tip.Height
+		status.TipTimestamp = int64(tip.Timestamp)
+	}
+	return status
+}
+
+func (s *service) getAccountsHeight() map[string]uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	heights := make(map[string]uint32)
+	for accountName, scannerSvc := range s.scanners {
+		heights[accountName] = scannerSvc.getScannedBlockHeight()
+	}
+	return heights
+}
+
 func (s *service) getOrCreateScanner(
 	accountName string, startingBlock uint32,
 ) *scannerService {
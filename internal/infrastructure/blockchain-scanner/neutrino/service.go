@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -149,7 +152,9 @@ func (s *service) StopWatchForAccount(accountName string) {
 	s.removeScanner(accountName)
 }
 
-func (s *service) GetUtxos(utxoList []domain.Utxo) ([]domain.Utxo, error) {
+func (s *service) GetUtxos(
+	_ context.Context, utxoList []domain.Utxo,
+) ([]domain.Utxo, error) {
 	baseUrl := s.nodeConfig.EsploraUrl
 	client := &http.Client{}
 	utxos := make([]domain.Utxo, 0, len(utxoList))
@@ -181,12 +186,14 @@ func (s *service) GetUtxos(utxoList []domain.Utxo) ([]domain.Utxo, error) {
 }
 
 func (s *service) GetUtxosForAddresses(
-	_ []domain.AddressInfo,
+	_ context.Context, _ []domain.AddressInfo,
 ) ([]*domain.Utxo, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (s *service) BroadcastTransaction(txHex string) (string, error) {
+func (s *service) BroadcastTransaction(
+	_ context.Context, txHex string,
+) (string, error) {
 	tx, err := transaction.NewTxFromHex(txHex)
 	if err != nil {
 		return "", fmt.Errorf("invalid tx: %s", err)
@@ -197,12 +204,44 @@ func (s *service) BroadcastTransaction(txHex string) (string, error) {
 	return tx.TxHash().String(), nil
 }
 
-func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error) {
+func (s *service) EstimateFeeRate(
+	_ context.Context, numOfBlocks uint32,
+) (uint64, error) {
+	// The node doesn't keep any mempool, the estimates of the esplora service
+	// are used instead.
+	estimates, err := s.getEsploraFeeEstimates()
+	if err != nil {
+		return 0, err
+	}
+	// Esplora estimates only a few targets, the closest one not shorter than
+	// the given one is used.
+	target := uint64(0)
+	satsPerByte := float64(0)
+	for key, value := range estimates {
+		numBlocks, err := strconv.ParseUint(key, 10, 32)
+		if err != nil || numBlocks < uint64(numOfBlocks) {
+			continue
+		}
+		if target == 0 || numBlocks < target {
+			target, satsPerByte = numBlocks, value
+		}
+	}
+	if target == 0 {
+		return 0, fmt.Errorf("fee rate estimate not available")
+	}
+	return uint64(math.Round(satsPerByte * 1000)), nil
+}
+
+func (s *service) GetTransactions(
+	_ context.Context, txids []string,
+) ([]domain.Transaction, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (s *service) GetLatestBlock() ([]byte, uint32, error) {
-	block, err := s.headersRepo.ChainTip(context.Background())
+func (s *service) GetLatestBlock(
+	ctx context.Context,
+) ([]byte, uint32, error) {
+	block, err := s.headersRepo.ChainTip(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -210,14 +249,92 @@ func (s *service) GetLatestBlock() ([]byte, uint32, error) {
 	return hash.CloneBytes(), block.Height, nil
 }
 
-func (s *service) GetBlockHash(height uint32) ([]byte, error) {
-	hash, err := s.headersRepo.GetBlockHashByHeight(context.Background(), height)
+func (s *service) GetBlockHash(
+	ctx context.Context, height uint32,
+) ([]byte, error) {
+	hash, err := s.headersRepo.GetBlockHashByHeight(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 	return hash.CloneBytes(), nil
 }
 
+func (s *service) GetStatus() ports.BlockchainScannerStatus {
+	status := ports.BlockchainScannerStatus{
+		AccountsHeight: s.getAccountsHeight(),
+	}
+
+	if tip, err := s.nodeSvc.GetChainTip(); err == nil {
+		status.TipHeight = tip.Height
+		status.TipTimestamp = int64(tip.Timestamp)
+	}
+
+	// The node doesn't expose the tip of its peers, the one of the esplora
+	// service is used instead as reference.
+	height, err := s.getEsploraTipHeight()
+	if err != nil {
+		return status
+	}
+	status.IsConnected = true
+	status.BackendHeight = height
+	return status
+}
+
+func (s *service) getEsploraTipHeight() (uint32, error) {
+	url := fmt.Sprintf("%s/blocks/tip/height", s.nodeConfig.EsploraUrl)
+	resp, err := http.Get(url)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s", body)
+	}
+	height, err := strconv.ParseUint(strings.TrimSpace(string(body)), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(height), nil
+}
+
+func (s *service) getEsploraFeeEstimates() (map[string]float64, error) {
+	url := fmt.Sprintf("%s/fee-estimates", s.nodeConfig.EsploraUrl)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s", body)
+	}
+	estimates := make(map[string]float64)
+	if err := json.Unmarshal(body, &estimates); err != nil {
+		return nil, err
+	}
+	return estimates, nil
+}
+
+func (s *service) getAccountsHeight() map[string]uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	heights := make(map[string]uint32)
+	for accountName, scannerSvc := range s.scanners {
+		heights[accountName] = scannerSvc.getScannedBlockHeight()
+	}
+	return heights
+}
+
 func (s *service) getOrCreateScanner(
 	accountName string, startingBlock uint32,
 ) *scannerService {
@@ -0,0 +1,214 @@
+// Package scopedscanner decorates a blockchain scanner shared among multiple
+// wallets, so that each of them sees only its own accounts.
+package scopedscanner
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const separator = "/"
+
+type scanner struct {
+	ports.BlockchainScanner
+
+	prefix string
+
+	lock         *sync.Mutex
+	utxoChannels map[chan []*domain.Utxo]chan []*domain.Utxo
+	txChannels   map[chan *domain.Transaction]chan *domain.Transaction
+}
+
+// NewScanner returns a view of the given scanner for the wallet with the given
+// id. The names of the accounts are namespaced with the wallet id before
+// reaching the underlying scanner, and restored in the utxos, txs and
+// addresses it returns, therefore the accounts of different wallets never
+// collide.
+// Starting and stopping the underlying scanner is up to its owner, the view
+// ignores both.
+func NewScanner(
+	bcScanner ports.BlockchainScanner, walletID string,
+) ports.BlockchainScanner {
+	return &scanner{
+		BlockchainScanner: bcScanner,
+		prefix:            walletID + separator,
+		lock:              &sync.Mutex{},
+		utxoChannels:      make(map[chan []*domain.Utxo]chan []*domain.Utxo),
+		txChannels: make(
+			map[chan *domain.Transaction]chan *domain.Transaction,
+		),
+	}
+}
+
+func (s *scanner) Start() {}
+
+func (s *scanner) Stop() {}
+
+func (s *scanner) WatchForAccount(
+	accountName string, startingBlockHeight uint32,
+	addresses []domain.AddressInfo,
+) {
+	s.BlockchainScanner.WatchForAccount(
+		s.scope(accountName), startingBlockHeight, s.scopeAddresses(addresses),
+	)
+}
+
+func (s *scanner) WatchForUtxos(accountName string, utxos []domain.UtxoInfo) {
+	scopedUtxos := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, u := range utxos {
+		u.AccountName = s.scope(u.AccountName)
+		scopedUtxos = append(scopedUtxos, u)
+	}
+	s.BlockchainScanner.WatchForUtxos(s.scope(accountName), scopedUtxos)
+}
+
+func (s *scanner) RestoreAccount(
+	accountIndex uint32, accountName, xpub string, masterBlindingKey []byte,
+	startingBlockHeight, addressesThreshold uint32,
+) ([]domain.AddressInfo, []domain.AddressInfo, error) {
+	external, internal, err := s.BlockchainScanner.RestoreAccount(
+		accountIndex, s.scope(accountName), xpub, masterBlindingKey,
+		startingBlockHeight, addressesThreshold,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.unscopeAddresses(external), s.unscopeAddresses(internal), nil
+}
+
+func (s *scanner) StopWatchForAccount(accountName string) {
+	s.BlockchainScanner.StopWatchForAccount(s.scope(accountName))
+}
+
+func (s *scanner) GetUtxoChannel(accountName string) chan []*domain.Utxo {
+	in := s.BlockchainScanner.GetUtxoChannel(s.scope(accountName))
+	if in == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if out, ok := s.utxoChannels[in]; ok {
+		return out
+	}
+	out := make(chan []*domain.Utxo)
+	s.utxoChannels[in] = out
+
+	go func() {
+		for utxos := range in {
+			for _, u := range utxos {
+				u.AccountName = s.unscope(u.AccountName)
+			}
+			out <- utxos
+		}
+
+		s.lock.Lock()
+		delete(s.utxoChannels, in)
+		s.lock.Unlock()
+		close(out)
+	}()
+
+	return out
+}
+
+func (s *scanner) GetTxChannel(accountName string) chan *domain.Transaction {
+	in := s.BlockchainScanner.GetTxChannel(s.scope(accountName))
+	if in == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if out, ok := s.txChannels[in]; ok {
+		return out
+	}
+	out := make(chan *domain.Transaction)
+	s.txChannels[in] = out
+
+	go func() {
+		for tx := range in {
+			accounts := make(map[string]struct{}, len(tx.Accounts))
+			for account := range tx.Accounts {
+				accounts[s.unscope(account)] = struct{}{}
+			}
+			tx.Accounts = accounts
+			out <- tx
+		}
+
+		s.lock.Lock()
+		delete(s.txChannels, in)
+		s.lock.Unlock()
+		close(out)
+	}()
+
+	return out
+}
+
+func (s *scanner) GetUtxosForAddresses(
+	ctx context.Context, addresses []domain.AddressInfo,
+) ([]*domain.Utxo, error) {
+	utxos, err := s.BlockchainScanner.GetUtxosForAddresses(
+		ctx, s.scopeAddresses(addresses),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range utxos {
+		u.AccountName = s.unscope(u.AccountName)
+	}
+	return utxos, nil
+}
+
+// GetStatus returns the status of the underlying scanner, restricted to the
+// accounts of the wallet.
+func (s *scanner) GetStatus() ports.BlockchainScannerStatus {
+	status := s.BlockchainScanner.GetStatus()
+
+	accountsHeight := make(map[string]uint32)
+	for account, height := range status.AccountsHeight {
+		if strings.HasPrefix(account, s.prefix) {
+			accountsHeight[s.unscope(account)] = height
+		}
+	}
+	status.AccountsHeight = accountsHeight
+	return status
+}
+
+func (s *scanner) scope(accountName string) string {
+	if accountName == "" {
+		return ""
+	}
+	return s.prefix + accountName
+}
+
+func (s *scanner) unscope(accountName string) string {
+	return strings.TrimPrefix(accountName, s.prefix)
+}
+
+func (s *scanner) scopeAddresses(
+	addresses []domain.AddressInfo,
+) []domain.AddressInfo {
+	scoped := make([]domain.AddressInfo, 0, len(addresses))
+	for _, a := range addresses {
+		a.Account = s.scope(a.Account)
+		scoped = append(scoped, a)
+	}
+	return scoped
+}
+
+func (s *scanner) unscopeAddresses(
+	addresses []domain.AddressInfo,
+) []domain.AddressInfo {
+	unscoped := make([]domain.AddressInfo, 0, len(addresses))
+	for _, a := range addresses {
+		a.Account = s.unscope(a.Account)
+		unscoped = append(unscoped, a)
+	}
+	return unscoped
+}
@@ -0,0 +1,204 @@
+package branchbound_selector
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	knapsack_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/knapsack"
+)
+
+// maxTries is the max number of branches explored while looking for a
+// changeless selection.
+const maxTries = 100000
+
+var (
+	ErrBlindedUtxos           = fmt.Errorf("error on utxos: all confidential utxos must be already revealed")
+	ErrTargetAmountNotReached = ports.ErrTargetAmountNotReached
+)
+
+type coin struct {
+	utxo  *domain.Utxo
+	value uint64
+}
+
+type selector struct {
+	costs    ports.CoinSelectionCosts
+	fallback ports.CoinSelector
+}
+
+// NewBranchAndBoundCoinSelector returns a coin selector that looks for a
+// selection not requiring any change, ie. exceeding the target amount by less
+// than the cost of the change, by exploring the combinations of utxos
+// largest-first.
+// The knapsack selection is used as fallback. In case both strategies find a
+// selection, the one with the lowest waste is returned.
+// The utxos of the fee asset are selected by effective value, according to
+// the given costs.
+func NewBranchAndBoundCoinSelector(
+	costs ports.CoinSelectionCosts,
+) ports.CoinSelector {
+	return &selector{
+		costs:    costs,
+		fallback: knapsack_selector.NewKnapsackCoinSelector(costs),
+	}
+}
+
+func (s *selector) SelectUtxos(
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) ([]*domain.Utxo, uint64, error) {
+	coins := make([]coin, 0, len(utxos))
+	for _, u := range utxos {
+		if u.IsConfidential() && !u.IsRevealed() {
+			return nil, 0, ErrBlindedUtxos
+		}
+		if u.Asset != targetAsset {
+			continue
+		}
+		// Skip the utxos that cost more than they are worth.
+		if value := s.costs.EffectiveValue(u); value > 0 {
+			coins = append(coins, coin{u, uint64(value)})
+		}
+	}
+
+	// The cost of the change can't be compared with the excess amount of a
+	// selection if they are in different assets, in that case only exact
+	// matches are changeless.
+	costOfChange := uint64(0)
+	if targetAsset == s.costs.FeeAsset {
+		costOfChange = s.costs.ChangeCost
+	}
+
+	selectedUtxos := s.branchAndBound(coins, targetAmount, costOfChange)
+
+	fallbackUtxos, _, err := s.fallback.SelectUtxos(
+		utxos, targetAmount, targetAsset,
+	)
+	if err != nil && len(selectedUtxos) <= 0 {
+		return nil, 0, err
+	}
+	if err == nil {
+		if len(selectedUtxos) <= 0 ||
+			s.waste(fallbackUtxos, targetAmount, targetAsset) <
+				s.waste(selectedUtxos, targetAmount, targetAsset) {
+			selectedUtxos = fallbackUtxos
+		}
+	}
+
+	totalAmount := uint64(0)
+	for _, u := range selectedUtxos {
+		totalAmount += u.Value
+	}
+
+	change := totalAmount - targetAmount
+	return selectedUtxos, change, nil
+}
+
+// branchAndBound explores the binary tree of inclusion and omission of every
+// coin, sorted by descending value, with depth-first search. A branch is cut
+// as soon as its total amount exceeds the target plus the cost of change, or
+// it can't reach the target anymore, or its waste is already higher than the
+// best selection found if spending inputs now is more expensive than later.
+// It returns the selection with the lowest waste, if any.
+func (s *selector) branchAndBound(
+	coins []coin, target, costOfChange uint64,
+) []*domain.Utxo {
+	sort.SliceStable(coins, func(i, j int) bool {
+		return coins[i].value > coins[j].value
+	})
+
+	available := uint64(0)
+	for _, c := range coins {
+		available += c.value
+	}
+	if available < target {
+		return nil
+	}
+
+	inputWaste := int64(s.costs.InputFee) - int64(s.costs.LongTermInputFee)
+	isFeeRateHigh := inputWaste > 0
+
+	current := uint64(0)
+	currentWaste := int64(0)
+	selection := make([]int, 0, len(coins))
+	bestSelection := make([]int, 0)
+	bestWaste := int64(math.MaxInt64)
+
+	for try, index := 0, 0; try < maxTries; try, index = try+1, index+1 {
+		backtrack := false
+		if current+available < target || current > target+costOfChange ||
+			(currentWaste > bestWaste && isFeeRateHigh) {
+			backtrack = true
+		} else if current >= target {
+			// The excess amount goes to fees in a changeless selection.
+			excess := int64(current - target)
+			if currentWaste+excess <= bestWaste {
+				bestSelection = append(bestSelection[:0], selection...)
+				bestWaste = currentWaste + excess
+			}
+			backtrack = true
+		}
+
+		if backtrack {
+			if len(selection) <= 0 {
+				break
+			}
+			// Add back the omitted coins before moving to the omission branch
+			// of the last included one.
+			last := selection[len(selection)-1]
+			for index--; index > last; index-- {
+				available += coins[index].value
+			}
+			current -= coins[index].value
+			currentWaste -= inputWaste
+			selection = selection[:len(selection)-1]
+			continue
+		}
+
+		c := coins[index]
+		available -= c.value
+		// Omitting a coin equal to the previous omitted one would explore the
+		// very same selections, therefore the inclusion branch is skipped.
+		if len(selection) <= 0 || index-1 == selection[len(selection)-1] ||
+			c.value != coins[index-1].value {
+			selection = append(selection, index)
+			current += c.value
+			currentWaste += inputWaste
+		}
+	}
+
+	if len(bestSelection) <= 0 {
+		return nil
+	}
+	selectedUtxos := make([]*domain.Utxo, 0, len(bestSelection))
+	for _, i := range bestSelection {
+		selectedUtxos = append(selectedUtxos, coins[i].utxo)
+	}
+	return selectedUtxos
+}
+
+// waste measures how much a selection costs more than an ideal one: the fees
+// paid to spend its inputs now rather than at the long-term fee rate, plus
+// either the cost of its change or, if changeless, the excess amount that goes
+// to fees.
+func (s *selector) waste(
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) int64 {
+	waste := int64(0)
+	total := int64(0)
+	for _, u := range utxos {
+		waste += int64(s.costs.InputFee) - int64(s.costs.LongTermInputFee)
+		total += s.costs.EffectiveValue(u)
+	}
+
+	excess := total - int64(targetAmount)
+	if excess <= 0 {
+		return waste
+	}
+	if targetAsset == s.costs.FeeAsset && excess <= int64(s.costs.ChangeCost) {
+		return waste + excess
+	}
+	return waste + int64(s.costs.ChangeCost)
+}
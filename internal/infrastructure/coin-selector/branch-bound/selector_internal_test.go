@@ -0,0 +1,163 @@
+package branchbound_selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const (
+	feeAsset   = "fee"
+	otherAsset = "other"
+)
+
+var costs = ports.CoinSelectionCosts{
+	FeeAsset:         feeAsset,
+	InputFee:         10,
+	LongTermInputFee: 5,
+	ChangeCost:       50,
+}
+
+func TestBranchAndBound(t *testing.T) {
+	tests := []struct {
+		name         string
+		values       []uint64
+		target       uint64
+		costOfChange uint64
+		expected     []uint64
+	}{
+		{
+			name:         "exact match",
+			values:       []uint64{1000, 500, 300, 200},
+			target:       700,
+			costOfChange: 0,
+			expected:     []uint64{500, 200},
+		},
+		{
+			name:         "match within cost of change",
+			values:       []uint64{1000, 620, 390},
+			target:       1000,
+			costOfChange: 20,
+			expected:     []uint64{1000},
+		},
+		{
+			name:         "lowest waste",
+			values:       []uint64{1010, 600, 400},
+			target:       1000,
+			costOfChange: 20,
+			expected:     []uint64{600, 400},
+		},
+		{
+			name:         "no changeless selection",
+			values:       []uint64{1000, 500},
+			target:       700,
+			costOfChange: 50,
+			expected:     nil,
+		},
+		{
+			name:         "not enough funds",
+			values:       []uint64{300, 200},
+			target:       700,
+			costOfChange: 50,
+			expected:     nil,
+		},
+	}
+
+	s := &selector{costs: ports.CoinSelectionCosts{FeeAsset: feeAsset}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coins := make([]coin, 0, len(tt.values))
+			for _, v := range tt.values {
+				coins = append(coins, coin{&domain.Utxo{Value: v}, v})
+			}
+
+			utxos := s.branchAndBound(coins, tt.target, tt.costOfChange)
+			var values []uint64
+			for _, u := range utxos {
+				values = append(values, u.Value)
+			}
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestWaste(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []uint64
+		target   uint64
+		asset    string
+		expected int64
+	}{
+		{
+			name:     "exact match",
+			values:   []uint64{510, 210},
+			target:   700,
+			asset:    feeAsset,
+			expected: 10,
+		},
+		{
+			name:     "changeless",
+			values:   []uint64{740},
+			target:   700,
+			asset:    feeAsset,
+			expected: 35,
+		},
+		{
+			name:     "with change",
+			values:   []uint64{1010},
+			target:   700,
+			asset:    feeAsset,
+			expected: 55,
+		},
+		{
+			name:     "with change of other asset",
+			values:   []uint64{710},
+			target:   700,
+			asset:    otherAsset,
+			expected: 55,
+		},
+	}
+
+	s := &selector{costs: costs}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utxos := make([]*domain.Utxo, 0, len(tt.values))
+			for _, v := range tt.values {
+				utxos = append(utxos, &domain.Utxo{Value: v, Asset: tt.asset})
+			}
+			require.Equal(t, tt.expected, s.waste(utxos, tt.target, tt.asset))
+		})
+	}
+}
+
+func TestSelectUtxos(t *testing.T) {
+	utxos := []*domain.Utxo{
+		{Value: 2000, Asset: feeAsset},
+		{Value: 510, Asset: feeAsset},
+		{Value: 310, Asset: feeAsset},
+		{Value: 700, Asset: otherAsset},
+	}
+	s := NewBranchAndBoundCoinSelector(costs)
+
+	t.Run("changeless", func(t *testing.T) {
+		selected, change, err := s.SelectUtxos(utxos, 800, feeAsset)
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+		require.Equal(t, 20, int(change))
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		selected, change, err := s.SelectUtxos(utxos, 1500, feeAsset)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		require.Equal(t, 500, int(change))
+	})
+
+	t.Run("not enough funds", func(t *testing.T) {
+		_, _, err := s.SelectUtxos(utxos, 1000, otherAsset)
+		require.ErrorIs(t, err, ErrTargetAmountNotReached)
+	})
+}
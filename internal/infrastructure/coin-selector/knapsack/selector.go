@@ -0,0 +1,183 @@
+package knapsack_selector
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+// iterations is the number of random subsets evaluated to find the one
+// closest to the target amount.
+const iterations = 1000
+
+var (
+	ErrBlindedUtxos           = fmt.Errorf("error on utxos: all confidential utxos must be already revealed")
+	ErrTargetAmountNotReached = ports.ErrTargetAmountNotReached
+)
+
+type coin struct {
+	utxo  *domain.Utxo
+	value uint64
+}
+
+type selector struct {
+	costs ports.CoinSelectionCosts
+	rand  *rand.Rand
+}
+
+// NewKnapsackCoinSelector returns a coin selector that looks for the subset
+// of utxos whose total amount is closest to the target one, while leaving a
+// change worth its cost. If no subset of the utxos smaller than the target
+// amount does better, the smallest utxo covering it alone is selected.
+// The utxos of the fee asset are selected by effective value, according to
+// the given costs.
+func NewKnapsackCoinSelector(costs ports.CoinSelectionCosts) ports.CoinSelector {
+	return &selector{
+		costs: costs,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (s *selector) SelectUtxos(
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) ([]*domain.Utxo, uint64, error) {
+	coins := make([]coin, 0, len(utxos))
+	for _, u := range utxos {
+		if u.IsConfidential() && !u.IsRevealed() {
+			return nil, 0, ErrBlindedUtxos
+		}
+		if u.Asset != targetAsset {
+			continue
+		}
+		// Skip the utxos that cost more than they are worth.
+		if value := s.costs.EffectiveValue(u); value > 0 {
+			coins = append(coins, coin{u, uint64(value)})
+		}
+	}
+
+	minChange := uint64(0)
+	if targetAsset == s.costs.FeeAsset {
+		minChange = s.costs.ChangeCost
+	}
+
+	selectedCoins := s.selectCoins(coins, targetAmount, minChange)
+	if len(selectedCoins) <= 0 {
+		return nil, 0, ErrTargetAmountNotReached
+	}
+
+	selectedUtxos := make([]*domain.Utxo, 0, len(selectedCoins))
+	totalAmount := uint64(0)
+	for _, c := range selectedCoins {
+		selectedUtxos = append(selectedUtxos, c.utxo)
+		totalAmount += c.utxo.Value
+	}
+
+	change := totalAmount - targetAmount
+	return selectedUtxos, change, nil
+}
+
+// selectCoins returns either an exact match for the target amount, the best
+// subset of the coins smaller than the target amount plus the min change, or
+// the smallest coin bigger than that, whichever is closer to the target.
+func (s *selector) selectCoins(
+	coins []coin, target, minChange uint64,
+) []coin {
+	sort.SliceStable(coins, func(i, j int) bool {
+		return coins[i].value > coins[j].value
+	})
+
+	var lowestLarger *coin
+	smaller := make([]coin, 0, len(coins))
+	smallerTotal := uint64(0)
+	for i := range coins {
+		c := coins[i]
+		if c.value == target {
+			return []coin{c}
+		}
+		if c.value < target+minChange {
+			smaller = append(smaller, c)
+			smallerTotal += c.value
+			continue
+		}
+		lowestLarger = &coins[i]
+	}
+
+	if smallerTotal == target {
+		return smaller
+	}
+	if smallerTotal < target {
+		if lowestLarger == nil {
+			return nil
+		}
+		return []coin{*lowestLarger}
+	}
+
+	best, bestTotal := s.approximateBestSubset(smaller, smallerTotal, target)
+	if bestTotal != target && smallerTotal >= target+minChange {
+		best, bestTotal = s.approximateBestSubset(
+			smaller, smallerTotal, target+minChange,
+		)
+	}
+
+	// The smallest larger coin is preferred if the best subset would leave a
+	// change not worth its cost, or if it's closer to the target anyway.
+	if lowestLarger != nil &&
+		((bestTotal != target && bestTotal < target+minChange) ||
+			lowestLarger.value <= bestTotal) {
+		return []coin{*lowestLarger}
+	}
+	return best
+}
+
+// approximateBestSubset randomly looks for the subset of the given coins,
+// sorted by descending value, with the lowest total amount covering the
+// target one. Every round starts with a random pick and then completes it
+// largest-first.
+func (s *selector) approximateBestSubset(
+	coins []coin, total, target uint64,
+) ([]coin, uint64) {
+	best := make([]bool, len(coins))
+	for i := range best {
+		best[i] = true
+	}
+	bestTotal := total
+
+	for rep := 0; rep < iterations && bestTotal != target; rep++ {
+		included := make([]bool, len(coins))
+		currentTotal := uint64(0)
+		reachedTarget := false
+		for pass := 0; pass < 2 && !reachedTarget; pass++ {
+			for i, c := range coins {
+				if pass == 0 && s.rand.Intn(2) == 0 {
+					continue
+				}
+				if included[i] {
+					continue
+				}
+				currentTotal += c.value
+				included[i] = true
+				if currentTotal >= target {
+					reachedTarget = true
+					if currentTotal < bestTotal {
+						bestTotal = currentTotal
+						copy(best, included)
+					}
+					currentTotal -= c.value
+					included[i] = false
+				}
+			}
+		}
+	}
+
+	subset := make([]coin, 0, len(coins))
+	for i, c := range coins {
+		if best[i] {
+			subset = append(subset, c)
+		}
+	}
+	return subset, bestTotal
+}
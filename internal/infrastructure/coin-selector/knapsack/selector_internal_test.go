@@ -0,0 +1,110 @@
+package knapsack_selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+func TestSelectCoins(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []uint64
+		target    uint64
+		minChange uint64
+		expected  []uint64
+	}{
+		{
+			name:     "exact match",
+			values:   []uint64{100, 700, 300},
+			target:   700,
+			expected: []uint64{700},
+		},
+		{
+			name:     "all smaller coins",
+			values:   []uint64{100, 200, 400, 1000},
+			target:   700,
+			expected: []uint64{400, 200, 100},
+		},
+		{
+			name:     "best subset",
+			values:   []uint64{500, 400, 300, 10000},
+			target:   700,
+			expected: []uint64{400, 300},
+		},
+		{
+			name:      "best subset with min change",
+			values:    []uint64{500, 400, 300, 10000},
+			target:    650,
+			minChange: 50,
+			expected:  []uint64{400, 300},
+		},
+		{
+			name:      "lowest larger coin",
+			values:    []uint64{500, 400, 720},
+			target:    650,
+			minChange: 50,
+			expected:  []uint64{720},
+		},
+		{
+			name:     "not enough funds",
+			values:   []uint64{100, 200},
+			target:   700,
+			expected: nil,
+		},
+	}
+
+	s := NewKnapsackCoinSelector(ports.CoinSelectionCosts{}).(*selector)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coins := make([]coin, 0, len(tt.values))
+			for _, v := range tt.values {
+				coins = append(coins, coin{&domain.Utxo{Value: v}, v})
+			}
+
+			selectedCoins := s.selectCoins(coins, tt.target, tt.minChange)
+			var values []uint64
+			for _, c := range selectedCoins {
+				values = append(values, c.value)
+			}
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestSelectUtxos(t *testing.T) {
+	costs := ports.CoinSelectionCosts{
+		FeeAsset:   "fee",
+		InputFee:   10,
+		ChangeCost: 50,
+	}
+	utxos := []*domain.Utxo{
+		{Value: 2000, Asset: "fee"},
+		{Value: 5, Asset: "fee"},
+		{Value: 510, Asset: "fee"},
+		{Value: 310, Asset: "fee"},
+		{Value: 700, Asset: "other"},
+	}
+	s := NewKnapsackCoinSelector(costs)
+
+	t.Run("fee asset", func(t *testing.T) {
+		selected, change, err := s.SelectUtxos(utxos, 800, "fee")
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+		require.Equal(t, 20, int(change))
+	})
+
+	t.Run("other asset", func(t *testing.T) {
+		selected, change, err := s.SelectUtxos(utxos, 700, "other")
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		require.Zero(t, change)
+	})
+
+	t.Run("not enough funds", func(t *testing.T) {
+		_, _, err := s.SelectUtxos(utxos, 2900, "fee")
+		require.ErrorIs(t, err, ErrTargetAmountNotReached)
+	})
+}
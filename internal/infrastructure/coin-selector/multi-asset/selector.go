@@ -0,0 +1,120 @@
+package multiasset_selector
+
+import (
+	"sort"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+// maxRounds is the max number of attempts to select the utxos of the fee
+// asset covering also the fees to spend themselves. Selectors not aware of
+// such fees may need more than one.
+const maxRounds = 10
+
+var ErrTargetAmountNotReached = ports.ErrTargetAmountNotReached
+
+type selector struct {
+	selector ports.CoinSelector
+	costs    ports.CoinSelectionCosts
+}
+
+// NewMultiAssetCoinSelector returns a coin selector that covers the target
+// amounts of multiple assets and the network fees in one pass, by using the
+// given single-asset selector for every asset.
+// The utxos of the other assets are selected first, so that the ones of the
+// fee asset cover their target amount plus the fees for the whole tx, which
+// grow with every selected input and change output according to the given
+// costs. A change of the fee asset is added only if worth its cost, otherwise
+// it's paid as fees.
+func NewMultiAssetCoinSelector(
+	coinSelector ports.CoinSelector, costs ports.CoinSelectionCosts,
+) ports.MultiAssetCoinSelector {
+	return &selector{coinSelector, costs}
+}
+
+func (s *selector) SelectUtxosForTargets(
+	utxos []*domain.Utxo, targetAmounts map[string]uint64, baseFee uint64,
+) ([]*domain.Utxo, map[string]uint64, uint64, error) {
+	assets := make([]string, 0, len(targetAmounts))
+	for asset := range targetAmounts {
+		if asset != s.costs.FeeAsset {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Strings(assets)
+
+	selectedUtxos := make([]*domain.Utxo, 0)
+	changeByAsset := make(map[string]uint64)
+	feeAmount := baseFee
+	for _, asset := range assets {
+		assetUtxos, change, err := s.selector.SelectUtxos(
+			utxos, targetAmounts[asset], asset,
+		)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		selectedUtxos = append(selectedUtxos, assetUtxos...)
+		feeAmount += uint64(len(assetUtxos)) * s.costs.InputFee
+		if change > 0 {
+			changeByAsset[asset] = change
+			feeAmount += s.costs.ChangeOutputFee
+		}
+	}
+
+	feeAssetUtxos, change, feeAmount, err := s.selectFeeAssetUtxos(
+		utxos, targetAmounts[s.costs.FeeAsset], feeAmount,
+	)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	selectedUtxos = append(selectedUtxos, feeAssetUtxos...)
+	if change > 0 {
+		changeByAsset[s.costs.FeeAsset] = change
+	}
+
+	return selectedUtxos, changeByAsset, feeAmount, nil
+}
+
+// selectFeeAssetUtxos returns the utxos of the fee asset covering the given
+// target and fee amounts, plus the fees for themselves and for the eventual
+// change output. It returns also the change and the final fee amounts.
+func (s *selector) selectFeeAssetUtxos(
+	utxos []*domain.Utxo, targetAmount, feeAmount uint64,
+) ([]*domain.Utxo, uint64, uint64, error) {
+	if targetAmount+feeAmount == 0 {
+		return nil, 0, 0, nil
+	}
+
+	target := targetAmount + feeAmount
+	for round := 0; round < maxRounds; round++ {
+		selectedUtxos, _, err := s.selector.SelectUtxos(
+			utxos, target, s.costs.FeeAsset,
+		)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		totalAmount := uint64(0)
+		for _, u := range selectedUtxos {
+			totalAmount += u.Value
+		}
+		inputsFee := uint64(len(selectedUtxos)) * s.costs.InputFee
+		requiredAmount := targetAmount + feeAmount + inputsFee
+		if totalAmount < requiredAmount {
+			target += requiredAmount - totalAmount
+			continue
+		}
+
+		fee := feeAmount + inputsFee
+		excess := totalAmount - requiredAmount
+		if excess <= s.costs.ChangeCost ||
+			excess < s.costs.ChangeOutputFee+s.costs.DustAmount {
+			return selectedUtxos, 0, fee + excess, nil
+		}
+		change := excess - s.costs.ChangeOutputFee
+		return selectedUtxos, change, fee + s.costs.ChangeOutputFee, nil
+	}
+
+	return nil, 0, 0, ErrTargetAmountNotReached
+}
@@ -0,0 +1,138 @@
+package multiasset_selector_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	bnb_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/branch-bound"
+	multiasset_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/multi-asset"
+	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
+)
+
+const (
+	lbtc = "lbtc"
+	usdt = "usdt"
+)
+
+var costs = ports.CoinSelectionCosts{
+	FeeAsset:         lbtc,
+	InputFee:         10,
+	LongTermInputFee: 5,
+	ChangeCost:       45,
+	ChangeOutputFee:  40,
+	DustAmount:       100,
+}
+
+func TestSelectUtxosForTargets(t *testing.T) {
+	tests := []struct {
+		name           string
+		coinSelector   ports.CoinSelector
+		targetAmounts  map[string]uint64
+		baseFee        uint64
+		expectedInputs int
+		expectedChange map[string]uint64
+		expectedFee    uint64
+	}{
+		{
+			name:           "with change",
+			coinSelector:   bnb_selector.NewBranchAndBoundCoinSelector(costs),
+			targetAmounts:  map[string]uint64{lbtc: 500},
+			baseFee:        20,
+			expectedInputs: 1,
+			expectedChange: map[string]uint64{lbtc: 430},
+			expectedFee:    70,
+		},
+		{
+			name:           "changeless",
+			coinSelector:   bnb_selector.NewBranchAndBoundCoinSelector(costs),
+			targetAmounts:  map[string]uint64{lbtc: 490},
+			baseFee:        20,
+			expectedInputs: 1,
+			expectedChange: map[string]uint64{},
+			expectedFee:    30,
+		},
+		{
+			name:           "multi asset",
+			coinSelector:   bnb_selector.NewBranchAndBoundCoinSelector(costs),
+			targetAmounts:  map[string]uint64{lbtc: 490, usdt: 700},
+			baseFee:        20,
+			expectedInputs: 2,
+			expectedChange: map[string]uint64{lbtc: 430},
+			expectedFee:    80,
+		},
+		{
+			name:           "fees only",
+			coinSelector:   bnb_selector.NewBranchAndBoundCoinSelector(costs),
+			targetAmounts:  map[string]uint64{usdt: 300},
+			baseFee:        20,
+			expectedInputs: 2,
+			expectedChange: map[string]uint64{lbtc: 440},
+			expectedFee:    80,
+		},
+		{
+			name:           "selector not fee-aware",
+			coinSelector:   ss_selector.NewSmallestSubsetCoinSelector(),
+			targetAmounts:  map[string]uint64{lbtc: 500},
+			baseFee:        20,
+			expectedInputs: 1,
+			expectedChange: map[string]uint64{lbtc: 430},
+			expectedFee:    70,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := multiasset_selector.NewMultiAssetCoinSelector(tt.coinSelector, costs)
+			selectedUtxos, changeByAsset, feeAmount, err := s.SelectUtxosForTargets(
+				newUtxos(), tt.targetAmounts, tt.baseFee,
+			)
+			require.NoError(t, err)
+			require.Len(t, selectedUtxos, tt.expectedInputs)
+			require.Equal(t, tt.expectedChange, changeByAsset)
+			require.Equal(t, tt.expectedFee, feeAmount)
+
+			// The selected utxos must balance the targets, change and fees.
+			inAmounts := make(map[string]uint64)
+			for _, u := range selectedUtxos {
+				inAmounts[u.Asset] += u.Value
+			}
+			outAmounts := make(map[string]uint64)
+			for asset, amount := range tt.targetAmounts {
+				outAmounts[asset] += amount
+			}
+			for asset, amount := range changeByAsset {
+				outAmounts[asset] += amount
+			}
+			outAmounts[lbtc] += feeAmount
+			require.Equal(t, outAmounts, inAmounts)
+		})
+	}
+}
+
+func TestSelectUtxosForTargetsFailing(t *testing.T) {
+	s := multiasset_selector.NewMultiAssetCoinSelector(
+		bnb_selector.NewBranchAndBoundCoinSelector(costs), costs,
+	)
+
+	_, _, _, err := s.SelectUtxosForTargets(
+		newUtxos(), map[string]uint64{usdt: 2000}, 20,
+	)
+	require.ErrorIs(t, err, ports.ErrTargetAmountNotReached)
+
+	_, _, _, err = s.SelectUtxosForTargets(
+		newUtxos(), map[string]uint64{lbtc: 11500}, 20,
+	)
+	require.ErrorIs(t, err, ports.ErrTargetAmountNotReached)
+}
+
+func newUtxos() []*domain.Utxo {
+	return []*domain.Utxo{
+		{UtxoKey: domain.UtxoKey{VOut: 0}, Value: 10000, Asset: lbtc},
+		{UtxoKey: domain.UtxoKey{VOut: 1}, Value: 1000, Asset: lbtc},
+		{UtxoKey: domain.UtxoKey{VOut: 2}, Value: 520, Asset: lbtc},
+		{UtxoKey: domain.UtxoKey{VOut: 3}, Value: 700, Asset: usdt},
+		{UtxoKey: domain.UtxoKey{VOut: 4}, Value: 300, Asset: usdt},
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 var (
 	ErrBlindedUtxos           = fmt.Errorf("error on utxos: all confidential utxos must be already revealed")
-	ErrTargetAmountNotReached = fmt.Errorf("not found enough utxos to cover target amount")
+	ErrTargetAmountNotReached = ports.ErrTargetAmountNotReached
 )
 
 type selector struct{}
@@ -0,0 +1,218 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+)
+
+const (
+	UtxoStatusUnconfirmed = "unconfirmed"
+	UtxoStatusConfirmed   = "confirmed"
+	UtxoStatusLocked      = "locked"
+	UtxoStatusSpent       = "spent"
+)
+
+// CollectTimeout is the max time to wait for the wallet metrics to be read
+// from db at every scrape.
+var CollectTimeout = 10 * time.Second
+
+var (
+	accountBalanceDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "account", "balance"),
+		"Balance of the account in satoshis, by asset and status "+
+			"(confirmed, unconfirmed or locked).",
+		[]string{"account", "asset", "status"}, nil,
+	)
+	accountUtxosDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "account", "utxos"),
+		"Number of utxos of the account, by status "+
+			"(unconfirmed, confirmed, locked or spent).",
+		[]string{"account", "status"}, nil,
+	)
+	accountLockedUtxosExpiryDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "account", "locked_utxos_next_expiry_timestamp_seconds",
+		),
+		"Unix timestamp of the first expiring lock among the account utxos.",
+		[]string{"account"}, nil,
+	)
+	accountScannedHeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "account", "scanned_height"),
+		"Height of the last block scanned for the account.",
+		[]string{"account"}, nil,
+	)
+	scannerConnectedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "scanner", "connected"),
+		"Whether the blockchain scanner backend is reachable.",
+		nil, nil,
+	)
+	scannerTipHeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "scanner", "tip_height"),
+		"Height of the chain tip known by the blockchain scanner.",
+		nil, nil,
+	)
+	scannerBackendHeightDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "scanner", "backend_height"),
+		"Height of the chain tip known by the blockchain scanner backend.",
+		nil, nil,
+	)
+)
+
+// walletCollector is the Prometheus collector of the metrics about the state
+// of the wallet and of the blockchain scanner. The metrics are read from db
+// and scanner at every scrape, therefore they're never out of date.
+type walletCollector struct {
+	repoManager ports.RepoManager
+	bcScanner   ports.BlockchainScanner
+
+	log  func(format string, a ...interface{})
+	warn func(err error, format string, a ...interface{})
+}
+
+// NewWalletCollector returns the collector of the metrics about balances and
+// utxos of every wallet account and about the sync status of the scanner.
+func NewWalletCollector(
+	repoManager ports.RepoManager, bcScanner ports.BlockchainScanner,
+) prometheus.Collector {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("metrics: %s", format)
+		log.Debugf(format, a...)
+	}
+	warnFn := func(err error, format string, a ...interface{}) {
+		format = fmt.Sprintf("metrics: %s", format)
+		log.WithError(err).Warnf(format, a...)
+	}
+	return &walletCollector{repoManager, bcScanner, logFn, warnFn}
+}
+
+func (c *walletCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- accountBalanceDesc
+	ch <- accountUtxosDesc
+	ch <- accountLockedUtxosExpiryDesc
+	ch <- accountScannedHeightDesc
+	ch <- scannerConnectedDesc
+	ch <- scannerTipHeightDesc
+	ch <- scannerBackendHeightDesc
+}
+
+func (c *walletCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectScannerMetrics(ch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), CollectTimeout)
+	defer cancel()
+
+	w, err := c.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		// Nothing to collect if the wallet is not yet initialized.
+		c.log("skip collecting account metrics: %s", err)
+		return
+	}
+
+	for accountName := range w.Accounts {
+		c.collectAccountMetrics(ctx, ch, accountName)
+	}
+}
+
+func (c *walletCollector) collectScannerMetrics(ch chan<- prometheus.Metric) {
+	status := c.bcScanner.GetStatus()
+
+	connected := 0.0
+	if status.IsConnected {
+		connected = 1
+	}
+	ch <- prometheus.MustNewConstMetric(
+		scannerConnectedDesc, prometheus.GaugeValue, connected,
+	)
+	ch <- prometheus.MustNewConstMetric(
+		scannerTipHeightDesc, prometheus.GaugeValue, float64(status.TipHeight),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		scannerBackendHeightDesc, prometheus.GaugeValue,
+		float64(status.BackendHeight),
+	)
+	for account, height := range status.AccountsHeight {
+		ch <- prometheus.MustNewConstMetric(
+			accountScannedHeightDesc, prometheus.GaugeValue, float64(height),
+			account,
+		)
+	}
+}
+
+func (c *walletCollector) collectAccountMetrics(
+	ctx context.Context, ch chan<- prometheus.Metric, accountName string,
+) {
+	balances, err := c.repoManager.UtxoRepository().GetBalanceForAccount(
+		ctx, accountName,
+	)
+	if err != nil {
+		c.warn(err, "failed to get balance for account %s", accountName)
+		return
+	}
+	for asset, balance := range balances {
+		for status, amount := range map[string]uint64{
+			UtxoStatusConfirmed:   balance.Confirmed,
+			UtxoStatusUnconfirmed: balance.Unconfirmed,
+			UtxoStatusLocked:      balance.Locked,
+		} {
+			ch <- prometheus.MustNewConstMetric(
+				accountBalanceDesc, prometheus.GaugeValue, float64(amount),
+				accountName, asset, status,
+			)
+		}
+	}
+
+	utxos, err := c.repoManager.UtxoRepository().GetAllUtxosForAccount(
+		ctx, accountName,
+	)
+	if err != nil {
+		c.warn(err, "failed to get utxos for account %s", accountName)
+		return
+	}
+	count := map[string]int{
+		UtxoStatusUnconfirmed: 0,
+		UtxoStatusConfirmed:   0,
+		UtxoStatusLocked:      0,
+		UtxoStatusSpent:       0,
+	}
+	var nextExpiry int64
+	for _, u := range utxos {
+		status := utxoStatus(u)
+		count[status]++
+		if status == UtxoStatusLocked {
+			if nextExpiry == 0 || u.LockExpiryTimestamp < nextExpiry {
+				nextExpiry = u.LockExpiryTimestamp
+			}
+		}
+	}
+	for status, num := range count {
+		ch <- prometheus.MustNewConstMetric(
+			accountUtxosDesc, prometheus.GaugeValue, float64(num),
+			accountName, status,
+		)
+	}
+	if nextExpiry > 0 {
+		ch <- prometheus.MustNewConstMetric(
+			accountLockedUtxosExpiryDesc, prometheus.GaugeValue,
+			float64(nextExpiry), accountName,
+		)
+	}
+}
+
+func utxoStatus(u *domain.Utxo) string {
+	if u.IsSpent() {
+		return UtxoStatusSpent
+	}
+	if u.IsLocked() {
+		return UtxoStatusLocked
+	}
+	if u.IsConfirmed() {
+		return UtxoStatusConfirmed
+	}
+	return UtxoStatusUnconfirmed
+}
@@ -0,0 +1,162 @@
+package metrics_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	"github.com/vulpemventures/ocean/internal/infrastructure/metrics"
+	cypher "github.com/vulpemventures/ocean/internal/infrastructure/mnemonic-cypher/aes128"
+	store "github.com/vulpemventures/ocean/internal/infrastructure/mnemonic-store/in-memory"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+var (
+	ctx      = context.Background()
+	password = "password"
+	mnemonic = []string{
+		"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+		"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+		"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+	}
+	accountNamespace = "bip84-account0"
+	asset            = network.Regtest.AssetID
+)
+
+func TestWalletCollector(t *testing.T) {
+	t.Run("without wallet", func(t *testing.T) {
+		collector := metrics.NewWalletCollector(
+			inmemory.NewRepoManager(), &bcScanner{},
+		)
+
+		expected := `
+# HELP ocean_scanner_connected Whether the blockchain scanner backend is reachable.
+# TYPE ocean_scanner_connected gauge
+ocean_scanner_connected 0
+`
+		err := testutil.CollectAndCompare(
+			collector, strings.NewReader(expected), "ocean_scanner_connected",
+		)
+		require.NoError(t, err)
+		// Only the scanner metrics are collected.
+		require.Equal(t, 3, testutil.CollectAndCount(collector))
+	})
+
+	t.Run("with wallet", func(t *testing.T) {
+		rm, err := newRepoManager()
+		require.NoError(t, err)
+
+		collector := metrics.NewWalletCollector(rm, &bcScanner{
+			status: ports.BlockchainScannerStatus{
+				IsConnected:    true,
+				TipHeight:      100,
+				BackendHeight:  101,
+				AccountsHeight: map[string]uint32{accountNamespace: 99},
+			},
+		})
+
+		expected := `
+# HELP ocean_account_balance Balance of the account in satoshis, by asset and status (confirmed, unconfirmed or locked).
+# TYPE ocean_account_balance gauge
+ocean_account_balance{account="bip84-account0",asset="` + asset + `",status="confirmed"} 2000
+ocean_account_balance{account="bip84-account0",asset="` + asset + `",status="locked"} 3000
+ocean_account_balance{account="bip84-account0",asset="` + asset + `",status="unconfirmed"} 1000
+# HELP ocean_account_utxos Number of utxos of the account, by status (unconfirmed, confirmed, locked or spent).
+# TYPE ocean_account_utxos gauge
+ocean_account_utxos{account="bip84-account0",status="confirmed"} 1
+ocean_account_utxos{account="bip84-account0",status="locked"} 1
+ocean_account_utxos{account="bip84-account0",status="spent"} 1
+ocean_account_utxos{account="bip84-account0",status="unconfirmed"} 1
+# HELP ocean_account_locked_utxos_next_expiry_timestamp_seconds Unix timestamp of the first expiring lock among the account utxos.
+# TYPE ocean_account_locked_utxos_next_expiry_timestamp_seconds gauge
+ocean_account_locked_utxos_next_expiry_timestamp_seconds{account="bip84-account0"} 2e+09
+# HELP ocean_account_scanned_height Height of the last block scanned for the account.
+# TYPE ocean_account_scanned_height gauge
+ocean_account_scanned_height{account="bip84-account0"} 99
+# HELP ocean_scanner_connected Whether the blockchain scanner backend is reachable.
+# TYPE ocean_scanner_connected gauge
+ocean_scanner_connected 1
+# HELP ocean_scanner_tip_height Height of the chain tip known by the blockchain scanner.
+# TYPE ocean_scanner_tip_height gauge
+ocean_scanner_tip_height 100
+# HELP ocean_scanner_backend_height Height of the chain tip known by the blockchain scanner backend.
+# TYPE ocean_scanner_backend_height gauge
+ocean_scanner_backend_height 101
+`
+		err = testutil.CollectAndCompare(collector, strings.NewReader(expected))
+		require.NoError(t, err)
+	})
+}
+
+// bcScanner is a stub of the blockchain scanner, it implements only the
+// method used by the collector.
+type bcScanner struct {
+	ports.BlockchainScanner
+	status ports.BlockchainScannerStatus
+}
+
+func (s *bcScanner) GetStatus() ports.BlockchainScannerStatus {
+	return s.status
+}
+
+func newRepoManager() (ports.RepoManager, error) {
+	domain.MnemonicCypher = cypher.NewAES128Cypher()
+	domain.MnemonicStore = store.NewInMemoryMnemonicStore()
+	rm := inmemory.NewRepoManager()
+
+	wallet, err := domain.NewWallet(
+		mnemonic, password, "m/84'/1'", network.Regtest.Name, 1, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := rm.WalletRepository().CreateWallet(ctx, wallet); err != nil {
+		return nil, err
+	}
+	if err := rm.WalletRepository().UpdateWallet(
+		ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+			w.Unlock(password)
+			w.CreateAccount("test", 0, false)
+			return w, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	confirmed := domain.UtxoStatus{BlockHeight: 1, BlockHash: "hash"}
+	utxos := []*domain.Utxo{
+		newUtxo("01", 1000, domain.UtxoStatus{}),
+		newUtxo("02", 2000, confirmed),
+		newUtxo("03", 3000, confirmed),
+		newUtxo("04", 4000, confirmed),
+	}
+	if _, err := rm.UtxoRepository().AddUtxos(ctx, utxos); err != nil {
+		return nil, err
+	}
+	if _, err := rm.UtxoRepository().LockUtxos(
+		ctx, []domain.UtxoKey{utxos[2].Key()}, 1, 2000000000, "",
+	); err != nil {
+		return nil, err
+	}
+	if _, err := rm.UtxoRepository().SpendUtxos(
+		ctx, []domain.UtxoKey{utxos[3].Key()}, "txid",
+	); err != nil {
+		return nil, err
+	}
+	return rm, nil
+}
+
+func newUtxo(txid string, value uint64, status domain.UtxoStatus) *domain.Utxo {
+	return &domain.Utxo{
+		UtxoKey:         domain.UtxoKey{TxID: txid},
+		Value:           value,
+		Asset:           asset,
+		AccountName:     accountNamespace,
+		ConfirmedStatus: status,
+	}
+}
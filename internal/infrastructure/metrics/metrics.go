@@ -0,0 +1,87 @@
+// Package metrics defines the Prometheus metrics about the activity of the
+// daemon. They are all registered with the default registry, that is
+// published on the profiler port.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "ocean"
+
+var (
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "Latency of the served RPCs, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	scannerRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the requests to the electrum server, by transport and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"transport", "method"})
+
+	scannerRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "request_errors_total",
+		Help:      "Number of failed requests to the electrum server, by transport and method.",
+	}, []string{"transport", "method"})
+
+	scannerReconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scanner",
+		Name:      "reconnects_total",
+		Help:      "Number of reconnections to the electrum server, by transport.",
+	}, []string{"transport"})
+
+	webhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "deliveries_total",
+		Help:      "Number of webhook deliveries, by event type and result.",
+	}, []string{"event", "result"})
+)
+
+// ObserveRpc records the latency of an RPC that terminated with the given
+// status code.
+func ObserveRpc(method, code string, duration time.Duration) {
+	rpcDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// ObserveScannerRequest records the latency of a request to the electrum
+// server through the given transport (tcp or ws), and whether it failed.
+func ObserveScannerRequest(
+	transport, method string, duration time.Duration, failed bool,
+) {
+	scannerRequestDuration.WithLabelValues(transport, method).Observe(
+		duration.Seconds(),
+	)
+	if failed {
+		scannerRequestErrors.WithLabelValues(transport, method).Inc()
+	}
+}
+
+// IncScannerReconnects records a reconnection to the electrum server through
+// the given transport.
+func IncScannerReconnects(transport string) {
+	scannerReconnects.WithLabelValues(transport).Inc()
+}
+
+// ObserveWebhookDelivery records the result of the delivery of a webhook for
+// the given event type.
+func ObserveWebhookDelivery(event string, delivered bool) {
+	result := "success"
+	if !delivered {
+		result = "failure"
+	}
+	webhookDeliveries.WithLabelValues(event, result).Inc()
+}
@@ -0,0 +1,139 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+// addressLabel is the record stored for the labels of every labelled script
+// of an account.
+type addressLabel struct {
+	AccountName string
+	Script      string
+	Labels      []string
+}
+
+type addressLabelRepository struct {
+	store *badgerhold.Store
+
+	log func(format string, a ...interface{})
+}
+
+func NewAddressLabelRepository(
+	store *badgerhold.Store,
+) domain.AddressLabelRepository {
+	return newAddressLabelRepository(store)
+}
+
+func newAddressLabelRepository(
+	store *badgerhold.Store,
+) *addressLabelRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("address label repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &addressLabelRepository{store, logFn}
+}
+
+func (r *addressLabelRepository) SetAddressLabels(
+	ctx context.Context, accountName, script string, labels []string,
+) error {
+	key := addressLabelKey(accountName, script)
+
+	var err error
+	if len(labels) <= 0 {
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxDelete(tx, key, addressLabel{})
+		} else {
+			err = r.store.Delete(key, addressLabel{})
+		}
+		if err != nil && err != badgerhold.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+
+	label := addressLabel{accountName, script, labels}
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(tx, key, label)
+	} else {
+		err = r.store.Upsert(key, label)
+	}
+	if err != nil {
+		return err
+	}
+
+	r.log("set labels for script %s of account %s", script, accountName)
+	return nil
+}
+
+func (r *addressLabelRepository) GetAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) (map[string][]string, error) {
+	list, err := r.findLabels(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsByScript := make(map[string][]string)
+	for _, l := range list {
+		labelsByScript[l.Script] = l.Labels
+	}
+	return labelsByScript, nil
+}
+
+func (r *addressLabelRepository) DeleteAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) error {
+	query := badgerhold.Where("AccountName").Eq(accountName)
+
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDeleteMatching(tx, addressLabel{}, query)
+	} else {
+		err = r.store.DeleteMatching(addressLabel{}, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+func (r *addressLabelRepository) findLabels(
+	ctx context.Context, accountName string,
+) ([]addressLabel, error) {
+	var list []addressLabel
+	var err error
+
+	query := badgerhold.Where("AccountName").Eq(accountName)
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *addressLabelRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *addressLabelRepository) close() {
+	r.store.Close()
+}
+
+func addressLabelKey(accountName, script string) string {
+	return fmt.Sprintf("%s:%s", accountName, script)
+}
@@ -0,0 +1,107 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type auditRepository struct {
+	store *badgerhold.Store
+
+	log func(format string, a ...interface{})
+}
+
+func NewAuditEventRepository(
+	store *badgerhold.Store,
+) domain.AuditEventRepository {
+	return newAuditEventRepository(store)
+}
+
+func newAuditEventRepository(store *badgerhold.Store) *auditRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("audit repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &auditRepository{store, logFn}
+}
+
+func (r *auditRepository) AddEvent(
+	ctx context.Context, event *domain.AuditEvent,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, event.Sequence, *event)
+	} else {
+		err = r.store.Insert(event.Sequence, *event)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added event %d", event.Sequence)
+	return true, nil
+}
+
+func (r *auditRepository) GetLastEvent(
+	ctx context.Context,
+) (*domain.AuditEvent, error) {
+	query := badgerhold.Where("Sequence").Ge(uint64(0)).
+		SortBy("Sequence").Reverse().Limit(1)
+
+	events, err := r.findEvents(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) <= 0 {
+		return nil, domain.ErrAuditEventNotFound
+	}
+	return &events[0], nil
+}
+
+func (r *auditRepository) GetEvents(
+	ctx context.Context, fromSequence, limit uint64,
+) ([]domain.AuditEvent, error) {
+	query := badgerhold.Where("Sequence").Ge(fromSequence).
+		SortBy("Sequence").Limit(int(limit))
+
+	return r.findEvents(ctx, query)
+}
+
+func (r *auditRepository) findEvents(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.AuditEvent, error) {
+	var list []domain.AuditEvent
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *auditRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *auditRepository) close() {
+	r.store.Close()
+}
@@ -0,0 +1,194 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type broadcastRepository struct {
+	store    *badgerhold.Store
+	chEvents chan domain.BroadcastEvent
+	chLock   *sync.Mutex
+
+	log func(format string, a ...interface{})
+}
+
+func NewBroadcastRepository(store *badgerhold.Store) domain.BroadcastRepository {
+	return newBroadcastRepository(store)
+}
+
+func newBroadcastRepository(store *badgerhold.Store) *broadcastRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("broadcast repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &broadcastRepository{
+		store, make(chan domain.BroadcastEvent), &sync.Mutex{}, logFn,
+	}
+}
+
+func (r *broadcastRepository) AddBroadcast(
+	ctx context.Context, broadcast *domain.Broadcast,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, broadcast.Txid, *broadcast)
+	} else {
+		err = r.store.Insert(broadcast.Txid, *broadcast)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added broadcast for tx %s", broadcast.Txid)
+
+	go r.publishEvent(domain.BroadcastEvent{
+		EventType:  domain.BroadcastPending,
+		Broadcasts: []domain.Broadcast{*broadcast},
+	})
+
+	return true, nil
+}
+
+func (r *broadcastRepository) GetBroadcast(
+	ctx context.Context, txid string,
+) (*domain.Broadcast, error) {
+	return r.getBroadcast(ctx, txid)
+}
+
+func (r *broadcastRepository) GetPendingBroadcasts(
+	ctx context.Context,
+) ([]domain.Broadcast, error) {
+	query := badgerhold.Where("Status").Eq(domain.BroadcastStatusPending)
+	return r.findBroadcasts(ctx, query)
+}
+
+func (r *broadcastRepository) UpdateBroadcasts(
+	ctx context.Context, broadcasts []domain.Broadcast,
+) error {
+	updatedBroadcasts := make([]domain.Broadcast, 0, len(broadcasts))
+	changedStatus := make(map[string]bool)
+	for _, broadcast := range broadcasts {
+		b, err := r.getBroadcast(ctx, broadcast.Txid)
+		if err != nil {
+			return err
+		}
+		changedStatus[b.Txid] = b.Status != broadcast.Status
+		b.Status = broadcast.Status
+		b.Attempts = broadcast.Attempts
+		b.NextCheckAt = broadcast.NextCheckAt
+		b.ConflictingTxid = broadcast.ConflictingTxid
+		updatedBroadcasts = append(updatedBroadcasts, *b)
+	}
+
+	broadcastsByStatus := make(map[domain.BroadcastStatus][]domain.Broadcast)
+	for _, b := range updatedBroadcasts {
+		var err error
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxUpdate(tx, b.Txid, b)
+		} else {
+			err = r.store.Update(b.Txid, b)
+		}
+		if err != nil {
+			return err
+		}
+		if changedStatus[b.Txid] {
+			broadcastsByStatus[b.Status] = append(broadcastsByStatus[b.Status], b)
+		}
+	}
+
+	r.log("updated %d broadcasts", len(updatedBroadcasts))
+
+	for status, broadcasts := range broadcastsByStatus {
+		go r.publishEvent(domain.BroadcastEvent{
+			EventType:  domain.BroadcastEventTypeFromStatus(status),
+			Broadcasts: broadcasts,
+		})
+	}
+	return nil
+}
+
+func (r *broadcastRepository) GetEventChannel() chan domain.BroadcastEvent {
+	return r.chEvents
+}
+
+func (r *broadcastRepository) getBroadcast(
+	ctx context.Context, txid string,
+) (*domain.Broadcast, error) {
+	var err error
+	var broadcast domain.Broadcast
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, txid, &broadcast)
+	} else {
+		err = r.store.Get(txid, &broadcast)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrBroadcastNotFound
+		}
+		return nil, err
+	}
+
+	return &broadcast, nil
+}
+
+func (r *broadcastRepository) findBroadcasts(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.Broadcast, error) {
+	var list []domain.Broadcast
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return nil, err
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].CreatedAt == list[j].CreatedAt {
+			return list[i].Txid < list[j].Txid
+		}
+		return list[i].CreatedAt < list[j].CreatedAt
+	})
+	return list, nil
+}
+
+func (r *broadcastRepository) publishEvent(event domain.BroadcastEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *broadcastRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *broadcastRepository) close() {
+	r.store.Close()
+	close(r.chEvents)
+}
@@ -0,0 +1,162 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type idempotencyRepository struct {
+	store *badgerhold.Store
+
+	log func(format string, a ...interface{})
+}
+
+func NewIdempotencyKeyRepository(
+	store *badgerhold.Store,
+) domain.IdempotencyKeyRepository {
+	return newIdempotencyKeyRepository(store)
+}
+
+func newIdempotencyKeyRepository(
+	store *badgerhold.Store,
+) *idempotencyRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("idempotency repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &idempotencyRepository{store, logFn}
+}
+
+func (r *idempotencyRepository) AddKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, key.Key, *key)
+	} else {
+		err = r.store.Insert(key.Key, *key)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added key %s for method %s", key.Key, key.Method)
+	return true, nil
+}
+
+func (r *idempotencyRepository) GetKey(
+	ctx context.Context, key string,
+) (*domain.IdempotencyKey, error) {
+	return r.getKey(ctx, key)
+}
+
+func (r *idempotencyRepository) UpdateKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) error {
+	k, err := r.getKey(ctx, key.Key)
+	if err != nil {
+		return err
+	}
+
+	k.Status = key.Status
+	k.Result = key.Result
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpdate(tx, k.Key, *k)
+	} else {
+		err = r.store.Update(k.Key, *k)
+	}
+	if err != nil {
+		return err
+	}
+
+	r.log("updated key %s", key.Key)
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteKey(
+	ctx context.Context, key string,
+) error {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, key, domain.IdempotencyKey{})
+	} else {
+		err = r.store.Delete(key, domain.IdempotencyKey{})
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpiredKeys(
+	ctx context.Context, now int64,
+) (int, error) {
+	var list []domain.IdempotencyKey
+	var err error
+
+	query := badgerhold.Where("ExpiresAt").Le(now)
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return 0, err
+	}
+
+	for _, k := range list {
+		if err := r.DeleteKey(ctx, k.Key); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(list) > 0 {
+		r.log("deleted %d expired keys", len(list))
+	}
+	return len(list), nil
+}
+
+func (r *idempotencyRepository) getKey(
+	ctx context.Context, key string,
+) (*domain.IdempotencyKey, error) {
+	var err error
+	var k domain.IdempotencyKey
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, key, &k)
+	} else {
+		err = r.store.Get(key, &k)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+
+	return &k, nil
+}
+
+func (r *idempotencyRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *idempotencyRepository) close() {
+	r.store.Close()
+}
@@ -0,0 +1,199 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type paymentRepository struct {
+	store    *badgerhold.Store
+	chEvents chan domain.PaymentEvent
+	chLock   *sync.Mutex
+
+	log func(format string, a ...interface{})
+}
+
+func NewPaymentRepository(store *badgerhold.Store) domain.PaymentRepository {
+	return newPaymentRepository(store)
+}
+
+func newPaymentRepository(store *badgerhold.Store) *paymentRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("payment repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &paymentRepository{
+		store, make(chan domain.PaymentEvent), &sync.Mutex{}, logFn,
+	}
+}
+
+func (r *paymentRepository) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, payment.ID, *payment)
+	} else {
+		err = r.store.Insert(payment.ID, *payment)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added payment %s for account %s", payment.ID, payment.AccountName)
+
+	go r.publishEvent(domain.PaymentEvent{
+		EventType: domain.PaymentQueued,
+		Payments:  []domain.Payment{*payment},
+	})
+
+	return true, nil
+}
+
+func (r *paymentRepository) GetPayment(
+	ctx context.Context, id string,
+) (*domain.Payment, error) {
+	return r.getPayment(ctx, id)
+}
+
+func (r *paymentRepository) GetQueuedPayments(
+	ctx context.Context, accountName string,
+) ([]domain.Payment, error) {
+	query := badgerhold.Where("Status").Eq(domain.PaymentStatusQueued)
+	if accountName != "" {
+		query = query.And("AccountName").Eq(accountName)
+	}
+	return r.findPayments(ctx, query)
+}
+
+func (r *paymentRepository) GetPaymentsByTxid(
+	ctx context.Context, txid string,
+) ([]domain.Payment, error) {
+	query := badgerhold.Where("Txid").Eq(txid)
+	return r.findPayments(ctx, query)
+}
+
+func (r *paymentRepository) UpdatePayments(
+	ctx context.Context, payments []domain.Payment,
+) error {
+	updatedPayments := make([]domain.Payment, 0, len(payments))
+	for _, payment := range payments {
+		p, err := r.getPayment(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		p.Status = payment.Status
+		p.Txid = payment.Txid
+		p.OutputIndex = payment.OutputIndex
+		updatedPayments = append(updatedPayments, *p)
+	}
+
+	paymentsByStatus := make(map[domain.PaymentStatus][]domain.Payment)
+	for _, p := range updatedPayments {
+		var err error
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxUpdate(tx, p.ID, p)
+		} else {
+			err = r.store.Update(p.ID, p)
+		}
+		if err != nil {
+			return err
+		}
+		paymentsByStatus[p.Status] = append(paymentsByStatus[p.Status], p)
+	}
+
+	r.log("updated %d payments", len(updatedPayments))
+
+	for status, payments := range paymentsByStatus {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentEventTypeFromStatus(status),
+			Payments:  payments,
+		})
+	}
+	return nil
+}
+
+func (r *paymentRepository) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepository) getPayment(
+	ctx context.Context, id string,
+) (*domain.Payment, error) {
+	var err error
+	var payment domain.Payment
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, id, &payment)
+	} else {
+		err = r.store.Get(id, &payment)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (r *paymentRepository) findPayments(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.Payment, error) {
+	var list []domain.Payment
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return nil, err
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].CreatedAt == list[j].CreatedAt {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].CreatedAt < list[j].CreatedAt
+	})
+	return list, nil
+}
+
+func (r *paymentRepository) publishEvent(event domain.PaymentEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *paymentRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *paymentRepository) close() {
+	r.store.Close()
+	close(r.chEvents)
+}
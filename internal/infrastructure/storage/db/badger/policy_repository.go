@@ -0,0 +1,199 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type policyRepository struct {
+	store *badgerhold.Store
+
+	log func(format string, a ...interface{})
+}
+
+func NewSpendingPolicyRepository(
+	store *badgerhold.Store,
+) domain.SpendingPolicyRepository {
+	return newSpendingPolicyRepository(store)
+}
+
+func newSpendingPolicyRepository(store *badgerhold.Store) *policyRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("policy repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &policyRepository{store, logFn}
+}
+
+func (r *policyRepository) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) error {
+	return r.upsertPolicy(ctx, *policy)
+}
+
+func (r *policyRepository) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	return r.getPolicy(ctx, accountName)
+}
+
+func (r *policyRepository) UpdatePolicy(
+	ctx context.Context, accountName string,
+	updateFn func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error),
+) error {
+	policy, err := r.getPolicy(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
+	updatedPolicy, err := updateFn(policy)
+	if err != nil {
+		return err
+	}
+
+	return r.upsertPolicy(ctx, *updatedPolicy)
+}
+
+func (r *policyRepository) DeletePolicy(
+	ctx context.Context, accountName string,
+) (bool, error) {
+	return r.delete(ctx, accountName, domain.SpendingPolicy{})
+}
+
+func (r *policyRepository) AddApproval(
+	ctx context.Context, approval *domain.SpendApproval,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, approval.ID, *approval)
+	} else {
+		err = r.store.Insert(approval.ID, *approval)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added approval %s", approval.ID)
+	return true, nil
+}
+
+func (r *policyRepository) GetApproval(
+	ctx context.Context, id string,
+) (*domain.SpendApproval, error) {
+	var err error
+	var approval domain.SpendApproval
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, id, &approval)
+	} else {
+		err = r.store.Get(id, &approval)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrSpendApprovalNotFound
+		}
+		return nil, err
+	}
+
+	return &approval, nil
+}
+
+func (r *policyRepository) GetAllApprovals(
+	ctx context.Context,
+) ([]domain.SpendApproval, error) {
+	var list []domain.SpendApproval
+	var err error
+
+	query := &badgerhold.Query{}
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *policyRepository) DeleteApproval(
+	ctx context.Context, id string,
+) (bool, error) {
+	return r.delete(ctx, id, domain.SpendApproval{})
+}
+
+func (r *policyRepository) upsertPolicy(
+	ctx context.Context, policy domain.SpendingPolicy,
+) error {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		return r.store.TxUpsert(tx, policy.AccountName, policy)
+	}
+	return r.store.Upsert(policy.AccountName, policy)
+}
+
+func (r *policyRepository) getPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	var err error
+	var policy domain.SpendingPolicy
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, accountName, &policy)
+	} else {
+		err = r.store.Get(accountName, &policy)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrPolicyNotFound
+		}
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *policyRepository) delete(
+	ctx context.Context, key string, dataType interface{},
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, key, dataType)
+	} else {
+		err = r.store.Delete(key, dataType)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *policyRepository) close() {
+	r.store.Close()
+}
@@ -1,6 +1,7 @@
 package dbbadger
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"sync"
@@ -21,6 +22,13 @@ type repoManager struct {
 	walletRepository *walletRepository
 	txRepository     *transactionRepository
 	scriptRepository *scriptRepository
+	policyRepository *policyRepository
+	auditRepository  *auditRepository
+	idempotencyRepo  *idempotencyRepository
+	paymentRepo      *paymentRepository
+	addressLabelRepo *addressLabelRepository
+	broadcastRepo    *broadcastRepository
+	scheduledTxRepo  *scheduledTxRepository
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -34,12 +42,20 @@ type repoManager struct {
 // is provided - to be used only for testing purposes), and opening and closing
 // the connection to them.
 func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager, error) {
-	var walletdbDir, utxoDir, txDir, scriptDir string
+	var walletdbDir, utxoDir, txDir, scriptDir, policyDir, auditDir, idempotencyDir, paymentDir string
+	var addressLabelDir, broadcastDir, scheduledTxDir string
 	if len(baseDbDir) > 0 {
 		walletdbDir = filepath.Join(baseDbDir, "wallet")
 		utxoDir = filepath.Join(baseDbDir, "utxos")
 		txDir = filepath.Join(baseDbDir, "txs")
 		scriptDir = filepath.Join(baseDbDir, "scripts")
+		policyDir = filepath.Join(baseDbDir, "policies")
+		auditDir = filepath.Join(baseDbDir, "audit")
+		idempotencyDir = filepath.Join(baseDbDir, "idempotency")
+		paymentDir = filepath.Join(baseDbDir, "payments")
+		addressLabelDir = filepath.Join(baseDbDir, "address-labels")
+		broadcastDir = filepath.Join(baseDbDir, "broadcasts")
+		scheduledTxDir = filepath.Join(baseDbDir, "scheduled-txs")
 	}
 
 	walletDb, err := createDb(walletdbDir, logger)
@@ -60,17 +76,59 @@ func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager,
 	if err != nil {
 		return nil, fmt.Errorf("opening external scripts db: %w", err)
 	}
+	policyDb, err := createDb(policyDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening spending policies db: %w", err)
+	}
+	auditDb, err := createDb(auditDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit db: %w", err)
+	}
+	idempotencyDb, err := createDb(idempotencyDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening idempotency keys db: %w", err)
+	}
+	paymentDb, err := createDb(paymentDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening payments db: %w", err)
+	}
+	addressLabelDb, err := createDb(addressLabelDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening address labels db: %w", err)
+	}
+	broadcastDb, err := createDb(broadcastDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening broadcasts db: %w", err)
+	}
+	scheduledTxDb, err := createDb(scheduledTxDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening scheduled txs db: %w", err)
+	}
 
 	utxoRepo := newUtxoRepository(utxoDb)
 	walletRepo := newWalletRepository(walletDb)
 	txRepo := newTransactionRepository(txDb)
 	scriptRepo := newExternalScriptRepository(scriptDb)
+	policyRepo := newSpendingPolicyRepository(policyDb)
+	auditRepo := newAuditEventRepository(auditDb)
+	idempotencyRepo := newIdempotencyKeyRepository(idempotencyDb)
+	paymentRepo := newPaymentRepository(paymentDb)
+	addressLabelRepo := newAddressLabelRepository(addressLabelDb)
+	broadcastRepo := newBroadcastRepository(broadcastDb)
+	scheduledTxRepo := newScheduledTxRepository(scheduledTxDb)
 
 	rm := &repoManager{
 		utxoRepository:      utxoRepo,
 		walletRepository:    walletRepo,
 		txRepository:        txRepo,
 		scriptRepository:    scriptRepo,
+		policyRepository:    policyRepo,
+		auditRepository:     auditRepo,
+		idempotencyRepo:     idempotencyRepo,
+		paymentRepo:         paymentRepo,
+		addressLabelRepo:    addressLabelRepo,
+		broadcastRepo:       broadcastRepo,
+		scheduledTxRepo:     scheduledTxRepo,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -101,6 +159,34 @@ func (d *repoManager) ExternalScriptRepository() domain.ExternalScriptRepository
 	return d.scriptRepository
 }
 
+func (d *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return d.policyRepository
+}
+
+func (d *repoManager) AuditEventRepository() domain.AuditEventRepository {
+	return d.auditRepository
+}
+
+func (d *repoManager) IdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return d.idempotencyRepo
+}
+
+func (d *repoManager) PaymentRepository() domain.PaymentRepository {
+	return d.paymentRepo
+}
+
+func (d *repoManager) AddressLabelRepository() domain.AddressLabelRepository {
+	return d.addressLabelRepo
+}
+
+func (d *repoManager) BroadcastRepository() domain.BroadcastRepository {
+	return d.broadcastRepo
+}
+
+func (d *repoManager) ScheduledTxRepository() domain.ScheduledTxRepository {
+	return d.scheduledTxRepo
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -130,6 +216,29 @@ func (d *repoManager) Reset() {
 	d.utxoRepository.reset()
 	d.txRepository.reset()
 	d.scriptRepository.reset()
+	d.policyRepository.reset()
+	d.auditRepository.reset()
+	d.idempotencyRepo.reset()
+	d.paymentRepo.reset()
+	d.addressLabelRepo.reset()
+	d.broadcastRepo.reset()
+	d.scheduledTxRepo.reset()
+}
+
+func (d *repoManager) Ping(_ context.Context) error {
+	stores := []*badgerhold.Store{
+		d.walletRepository.store, d.utxoRepository.store, d.txRepository.store,
+		d.scriptRepository.store, d.policyRepository.store,
+		d.auditRepository.store, d.idempotencyRepo.store,
+		d.paymentRepo.store, d.addressLabelRepo.store, d.broadcastRepo.store,
+		d.scheduledTxRepo.store,
+	}
+	for _, store := range stores {
+		if store.Badger().IsClosed() {
+			return fmt.Errorf("db is closed")
+		}
+	}
+	return nil
 }
 
 func (d *repoManager) Close() {
@@ -137,6 +246,13 @@ func (d *repoManager) Close() {
 	d.utxoRepository.close()
 	d.txRepository.close()
 	d.scriptRepository.close()
+	d.policyRepository.close()
+	d.auditRepository.close()
+	d.idempotencyRepo.close()
+	d.paymentRepo.close()
+	d.addressLabelRepo.close()
+	d.broadcastRepo.close()
+	d.scheduledTxRepo.close()
 }
 
 func (rm *repoManager) listenToWalletEvents() {
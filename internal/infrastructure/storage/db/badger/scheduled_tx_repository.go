@@ -0,0 +1,153 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/badger/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type scheduledTxRepository struct {
+	store *badgerhold.Store
+
+	log func(format string, a ...interface{})
+}
+
+func NewScheduledTxRepository(
+	store *badgerhold.Store,
+) domain.ScheduledTxRepository {
+	return newScheduledTxRepository(store)
+}
+
+func newScheduledTxRepository(store *badgerhold.Store) *scheduledTxRepository {
+	logFn := func(format string, a ...interface{}) {
+		format = fmt.Sprintf("scheduled tx repository: %s", format)
+		log.Debugf(format, a...)
+	}
+	return &scheduledTxRepository{store, logFn}
+}
+
+func (r *scheduledTxRepository) AddScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		dbTx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(dbTx, tx.Txid, *tx)
+	} else {
+		err = r.store.Insert(tx.Txid, *tx)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+
+	r.log("added scheduled tx %s", tx.Txid)
+	return true, nil
+}
+
+func (r *scheduledTxRepository) GetScheduledTx(
+	ctx context.Context, txid string,
+) (*domain.ScheduledTx, error) {
+	return r.getScheduledTx(ctx, txid)
+}
+
+func (r *scheduledTxRepository) GetAllScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	return r.findScheduledTxs(ctx, nil)
+}
+
+func (r *scheduledTxRepository) GetPendingScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	query := badgerhold.Where("Status").Eq(domain.ScheduledTxStatusScheduled)
+	return r.findScheduledTxs(ctx, query)
+}
+
+func (r *scheduledTxRepository) UpdateScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) error {
+	storedTx, err := r.getScheduledTx(ctx, tx.Txid)
+	if err != nil {
+		return err
+	}
+	storedTx.Status = tx.Status
+	storedTx.ConflictingTxid = tx.ConflictingTxid
+
+	if ctx.Value("tx") != nil {
+		dbTx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpdate(dbTx, storedTx.Txid, *storedTx)
+	} else {
+		err = r.store.Update(storedTx.Txid, *storedTx)
+	}
+	if err != nil {
+		return err
+	}
+
+	r.log("updated scheduled tx %s to status %s", tx.Txid, tx.Status)
+	return nil
+}
+
+func (r *scheduledTxRepository) getScheduledTx(
+	ctx context.Context, txid string,
+) (*domain.ScheduledTx, error) {
+	var err error
+	var tx domain.ScheduledTx
+
+	if ctx.Value("tx") != nil {
+		dbTx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(dbTx, txid, &tx)
+	} else {
+		err = r.store.Get(txid, &tx)
+	}
+
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrScheduledTxNotFound
+		}
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+func (r *scheduledTxRepository) findScheduledTxs(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.ScheduledTx, error) {
+	var list []domain.ScheduledTx
+	var err error
+
+	if ctx.Value("tx") != nil {
+		dbTx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(dbTx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil && err != badgerhold.ErrNotFound {
+		return nil, err
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].CreatedAt == list[j].CreatedAt {
+			return list[i].Txid < list[j].Txid
+		}
+		return list[i].CreatedAt < list[j].CreatedAt
+	})
+	return list, nil
+}
+
+func (r *scheduledTxRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *scheduledTxRepository) close() {
+	r.store.Close()
+}
@@ -81,6 +81,32 @@ func (r *transactionRepository) ConfirmTransaction(
 	return true, nil
 }
 
+func (r *transactionRepository) EvictTransaction(
+	ctx context.Context, txid, replacementTxid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if tx.IsConfirmed() || tx.IsEvicted() {
+		return false, nil
+	}
+
+	tx.Evict(replacementTxid)
+
+	if err := r.updateTx(ctx, *tx); err != nil {
+		return false, err
+	}
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionEvicted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (r *transactionRepository) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
@@ -70,7 +70,8 @@ func (r *utxoRepository) GetSpendableUtxos(
 	ctx context.Context,
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
-		And("LockTimestamp").Eq(int64(0))
+		And("LockTimestamp").Eq(int64(0)).And("FrozenTimestamp").Eq(int64(0)).
+		And("EvictedTimestamp").Eq(int64(0))
 
 	return r.findUtxos(ctx, query)
 }
@@ -87,7 +88,8 @@ func (r *utxoRepository) GetSpendableUtxosForAccount(
 	ctx context.Context, accountName string, scripts [][]byte,
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
-		And("LockTimestamp").Eq(int64(0)).And("AccountName").Eq(accountName)
+		And("LockTimestamp").Eq(int64(0)).And("FrozenTimestamp").Eq(int64(0)).
+		And("EvictedTimestamp").Eq(int64(0)).And("AccountName").Eq(accountName)
 
 	utxos, err := r.findUtxos(ctx, query)
 	if err != nil {
@@ -114,7 +116,36 @@ func (r *utxoRepository) GetLockedUtxosForAccount(
 	ctx context.Context, accountName string, scripts [][]byte,
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
-		And("LockTimestamp").Gt(int64(0)).And("AccountName").Eq(accountName)
+		And("LockTimestamp").Gt(int64(0)).And("FrozenTimestamp").Eq(int64(0)).
+		And("EvictedTimestamp").Eq(int64(0)).And("AccountName").Eq(accountName)
+
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scripts) <= 0 {
+		return utxos, nil
+	}
+
+	filteredUtxos := make([]*domain.Utxo, 0, len(utxos))
+	for _, u := range utxos {
+		for _, script := range scripts {
+			if bytes.Equal(u.Script, script) {
+				filteredUtxos = append(filteredUtxos, u)
+				break
+			}
+		}
+	}
+	return filteredUtxos, nil
+}
+
+func (r *utxoRepository) GetFrozenUtxosForAccount(
+	ctx context.Context, accountName string, scripts [][]byte,
+) ([]*domain.Utxo, error) {
+	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
+		And("FrozenTimestamp").Gt(int64(0)).And("EvictedTimestamp").Eq(int64(0)).
+		And("AccountName").Eq(accountName)
 
 	utxos, err := r.findUtxos(ctx, query)
 	if err != nil {
@@ -147,7 +178,7 @@ func (r *utxoRepository) GetBalanceForAccount(
 
 	balance := make(map[string]*domain.Balance)
 	for _, u := range utxos {
-		if u.IsSpent() {
+		if u.IsSpent() || u.IsEvicted() {
 			continue
 		}
 
@@ -156,7 +187,9 @@ func (r *utxoRepository) GetBalanceForAccount(
 		}
 
 		b := balance[u.Asset]
-		if u.IsLocked() {
+		if u.IsFrozen() {
+			b.Frozen += u.Value
+		} else if u.IsLocked() {
 			b.Locked += u.Value
 		} else {
 			if u.IsConfirmed() {
@@ -175,6 +208,12 @@ func (r *utxoRepository) SpendUtxos(
 	return r.spendUtxos(ctx, utxoKeys, txid)
 }
 
+func (r *utxoRepository) RespendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (int, error) {
+	return r.respendUtxos(ctx, utxoKeys, txid)
+}
+
 func (r *utxoRepository) ConfirmSpendUtxos(
 	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -190,8 +229,9 @@ func (r *utxoRepository) ConfirmUtxos(
 func (r *utxoRepository) LockUtxos(
 	ctx context.Context,
 	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (int, error) {
-	return r.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp)
+	return r.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp, owner)
 }
 
 func (r *utxoRepository) UnlockUtxos(
@@ -200,6 +240,54 @@ func (r *utxoRepository) UnlockUtxos(
 	return r.unlockUtxos(ctx, utxoKeys)
 }
 
+func (r *utxoRepository) ReleaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, owner string,
+) (int, error) {
+	return r.releaseUtxos(ctx, utxoKeys, owner)
+}
+
+func (r *utxoRepository) FreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	return r.freezeUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (r *utxoRepository) UnfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return r.unfreezeUtxos(ctx, utxoKeys)
+}
+
+func (r *utxoRepository) EvictUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	return r.evictUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (r *utxoRepository) SetUtxoLabels(
+	ctx context.Context, utxoKeys []domain.UtxoKey, labels []string,
+) (int, error) {
+	count := 0
+	for _, key := range utxoKeys {
+		query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+		utxos, err := r.findUtxos(ctx, query)
+		if err != nil {
+			return -1, err
+		}
+		if utxos == nil {
+			continue
+		}
+
+		utxo := utxos[0]
+		utxo.SetLabels(labels)
+		if err := r.updateUtxo(ctx, utxo); err != nil {
+			return -1, err
+		}
+		count++
+	}
+	return count, nil
+}
+
 func (r *utxoRepository) DeleteUtxosForAccount(
 	ctx context.Context, accountName string,
 ) error {
@@ -276,6 +364,31 @@ func (r *utxoRepository) spendUtxos(
 	return count, nil
 }
 
+func (r *utxoRepository) respendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.respendUtxo(ctx, key, txid)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoSpent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) confirmSpendUtxos(
 	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -330,11 +443,12 @@ func (r *utxoRepository) confirmUtxos(
 func (r *utxoRepository) lockUtxos(
 	ctx context.Context,
 	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0)
 	for _, key := range utxoKeys {
-		done, info, err := r.lockUtxo(ctx, key, timestamp, expiryTimestamp)
+		done, info, err := r.lockUtxo(ctx, key, timestamp, expiryTimestamp, owner)
 		if err != nil {
 			return -1, err
 		}
@@ -380,6 +494,110 @@ func (r *utxoRepository) unlockUtxos(
 	return count, nil
 }
 
+func (r *utxoRepository) releaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, owner string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.releaseUtxo(ctx, key, owner)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) freezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.freezeUtxo(ctx, key, timestamp)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) unfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.unfreezeUtxo(ctx, key)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) evictUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.evictUtxo(ctx, key, timestamp)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoEvicted,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) spendUtxo(
 	ctx context.Context, key domain.UtxoKey, txid string,
 ) (bool, *domain.UtxoInfo, error) {
@@ -409,6 +627,36 @@ func (r *utxoRepository) spendUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (r *utxoRepository) respendUtxo(
+	ctx context.Context, key domain.UtxoKey, txid string,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsSpent() || utxo.IsConfirmedSpent() ||
+		utxo.SpentStatus.Txid == txid {
+		return false, nil, nil
+	}
+
+	if err := utxo.Respend(txid); err != nil {
+		return false, nil, err
+	}
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
 func (r *utxoRepository) confirmSpendUtxo(
 	ctx context.Context, key domain.UtxoKey, status domain.UtxoStatus,
 ) (bool, *domain.UtxoInfo, error) {
@@ -469,6 +717,7 @@ func (r *utxoRepository) confirmUtxo(
 
 func (r *utxoRepository) lockUtxo(
 	ctx context.Context, key domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (bool, *domain.UtxoInfo, error) {
 	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
 	utxos, err := r.findUtxos(ctx, query)
@@ -481,11 +730,11 @@ func (r *utxoRepository) lockUtxo(
 	}
 
 	utxo := utxos[0]
-	if utxo.IsLocked() {
+	if !utxo.CanLock(owner) {
 		return false, nil, nil
 	}
 
-	utxo.Lock(timestamp, expiryTimestamp)
+	utxo.Lock(timestamp, expiryTimestamp, owner)
 	if err := r.updateUtxo(ctx, utxo); err != nil {
 		return false, nil, err
 	}
@@ -508,7 +757,7 @@ func (r *utxoRepository) unlockUtxo(
 	}
 
 	utxo := utxos[0]
-	if !utxo.IsLocked() {
+	if !utxo.IsLocked() || !utxo.CanUnlock() {
 		return false, nil, nil
 	}
 
@@ -521,6 +770,117 @@ func (r *utxoRepository) unlockUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (r *utxoRepository) releaseUtxo(
+	ctx context.Context, key domain.UtxoKey, owner string,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsLocked() {
+		return false, nil, nil
+	}
+	if len(owner) > 0 && !utxo.IsLockedBy(owner) {
+		return false, nil, nil
+	}
+
+	utxo.Release()
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (r *utxoRepository) freezeUtxo(
+	ctx context.Context, key domain.UtxoKey, timestamp int64,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if utxo.IsFrozen() {
+		return false, nil, nil
+	}
+
+	utxo.Freeze(timestamp)
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (r *utxoRepository) unfreezeUtxo(
+	ctx context.Context, key domain.UtxoKey,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsFrozen() {
+		return false, nil, nil
+	}
+
+	utxo.Unfreeze()
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (r *utxoRepository) evictUtxo(
+	ctx context.Context, key domain.UtxoKey, timestamp int64,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if utxo.IsEvicted() {
+		return false, nil, nil
+	}
+
+	utxo.Evict(timestamp)
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
 func (r *utxoRepository) findUtxos(
 	ctx context.Context, query *badgerhold.Query,
 ) ([]*domain.Utxo, error) {
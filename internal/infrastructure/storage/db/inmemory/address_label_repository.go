@@ -0,0 +1,77 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type addressLabelInmemoryStore struct {
+	labelsByAccount map[string]map[string][]string
+	lock            *sync.RWMutex
+}
+
+type addressLabelRepository struct {
+	store *addressLabelInmemoryStore
+}
+
+func NewAddressLabelRepository() domain.AddressLabelRepository {
+	return newAddressLabelRepository()
+}
+
+func newAddressLabelRepository() *addressLabelRepository {
+	return &addressLabelRepository{&addressLabelInmemoryStore{
+		labelsByAccount: make(map[string]map[string][]string),
+		lock:            &sync.RWMutex{},
+	}}
+}
+
+func (r *addressLabelRepository) SetAddressLabels(
+	_ context.Context, accountName, script string, labels []string,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	labelsByScript, ok := r.store.labelsByAccount[accountName]
+	if !ok {
+		labelsByScript = make(map[string][]string)
+		r.store.labelsByAccount[accountName] = labelsByScript
+	}
+	if len(labels) <= 0 {
+		delete(labelsByScript, script)
+		return nil
+	}
+	labelsByScript[script] = append([]string{}, labels...)
+	return nil
+}
+
+func (r *addressLabelRepository) GetAddressLabelsForAccount(
+	_ context.Context, accountName string,
+) (map[string][]string, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	labelsByScript := make(map[string][]string)
+	for script, labels := range r.store.labelsByAccount[accountName] {
+		labelsByScript[script] = append([]string{}, labels...)
+	}
+	return labelsByScript, nil
+}
+
+func (r *addressLabelRepository) DeleteAddressLabelsForAccount(
+	_ context.Context, accountName string,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	delete(r.store.labelsByAccount, accountName)
+	return nil
+}
+
+func (r *addressLabelRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.labelsByAccount = make(map[string]map[string][]string)
+}
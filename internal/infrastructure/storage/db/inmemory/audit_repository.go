@@ -0,0 +1,87 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type auditInmemoryStore struct {
+	events []domain.AuditEvent
+	lock   *sync.RWMutex
+}
+
+type auditRepository struct {
+	store *auditInmemoryStore
+}
+
+func NewAuditEventRepository() domain.AuditEventRepository {
+	return newAuditEventRepository()
+}
+
+func newAuditEventRepository() *auditRepository {
+	return &auditRepository{
+		store: &auditInmemoryStore{
+			events: make([]domain.AuditEvent, 0),
+			lock:   &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *auditRepository) AddEvent(
+	_ context.Context, event *domain.AuditEvent,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	// Events are stored sorted by sequence, starting from 1, therefore the
+	// sequence of the next event must be equal to the length of the log + 1.
+	if event.Sequence <= uint64(len(r.store.events)) {
+		return false, nil
+	}
+
+	r.store.events = append(r.store.events, *event)
+	return true, nil
+}
+
+func (r *auditRepository) GetLastEvent(
+	_ context.Context,
+) (*domain.AuditEvent, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	if len(r.store.events) <= 0 {
+		return nil, domain.ErrAuditEventNotFound
+	}
+
+	event := r.store.events[len(r.store.events)-1]
+	return &event, nil
+}
+
+func (r *auditRepository) GetEvents(
+	_ context.Context, fromSequence, limit uint64,
+) ([]domain.AuditEvent, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	events := make([]domain.AuditEvent, 0)
+	for _, e := range r.store.events {
+		if uint64(len(events)) >= limit {
+			break
+		}
+		if e.Sequence >= fromSequence {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *auditRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.events = make([]domain.AuditEvent, 0)
+}
+
+func (r *auditRepository) close() {}
@@ -0,0 +1,154 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type broadcastInmemoryStore struct {
+	broadcasts map[string]domain.Broadcast
+	lock       *sync.RWMutex
+}
+
+type broadcastRepository struct {
+	store    *broadcastInmemoryStore
+	chEvents chan domain.BroadcastEvent
+	chLock   *sync.Mutex
+}
+
+func NewBroadcastRepository() domain.BroadcastRepository {
+	return newBroadcastRepository()
+}
+
+func newBroadcastRepository() *broadcastRepository {
+	return &broadcastRepository{
+		store: &broadcastInmemoryStore{
+			broadcasts: make(map[string]domain.Broadcast),
+			lock:       &sync.RWMutex{},
+		},
+		chEvents: make(chan domain.BroadcastEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *broadcastRepository) AddBroadcast(
+	_ context.Context, broadcast *domain.Broadcast,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.broadcasts[broadcast.Txid]; ok {
+		return false, nil
+	}
+
+	r.store.broadcasts[broadcast.Txid] = *broadcast
+
+	go r.publishEvent(domain.BroadcastEvent{
+		EventType:  domain.BroadcastPending,
+		Broadcasts: []domain.Broadcast{*broadcast},
+	})
+
+	return true, nil
+}
+
+func (r *broadcastRepository) GetBroadcast(
+	_ context.Context, txid string,
+) (*domain.Broadcast, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	broadcast, ok := r.store.broadcasts[txid]
+	if !ok {
+		return nil, domain.ErrBroadcastNotFound
+	}
+	return &broadcast, nil
+}
+
+func (r *broadcastRepository) GetPendingBroadcasts(
+	_ context.Context,
+) ([]domain.Broadcast, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	broadcasts := make([]domain.Broadcast, 0)
+	for _, b := range r.store.broadcasts {
+		if b.IsPending() {
+			broadcasts = append(broadcasts, b)
+		}
+	}
+	sortBroadcasts(broadcasts)
+	return broadcasts, nil
+}
+
+func (r *broadcastRepository) UpdateBroadcasts(
+	_ context.Context, broadcasts []domain.Broadcast,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	for _, broadcast := range broadcasts {
+		if _, ok := r.store.broadcasts[broadcast.Txid]; !ok {
+			return domain.ErrBroadcastNotFound
+		}
+	}
+
+	broadcastsByStatus := make(map[domain.BroadcastStatus][]domain.Broadcast)
+	for _, broadcast := range broadcasts {
+		b := r.store.broadcasts[broadcast.Txid]
+		statusChanged := b.Status != broadcast.Status
+		b.Status = broadcast.Status
+		b.Attempts = broadcast.Attempts
+		b.NextCheckAt = broadcast.NextCheckAt
+		b.ConflictingTxid = broadcast.ConflictingTxid
+		r.store.broadcasts[broadcast.Txid] = b
+		if statusChanged {
+			broadcastsByStatus[b.Status] = append(broadcastsByStatus[b.Status], b)
+		}
+	}
+
+	for status, broadcasts := range broadcastsByStatus {
+		go r.publishEvent(domain.BroadcastEvent{
+			EventType:  domain.BroadcastEventTypeFromStatus(status),
+			Broadcasts: broadcasts,
+		})
+	}
+	return nil
+}
+
+func (r *broadcastRepository) GetEventChannel() chan domain.BroadcastEvent {
+	return r.chEvents
+}
+
+func (r *broadcastRepository) publishEvent(event domain.BroadcastEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *broadcastRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.broadcasts = make(map[string]domain.Broadcast)
+}
+
+func (r *broadcastRepository) close() {
+	close(r.chEvents)
+}
+
+func sortBroadcasts(broadcasts []domain.Broadcast) {
+	sort.SliceStable(broadcasts, func(i, j int) bool {
+		if broadcasts[i].CreatedAt == broadcasts[j].CreatedAt {
+			return broadcasts[i].Txid < broadcasts[j].Txid
+		}
+		return broadcasts[i].CreatedAt < broadcasts[j].CreatedAt
+	})
+}
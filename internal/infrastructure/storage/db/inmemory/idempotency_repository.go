@@ -0,0 +1,109 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type idempotencyInmemoryStore struct {
+	keys map[string]domain.IdempotencyKey
+	lock *sync.RWMutex
+}
+
+type idempotencyRepository struct {
+	store *idempotencyInmemoryStore
+}
+
+func NewIdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return newIdempotencyKeyRepository()
+}
+
+func newIdempotencyKeyRepository() *idempotencyRepository {
+	return &idempotencyRepository{
+		store: &idempotencyInmemoryStore{
+			keys: make(map[string]domain.IdempotencyKey),
+			lock: &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *idempotencyRepository) AddKey(
+	_ context.Context, key *domain.IdempotencyKey,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.keys[key.Key]; ok {
+		return false, nil
+	}
+
+	r.store.keys[key.Key] = *key
+	return true, nil
+}
+
+func (r *idempotencyRepository) GetKey(
+	_ context.Context, key string,
+) (*domain.IdempotencyKey, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	k, ok := r.store.keys[key]
+	if !ok {
+		return nil, domain.ErrIdempotencyKeyNotFound
+	}
+	return &k, nil
+}
+
+func (r *idempotencyRepository) UpdateKey(
+	_ context.Context, key *domain.IdempotencyKey,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	k, ok := r.store.keys[key.Key]
+	if !ok {
+		return domain.ErrIdempotencyKeyNotFound
+	}
+
+	k.Status = key.Status
+	k.Result = key.Result
+	r.store.keys[key.Key] = k
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteKey(
+	_ context.Context, key string,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	delete(r.store.keys, key)
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpiredKeys(
+	_ context.Context, now int64,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	count := 0
+	for key, k := range r.store.keys {
+		if k.IsExpired(now) {
+			delete(r.store.keys, key)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *idempotencyRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.keys = make(map[string]domain.IdempotencyKey)
+}
+
+func (r *idempotencyRepository) close() {}
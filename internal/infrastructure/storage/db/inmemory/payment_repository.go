@@ -0,0 +1,170 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type paymentInmemoryStore struct {
+	payments map[string]domain.Payment
+	lock     *sync.RWMutex
+}
+
+type paymentRepository struct {
+	store    *paymentInmemoryStore
+	chEvents chan domain.PaymentEvent
+	chLock   *sync.Mutex
+}
+
+func NewPaymentRepository() domain.PaymentRepository {
+	return newPaymentRepository()
+}
+
+func newPaymentRepository() *paymentRepository {
+	return &paymentRepository{
+		store: &paymentInmemoryStore{
+			payments: make(map[string]domain.Payment),
+			lock:     &sync.RWMutex{},
+		},
+		chEvents: make(chan domain.PaymentEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *paymentRepository) AddPayment(
+	_ context.Context, payment *domain.Payment,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.payments[payment.ID]; ok {
+		return false, nil
+	}
+
+	r.store.payments[payment.ID] = *payment
+
+	go r.publishEvent(domain.PaymentEvent{
+		EventType: domain.PaymentQueued,
+		Payments:  []domain.Payment{*payment},
+	})
+
+	return true, nil
+}
+
+func (r *paymentRepository) GetPayment(
+	_ context.Context, id string,
+) (*domain.Payment, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	payment, ok := r.store.payments[id]
+	if !ok {
+		return nil, domain.ErrPaymentNotFound
+	}
+	return &payment, nil
+}
+
+func (r *paymentRepository) GetQueuedPayments(
+	_ context.Context, accountName string,
+) ([]domain.Payment, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	payments := make([]domain.Payment, 0)
+	for _, p := range r.store.payments {
+		if !p.IsQueued() {
+			continue
+		}
+		if accountName != "" && p.AccountName != accountName {
+			continue
+		}
+		payments = append(payments, p)
+	}
+	sortPayments(payments)
+	return payments, nil
+}
+
+func (r *paymentRepository) GetPaymentsByTxid(
+	_ context.Context, txid string,
+) ([]domain.Payment, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	payments := make([]domain.Payment, 0)
+	for _, p := range r.store.payments {
+		if p.Txid == txid {
+			payments = append(payments, p)
+		}
+	}
+	sortPayments(payments)
+	return payments, nil
+}
+
+func (r *paymentRepository) UpdatePayments(
+	_ context.Context, payments []domain.Payment,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	for _, payment := range payments {
+		if _, ok := r.store.payments[payment.ID]; !ok {
+			return domain.ErrPaymentNotFound
+		}
+	}
+
+	paymentsByStatus := make(map[domain.PaymentStatus][]domain.Payment)
+	for _, payment := range payments {
+		p := r.store.payments[payment.ID]
+		p.Status = payment.Status
+		p.Txid = payment.Txid
+		p.OutputIndex = payment.OutputIndex
+		r.store.payments[payment.ID] = p
+		paymentsByStatus[p.Status] = append(paymentsByStatus[p.Status], p)
+	}
+
+	for status, payments := range paymentsByStatus {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentEventTypeFromStatus(status),
+			Payments:  payments,
+		})
+	}
+	return nil
+}
+
+func (r *paymentRepository) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepository) publishEvent(event domain.PaymentEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *paymentRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.payments = make(map[string]domain.Payment)
+}
+
+func (r *paymentRepository) close() {
+	close(r.chEvents)
+}
+
+func sortPayments(payments []domain.Payment) {
+	sort.SliceStable(payments, func(i, j int) bool {
+		if payments[i].CreatedAt == payments[j].CreatedAt {
+			return payments[i].ID < payments[j].ID
+		}
+		return payments[i].CreatedAt < payments[j].CreatedAt
+	})
+}
@@ -0,0 +1,160 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type policyInmemoryStore struct {
+	policies  map[string]*domain.SpendingPolicy
+	approvals map[string]*domain.SpendApproval
+	lock      *sync.RWMutex
+}
+
+type policyRepository struct {
+	store *policyInmemoryStore
+}
+
+func NewSpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return newSpendingPolicyRepository()
+}
+
+func newSpendingPolicyRepository() *policyRepository {
+	return &policyRepository{
+		store: &policyInmemoryStore{
+			policies:  make(map[string]*domain.SpendingPolicy),
+			approvals: make(map[string]*domain.SpendApproval),
+			lock:      &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *policyRepository) SetPolicy(
+	_ context.Context, policy *domain.SpendingPolicy,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.policies[policy.AccountName] = policy
+	return nil
+}
+
+func (r *policyRepository) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	return r.getPolicy(ctx, accountName)
+}
+
+func (r *policyRepository) UpdatePolicy(
+	ctx context.Context, accountName string,
+	updateFn func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error),
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	policy, err := r.getPolicy(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
+	updatedPolicy, err := updateFn(policy)
+	if err != nil {
+		return err
+	}
+
+	r.store.policies[accountName] = updatedPolicy
+	return nil
+}
+
+func (r *policyRepository) DeletePolicy(
+	_ context.Context, accountName string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.policies[accountName]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.policies, accountName)
+	return true, nil
+}
+
+func (r *policyRepository) AddApproval(
+	_ context.Context, approval *domain.SpendApproval,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.approvals[approval.ID]; ok {
+		return false, nil
+	}
+
+	r.store.approvals[approval.ID] = approval
+	return true, nil
+}
+
+func (r *policyRepository) GetApproval(
+	_ context.Context, id string,
+) (*domain.SpendApproval, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	approval, ok := r.store.approvals[id]
+	if !ok {
+		return nil, domain.ErrSpendApprovalNotFound
+	}
+	return approval, nil
+}
+
+func (r *policyRepository) GetAllApprovals(
+	_ context.Context,
+) ([]domain.SpendApproval, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	approvals := make([]domain.SpendApproval, 0, len(r.store.approvals))
+	for _, approval := range r.store.approvals {
+		approvals = append(approvals, *approval)
+	}
+	return approvals, nil
+}
+
+func (r *policyRepository) DeleteApproval(
+	_ context.Context, id string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.approvals[id]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.approvals, id)
+	return true, nil
+}
+
+func (r *policyRepository) getPolicy(
+	_ context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	policy, ok := r.store.policies[accountName]
+	if !ok {
+		return nil, domain.ErrPolicyNotFound
+	}
+	return policy, nil
+}
+
+func (r *policyRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.policies = make(map[string]*domain.SpendingPolicy)
+	r.store.approvals = make(map[string]*domain.SpendApproval)
+}
+
+func (r *policyRepository) close() {}
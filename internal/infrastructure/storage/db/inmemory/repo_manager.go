@@ -1,6 +1,7 @@
 package inmemory
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -13,6 +14,13 @@ type repoManager struct {
 	walletRepository *walletRepository
 	txRepository     *txRepository
 	scriptRepository *scriptRepository
+	policyRepository *policyRepository
+	auditRepository  *auditRepository
+	idempotencyRepo  *idempotencyRepository
+	paymentRepo      *paymentRepository
+	addressLabelRepo *addressLabelRepository
+	broadcastRepo    *broadcastRepository
+	scheduledTxRepo  *scheduledTxRepository
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -25,12 +33,26 @@ func NewRepoManager() ports.RepoManager {
 	walletRepo := newWalletRepository()
 	txRepo := newTransactionRepository()
 	scriptRepo := newExternalScriptRepository()
+	policyRepo := newSpendingPolicyRepository()
+	auditRepo := newAuditEventRepository()
+	idempotencyRepo := newIdempotencyKeyRepository()
+	paymentRepo := newPaymentRepository()
+	addressLabelRepo := newAddressLabelRepository()
+	broadcastRepo := newBroadcastRepository()
+	scheduledTxRepo := newScheduledTxRepository()
 
 	rm := &repoManager{
 		utxoRepository:      utxoRepo,
 		walletRepository:    walletRepo,
 		txRepository:        txRepo,
 		scriptRepository:    scriptRepo,
+		policyRepository:    policyRepo,
+		auditRepository:     auditRepo,
+		idempotencyRepo:     idempotencyRepo,
+		paymentRepo:         paymentRepo,
+		addressLabelRepo:    addressLabelRepo,
+		broadcastRepo:       broadcastRepo,
+		scheduledTxRepo:     scheduledTxRepo,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -61,6 +83,34 @@ func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepositor
 	return rm.scriptRepository
 }
 
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
+func (rm *repoManager) AuditEventRepository() domain.AuditEventRepository {
+	return rm.auditRepository
+}
+
+func (rm *repoManager) IdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return rm.idempotencyRepo
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepo
+}
+
+func (rm *repoManager) AddressLabelRepository() domain.AddressLabelRepository {
+	return rm.addressLabelRepo
+}
+
+func (rm *repoManager) BroadcastRepository() domain.BroadcastRepository {
+	return rm.broadcastRepo
+}
+
+func (rm *repoManager) ScheduledTxRepository() domain.ScheduledTxRepository {
+	return rm.scheduledTxRepo
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -90,6 +140,13 @@ func (rm *repoManager) Reset() {
 	rm.utxoRepository.reset()
 	rm.txRepository.reset()
 	rm.scriptRepository.reset()
+	rm.policyRepository.reset()
+	rm.auditRepository.reset()
+	rm.idempotencyRepo.reset()
+	rm.paymentRepo.reset()
+	rm.addressLabelRepo.reset()
+	rm.broadcastRepo.reset()
+	rm.scheduledTxRepo.reset()
 }
 
 func (rm *repoManager) listenToWalletEvents() {
@@ -144,11 +201,20 @@ func (rm *repoManager) listenToScriptEvents() {
 	}
 }
 
+func (rm *repoManager) Ping(_ context.Context) error {
+	return nil
+}
+
 func (rm *repoManager) Close() {
 	rm.walletRepository.close()
 	rm.utxoRepository.close()
 	rm.txRepository.close()
 	rm.scriptRepository.close()
+	rm.policyRepository.close()
+	rm.auditRepository.close()
+	rm.idempotencyRepo.close()
+	rm.paymentRepo.close()
+	rm.broadcastRepo.close()
 }
 
 // handlerMap is a util type to prevent race conditions when registering
@@ -0,0 +1,116 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type scheduledTxInmemoryStore struct {
+	txs  map[string]domain.ScheduledTx
+	lock *sync.RWMutex
+}
+
+type scheduledTxRepository struct {
+	store *scheduledTxInmemoryStore
+}
+
+func NewScheduledTxRepository() domain.ScheduledTxRepository {
+	return newScheduledTxRepository()
+}
+
+func newScheduledTxRepository() *scheduledTxRepository {
+	return &scheduledTxRepository{
+		store: &scheduledTxInmemoryStore{
+			txs:  make(map[string]domain.ScheduledTx),
+			lock: &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *scheduledTxRepository) AddScheduledTx(
+	_ context.Context, tx *domain.ScheduledTx,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.txs[tx.Txid]; ok {
+		return false, nil
+	}
+
+	r.store.txs[tx.Txid] = *tx
+	return true, nil
+}
+
+func (r *scheduledTxRepository) GetScheduledTx(
+	_ context.Context, txid string,
+) (*domain.ScheduledTx, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	tx, ok := r.store.txs[txid]
+	if !ok {
+		return nil, domain.ErrScheduledTxNotFound
+	}
+	return &tx, nil
+}
+
+func (r *scheduledTxRepository) GetAllScheduledTxs(
+	_ context.Context,
+) ([]domain.ScheduledTx, error) {
+	return r.findScheduledTxs(func(_ domain.ScheduledTx) bool { return true }), nil
+}
+
+func (r *scheduledTxRepository) GetPendingScheduledTxs(
+	_ context.Context,
+) ([]domain.ScheduledTx, error) {
+	return r.findScheduledTxs(func(tx domain.ScheduledTx) bool {
+		return tx.IsScheduled()
+	}), nil
+}
+
+func (r *scheduledTxRepository) UpdateScheduledTx(
+	_ context.Context, tx *domain.ScheduledTx,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	storedTx, ok := r.store.txs[tx.Txid]
+	if !ok {
+		return domain.ErrScheduledTxNotFound
+	}
+	storedTx.Status = tx.Status
+	storedTx.ConflictingTxid = tx.ConflictingTxid
+	r.store.txs[tx.Txid] = storedTx
+	return nil
+}
+
+func (r *scheduledTxRepository) findScheduledTxs(
+	filter func(tx domain.ScheduledTx) bool,
+) []domain.ScheduledTx {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	txs := make([]domain.ScheduledTx, 0)
+	for _, tx := range r.store.txs {
+		if filter(tx) {
+			txs = append(txs, tx)
+		}
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].CreatedAt == txs[j].CreatedAt {
+			return txs[i].Txid < txs[j].Txid
+		}
+		return txs[i].CreatedAt < txs[j].CreatedAt
+	})
+	return txs
+}
+
+func (r *scheduledTxRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.txs = make(map[string]domain.ScheduledTx)
+}
@@ -55,6 +55,15 @@ func (r *txRepository) ConfirmTransaction(
 	return r.confirmTx(ctx, txid, blockHash, blockheight, blocktime)
 }
 
+func (r *txRepository) EvictTransaction(
+	ctx context.Context, txid, replacementTxid string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.evictTx(ctx, txid, replacementTxid)
+}
+
 func (r *txRepository) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
@@ -131,6 +140,30 @@ func (r *txRepository) confirmTx(
 	return true, nil
 }
 
+func (r *txRepository) evictTx(
+	ctx context.Context, txid, replacementTxid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if tx.IsConfirmed() || tx.IsEvicted() {
+		return false, nil
+	}
+
+	tx.Evict(replacementTxid)
+
+	r.store.txs[txid] = tx
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionEvicted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (r *txRepository) getTx(
 	_ context.Context, txid string,
 ) (*domain.Transaction, error) {
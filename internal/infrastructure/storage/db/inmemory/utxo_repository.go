@@ -85,7 +85,7 @@ func (r *utxoRepository) GetAllUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, false, false, nil)
+	return r.getUtxosForAccount(account, false, false, false, nil)
 }
 
 func (r *utxoRepository) GetSpendableUtxosForAccount(
@@ -94,7 +94,7 @@ func (r *utxoRepository) GetSpendableUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, true, false, scripts)
+	return r.getUtxosForAccount(account, true, false, false, scripts)
 }
 
 func (r *utxoRepository) GetLockedUtxosForAccount(
@@ -103,7 +103,16 @@ func (r *utxoRepository) GetLockedUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, false, true, scripts)
+	return r.getUtxosForAccount(account, false, true, false, scripts)
+}
+
+func (r *utxoRepository) GetFrozenUtxosForAccount(
+	_ context.Context, account string, scripts [][]byte,
+) ([]*domain.Utxo, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	return r.getUtxosForAccount(account, false, false, true, scripts)
 }
 
 func (r *utxoRepository) GetBalanceForAccount(
@@ -112,10 +121,10 @@ func (r *utxoRepository) GetBalanceForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	utxos, _ := r.getUtxosForAccount(account, false, false, nil)
+	utxos, _ := r.getUtxosForAccount(account, false, false, false, nil)
 	balance := make(map[string]*domain.Balance)
 	for _, u := range utxos {
-		if u.IsSpent() {
+		if u.IsSpent() || u.IsEvicted() {
 			continue
 		}
 
@@ -123,7 +132,9 @@ func (r *utxoRepository) GetBalanceForAccount(
 			balance[u.Asset] = &domain.Balance{}
 		}
 		b := balance[u.Asset]
-		if u.IsLocked() {
+		if u.IsFrozen() {
+			b.Frozen += u.Value
+		} else if u.IsLocked() {
 			b.Locked += u.Value
 		} else {
 			if u.IsConfirmed() {
@@ -146,6 +157,15 @@ func (r *utxoRepository) SpendUtxos(
 	return r.spendUtxos(utxos, txid)
 }
 
+func (r *utxoRepository) RespendUtxos(
+	_ context.Context, utxos []domain.UtxoKey, txid string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.respendUtxos(utxos, txid)
+}
+
 func (r *utxoRepository) ConfirmSpendUtxos(
 	_ context.Context, utxos []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -166,11 +186,12 @@ func (r *utxoRepository) ConfirmUtxos(
 
 func (r *utxoRepository) LockUtxos(
 	_ context.Context, utxos []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (int, error) {
 	r.store.lock.Lock()
 	defer r.store.lock.Unlock()
 
-	return r.lockUtxos(utxos, timestamp, expiryTimestamp)
+	return r.lockUtxos(utxos, timestamp, expiryTimestamp, owner)
 }
 
 func (r *utxoRepository) UnlockUtxos(
@@ -182,6 +203,60 @@ func (r *utxoRepository) UnlockUtxos(
 	return r.unlockUtxos(utxos)
 }
 
+func (r *utxoRepository) ReleaseUtxos(
+	_ context.Context, utxos []domain.UtxoKey, owner string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.releaseUtxos(utxos, owner)
+}
+
+func (r *utxoRepository) FreezeUtxos(
+	_ context.Context, utxos []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.freezeUtxos(utxos, timestamp)
+}
+
+func (r *utxoRepository) UnfreezeUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unfreezeUtxos(utxos)
+}
+
+func (r *utxoRepository) EvictUtxos(
+	_ context.Context, utxos []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.evictUtxos(utxos, timestamp)
+}
+
+func (r *utxoRepository) SetUtxoLabels(
+	_ context.Context, utxos []domain.UtxoKey, labels []string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	count := 0
+	for _, key := range utxos {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+		utxo.SetLabels(labels)
+		count++
+	}
+	return count, nil
+}
+
 func (r *utxoRepository) DeleteUtxosForAccount(
 	_ context.Context, accountName string,
 ) error {
@@ -229,7 +304,7 @@ func (r *utxoRepository) getUtxos(spendableOnly bool) []*domain.Utxo {
 	utxos := make([]*domain.Utxo, 0, len(r.store.utxos))
 	for _, u := range r.store.utxos {
 		if spendableOnly {
-			if !u.IsLocked() && !u.IsSpent() {
+			if !u.IsLocked() && !u.IsFrozen() && !u.IsSpent() && !u.IsEvicted() {
 				utxos = append(utxos, u)
 			}
 			continue
@@ -240,7 +315,8 @@ func (r *utxoRepository) getUtxos(spendableOnly bool) []*domain.Utxo {
 }
 
 func (r *utxoRepository) getUtxosForAccount(
-	account string, spendableOnly, lockedOnly bool, scripts [][]byte,
+	account string, spendableOnly, lockedOnly, frozenOnly bool,
+	scripts [][]byte,
 ) ([]*domain.Utxo, error) {
 	keys := r.store.utxosByAccount[account]
 	if len(keys) == 0 {
@@ -252,14 +328,21 @@ func (r *utxoRepository) getUtxosForAccount(
 		u := r.store.utxos[k.Hash()]
 
 		if spendableOnly {
-			if !u.IsLocked() && !u.IsSpent() {
+			if !u.IsLocked() && !u.IsFrozen() && !u.IsSpent() && !u.IsEvicted() {
 				utxos = append(utxos, u)
 			}
 			continue
 		}
 
 		if lockedOnly {
-			if u.IsLocked() && !u.IsSpent() {
+			if u.IsLocked() && !u.IsFrozen() && !u.IsSpent() && !u.IsEvicted() {
+				utxos = append(utxos, u)
+			}
+			continue
+		}
+
+		if frozenOnly {
+			if u.IsFrozen() && !u.IsSpent() && !u.IsEvicted() {
 				utxos = append(utxos, u)
 			}
 			continue
@@ -316,6 +399,40 @@ func (r *utxoRepository) spendUtxos(
 	return count, nil
 }
 
+func (r *utxoRepository) respendUtxos(
+	keys []domain.UtxoKey, txid string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if !utxo.IsSpent() || utxo.IsConfirmedSpent() ||
+			utxo.SpentStatus.Txid == txid {
+			continue
+		}
+
+		if err := utxo.Respend(txid); err != nil {
+			return -1, err
+		}
+
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoSpent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) confirmSpendUtxos(
 	keys []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -383,7 +500,7 @@ func (r *utxoRepository) confirmUtxos(
 }
 
 func (r *utxoRepository) lockUtxos(
-	keys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	keys []domain.UtxoKey, timestamp, expiryTimestamp int64, owner string,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
@@ -393,11 +510,11 @@ func (r *utxoRepository) lockUtxos(
 			continue
 		}
 
-		if utxo.IsLocked() {
+		if !utxo.CanLock(owner) {
 			continue
 		}
 
-		utxo.Lock(timestamp, expiryTimestamp)
+		utxo.Lock(timestamp, expiryTimestamp, owner)
 		utxosInfo = append(utxosInfo, utxo.Info())
 		count++
 	}
@@ -421,7 +538,7 @@ func (r *utxoRepository) unlockUtxos(keys []domain.UtxoKey) (int, error) {
 			continue
 		}
 
-		if !utxo.IsLocked() {
+		if !utxo.IsLocked() || !utxo.CanUnlock() {
 			continue
 		}
 
@@ -440,6 +557,127 @@ func (r *utxoRepository) unlockUtxos(keys []domain.UtxoKey) (int, error) {
 	return count, nil
 }
 
+func (r *utxoRepository) releaseUtxos(
+	keys []domain.UtxoKey, owner string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if !utxo.IsLocked() {
+			continue
+		}
+		if len(owner) > 0 && !utxo.IsLockedBy(owner) {
+			continue
+		}
+
+		utxo.Release()
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) freezeUtxos(
+	keys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if utxo.IsFrozen() {
+			continue
+		}
+
+		utxo.Freeze(timestamp)
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) unfreezeUtxos(keys []domain.UtxoKey) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if !utxo.IsFrozen() {
+			continue
+		}
+
+		utxo.Unfreeze()
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) evictUtxos(
+	keys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if utxo.IsEvicted() {
+			continue
+		}
+
+		utxo.Evict(timestamp)
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoEvicted,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) publishEvent(event domain.UtxoEvent) {
 	r.chLock.Lock()
 	defer r.chLock.Unlock()
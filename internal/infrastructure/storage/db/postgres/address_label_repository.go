@@ -0,0 +1,75 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type addressLabelRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewAddressLabelRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.AddressLabelRepository {
+	return newAddressLabelRepositoryPgImpl(pgxPool)
+}
+
+func newAddressLabelRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) *addressLabelRepositoryPg {
+	return &addressLabelRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *addressLabelRepositoryPg) SetAddressLabels(
+	ctx context.Context, accountName, script string, labels []string,
+) error {
+	if len(labels) <= 0 {
+		return r.querier.DeleteAddressLabel(ctx, queries.DeleteAddressLabelParams{
+			AccountName: accountName,
+			Script:      script,
+		})
+	}
+
+	return r.querier.UpsertAddressLabel(ctx, queries.UpsertAddressLabelParams{
+		AccountName: accountName,
+		Script:      script,
+		Labels:      encodeLabels(labels),
+	})
+}
+
+func (r *addressLabelRepositoryPg) GetAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) (map[string][]string, error) {
+	rows, err := r.querier.GetAddressLabelsForAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsByScript := make(map[string][]string)
+	for _, row := range rows {
+		labelsByScript[row.Script] = decodeLabels(row.Labels)
+	}
+	return labelsByScript, nil
+}
+
+func (r *addressLabelRepositoryPg) DeleteAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) error {
+	return r.querier.DeleteAddressLabelsForAccount(ctx, accountName)
+}
+
+func (r *addressLabelRepositoryPg) close() {}
+
+func (r *addressLabelRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetAddressLabels(ctx)
+}
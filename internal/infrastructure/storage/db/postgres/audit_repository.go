@@ -0,0 +1,176 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type auditRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewAuditEventRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.AuditEventRepository {
+	return newAuditEventRepositoryPgImpl(pgxPool)
+}
+
+func newAuditEventRepositoryPgImpl(pgxPool *pgxpool.Pool) *auditRepositoryPg {
+	return &auditRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *auditRepositoryPg) AddEvent(
+	ctx context.Context, event *domain.AuditEvent,
+) (bool, error) {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	sequence := int64(event.Sequence)
+	if err := querierWithTx.InsertAuditEvent(
+		ctx, queries.InsertAuditEventParams{
+			Sequence:     sequence,
+			Timestamp:    event.Timestamp,
+			Caller:       event.Caller,
+			Method:       event.Method,
+			AccountName:  event.AccountName,
+			Txid:         event.Txid,
+			ErrorMessage: event.Error,
+			PrevHash:     event.PrevHash,
+			Hash:         event.Hash,
+		},
+	); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	for asset, amount := range event.Amounts {
+		if err := querierWithTx.InsertAuditEventAmount(
+			ctx, queries.InsertAuditEventAmountParams{
+				Asset:      asset,
+				Amount:     int64(amount),
+				FkSequence: sequence,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+	for _, destination := range event.Destinations {
+		if err := querierWithTx.InsertAuditEventDestination(
+			ctx, queries.InsertAuditEventDestinationParams{
+				Destination: destination,
+				FkSequence:  sequence,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *auditRepositoryPg) GetLastEvent(
+	ctx context.Context,
+) (*domain.AuditEvent, error) {
+	event, err := r.querier.GetLastAuditEvent(ctx)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrAuditEventNotFound
+		}
+		return nil, err
+	}
+
+	return r.toAuditEvent(ctx, event)
+}
+
+func (r *auditRepositoryPg) GetEvents(
+	ctx context.Context, fromSequence, limit uint64,
+) ([]domain.AuditEvent, error) {
+	rows, err := r.querier.GetAuditEvents(ctx, queries.GetAuditEventsParams{
+		Sequence: int64(fromSequence),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := r.toAuditEvent(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+func (r *auditRepositoryPg) toAuditEvent(
+	ctx context.Context, event queries.AuditEvent,
+) (*domain.AuditEvent, error) {
+	amounts, err := r.querier.GetAuditEventAmounts(ctx, event.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := r.querier.GetAuditEventDestinations(
+		ctx, event.Sequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amountsByAsset := make(map[string]uint64)
+	for _, a := range amounts {
+		amountsByAsset[a.Asset] = uint64(a.Amount)
+	}
+	dests := make([]string, 0, len(destinations))
+	for _, d := range destinations {
+		dests = append(dests, d.Destination)
+	}
+
+	return &domain.AuditEvent{
+		Sequence:     uint64(event.Sequence),
+		Timestamp:    event.Timestamp,
+		Caller:       event.Caller,
+		Method:       event.Method,
+		AccountName:  event.AccountName,
+		Amounts:      amountsByAsset,
+		Destinations: dests,
+		Txid:         event.Txid,
+		Error:        event.ErrorMessage,
+		PrevHash:     event.PrevHash,
+		Hash:         event.Hash,
+	}, nil
+}
+
+func (r *auditRepositoryPg) close() {}
+
+func (r *auditRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetAuditEvents(ctx)
+}
@@ -0,0 +1,190 @@
+package postgresdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type broadcastRepositoryPg struct {
+	pgxPool  *pgxpool.Pool
+	querier  *queries.Queries
+	chEvents chan domain.BroadcastEvent
+	chLock   *sync.Mutex
+}
+
+func NewBroadcastRepositoryPgImpl(pgxPool *pgxpool.Pool) domain.BroadcastRepository {
+	return newBroadcastRepositoryPgImpl(pgxPool)
+}
+
+func newBroadcastRepositoryPgImpl(pgxPool *pgxpool.Pool) *broadcastRepositoryPg {
+	return &broadcastRepositoryPg{
+		pgxPool:  pgxPool,
+		querier:  queries.New(pgxPool),
+		chEvents: make(chan domain.BroadcastEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *broadcastRepositoryPg) AddBroadcast(
+	ctx context.Context, broadcast *domain.Broadcast,
+) (bool, error) {
+	if err := r.querier.InsertBroadcast(ctx, queries.InsertBroadcastParams{
+		Txid:            broadcast.Txid,
+		TxHex:           broadcast.TxHex,
+		Status:          int32(broadcast.Status),
+		Attempts:        int32(broadcast.Attempts),
+		NextCheckAt:     broadcast.NextCheckAt,
+		ConflictingTxid: broadcast.ConflictingTxid,
+		CreatedAt:       broadcast.CreatedAt,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	go r.publishEvent(domain.BroadcastEvent{
+		EventType:  domain.BroadcastPending,
+		Broadcasts: []domain.Broadcast{*broadcast},
+	})
+
+	return true, nil
+}
+
+func (r *broadcastRepositoryPg) GetBroadcast(
+	ctx context.Context, txid string,
+) (*domain.Broadcast, error) {
+	b, err := r.querier.GetBroadcast(ctx, txid)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrBroadcastNotFound
+		}
+		return nil, err
+	}
+
+	broadcast := toBroadcast(b)
+	return &broadcast, nil
+}
+
+func (r *broadcastRepositoryPg) GetPendingBroadcasts(
+	ctx context.Context,
+) ([]domain.Broadcast, error) {
+	rows, err := r.querier.GetBroadcastsByStatus(
+		ctx, int32(domain.BroadcastStatusPending),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	broadcasts := make([]domain.Broadcast, 0, len(rows))
+	for _, b := range rows {
+		broadcasts = append(broadcasts, toBroadcast(b))
+	}
+	return broadcasts, nil
+}
+
+func (r *broadcastRepositoryPg) UpdateBroadcasts(
+	ctx context.Context, broadcasts []domain.Broadcast,
+) error {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	broadcastsByStatus := make(map[domain.BroadcastStatus][]domain.Broadcast)
+	for _, broadcast := range broadcasts {
+		b, err := querierWithTx.GetBroadcast(ctx, broadcast.Txid)
+		if err != nil {
+			if err.Error() == pgxNoRows {
+				return domain.ErrBroadcastNotFound
+			}
+			return err
+		}
+		if _, err := querierWithTx.UpdateBroadcast(
+			ctx, queries.UpdateBroadcastParams{
+				Status:          int32(broadcast.Status),
+				Attempts:        int32(broadcast.Attempts),
+				NextCheckAt:     broadcast.NextCheckAt,
+				ConflictingTxid: broadcast.ConflictingTxid,
+				Txid:            broadcast.Txid,
+			},
+		); err != nil {
+			return err
+		}
+
+		if domain.BroadcastStatus(b.Status) != broadcast.Status {
+			updated := toBroadcast(b)
+			updated.Status = broadcast.Status
+			updated.Attempts = broadcast.Attempts
+			updated.NextCheckAt = broadcast.NextCheckAt
+			updated.ConflictingTxid = broadcast.ConflictingTxid
+			broadcastsByStatus[updated.Status] = append(
+				broadcastsByStatus[updated.Status], updated,
+			)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	for status, broadcasts := range broadcastsByStatus {
+		go r.publishEvent(domain.BroadcastEvent{
+			EventType:  domain.BroadcastEventTypeFromStatus(status),
+			Broadcasts: broadcasts,
+		})
+	}
+	return nil
+}
+
+func (r *broadcastRepositoryPg) GetEventChannel() chan domain.BroadcastEvent {
+	return r.chEvents
+}
+
+func (r *broadcastRepositoryPg) publishEvent(event domain.BroadcastEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *broadcastRepositoryPg) close() {
+	close(r.chEvents)
+}
+
+func (r *broadcastRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetBroadcasts(ctx)
+}
+
+func toBroadcast(b queries.Broadcast) domain.Broadcast {
+	return domain.Broadcast{
+		Txid:            b.Txid,
+		TxHex:           b.TxHex,
+		Status:          domain.BroadcastStatus(b.Status),
+		Attempts:        uint32(b.Attempts),
+		NextCheckAt:     b.NextCheckAt,
+		ConflictingTxid: b.ConflictingTxid,
+		CreatedAt:       b.CreatedAt,
+	}
+}
@@ -0,0 +1,118 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type idempotencyRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewIdempotencyKeyRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.IdempotencyKeyRepository {
+	return newIdempotencyKeyRepositoryPgImpl(pgxPool)
+}
+
+func newIdempotencyKeyRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) *idempotencyRepositoryPg {
+	return &idempotencyRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *idempotencyRepositoryPg) AddKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) (bool, error) {
+	if err := r.querier.InsertIdempotencyKey(
+		ctx, queries.InsertIdempotencyKeyParams{
+			Key:         key.Key,
+			Method:      key.Method,
+			RequestHash: key.RequestHash,
+			Status:      int32(key.Status),
+			Result:      key.Result,
+			CreatedAt:   key.CreatedAt,
+			ExpiresAt:   key.ExpiresAt,
+		},
+	); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *idempotencyRepositoryPg) GetKey(
+	ctx context.Context, key string,
+) (*domain.IdempotencyKey, error) {
+	k, err := r.querier.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+
+	return &domain.IdempotencyKey{
+		Key:         k.Key,
+		Method:      k.Method,
+		RequestHash: k.RequestHash,
+		Status:      domain.IdempotencyKeyStatus(k.Status),
+		Result:      k.Result,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}, nil
+}
+
+func (r *idempotencyRepositoryPg) UpdateKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) error {
+	count, err := r.querier.UpdateIdempotencyKey(
+		ctx, queries.UpdateIdempotencyKeyParams{
+			Status: int32(key.Status),
+			Result: key.Result,
+			Key:    key.Key,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return domain.ErrIdempotencyKeyNotFound
+	}
+	return nil
+}
+
+func (r *idempotencyRepositoryPg) DeleteKey(
+	ctx context.Context, key string,
+) error {
+	return r.querier.DeleteIdempotencyKey(ctx, key)
+}
+
+func (r *idempotencyRepositoryPg) DeleteExpiredKeys(
+	ctx context.Context, now int64,
+) (int, error) {
+	count, err := r.querier.DeleteExpiredIdempotencyKeys(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *idempotencyRepositoryPg) close() {}
+
+func (r *idempotencyRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetIdempotencyKeys(ctx)
+}
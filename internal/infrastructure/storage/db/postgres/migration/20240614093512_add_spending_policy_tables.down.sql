@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS spend_approval;
+
+DROP TABLE IF EXISTS policy_spend;
+
+DROP TABLE IF EXISTS policy_allowed_script;
+
+DROP TABLE IF EXISTS policy_asset_limit;
+
+DROP TABLE IF EXISTS spending_policy;
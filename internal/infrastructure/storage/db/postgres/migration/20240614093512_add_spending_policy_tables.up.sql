@@ -0,0 +1,38 @@
+CREATE TABLE spending_policy (
+    account_name VARCHAR(50) NOT NULL PRIMARY KEY,
+    max_millisats_per_byte BIGINT NOT NULL
+);
+
+CREATE TABLE policy_asset_limit (
+    asset VARCHAR(64) NOT NULL,
+    spend_limit BIGINT NOT NULL,
+    window_seconds BIGINT NOT NULL,
+    approval_threshold BIGINT NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    PRIMARY KEY (asset, fk_account_name),
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE policy_allowed_script (
+    script VARCHAR(1000) NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    PRIMARY KEY (script, fk_account_name),
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE policy_spend (
+    id SERIAL PRIMARY KEY,
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    timestamp BIGINT NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE spend_approval (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    tx VARCHAR(10485760) NOT NULL,
+    sighash_type INTEGER NOT NULL,
+    requested_by VARCHAR(255) NOT NULL,
+    timestamp BIGINT NOT NULL
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS audit_event_destination;
+
+DROP TABLE IF EXISTS audit_event_amount;
+
+DROP TABLE IF EXISTS audit_event;
@@ -0,0 +1,26 @@
+CREATE TABLE audit_event (
+    sequence BIGINT NOT NULL PRIMARY KEY,
+    timestamp BIGINT NOT NULL,
+    caller VARCHAR(255) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    account_name VARCHAR(255) NOT NULL,
+    txid VARCHAR(64) NOT NULL,
+    error_message TEXT NOT NULL,
+    prev_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL
+);
+
+CREATE TABLE audit_event_amount (
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    fk_sequence BIGINT NOT NULL,
+    PRIMARY KEY (asset, fk_sequence),
+    FOREIGN KEY (fk_sequence) REFERENCES audit_event(sequence) ON DELETE CASCADE
+);
+
+CREATE TABLE audit_event_destination (
+    id SERIAL PRIMARY KEY,
+    destination VARCHAR(1000) NOT NULL,
+    fk_sequence BIGINT NOT NULL,
+    FOREIGN KEY (fk_sequence) REFERENCES audit_event(sequence) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE idempotency_key (
+    key VARCHAR(255) NOT NULL PRIMARY KEY,
+    method VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status INTEGER NOT NULL,
+    result TEXT NOT NULL,
+    created_at BIGINT NOT NULL,
+    expires_at BIGINT NOT NULL
+);
@@ -0,0 +1,16 @@
+CREATE TABLE payment (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL,
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    script TEXT NOT NULL,
+    blinding_key VARCHAR(66) NOT NULL,
+    deadline BIGINT NOT NULL,
+    status INTEGER NOT NULL,
+    txid VARCHAR(64) NOT NULL,
+    output_index INTEGER NOT NULL,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX payment_status_idx ON payment(status);
+CREATE INDEX payment_txid_idx ON payment(txid);
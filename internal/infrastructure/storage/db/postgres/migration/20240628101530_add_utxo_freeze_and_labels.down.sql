@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS address_label;
+ALTER TABLE utxo DROP COLUMN labels;
+ALTER TABLE utxo DROP COLUMN frozen_timestamp;
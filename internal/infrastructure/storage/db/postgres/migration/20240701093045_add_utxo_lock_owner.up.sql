@@ -0,0 +1 @@
+ALTER TABLE utxo ADD COLUMN lock_owner TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE transaction DROP COLUMN evicted_by;
+ALTER TABLE utxo DROP COLUMN evicted_timestamp;
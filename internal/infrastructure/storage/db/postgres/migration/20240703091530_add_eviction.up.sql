@@ -0,0 +1,2 @@
+ALTER TABLE utxo ADD COLUMN evicted_timestamp BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE transaction ADD COLUMN evicted_by TEXT NOT NULL DEFAULT '';
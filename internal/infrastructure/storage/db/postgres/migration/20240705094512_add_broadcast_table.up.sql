@@ -0,0 +1,11 @@
+CREATE TABLE broadcast (
+    txid VARCHAR(64) NOT NULL PRIMARY KEY,
+    tx_hex TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    attempts INTEGER NOT NULL,
+    next_check_at BIGINT NOT NULL,
+    conflicting_txid VARCHAR(64) NOT NULL,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX broadcast_status_idx ON broadcast(status);
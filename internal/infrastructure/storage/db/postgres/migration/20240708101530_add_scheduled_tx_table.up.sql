@@ -0,0 +1,11 @@
+CREATE TABLE scheduled_tx (
+    txid VARCHAR(64) NOT NULL PRIMARY KEY,
+    tx_hex TEXT NOT NULL,
+    locktime BIGINT NOT NULL,
+    broadcast_at BIGINT NOT NULL,
+    status INTEGER NOT NULL,
+    conflicting_txid VARCHAR(64) NOT NULL,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX scheduled_tx_status_idx ON scheduled_tx(status);
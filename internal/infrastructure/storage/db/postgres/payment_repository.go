@@ -0,0 +1,208 @@
+package postgresdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type paymentRepositoryPg struct {
+	pgxPool  *pgxpool.Pool
+	querier  *queries.Queries
+	chEvents chan domain.PaymentEvent
+	chLock   *sync.Mutex
+}
+
+func NewPaymentRepositoryPgImpl(pgxPool *pgxpool.Pool) domain.PaymentRepository {
+	return newPaymentRepositoryPgImpl(pgxPool)
+}
+
+func newPaymentRepositoryPgImpl(pgxPool *pgxpool.Pool) *paymentRepositoryPg {
+	return &paymentRepositoryPg{
+		pgxPool:  pgxPool,
+		querier:  queries.New(pgxPool),
+		chEvents: make(chan domain.PaymentEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *paymentRepositoryPg) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (bool, error) {
+	if err := r.querier.InsertPayment(ctx, queries.InsertPaymentParams{
+		ID:          payment.ID,
+		AccountName: payment.AccountName,
+		Asset:       payment.Asset,
+		Amount:      int64(payment.Amount),
+		Script:      payment.Script,
+		BlindingKey: payment.BlindingKey,
+		Deadline:    payment.Deadline,
+		Status:      int32(payment.Status),
+		Txid:        payment.Txid,
+		OutputIndex: int32(payment.OutputIndex),
+		CreatedAt:   payment.CreatedAt,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	go r.publishEvent(domain.PaymentEvent{
+		EventType: domain.PaymentQueued,
+		Payments:  []domain.Payment{*payment},
+	})
+
+	return true, nil
+}
+
+func (r *paymentRepositoryPg) GetPayment(
+	ctx context.Context, id string,
+) (*domain.Payment, error) {
+	p, err := r.querier.GetPayment(ctx, id)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	payment := toPayment(p)
+	return &payment, nil
+}
+
+func (r *paymentRepositoryPg) GetQueuedPayments(
+	ctx context.Context, accountName string,
+) ([]domain.Payment, error) {
+	rows, err := r.querier.GetPaymentsByStatus(
+		ctx, int32(domain.PaymentStatusQueued),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]domain.Payment, 0, len(rows))
+	for _, p := range rows {
+		if accountName != "" && p.AccountName != accountName {
+			continue
+		}
+		payments = append(payments, toPayment(p))
+	}
+	return payments, nil
+}
+
+func (r *paymentRepositoryPg) GetPaymentsByTxid(
+	ctx context.Context, txid string,
+) ([]domain.Payment, error) {
+	rows, err := r.querier.GetPaymentsByTxid(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]domain.Payment, 0, len(rows))
+	for _, p := range rows {
+		payments = append(payments, toPayment(p))
+	}
+	return payments, nil
+}
+
+func (r *paymentRepositoryPg) UpdatePayments(
+	ctx context.Context, payments []domain.Payment,
+) error {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	for _, payment := range payments {
+		count, err := querierWithTx.UpdatePayment(
+			ctx, queries.UpdatePaymentParams{
+				Status:      int32(payment.Status),
+				Txid:        payment.Txid,
+				OutputIndex: int32(payment.OutputIndex),
+				ID:          payment.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if count <= 0 {
+			return domain.ErrPaymentNotFound
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	paymentsByStatus := make(map[domain.PaymentStatus][]domain.Payment)
+	for _, payment := range payments {
+		p, err := r.GetPayment(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		paymentsByStatus[p.Status] = append(paymentsByStatus[p.Status], *p)
+	}
+	for status, payments := range paymentsByStatus {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentEventTypeFromStatus(status),
+			Payments:  payments,
+		})
+	}
+	return nil
+}
+
+func (r *paymentRepositoryPg) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepositoryPg) publishEvent(event domain.PaymentEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *paymentRepositoryPg) close() {
+	close(r.chEvents)
+}
+
+func (r *paymentRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetPayments(ctx)
+}
+
+func toPayment(p queries.Payment) domain.Payment {
+	return domain.Payment{
+		ID:          p.ID,
+		AccountName: p.AccountName,
+		Asset:       p.Asset,
+		Amount:      uint64(p.Amount),
+		Script:      p.Script,
+		BlindingKey: p.BlindingKey,
+		Deadline:    p.Deadline,
+		Status:      domain.PaymentStatus(p.Status),
+		Txid:        p.Txid,
+		OutputIndex: uint32(p.OutputIndex),
+		CreatedAt:   p.CreatedAt,
+	}
+}
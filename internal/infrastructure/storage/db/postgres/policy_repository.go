@@ -0,0 +1,293 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type policyRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewSpendingPolicyRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.SpendingPolicyRepository {
+	return newSpendingPolicyRepositoryPgImpl(pgxPool)
+}
+
+func newSpendingPolicyRepositoryPgImpl(pgxPool *pgxpool.Pool) *policyRepositoryPg {
+	return &policyRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *policyRepositoryPg) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) error {
+	return r.upsertPolicy(ctx, *policy)
+}
+
+func (r *policyRepositoryPg) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	return r.getPolicy(ctx, accountName)
+}
+
+func (r *policyRepositoryPg) UpdatePolicy(
+	ctx context.Context, accountName string,
+	updateFn func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error),
+) error {
+	policy, err := r.getPolicy(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
+	updatedPolicy, err := updateFn(policy)
+	if err != nil {
+		return err
+	}
+
+	return r.upsertPolicy(ctx, *updatedPolicy)
+}
+
+func (r *policyRepositoryPg) DeletePolicy(
+	ctx context.Context, accountName string,
+) (bool, error) {
+	if _, err := r.querier.GetSpendingPolicy(ctx, accountName); err != nil {
+		if err.Error() == pgxNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteSpendingPolicy(ctx, accountName); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositoryPg) AddApproval(
+	ctx context.Context, approval *domain.SpendApproval,
+) (bool, error) {
+	if err := r.querier.InsertSpendApproval(ctx, queries.InsertSpendApprovalParams{
+		ID:          approval.ID,
+		Tx:          approval.Tx,
+		SighashType: int32(approval.SighashType),
+		RequestedBy: approval.RequestedBy,
+		Timestamp:   approval.Timestamp,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *policyRepositoryPg) GetApproval(
+	ctx context.Context, id string,
+) (*domain.SpendApproval, error) {
+	approval, err := r.querier.GetSpendApproval(ctx, id)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrSpendApprovalNotFound
+		}
+		return nil, err
+	}
+
+	return toSpendApproval(approval), nil
+}
+
+func (r *policyRepositoryPg) GetAllApprovals(
+	ctx context.Context,
+) ([]domain.SpendApproval, error) {
+	rows, err := r.querier.GetAllSpendApprovals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]domain.SpendApproval, 0, len(rows))
+	for _, row := range rows {
+		approvals = append(approvals, *toSpendApproval(row))
+	}
+	return approvals, nil
+}
+
+func (r *policyRepositoryPg) DeleteApproval(
+	ctx context.Context, id string,
+) (bool, error) {
+	if _, err := r.querier.GetSpendApproval(ctx, id); err != nil {
+		if err.Error() == pgxNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteSpendApproval(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositoryPg) upsertPolicy(
+	ctx context.Context, policy domain.SpendingPolicy,
+) error {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	if err := querierWithTx.UpsertSpendingPolicy(
+		ctx, queries.UpsertSpendingPolicyParams{
+			AccountName:         policy.AccountName,
+			MaxMillisatsPerByte: int64(policy.MaxMillisatsPerByte),
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := querierWithTx.DeletePolicyAssetLimits(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for _, l := range policy.AssetLimits {
+		if err := querierWithTx.InsertPolicyAssetLimit(
+			ctx, queries.InsertPolicyAssetLimitParams{
+				Asset:             l.Asset,
+				SpendLimit:        int64(l.SpendLimit),
+				WindowSeconds:     l.WindowSeconds,
+				ApprovalThreshold: int64(l.ApprovalThreshold),
+				FkAccountName:     policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := querierWithTx.DeletePolicyAllowedScripts(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for script := range policy.AllowedScripts {
+		if err := querierWithTx.InsertPolicyAllowedScript(
+			ctx, queries.InsertPolicyAllowedScriptParams{
+				Script:        script,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := querierWithTx.DeletePolicySpends(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for _, s := range policy.Spends {
+		if err := querierWithTx.InsertPolicySpend(
+			ctx, queries.InsertPolicySpendParams{
+				Asset:         s.Asset,
+				Amount:        int64(s.Amount),
+				Timestamp:     s.Timestamp,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *policyRepositoryPg) getPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	policy, err := r.querier.GetSpendingPolicy(ctx, accountName)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrPolicyNotFound
+		}
+		return nil, err
+	}
+
+	limits, err := r.querier.GetPolicyAssetLimits(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	scripts, err := r.querier.GetPolicyAllowedScripts(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	spends, err := r.querier.GetPolicySpends(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	assetLimits := make(map[string]domain.AssetLimit)
+	for _, l := range limits {
+		assetLimits[l.Asset] = domain.AssetLimit{
+			Asset:             l.Asset,
+			SpendLimit:        uint64(l.SpendLimit),
+			WindowSeconds:     l.WindowSeconds,
+			ApprovalThreshold: uint64(l.ApprovalThreshold),
+		}
+	}
+	allowedScripts := make(map[string]struct{})
+	for _, s := range scripts {
+		allowedScripts[s.Script] = struct{}{}
+	}
+	policySpends := make([]domain.Spend, 0, len(spends))
+	for _, s := range spends {
+		policySpends = append(policySpends, domain.Spend{
+			Asset:     s.Asset,
+			Amount:    uint64(s.Amount),
+			Timestamp: s.Timestamp,
+		})
+	}
+
+	return &domain.SpendingPolicy{
+		AccountName:         policy.AccountName,
+		AssetLimits:         assetLimits,
+		AllowedScripts:      allowedScripts,
+		MaxMillisatsPerByte: uint64(policy.MaxMillisatsPerByte),
+		Spends:              policySpends,
+	}, nil
+}
+
+func (r *policyRepositoryPg) close() {}
+
+func (r *policyRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetSpendingPolicies(ctx)
+	querier.ResetSpendApprovals(ctx)
+}
+
+func toSpendApproval(approval queries.SpendApproval) *domain.SpendApproval {
+	return &domain.SpendApproval{
+		ID:          approval.ID,
+		Tx:          approval.Tx,
+		SighashType: uint32(approval.SighashType),
+		RequestedBy: approval.RequestedBy,
+		Timestamp:   approval.Timestamp,
+	}
+}
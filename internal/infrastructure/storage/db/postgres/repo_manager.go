@@ -3,11 +3,13 @@ package postgresdb
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"sync"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
 	"github.com/vulpemventures/ocean/internal/core/domain"
@@ -29,6 +31,13 @@ type repoManager struct {
 	walletRepository *walletRepositoryPg
 	txRepository     *txRepositoryPg
 	scriptRepository *scriptRepositoryPg
+	policyRepository *policyRepositoryPg
+	auditRepository  *auditRepositoryPg
+	idempotencyRepo  *idempotencyRepositoryPg
+	paymentRepo      *paymentRepositoryPg
+	addressLabelRepo *addressLabelRepositoryPg
+	broadcastRepo    *broadcastRepositoryPg
+	scheduledTxRepo  *scheduledTxRepositoryPg
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -39,6 +48,13 @@ type repoManager struct {
 func NewRepoManager(dbConfig DbConfig) (ports.RepoManager, error) {
 	dataSource := insecureDataSourceStr(dbConfig)
 
+	if len(dbConfig.Schema) > 0 {
+		if err := createSchema(dataSource, dbConfig.Schema); err != nil {
+			return nil, err
+		}
+		dataSource = withSearchPath(dataSource, dbConfig.Schema)
+	}
+
 	pgxPool, err := connect(dataSource)
 	if err != nil {
 		return nil, err
@@ -52,6 +68,13 @@ func NewRepoManager(dbConfig DbConfig) (ports.RepoManager, error) {
 	walletRepository := newWalletRepositoryPgImpl(pgxPool)
 	txRepository := newTxRepositoryPgImpl(pgxPool)
 	scriptRepository := newExternalScriptRepositoryPgImpl(pgxPool)
+	policyRepository := newSpendingPolicyRepositoryPgImpl(pgxPool)
+	auditRepository := newAuditEventRepositoryPgImpl(pgxPool)
+	idempotencyRepo := newIdempotencyKeyRepositoryPgImpl(pgxPool)
+	paymentRepo := newPaymentRepositoryPgImpl(pgxPool)
+	addressLabelRepo := newAddressLabelRepositoryPgImpl(pgxPool)
+	broadcastRepo := newBroadcastRepositoryPgImpl(pgxPool)
+	scheduledTxRepo := newScheduledTxRepositoryPgImpl(pgxPool)
 
 	rm := &repoManager{
 		pgxPool:             pgxPool,
@@ -59,6 +82,13 @@ func NewRepoManager(dbConfig DbConfig) (ports.RepoManager, error) {
 		walletRepository:    walletRepository,
 		txRepository:        txRepository,
 		scriptRepository:    scriptRepository,
+		policyRepository:    policyRepository,
+		auditRepository:     auditRepository,
+		idempotencyRepo:     idempotencyRepo,
+		paymentRepo:         paymentRepo,
+		addressLabelRepo:    addressLabelRepo,
+		broadcastRepo:       broadcastRepo,
+		scheduledTxRepo:     scheduledTxRepo,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -80,6 +110,10 @@ type DbConfig struct {
 	DbPort             int
 	DbName             string
 	MigrationSourceURL string
+	// Schema is the optional schema where the tables are created and looked
+	// up, instead of the default one. It lets multiple repo managers share the
+	// same database.
+	Schema string
 }
 
 func (rm *repoManager) UtxoRepository() domain.UtxoRepository {
@@ -98,6 +132,34 @@ func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepositor
 	return rm.scriptRepository
 }
 
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
+func (rm *repoManager) AuditEventRepository() domain.AuditEventRepository {
+	return rm.auditRepository
+}
+
+func (rm *repoManager) IdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return rm.idempotencyRepo
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepo
+}
+
+func (rm *repoManager) AddressLabelRepository() domain.AddressLabelRepository {
+	return rm.addressLabelRepo
+}
+
+func (rm *repoManager) BroadcastRepository() domain.BroadcastRepository {
+	return rm.broadcastRepo
+}
+
+func (rm *repoManager) ScheduledTxRepository() domain.ScheduledTxRepository {
+	return rm.scheduledTxRepo
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -194,15 +256,33 @@ func (rm *repoManager) Reset() {
 	rm.utxoRepository.reset(querier, ctx)
 	rm.txRepository.reset(querier, ctx)
 	rm.scriptRepository.reset(querier, ctx)
+	rm.policyRepository.reset(querier, ctx)
+	rm.auditRepository.reset(querier, ctx)
+	rm.idempotencyRepo.reset(querier, ctx)
+	rm.paymentRepo.reset(querier, ctx)
+	rm.addressLabelRepo.reset(querier, ctx)
+	rm.broadcastRepo.reset(querier, ctx)
+	rm.scheduledTxRepo.reset(querier, ctx)
 
 	tx.Commit(ctx)
 }
 
+func (rm *repoManager) Ping(ctx context.Context) error {
+	return rm.pgxPool.Ping(ctx)
+}
+
 func (rm *repoManager) Close() {
 	rm.utxoRepository.close()
 	rm.txRepository.close()
 	rm.walletRepository.close()
 	rm.scriptRepository.close()
+	rm.policyRepository.close()
+	rm.auditRepository.close()
+	rm.idempotencyRepo.close()
+	rm.paymentRepo.close()
+	rm.addressLabelRepo.close()
+	rm.broadcastRepo.close()
+	rm.scheduledTxRepo.close()
 
 	rm.pgxPool.Close()
 }
@@ -238,6 +318,29 @@ func connect(dataSource string) (*pgxpool.Pool, error) {
 	return pgxpool.Connect(context.Background(), dataSource)
 }
 
+// createSchema creates the given schema, if not existing.
+func createSchema(dataSource, schema string) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, dataSource)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(
+		ctx,
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", pgx.Identifier{schema}.Sanitize()),
+	)
+	return err
+}
+
+// withSearchPath adds the given schema as search path to the given
+// connection string.
+func withSearchPath(dataSource, schema string) string {
+	searchPath := url.QueryEscape(pgx.Identifier{schema}.Sanitize())
+	return fmt.Sprintf("%s&search_path=%s", dataSource, searchPath)
+}
+
 func migrateDb(dataSource, migrationSourceUrl string) error {
 	pg := postgres.Postgres{}
 
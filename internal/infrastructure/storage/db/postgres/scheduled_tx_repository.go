@@ -0,0 +1,131 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type scheduledTxRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewScheduledTxRepositoryPgImpl(pgxPool *pgxpool.Pool) domain.ScheduledTxRepository {
+	return newScheduledTxRepositoryPgImpl(pgxPool)
+}
+
+func newScheduledTxRepositoryPgImpl(pgxPool *pgxpool.Pool) *scheduledTxRepositoryPg {
+	return &scheduledTxRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *scheduledTxRepositoryPg) AddScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) (bool, error) {
+	if err := r.querier.InsertScheduledTx(ctx, queries.InsertScheduledTxParams{
+		Txid:            tx.Txid,
+		TxHex:           tx.TxHex,
+		Locktime:        int64(tx.Locktime),
+		BroadcastAt:     tx.BroadcastAt,
+		Status:          int32(tx.Status),
+		ConflictingTxid: tx.ConflictingTxid,
+		CreatedAt:       tx.CreatedAt,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok && pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *scheduledTxRepositoryPg) GetScheduledTx(
+	ctx context.Context, txid string,
+) (*domain.ScheduledTx, error) {
+	row, err := r.querier.GetScheduledTx(ctx, txid)
+	if err != nil {
+		if err.Error() == pgxNoRows {
+			return nil, domain.ErrScheduledTxNotFound
+		}
+		return nil, err
+	}
+
+	tx := toScheduledTx(row)
+	return &tx, nil
+}
+
+func (r *scheduledTxRepositoryPg) GetAllScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	rows, err := r.querier.GetAllScheduledTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledTxs(rows), nil
+}
+
+func (r *scheduledTxRepositoryPg) GetPendingScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	rows, err := r.querier.GetScheduledTxsByStatus(
+		ctx, int32(domain.ScheduledTxStatusScheduled),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledTxs(rows), nil
+}
+
+func (r *scheduledTxRepositoryPg) UpdateScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) error {
+	count, err := r.querier.UpdateScheduledTx(
+		ctx, queries.UpdateScheduledTxParams{
+			Status:          int32(tx.Status),
+			ConflictingTxid: tx.ConflictingTxid,
+			Txid:            tx.Txid,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return domain.ErrScheduledTxNotFound
+	}
+	return nil
+}
+
+func (r *scheduledTxRepositoryPg) close() {}
+
+func (r *scheduledTxRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetScheduledTxs(ctx)
+}
+
+func toScheduledTxs(rows []queries.ScheduledTx) []domain.ScheduledTx {
+	txs := make([]domain.ScheduledTx, 0, len(rows))
+	for _, row := range rows {
+		txs = append(txs, toScheduledTx(row))
+	}
+	return txs
+}
+
+func toScheduledTx(row queries.ScheduledTx) domain.ScheduledTx {
+	return domain.ScheduledTx{
+		Txid:            row.Txid,
+		TxHex:           row.TxHex,
+		Locktime:        uint32(row.Locktime),
+		BroadcastAt:     row.BroadcastAt,
+		Status:          domain.ScheduledTxStatus(row.Status),
+		ConflictingTxid: row.ConflictingTxid,
+		CreatedAt:       row.CreatedAt,
+	}
+}
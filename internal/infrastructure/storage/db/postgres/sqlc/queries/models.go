@@ -26,18 +26,127 @@ type AccountScriptInfo struct {
 	FkAccountName  string
 }
 
+type AddressLabel struct {
+	AccountName string
+	Script      string
+	Labels      string
+}
+
+type AuditEvent struct {
+	Sequence     int64
+	Timestamp    int64
+	Caller       string
+	Method       string
+	AccountName  string
+	Txid         string
+	ErrorMessage string
+	PrevHash     string
+	Hash         string
+}
+
+type AuditEventAmount struct {
+	Asset      string
+	Amount     int64
+	FkSequence int64
+}
+
+type AuditEventDestination struct {
+	ID          int32
+	Destination string
+	FkSequence  int64
+}
+
+type Broadcast struct {
+	Txid            string
+	TxHex           string
+	Status          int32
+	Attempts        int32
+	NextCheckAt     int64
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
 type ExternalScript struct {
 	Account     string
 	Script      string
 	BlindingKey []byte
 }
 
+type IdempotencyKey struct {
+	Key         string
+	Method      string
+	RequestHash string
+	Status      int32
+	Result      string
+	CreatedAt   int64
+	ExpiresAt   int64
+}
+
+type Payment struct {
+	ID          string
+	AccountName string
+	Asset       string
+	Amount      int64
+	Script      string
+	BlindingKey string
+	Deadline    int64
+	Status      int32
+	Txid        string
+	OutputIndex int32
+	CreatedAt   int64
+}
+
+type PolicyAllowedScript struct {
+	Script        string
+	FkAccountName string
+}
+
+type PolicyAssetLimit struct {
+	Asset             string
+	SpendLimit        int64
+	WindowSeconds     int64
+	ApprovalThreshold int64
+	FkAccountName     string
+}
+
+type PolicySpend struct {
+	ID            int32
+	Asset         string
+	Amount        int64
+	Timestamp     int64
+	FkAccountName string
+}
+
+type ScheduledTx struct {
+	Txid            string
+	TxHex           string
+	Locktime        int64
+	BroadcastAt     int64
+	Status          int32
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
+type SpendApproval struct {
+	ID          string
+	Tx          string
+	SighashType int32
+	RequestedBy string
+	Timestamp   int64
+}
+
+type SpendingPolicy struct {
+	AccountName         string
+	MaxMillisatsPerByte int64
+}
+
 type Transaction struct {
 	TxID        string
 	TxHex       string
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	EvictedBy   string
 }
 
 type TxInputAccount struct {
@@ -63,6 +172,10 @@ type Utxo struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 }
 
 type UtxoStatus struct {
@@ -28,6 +28,77 @@ func (q *Queries) DeleteAccountScripts(ctx context.Context, fkAccountName string
 	return err
 }
 
+const deleteAddressLabel = `-- name: DeleteAddressLabel :exec
+DELETE FROM address_label WHERE account_name = $1 AND script = $2
+`
+
+type DeleteAddressLabelParams struct {
+	AccountName string
+	Script      string
+}
+
+func (q *Queries) DeleteAddressLabel(ctx context.Context, arg DeleteAddressLabelParams) error {
+	_, err := q.db.Exec(ctx, deleteAddressLabel, arg.AccountName, arg.Script)
+	return err
+}
+
+const deleteAddressLabelsForAccount = `-- name: DeleteAddressLabelsForAccount :exec
+DELETE FROM address_label WHERE account_name = $1
+`
+
+func (q *Queries) DeleteAddressLabelsForAccount(ctx context.Context, accountName string) error {
+	_, err := q.db.Exec(ctx, deleteAddressLabelsForAccount, accountName)
+	return err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_key WHERE expires_at <= $1
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, expiresAt int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_key WHERE key = $1
+`
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, key)
+	return err
+}
+
+const deletePolicyAllowedScripts = `-- name: DeletePolicyAllowedScripts :exec
+DELETE FROM policy_allowed_script WHERE fk_account_name = $1
+`
+
+func (q *Queries) DeletePolicyAllowedScripts(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deletePolicyAllowedScripts, fkAccountName)
+	return err
+}
+
+const deletePolicyAssetLimits = `-- name: DeletePolicyAssetLimits :exec
+DELETE FROM policy_asset_limit WHERE fk_account_name = $1
+`
+
+func (q *Queries) DeletePolicyAssetLimits(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deletePolicyAssetLimits, fkAccountName)
+	return err
+}
+
+const deletePolicySpends = `-- name: DeletePolicySpends :exec
+DELETE FROM policy_spend WHERE fk_account_name = $1
+`
+
+func (q *Queries) DeletePolicySpends(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deletePolicySpends, fkAccountName)
+	return err
+}
+
 const deleteScript = `-- name: DeleteScript :exec
 DELETE FROM external_script WHERE account = $1
 `
@@ -37,6 +108,24 @@ func (q *Queries) DeleteScript(ctx context.Context, account string) error {
 	return err
 }
 
+const deleteSpendApproval = `-- name: DeleteSpendApproval :exec
+DELETE FROM spend_approval WHERE id = $1
+`
+
+func (q *Queries) DeleteSpendApproval(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteSpendApproval, id)
+	return err
+}
+
+const deleteSpendingPolicy = `-- name: DeleteSpendingPolicy :exec
+DELETE FROM spending_policy WHERE account_name = $1
+`
+
+func (q *Queries) DeleteSpendingPolicy(ctx context.Context, accountName string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingPolicy, accountName)
+	return err
+}
+
 const deleteTransactionInputAccounts = `-- name: DeleteTransactionInputAccounts :exec
 DELETE FROM tx_input_account WHERE fk_tx_id=$1
 `
@@ -85,6 +174,62 @@ func (q *Queries) GetAccount(ctx context.Context, namespace string) (Account, er
 	return i, err
 }
 
+const getAddressLabelsForAccount = `-- name: GetAddressLabelsForAccount :many
+SELECT account_name, script, labels FROM address_label WHERE account_name = $1
+`
+
+func (q *Queries) GetAddressLabelsForAccount(ctx context.Context, accountName string) ([]AddressLabel, error) {
+	rows, err := q.db.Query(ctx, getAddressLabelsForAccount, accountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AddressLabel
+	for rows.Next() {
+		var i AddressLabel
+		if err := rows.Scan(&i.AccountName, &i.Script, &i.Labels); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllScheduledTxs = `-- name: GetAllScheduledTxs :many
+SELECT txid, tx_hex, locktime, broadcast_at, status, conflicting_txid, created_at FROM scheduled_tx ORDER BY created_at ASC, txid ASC
+`
+
+func (q *Queries) GetAllScheduledTxs(ctx context.Context) ([]ScheduledTx, error) {
+	rows, err := q.db.Query(ctx, getAllScheduledTxs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduledTx
+	for rows.Next() {
+		var i ScheduledTx
+		if err := rows.Scan(
+			&i.Txid,
+			&i.TxHex,
+			&i.Locktime,
+			&i.BroadcastAt,
+			&i.Status,
+			&i.ConflictingTxid,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllScripts = `-- name: GetAllScripts :many
 SELECT account, script, blinding_key FROM external_script
 `
@@ -109,8 +254,38 @@ func (q *Queries) GetAllScripts(ctx context.Context) ([]ExternalScript, error) {
 	return items, nil
 }
 
+const getAllSpendApprovals = `-- name: GetAllSpendApprovals :many
+SELECT id, tx, sighash_type, requested_by, timestamp FROM spend_approval
+`
+
+func (q *Queries) GetAllSpendApprovals(ctx context.Context) ([]SpendApproval, error) {
+	rows, err := q.db.Query(ctx, getAllSpendApprovals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendApproval
+	for rows.Next() {
+		var i SpendApproval
+		if err := rows.Scan(
+			&i.ID,
+			&i.Tx,
+			&i.SighashType,
+			&i.RequestedBy,
+			&i.Timestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllUtxos = `-- name: GetAllUtxos :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 `
 
 type GetAllUtxosRow struct {
@@ -130,6 +305,10 @@ type GetAllUtxosRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -139,39 +318,451 @@ type GetAllUtxosRow struct {
 	TxID_2              sql.NullString
 }
 
-func (q *Queries) GetAllUtxos(ctx context.Context) ([]GetAllUtxosRow, error) {
-	rows, err := q.db.Query(ctx, getAllUtxos)
+func (q *Queries) GetAllUtxos(ctx context.Context) ([]GetAllUtxosRow, error) {
+	rows, err := q.db.Query(ctx, getAllUtxos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllUtxosRow
+	for rows.Next() {
+		var i GetAllUtxosRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TxID,
+			&i.Vout,
+			&i.Value,
+			&i.Asset,
+			&i.ValueCommitment,
+			&i.AssetCommitment,
+			&i.ValueBlinder,
+			&i.AssetBlinder,
+			&i.Script,
+			&i.Nonce,
+			&i.RangeProof,
+			&i.SurjectionProof,
+			&i.AccountName,
+			&i.LockTimestamp,
+			&i.LockExpiryTimestamp,
+			&i.FrozenTimestamp,
+			&i.Labels,
+			&i.LockOwner,
+			&i.EvictedTimestamp,
+			&i.ID_2,
+			&i.BlockHeight,
+			&i.BlockTime,
+			&i.BlockHash,
+			&i.Status,
+			&i.FkUtxoID,
+			&i.TxID_2,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditEventAmounts = `-- name: GetAuditEventAmounts :many
+SELECT asset, amount, fk_sequence FROM audit_event_amount WHERE fk_sequence = $1
+`
+
+func (q *Queries) GetAuditEventAmounts(ctx context.Context, fkSequence int64) ([]AuditEventAmount, error) {
+	rows, err := q.db.Query(ctx, getAuditEventAmounts, fkSequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEventAmount
+	for rows.Next() {
+		var i AuditEventAmount
+		if err := rows.Scan(&i.Asset, &i.Amount, &i.FkSequence); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditEventDestinations = `-- name: GetAuditEventDestinations :many
+SELECT id, destination, fk_sequence FROM audit_event_destination WHERE fk_sequence = $1 ORDER BY id ASC
+`
+
+func (q *Queries) GetAuditEventDestinations(ctx context.Context, fkSequence int64) ([]AuditEventDestination, error) {
+	rows, err := q.db.Query(ctx, getAuditEventDestinations, fkSequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEventDestination
+	for rows.Next() {
+		var i AuditEventDestination
+		if err := rows.Scan(&i.ID, &i.Destination, &i.FkSequence); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditEvents = `-- name: GetAuditEvents :many
+SELECT sequence, timestamp, caller, method, account_name, txid, error_message, prev_hash, hash FROM audit_event WHERE sequence >= $1 ORDER BY sequence ASC LIMIT $2
+`
+
+type GetAuditEventsParams struct {
+	Sequence int64
+	Limit    int32
+}
+
+func (q *Queries) GetAuditEvents(ctx context.Context, arg GetAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, getAuditEvents, arg.Sequence, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.Sequence,
+			&i.Timestamp,
+			&i.Caller,
+			&i.Method,
+			&i.AccountName,
+			&i.Txid,
+			&i.ErrorMessage,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBroadcast = `-- name: GetBroadcast :one
+SELECT txid, tx_hex, status, attempts, next_check_at, conflicting_txid, created_at FROM broadcast WHERE txid = $1
+`
+
+func (q *Queries) GetBroadcast(ctx context.Context, txid string) (Broadcast, error) {
+	row := q.db.QueryRow(ctx, getBroadcast, txid)
+	var i Broadcast
+	err := row.Scan(
+		&i.Txid,
+		&i.TxHex,
+		&i.Status,
+		&i.Attempts,
+		&i.NextCheckAt,
+		&i.ConflictingTxid,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBroadcastsByStatus = `-- name: GetBroadcastsByStatus :many
+SELECT txid, tx_hex, status, attempts, next_check_at, conflicting_txid, created_at FROM broadcast WHERE status = $1 ORDER BY created_at ASC, txid ASC
+`
+
+func (q *Queries) GetBroadcastsByStatus(ctx context.Context, status int32) ([]Broadcast, error) {
+	rows, err := q.db.Query(ctx, getBroadcastsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Broadcast
+	for rows.Next() {
+		var i Broadcast
+		if err := rows.Scan(
+			&i.Txid,
+			&i.TxHex,
+			&i.Status,
+			&i.Attempts,
+			&i.NextCheckAt,
+			&i.ConflictingTxid,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT key, method, request_hash, status, result, created_at, expires_at FROM idempotency_key WHERE key = $1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Key,
+		&i.Method,
+		&i.RequestHash,
+		&i.Status,
+		&i.Result,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getLastAuditEvent = `-- name: GetLastAuditEvent :one
+SELECT sequence, timestamp, caller, method, account_name, txid, error_message, prev_hash, hash FROM audit_event ORDER BY sequence DESC LIMIT 1
+`
+
+func (q *Queries) GetLastAuditEvent(ctx context.Context) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, getLastAuditEvent)
+	var i AuditEvent
+	err := row.Scan(
+		&i.Sequence,
+		&i.Timestamp,
+		&i.Caller,
+		&i.Method,
+		&i.AccountName,
+		&i.Txid,
+		&i.ErrorMessage,
+		&i.PrevHash,
+		&i.Hash,
+	)
+	return i, err
+}
+
+const getPayment = `-- name: GetPayment :one
+SELECT id, account_name, asset, amount, script, blinding_key, deadline, status, txid, output_index, created_at FROM payment WHERE id = $1
+`
+
+func (q *Queries) GetPayment(ctx context.Context, id string) (Payment, error) {
+	row := q.db.QueryRow(ctx, getPayment, id)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.Asset,
+		&i.Amount,
+		&i.Script,
+		&i.BlindingKey,
+		&i.Deadline,
+		&i.Status,
+		&i.Txid,
+		&i.OutputIndex,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPaymentsByStatus = `-- name: GetPaymentsByStatus :many
+SELECT id, account_name, asset, amount, script, blinding_key, deadline, status, txid, output_index, created_at FROM payment WHERE status = $1 ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetPaymentsByStatus(ctx context.Context, status int32) ([]Payment, error) {
+	rows, err := q.db.Query(ctx, getPaymentsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountName,
+			&i.Asset,
+			&i.Amount,
+			&i.Script,
+			&i.BlindingKey,
+			&i.Deadline,
+			&i.Status,
+			&i.Txid,
+			&i.OutputIndex,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentsByTxid = `-- name: GetPaymentsByTxid :many
+SELECT id, account_name, asset, amount, script, blinding_key, deadline, status, txid, output_index, created_at FROM payment WHERE txid = $1 ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetPaymentsByTxid(ctx context.Context, txid string) ([]Payment, error) {
+	rows, err := q.db.Query(ctx, getPaymentsByTxid, txid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountName,
+			&i.Asset,
+			&i.Amount,
+			&i.Script,
+			&i.BlindingKey,
+			&i.Deadline,
+			&i.Status,
+			&i.Txid,
+			&i.OutputIndex,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPolicyAllowedScripts = `-- name: GetPolicyAllowedScripts :many
+SELECT script, fk_account_name FROM policy_allowed_script WHERE fk_account_name = $1
+`
+
+func (q *Queries) GetPolicyAllowedScripts(ctx context.Context, fkAccountName string) ([]PolicyAllowedScript, error) {
+	rows, err := q.db.Query(ctx, getPolicyAllowedScripts, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PolicyAllowedScript
+	for rows.Next() {
+		var i PolicyAllowedScript
+		if err := rows.Scan(&i.Script, &i.FkAccountName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPolicyAssetLimits = `-- name: GetPolicyAssetLimits :many
+SELECT asset, spend_limit, window_seconds, approval_threshold, fk_account_name FROM policy_asset_limit WHERE fk_account_name = $1
+`
+
+func (q *Queries) GetPolicyAssetLimits(ctx context.Context, fkAccountName string) ([]PolicyAssetLimit, error) {
+	rows, err := q.db.Query(ctx, getPolicyAssetLimits, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PolicyAssetLimit
+	for rows.Next() {
+		var i PolicyAssetLimit
+		if err := rows.Scan(
+			&i.Asset,
+			&i.SpendLimit,
+			&i.WindowSeconds,
+			&i.ApprovalThreshold,
+			&i.FkAccountName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPolicySpends = `-- name: GetPolicySpends :many
+SELECT id, asset, amount, timestamp, fk_account_name FROM policy_spend WHERE fk_account_name = $1
+`
+
+func (q *Queries) GetPolicySpends(ctx context.Context, fkAccountName string) ([]PolicySpend, error) {
+	rows, err := q.db.Query(ctx, getPolicySpends, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PolicySpend
+	for rows.Next() {
+		var i PolicySpend
+		if err := rows.Scan(
+			&i.ID,
+			&i.Asset,
+			&i.Amount,
+			&i.Timestamp,
+			&i.FkAccountName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScheduledTx = `-- name: GetScheduledTx :one
+SELECT txid, tx_hex, locktime, broadcast_at, status, conflicting_txid, created_at FROM scheduled_tx WHERE txid = $1
+`
+
+func (q *Queries) GetScheduledTx(ctx context.Context, txid string) (ScheduledTx, error) {
+	row := q.db.QueryRow(ctx, getScheduledTx, txid)
+	var i ScheduledTx
+	err := row.Scan(
+		&i.Txid,
+		&i.TxHex,
+		&i.Locktime,
+		&i.BroadcastAt,
+		&i.Status,
+		&i.ConflictingTxid,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getScheduledTxsByStatus = `-- name: GetScheduledTxsByStatus :many
+SELECT txid, tx_hex, locktime, broadcast_at, status, conflicting_txid, created_at FROM scheduled_tx WHERE status = $1 ORDER BY created_at ASC, txid ASC
+`
+
+func (q *Queries) GetScheduledTxsByStatus(ctx context.Context, status int32) ([]ScheduledTx, error) {
+	rows, err := q.db.Query(ctx, getScheduledTxsByStatus, status)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAllUtxosRow
+	var items []ScheduledTx
 	for rows.Next() {
-		var i GetAllUtxosRow
+		var i ScheduledTx
 		if err := rows.Scan(
-			&i.ID,
-			&i.TxID,
-			&i.Vout,
-			&i.Value,
-			&i.Asset,
-			&i.ValueCommitment,
-			&i.AssetCommitment,
-			&i.ValueBlinder,
-			&i.AssetBlinder,
-			&i.Script,
-			&i.Nonce,
-			&i.RangeProof,
-			&i.SurjectionProof,
-			&i.AccountName,
-			&i.LockTimestamp,
-			&i.LockExpiryTimestamp,
-			&i.ID_2,
-			&i.BlockHeight,
-			&i.BlockTime,
-			&i.BlockHash,
+			&i.Txid,
+			&i.TxHex,
+			&i.Locktime,
+			&i.BroadcastAt,
 			&i.Status,
-			&i.FkUtxoID,
-			&i.TxID_2,
+			&i.ConflictingTxid,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -194,8 +785,36 @@ func (q *Queries) GetScript(ctx context.Context, account string) (ExternalScript
 	return i, err
 }
 
+const getSpendApproval = `-- name: GetSpendApproval :one
+SELECT id, tx, sighash_type, requested_by, timestamp FROM spend_approval WHERE id = $1
+`
+
+func (q *Queries) GetSpendApproval(ctx context.Context, id string) (SpendApproval, error) {
+	row := q.db.QueryRow(ctx, getSpendApproval, id)
+	var i SpendApproval
+	err := row.Scan(
+		&i.ID,
+		&i.Tx,
+		&i.SighashType,
+		&i.RequestedBy,
+		&i.Timestamp,
+	)
+	return i, err
+}
+
+const getSpendingPolicy = `-- name: GetSpendingPolicy :one
+SELECT account_name, max_millisats_per_byte FROM spending_policy WHERE account_name = $1
+`
+
+func (q *Queries) GetSpendingPolicy(ctx context.Context, accountName string) (SpendingPolicy, error) {
+	row := q.db.QueryRow(ctx, getSpendingPolicy, accountName)
+	var i SpendingPolicy
+	err := row.Scan(&i.AccountName, &i.MaxMillisatsPerByte)
+	return i, err
+}
+
 const getTransaction = `-- name: GetTransaction :many
-SELECT tx_id, tx_hex, block_hash, block_height, block_time, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id WHERE tx_id=$1
+SELECT tx_id, tx_hex, block_hash, block_height, block_time, evicted_by, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id WHERE tx_id=$1
 `
 
 type GetTransactionRow struct {
@@ -204,6 +823,7 @@ type GetTransactionRow struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	EvictedBy   string
 	ID          sql.NullInt32
 	AccountName sql.NullString
 	FkTxID      sql.NullString
@@ -224,6 +844,7 @@ func (q *Queries) GetTransaction(ctx context.Context, txID string) ([]GetTransac
 			&i.BlockHash,
 			&i.BlockHeight,
 			&i.BlockTime,
+			&i.EvictedBy,
 			&i.ID,
 			&i.AccountName,
 			&i.FkTxID,
@@ -239,7 +860,7 @@ func (q *Queries) GetTransaction(ctx context.Context, txID string) ([]GetTransac
 }
 
 const getUtxoForKey = `-- name: GetUtxoForKey :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.tx_id = $1 AND u.vout = $2
 `
 
@@ -265,6 +886,10 @@ type GetUtxoForKeyRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -300,6 +925,10 @@ func (q *Queries) GetUtxoForKey(ctx context.Context, arg GetUtxoForKeyParams) ([
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.FrozenTimestamp,
+			&i.Labels,
+			&i.LockOwner,
+			&i.EvictedTimestamp,
 			&i.ID_2,
 			&i.BlockHeight,
 			&i.BlockTime,
@@ -319,7 +948,7 @@ func (q *Queries) GetUtxoForKey(ctx context.Context, arg GetUtxoForKeyParams) ([
 }
 
 const getUtxosForAccount = `-- name: GetUtxosForAccount :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.account_name = $1
 `
 
@@ -340,6 +969,10 @@ type GetUtxosForAccountRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -375,6 +1008,10 @@ func (q *Queries) GetUtxosForAccount(ctx context.Context, accountName string) ([
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.FrozenTimestamp,
+			&i.Labels,
+			&i.LockOwner,
+			&i.EvictedTimestamp,
 			&i.ID_2,
 			&i.BlockHeight,
 			&i.BlockTime,
@@ -394,7 +1031,7 @@ func (q *Queries) GetUtxosForAccount(ctx context.Context, accountName string) ([
 }
 
 const getUtxosForAccountName = `-- name: GetUtxosForAccountName :many
-SELECT id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp FROM utxo WHERE account_name=$1
+SELECT id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp FROM utxo WHERE account_name=$1
 `
 
 func (q *Queries) GetUtxosForAccountName(ctx context.Context, accountName string) ([]Utxo, error) {
@@ -423,6 +1060,10 @@ func (q *Queries) GetUtxosForAccountName(ctx context.Context, accountName string
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.FrozenTimestamp,
+			&i.Labels,
+			&i.LockOwner,
+			&i.EvictedTimestamp,
 		); err != nil {
 			return nil, err
 		}
@@ -549,6 +1190,247 @@ type InsertAccountScriptsParams struct {
 	FkAccountName  string
 }
 
+const insertAuditEvent = `-- name: InsertAuditEvent :exec
+INSERT INTO audit_event(sequence,timestamp,caller,method,account_name,txid,error_message,prev_hash,hash)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9)
+`
+
+type InsertAuditEventParams struct {
+	Sequence     int64
+	Timestamp    int64
+	Caller       string
+	Method       string
+	AccountName  string
+	Txid         string
+	ErrorMessage string
+	PrevHash     string
+	Hash         string
+}
+
+// AUDIT EVENT
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEvent,
+		arg.Sequence,
+		arg.Timestamp,
+		arg.Caller,
+		arg.Method,
+		arg.AccountName,
+		arg.Txid,
+		arg.ErrorMessage,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const insertAuditEventAmount = `-- name: InsertAuditEventAmount :exec
+INSERT INTO audit_event_amount(asset,amount,fk_sequence) VALUES($1,$2,$3)
+`
+
+type InsertAuditEventAmountParams struct {
+	Asset      string
+	Amount     int64
+	FkSequence int64
+}
+
+func (q *Queries) InsertAuditEventAmount(ctx context.Context, arg InsertAuditEventAmountParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEventAmount, arg.Asset, arg.Amount, arg.FkSequence)
+	return err
+}
+
+const insertAuditEventDestination = `-- name: InsertAuditEventDestination :exec
+INSERT INTO audit_event_destination(destination,fk_sequence) VALUES($1,$2)
+`
+
+type InsertAuditEventDestinationParams struct {
+	Destination string
+	FkSequence  int64
+}
+
+func (q *Queries) InsertAuditEventDestination(ctx context.Context, arg InsertAuditEventDestinationParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEventDestination, arg.Destination, arg.FkSequence)
+	return err
+}
+
+const insertBroadcast = `-- name: InsertBroadcast :exec
+INSERT INTO broadcast(txid,tx_hex,status,attempts,next_check_at,conflicting_txid,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type InsertBroadcastParams struct {
+	Txid            string
+	TxHex           string
+	Status          int32
+	Attempts        int32
+	NextCheckAt     int64
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
+func (q *Queries) InsertBroadcast(ctx context.Context, arg InsertBroadcastParams) error {
+	_, err := q.db.Exec(ctx, insertBroadcast,
+		arg.Txid,
+		arg.TxHex,
+		arg.Status,
+		arg.Attempts,
+		arg.NextCheckAt,
+		arg.ConflictingTxid,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertIdempotencyKey = `-- name: InsertIdempotencyKey :exec
+INSERT INTO idempotency_key(key,method,request_hash,status,result,created_at,expires_at)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type InsertIdempotencyKeyParams struct {
+	Key         string
+	Method      string
+	RequestHash string
+	Status      int32
+	Result      string
+	CreatedAt   int64
+	ExpiresAt   int64
+}
+
+func (q *Queries) InsertIdempotencyKey(ctx context.Context, arg InsertIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, insertIdempotencyKey,
+		arg.Key,
+		arg.Method,
+		arg.RequestHash,
+		arg.Status,
+		arg.Result,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertPayment = `-- name: InsertPayment :exec
+INSERT INTO payment(id,account_name,asset,amount,script,blinding_key,deadline,status,txid,output_index,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+`
+
+type InsertPaymentParams struct {
+	ID          string
+	AccountName string
+	Asset       string
+	Amount      int64
+	Script      string
+	BlindingKey string
+	Deadline    int64
+	Status      int32
+	Txid        string
+	OutputIndex int32
+	CreatedAt   int64
+}
+
+func (q *Queries) InsertPayment(ctx context.Context, arg InsertPaymentParams) error {
+	_, err := q.db.Exec(ctx, insertPayment,
+		arg.ID,
+		arg.AccountName,
+		arg.Asset,
+		arg.Amount,
+		arg.Script,
+		arg.BlindingKey,
+		arg.Deadline,
+		arg.Status,
+		arg.Txid,
+		arg.OutputIndex,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertPolicyAllowedScript = `-- name: InsertPolicyAllowedScript :exec
+INSERT INTO policy_allowed_script(script,fk_account_name) VALUES($1,$2)
+`
+
+type InsertPolicyAllowedScriptParams struct {
+	Script        string
+	FkAccountName string
+}
+
+func (q *Queries) InsertPolicyAllowedScript(ctx context.Context, arg InsertPolicyAllowedScriptParams) error {
+	_, err := q.db.Exec(ctx, insertPolicyAllowedScript, arg.Script, arg.FkAccountName)
+	return err
+}
+
+const insertPolicyAssetLimit = `-- name: InsertPolicyAssetLimit :exec
+INSERT INTO policy_asset_limit(asset,spend_limit,window_seconds,approval_threshold,fk_account_name)
+VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertPolicyAssetLimitParams struct {
+	Asset             string
+	SpendLimit        int64
+	WindowSeconds     int64
+	ApprovalThreshold int64
+	FkAccountName     string
+}
+
+func (q *Queries) InsertPolicyAssetLimit(ctx context.Context, arg InsertPolicyAssetLimitParams) error {
+	_, err := q.db.Exec(ctx, insertPolicyAssetLimit,
+		arg.Asset,
+		arg.SpendLimit,
+		arg.WindowSeconds,
+		arg.ApprovalThreshold,
+		arg.FkAccountName,
+	)
+	return err
+}
+
+const insertPolicySpend = `-- name: InsertPolicySpend :exec
+INSERT INTO policy_spend(asset,amount,timestamp,fk_account_name) VALUES($1,$2,$3,$4)
+`
+
+type InsertPolicySpendParams struct {
+	Asset         string
+	Amount        int64
+	Timestamp     int64
+	FkAccountName string
+}
+
+func (q *Queries) InsertPolicySpend(ctx context.Context, arg InsertPolicySpendParams) error {
+	_, err := q.db.Exec(ctx, insertPolicySpend,
+		arg.Asset,
+		arg.Amount,
+		arg.Timestamp,
+		arg.FkAccountName,
+	)
+	return err
+}
+
+const insertScheduledTx = `-- name: InsertScheduledTx :exec
+INSERT INTO scheduled_tx(txid,tx_hex,locktime,broadcast_at,status,conflicting_txid,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type InsertScheduledTxParams struct {
+	Txid            string
+	TxHex           string
+	Locktime        int64
+	BroadcastAt     int64
+	Status          int32
+	ConflictingTxid string
+	CreatedAt       int64
+}
+
+func (q *Queries) InsertScheduledTx(ctx context.Context, arg InsertScheduledTxParams) error {
+	_, err := q.db.Exec(ctx, insertScheduledTx,
+		arg.Txid,
+		arg.TxHex,
+		arg.Locktime,
+		arg.BroadcastAt,
+		arg.Status,
+		arg.ConflictingTxid,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const insertScript = `-- name: InsertScript :exec
 INSERT INTO external_script(account,script,blinding_key) VALUES($1,$2,$3)
 `
@@ -565,9 +1447,33 @@ func (q *Queries) InsertScript(ctx context.Context, arg InsertScriptParams) erro
 	return err
 }
 
+const insertSpendApproval = `-- name: InsertSpendApproval :exec
+INSERT INTO spend_approval(id,tx,sighash_type,requested_by,timestamp) VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertSpendApprovalParams struct {
+	ID          string
+	Tx          string
+	SighashType int32
+	RequestedBy string
+	Timestamp   int64
+}
+
+// SPEND APPROVAL
+func (q *Queries) InsertSpendApproval(ctx context.Context, arg InsertSpendApprovalParams) error {
+	_, err := q.db.Exec(ctx, insertSpendApproval,
+		arg.ID,
+		arg.Tx,
+		arg.SighashType,
+		arg.RequestedBy,
+		arg.Timestamp,
+	)
+	return err
+}
+
 const insertTransaction = `-- name: InsertTransaction :one
-INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time)
-VALUES($1,$2,$3,$4,$5) RETURNING tx_id, tx_hex, block_hash, block_height, block_time
+INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time,evicted_by)
+VALUES($1,$2,$3,$4,$5,$6) RETURNING tx_id, tx_hex, block_hash, block_height, block_time, evicted_by
 `
 
 type InsertTransactionParams struct {
@@ -576,6 +1482,7 @@ type InsertTransactionParams struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	EvictedBy   string
 }
 
 // TRANSACTION
@@ -586,6 +1493,7 @@ func (q *Queries) InsertTransaction(ctx context.Context, arg InsertTransactionPa
 		arg.BlockHash,
 		arg.BlockHeight,
 		arg.BlockTime,
+		arg.EvictedBy,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -594,6 +1502,7 @@ func (q *Queries) InsertTransaction(ctx context.Context, arg InsertTransactionPa
 		&i.BlockHash,
 		&i.BlockHeight,
 		&i.BlockTime,
+		&i.EvictedBy,
 	)
 	return i, err
 }
@@ -616,8 +1525,8 @@ func (q *Queries) InsertTransactionInputAccount(ctx context.Context, arg InsertT
 }
 
 const insertUtxo = `-- name: InsertUtxo :one
-INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15) RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp
+INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp,frozen_timestamp,labels,lock_owner,evicted_timestamp)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15, $16, $17, $18, $19) RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp
 `
 
 type InsertUtxoParams struct {
@@ -636,6 +1545,10 @@ type InsertUtxoParams struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 }
 
 // UTXO
@@ -656,6 +1569,10 @@ func (q *Queries) InsertUtxo(ctx context.Context, arg InsertUtxoParams) (Utxo, e
 		arg.AccountName,
 		arg.LockTimestamp,
 		arg.LockExpiryTimestamp,
+		arg.FrozenTimestamp,
+		arg.Labels,
+		arg.LockOwner,
+		arg.EvictedTimestamp,
 	)
 	var i Utxo
 	err := row.Scan(
@@ -675,6 +1592,10 @@ func (q *Queries) InsertUtxo(ctx context.Context, arg InsertUtxoParams) (Utxo, e
 		&i.AccountName,
 		&i.LockTimestamp,
 		&i.LockExpiryTimestamp,
+		&i.FrozenTimestamp,
+		&i.Labels,
+		&i.LockOwner,
+		&i.EvictedTimestamp,
 	)
 	return i, err
 }
@@ -754,6 +1675,60 @@ func (q *Queries) InsertWallet(ctx context.Context, arg InsertWalletParams) (Wal
 	return i, err
 }
 
+const resetAddressLabels = `-- name: ResetAddressLabels :exec
+DELETE FROM address_label
+`
+
+func (q *Queries) ResetAddressLabels(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetAddressLabels)
+	return err
+}
+
+const resetAuditEvents = `-- name: ResetAuditEvents :exec
+DELETE FROM audit_event
+`
+
+func (q *Queries) ResetAuditEvents(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetAuditEvents)
+	return err
+}
+
+const resetBroadcasts = `-- name: ResetBroadcasts :exec
+DELETE FROM broadcast
+`
+
+func (q *Queries) ResetBroadcasts(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetBroadcasts)
+	return err
+}
+
+const resetIdempotencyKeys = `-- name: ResetIdempotencyKeys :exec
+DELETE FROM idempotency_key
+`
+
+func (q *Queries) ResetIdempotencyKeys(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetIdempotencyKeys)
+	return err
+}
+
+const resetPayments = `-- name: ResetPayments :exec
+DELETE FROM payment
+`
+
+func (q *Queries) ResetPayments(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetPayments)
+	return err
+}
+
+const resetScheduledTxs = `-- name: ResetScheduledTxs :exec
+DELETE FROM scheduled_tx
+`
+
+func (q *Queries) ResetScheduledTxs(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetScheduledTxs)
+	return err
+}
+
 const resetScripts = `-- name: ResetScripts :exec
 DELETE FROM external_script
 `
@@ -763,6 +1738,24 @@ func (q *Queries) ResetScripts(ctx context.Context) error {
 	return err
 }
 
+const resetSpendApprovals = `-- name: ResetSpendApprovals :exec
+DELETE FROM spend_approval
+`
+
+func (q *Queries) ResetSpendApprovals(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetSpendApprovals)
+	return err
+}
+
+const resetSpendingPolicies = `-- name: ResetSpendingPolicies :exec
+DELETE FROM spending_policy
+`
+
+func (q *Queries) ResetSpendingPolicies(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetSpendingPolicies)
+	return err
+}
+
 const resetTransactions = `-- name: ResetTransactions :exec
 DELETE FROM transaction
 `
@@ -823,8 +1816,98 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 	return i, err
 }
 
+const updateBroadcast = `-- name: UpdateBroadcast :execrows
+UPDATE broadcast SET status = $1, attempts = $2, next_check_at = $3, conflicting_txid = $4 WHERE txid = $5
+`
+
+type UpdateBroadcastParams struct {
+	Status          int32
+	Attempts        int32
+	NextCheckAt     int64
+	ConflictingTxid string
+	Txid            string
+}
+
+func (q *Queries) UpdateBroadcast(ctx context.Context, arg UpdateBroadcastParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateBroadcast,
+		arg.Status,
+		arg.Attempts,
+		arg.NextCheckAt,
+		arg.ConflictingTxid,
+		arg.Txid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateIdempotencyKey = `-- name: UpdateIdempotencyKey :execrows
+UPDATE idempotency_key SET status = $1, result = $2 WHERE key = $3
+`
+
+type UpdateIdempotencyKeyParams struct {
+	Status int32
+	Result string
+	Key    string
+}
+
+func (q *Queries) UpdateIdempotencyKey(ctx context.Context, arg UpdateIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateIdempotencyKey, arg.Status, arg.Result, arg.Key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePayment = `-- name: UpdatePayment :execrows
+UPDATE payment SET status = $1, txid = $2, output_index = $3 WHERE id = $4
+`
+
+type UpdatePaymentParams struct {
+	Status      int32
+	Txid        string
+	OutputIndex int32
+	ID          string
+}
+
+func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePayment,
+		arg.Status,
+		arg.Txid,
+		arg.OutputIndex,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateScheduledTx = `-- name: UpdateScheduledTx :execrows
+UPDATE scheduled_tx SET status = $1, conflicting_txid = $2 WHERE txid = $3
+`
+
+type UpdateScheduledTxParams struct {
+	Status          int32
+	ConflictingTxid string
+	Txid            string
+}
+
+func (q *Queries) UpdateScheduledTx(ctx context.Context, arg UpdateScheduledTxParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateScheduledTx,
+		arg.Status,
+		arg.ConflictingTxid,
+		arg.Txid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateTransaction = `-- name: UpdateTransaction :one
-UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4 WHERE tx_id=$5 RETURNING tx_id, tx_hex, block_hash, block_height, block_time
+UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4, evicted_by=$5 WHERE tx_id=$6 RETURNING tx_id, tx_hex, block_hash, block_height, block_time, evicted_by
 `
 
 type UpdateTransactionParams struct {
@@ -832,6 +1915,7 @@ type UpdateTransactionParams struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	EvictedBy   string
 	TxID        string
 }
 
@@ -841,6 +1925,7 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.BlockHash,
 		arg.BlockHeight,
 		arg.BlockTime,
+		arg.EvictedBy,
 		arg.TxID,
 	)
 	var i Transaction
@@ -850,12 +1935,13 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		&i.BlockHash,
 		&i.BlockHeight,
 		&i.BlockTime,
+		&i.EvictedBy,
 	)
 	return i, err
 }
 
 const updateUtxo = `-- name: UpdateUtxo :one
-UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13 WHERE tx_id=$14 and vout=$15 RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp
+UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13, frozen_timestamp=$14, labels=$15, lock_owner=$16, evicted_timestamp=$17 WHERE tx_id=$18 and vout=$19 RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, frozen_timestamp, labels, lock_owner, evicted_timestamp
 `
 
 type UpdateUtxoParams struct {
@@ -872,6 +1958,10 @@ type UpdateUtxoParams struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	FrozenTimestamp     int64
+	Labels              string
+	LockOwner           string
+	EvictedTimestamp    int64
 	TxID                string
 	Vout                int32
 }
@@ -891,6 +1981,10 @@ func (q *Queries) UpdateUtxo(ctx context.Context, arg UpdateUtxoParams) (Utxo, e
 		arg.AccountName,
 		arg.LockTimestamp,
 		arg.LockExpiryTimestamp,
+		arg.FrozenTimestamp,
+		arg.Labels,
+		arg.LockOwner,
+		arg.EvictedTimestamp,
 		arg.TxID,
 		arg.Vout,
 	)
@@ -912,6 +2006,10 @@ func (q *Queries) UpdateUtxo(ctx context.Context, arg UpdateUtxoParams) (Utxo, e
 		&i.AccountName,
 		&i.LockTimestamp,
 		&i.LockExpiryTimestamp,
+		&i.FrozenTimestamp,
+		&i.Labels,
+		&i.LockOwner,
+		&i.EvictedTimestamp,
 	)
 	return i, err
 }
@@ -952,3 +2050,36 @@ func (q *Queries) UpdateWallet(ctx context.Context, arg UpdateWalletParams) (Wal
 	)
 	return i, err
 }
+
+const upsertAddressLabel = `-- name: UpsertAddressLabel :exec
+INSERT INTO address_label(account_name,script,labels) VALUES($1,$2,$3)
+ON CONFLICT (account_name, script) DO UPDATE SET labels = EXCLUDED.labels
+`
+
+type UpsertAddressLabelParams struct {
+	AccountName string
+	Script      string
+	Labels      string
+}
+
+// ADDRESS LABEL
+func (q *Queries) UpsertAddressLabel(ctx context.Context, arg UpsertAddressLabelParams) error {
+	_, err := q.db.Exec(ctx, upsertAddressLabel, arg.AccountName, arg.Script, arg.Labels)
+	return err
+}
+
+const upsertSpendingPolicy = `-- name: UpsertSpendingPolicy :exec
+INSERT INTO spending_policy(account_name,max_millisats_per_byte) VALUES($1,$2)
+ON CONFLICT (account_name) DO UPDATE SET max_millisats_per_byte = EXCLUDED.max_millisats_per_byte
+`
+
+type UpsertSpendingPolicyParams struct {
+	AccountName         string
+	MaxMillisatsPerByte int64
+}
+
+// SPENDING POLICY
+func (q *Queries) UpsertSpendingPolicy(ctx context.Context, arg UpsertSpendingPolicyParams) error {
+	_, err := q.db.Exec(ctx, upsertSpendingPolicy, arg.AccountName, arg.MaxMillisatsPerByte)
+	return err
+}
@@ -33,8 +33,8 @@ DELETE FROM account WHERE namespace = $1;
 
 /* UTXO */
 -- name: InsertUtxo :one
-INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15) RETURNING *;
+INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp,frozen_timestamp,labels,lock_owner,evicted_timestamp)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15, $16, $17, $18, $19) RETURNING *;
 
 -- name: InsertUtxoStatus :one
 INSERT INTO utxo_status(block_height,block_time,block_hash,status,fk_utxo_id,tx_id)
@@ -52,7 +52,7 @@ SELECT * FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.account_name = $1;
 
 -- name: UpdateUtxo :one
-UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13 WHERE tx_id=$14 and vout=$15 RETURNING *;
+UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13, frozen_timestamp=$14, labels=$15, lock_owner=$16, evicted_timestamp=$17 WHERE tx_id=$18 and vout=$19 RETURNING *;
 
 -- name: DeleteUtxoStatuses :exec
 DELETE FROM utxo_status WHERE fk_utxo_id = $1;
@@ -65,15 +65,15 @@ SELECT * FROM utxo WHERE account_name=$1;
 
 /* TRANSACTION */
 -- name: InsertTransaction :one
-INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time)
-VALUES($1,$2,$3,$4,$5) RETURNING *;
+INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time,evicted_by)
+VALUES($1,$2,$3,$4,$5,$6) RETURNING *;
 
 -- name: InsertTransactionInputAccount :one
 INSERT INTO tx_input_account(account_name, fk_tx_id)
 VALUES($1,$2) RETURNING *;
 
 -- name: UpdateTransaction :one
-UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4 WHERE tx_id=$5 RETURNING *;
+UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4, evicted_by=$5 WHERE tx_id=$6 RETURNING *;
 
 -- name: DeleteTransactionInputAccounts :exec
 DELETE FROM tx_input_account WHERE fk_tx_id=$1;
@@ -94,6 +94,160 @@ SELECT * FROM external_script WHERE account = $1;
 -- name: DeleteScript :exec
 DELETE FROM external_script WHERE account = $1;
 
+/* SPENDING POLICY */
+-- name: UpsertSpendingPolicy :exec
+INSERT INTO spending_policy(account_name,max_millisats_per_byte) VALUES($1,$2)
+ON CONFLICT (account_name) DO UPDATE SET max_millisats_per_byte = EXCLUDED.max_millisats_per_byte;
+
+-- name: GetSpendingPolicy :one
+SELECT * FROM spending_policy WHERE account_name = $1;
+
+-- name: DeleteSpendingPolicy :exec
+DELETE FROM spending_policy WHERE account_name = $1;
+
+-- name: InsertPolicyAssetLimit :exec
+INSERT INTO policy_asset_limit(asset,spend_limit,window_seconds,approval_threshold,fk_account_name)
+VALUES($1,$2,$3,$4,$5);
+
+-- name: GetPolicyAssetLimits :many
+SELECT * FROM policy_asset_limit WHERE fk_account_name = $1;
+
+-- name: DeletePolicyAssetLimits :exec
+DELETE FROM policy_asset_limit WHERE fk_account_name = $1;
+
+-- name: InsertPolicyAllowedScript :exec
+INSERT INTO policy_allowed_script(script,fk_account_name) VALUES($1,$2);
+
+-- name: GetPolicyAllowedScripts :many
+SELECT * FROM policy_allowed_script WHERE fk_account_name = $1;
+
+-- name: DeletePolicyAllowedScripts :exec
+DELETE FROM policy_allowed_script WHERE fk_account_name = $1;
+
+-- name: InsertPolicySpend :exec
+INSERT INTO policy_spend(asset,amount,timestamp,fk_account_name) VALUES($1,$2,$3,$4);
+
+-- name: GetPolicySpends :many
+SELECT * FROM policy_spend WHERE fk_account_name = $1;
+
+-- name: DeletePolicySpends :exec
+DELETE FROM policy_spend WHERE fk_account_name = $1;
+
+/* SPEND APPROVAL */
+-- name: InsertSpendApproval :exec
+INSERT INTO spend_approval(id,tx,sighash_type,requested_by,timestamp) VALUES($1,$2,$3,$4,$5);
+
+-- name: GetSpendApproval :one
+SELECT * FROM spend_approval WHERE id = $1;
+
+-- name: GetAllSpendApprovals :many
+SELECT * FROM spend_approval;
+
+-- name: DeleteSpendApproval :exec
+DELETE FROM spend_approval WHERE id = $1;
+
+/* AUDIT EVENT */
+-- name: InsertAuditEvent :exec
+INSERT INTO audit_event(sequence,timestamp,caller,method,account_name,txid,error_message,prev_hash,hash)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9);
+
+-- name: GetLastAuditEvent :one
+SELECT * FROM audit_event ORDER BY sequence DESC LIMIT 1;
+
+-- name: GetAuditEvents :many
+SELECT * FROM audit_event WHERE sequence >= $1 ORDER BY sequence ASC LIMIT $2;
+
+-- name: InsertAuditEventAmount :exec
+INSERT INTO audit_event_amount(asset,amount,fk_sequence) VALUES($1,$2,$3);
+
+-- name: GetAuditEventAmounts :many
+SELECT * FROM audit_event_amount WHERE fk_sequence = $1;
+
+-- name: InsertAuditEventDestination :exec
+INSERT INTO audit_event_destination(destination,fk_sequence) VALUES($1,$2);
+
+-- name: GetAuditEventDestinations :many
+SELECT * FROM audit_event_destination WHERE fk_sequence = $1 ORDER BY id ASC;
+
+/* IDEMPOTENCY KEY */
+-- name: InsertIdempotencyKey :exec
+INSERT INTO idempotency_key(key,method,request_hash,status,result,created_at,expires_at)
+VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_key WHERE key = $1;
+
+-- name: UpdateIdempotencyKey :execrows
+UPDATE idempotency_key SET status = $1, result = $2 WHERE key = $3;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_key WHERE key = $1;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_key WHERE expires_at <= $1;
+
+/* PAYMENT */
+-- name: InsertPayment :exec
+INSERT INTO payment(id,account_name,asset,amount,script,blinding_key,deadline,status,txid,output_index,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11);
+
+-- name: GetPayment :one
+SELECT * FROM payment WHERE id = $1;
+
+-- name: GetPaymentsByStatus :many
+SELECT * FROM payment WHERE status = $1 ORDER BY created_at ASC, id ASC;
+
+-- name: GetPaymentsByTxid :many
+SELECT * FROM payment WHERE txid = $1 ORDER BY created_at ASC, id ASC;
+
+-- name: UpdatePayment :execrows
+UPDATE payment SET status = $1, txid = $2, output_index = $3 WHERE id = $4;
+
+/* BROADCAST */
+-- name: InsertBroadcast :exec
+INSERT INTO broadcast(txid,tx_hex,status,attempts,next_check_at,conflicting_txid,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetBroadcast :one
+SELECT * FROM broadcast WHERE txid = $1;
+
+-- name: GetBroadcastsByStatus :many
+SELECT * FROM broadcast WHERE status = $1 ORDER BY created_at ASC, txid ASC;
+
+-- name: UpdateBroadcast :execrows
+UPDATE broadcast SET status = $1, attempts = $2, next_check_at = $3, conflicting_txid = $4 WHERE txid = $5;
+
+/* SCHEDULED TX */
+-- name: InsertScheduledTx :exec
+INSERT INTO scheduled_tx(txid,tx_hex,locktime,broadcast_at,status,conflicting_txid,created_at)
+VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetScheduledTx :one
+SELECT * FROM scheduled_tx WHERE txid = $1;
+
+-- name: GetAllScheduledTxs :many
+SELECT * FROM scheduled_tx ORDER BY created_at ASC, txid ASC;
+
+-- name: GetScheduledTxsByStatus :many
+SELECT * FROM scheduled_tx WHERE status = $1 ORDER BY created_at ASC, txid ASC;
+
+-- name: UpdateScheduledTx :execrows
+UPDATE scheduled_tx SET status = $1, conflicting_txid = $2 WHERE txid = $3;
+
+/* ADDRESS LABEL */
+-- name: UpsertAddressLabel :exec
+INSERT INTO address_label(account_name,script,labels) VALUES($1,$2,$3)
+ON CONFLICT (account_name, script) DO UPDATE SET labels = EXCLUDED.labels;
+
+-- name: DeleteAddressLabel :exec
+DELETE FROM address_label WHERE account_name = $1 AND script = $2;
+
+-- name: GetAddressLabelsForAccount :many
+SELECT * FROM address_label WHERE account_name = $1;
+
+-- name: DeleteAddressLabelsForAccount :exec
+DELETE FROM address_label WHERE account_name = $1;
+
 -- name: ResetUtxos :exec
 DELETE FROM utxo;
 
@@ -105,3 +259,27 @@ DELETE FROM transaction;
 
 -- name: ResetScripts :exec
 DELETE FROM external_script;
+
+-- name: ResetSpendingPolicies :exec
+DELETE FROM spending_policy;
+
+-- name: ResetSpendApprovals :exec
+DELETE FROM spend_approval;
+
+-- name: ResetAuditEvents :exec
+DELETE FROM audit_event;
+
+-- name: ResetIdempotencyKeys :exec
+DELETE FROM idempotency_key;
+
+-- name: ResetPayments :exec
+DELETE FROM payment;
+
+-- name: ResetAddressLabels :exec
+DELETE FROM address_label;
+
+-- name: ResetBroadcasts :exec
+DELETE FROM broadcast;
+
+-- name: ResetScheduledTxs :exec
+DELETE FROM scheduled_tx;
@@ -64,6 +64,7 @@ func (t *txRepositoryPg) AddTransaction(
 			BlockHash:   trx.BlockHash,
 			BlockHeight: int32(trx.BlockHeight),
 			BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+			EvictedBy:   trx.EvictedBy,
 		},
 	)
 	if err != nil {
@@ -125,6 +126,32 @@ func (t *txRepositoryPg) ConfirmTransaction(
 	return true, nil
 }
 
+func (t *txRepositoryPg) EvictTransaction(
+	ctx context.Context, txid, replacementTxid string,
+) (bool, error) {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if tx.IsConfirmed() || tx.IsEvicted() {
+		return false, nil
+	}
+
+	tx.Evict(replacementTxid)
+
+	if err := t.updateTx(ctx, t.querier, *tx); err != nil {
+		return false, err
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionEvicted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (t *txRepositoryPg) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
@@ -177,6 +204,7 @@ func (t *txRepositoryPg) updateTx(
 		BlockHash:   trx.BlockHash,
 		BlockHeight: int32(trx.BlockHeight),
 		BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+		EvictedBy:   trx.EvictedBy,
 		TxID:        trx.TxID,
 	}); err != nil {
 		return err
@@ -226,6 +254,7 @@ func (t *txRepositoryPg) getTx(
 		BlockHeight: uint64(tx[0].BlockHeight),
 		BlockTime:   tx[0].BlockTime.Int64,
 		Accounts:    accounts,
+		EvictedBy:   tx[0].EvictedBy,
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"sync"
 
 	"github.com/jackc/pgconn"
@@ -74,6 +75,10 @@ func (u *utxoRepositoryPg) AddUtxos(
 			AccountName:         v.AccountName,
 			LockTimestamp:       v.LockTimestamp,
 			LockExpiryTimestamp: v.LockExpiryTimestamp,
+			LockOwner:           v.LockOwner,
+			EvictedTimestamp:    v.EvictedTimestamp,
+			FrozenTimestamp:     v.FrozenTimestamp,
+			Labels:              encodeLabels(v.Labels),
 		}
 		utxo, err := querierWithTx.InsertUtxo(ctx, req)
 		if err != nil {
@@ -166,6 +171,10 @@ func (u *utxoRepositoryPg) GetUtxosByKey(
 			AccountName:         utxo[0].AccountName,
 			LockTimestamp:       utxo[0].LockTimestamp,
 			LockExpiryTimestamp: utxo[0].LockExpiryTimestamp,
+			LockOwner:           utxo[0].LockOwner,
+			EvictedTimestamp:    utxo[0].EvictedTimestamp,
+			FrozenTimestamp:     utxo[0].FrozenTimestamp,
+			Labels:              decodeLabels(utxo[0].Labels),
 		}
 
 		for _, v := range utxo {
@@ -230,7 +239,7 @@ func (u *utxoRepositoryPg) GetSpendableUtxos(
 	}
 
 	for _, v := range utxosByKey {
-		if !v.IsLocked() && !v.IsSpent() {
+		if !v.IsLocked() && !v.IsFrozen() && !v.IsSpent() && !v.IsEvicted() {
 			resp = append(resp, v)
 		}
 	}
@@ -292,7 +301,7 @@ func (u *utxoRepositoryPg) GetSpendableUtxosForAccount(
 	}
 
 	for _, v := range utxosByKey {
-		if !v.IsLocked() && !v.IsSpent() {
+		if !v.IsLocked() && !v.IsFrozen() && !v.IsSpent() && !v.IsEvicted() {
 			found := len(scripts) <= 0
 			for _, script := range scripts {
 				if bytes.Equal(v.Script, script) {
@@ -333,7 +342,48 @@ func (u *utxoRepositoryPg) GetLockedUtxosForAccount(
 	}
 
 	for _, v := range utxosByKey {
-		if v.IsLocked() && !v.IsSpent() {
+		if v.IsLocked() && !v.IsFrozen() && !v.IsSpent() && !v.IsEvicted() {
+			found := len(scripts) <= 0
+			for _, script := range scripts {
+				if bytes.Equal(v.Script, script) {
+					found = true
+					break
+				}
+			}
+			if found {
+				resp = append(resp, v)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func (u *utxoRepositoryPg) GetFrozenUtxosForAccount(
+	ctx context.Context, account string, scripts [][]byte,
+) ([]*domain.Utxo, error) {
+	resp := make([]*domain.Utxo, 0)
+	utxos, err := u.querier.GetUtxosForAccount(ctx, account)
+	if err != nil {
+		return nil, nil
+	}
+
+	req := make([]queries.GetAllUtxosRow, 0, len(utxos))
+	for _, v := range utxos {
+		req = append(
+			req,
+			toGetAllUtxosRow(v),
+		)
+
+	}
+
+	utxosByKey, err := u.convertToUtxos(req)
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, v := range utxosByKey {
+		if v.IsFrozen() && !v.IsSpent() && !v.IsEvicted() {
 			found := len(scripts) <= 0
 			for _, script := range scripts {
 				if bytes.Equal(v.Script, script) {
@@ -374,7 +424,7 @@ func (u *utxoRepositoryPg) GetBalanceForAccount(
 	}
 
 	for _, v := range utxosByKey {
-		if v.IsSpent() {
+		if v.IsSpent() || v.IsEvicted() {
 			continue
 		}
 
@@ -383,7 +433,9 @@ func (u *utxoRepositoryPg) GetBalanceForAccount(
 		}
 
 		b := resp[v.Asset]
-		if v.IsLocked() {
+		if v.IsFrozen() {
+			b.Frozen += v.Value
+		} else if v.IsLocked() {
 			b.Locked += v.Value
 		} else {
 			if v.IsConfirmed() {
@@ -403,6 +455,12 @@ func (u *utxoRepositoryPg) SpendUtxos(
 	return u.spendUtxos(ctx, utxoKeys, txid)
 }
 
+func (u *utxoRepositoryPg) RespendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (int, error) {
+	return u.respendUtxos(ctx, utxoKeys, txid)
+}
+
 func (u *utxoRepositoryPg) ConfirmSpendUtxos(
 	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -418,8 +476,9 @@ func (u *utxoRepositoryPg) ConfirmUtxos(
 func (u *utxoRepositoryPg) LockUtxos(
 	ctx context.Context,
 	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (int, error) {
-	return u.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp)
+	return u.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp, owner)
 }
 
 func (u *utxoRepositoryPg) UnlockUtxos(
@@ -428,6 +487,48 @@ func (u *utxoRepositoryPg) UnlockUtxos(
 	return u.unlockUtxos(ctx, utxoKeys)
 }
 
+func (u *utxoRepositoryPg) ReleaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, owner string,
+) (int, error) {
+	return u.releaseUtxos(ctx, utxoKeys, owner)
+}
+
+func (u *utxoRepositoryPg) FreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	return u.freezeUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (u *utxoRepositoryPg) UnfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return u.unfreezeUtxos(ctx, utxoKeys)
+}
+
+func (u *utxoRepositoryPg) EvictUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	return u.evictUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (u *utxoRepositoryPg) SetUtxoLabels(
+	ctx context.Context, utxoKeys []domain.UtxoKey, labels []string,
+) (int, error) {
+	utxos, err := u.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, utxo := range utxos {
+		utxo.SetLabels(labels)
+		if err := u.updateUtxo(ctx, utxo); err != nil {
+			return -1, err
+		}
+	}
+
+	return len(utxos), nil
+}
+
 func (u *utxoRepositoryPg) DeleteUtxosForAccount(
 	ctx context.Context, accountName string,
 ) error {
@@ -544,6 +645,61 @@ func (u *utxoRepositoryPg) spendUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (u *utxoRepositoryPg) respendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.respendUtxo(ctx, key, txid)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoSpent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (u *utxoRepositoryPg) respendUtxo(
+	ctx context.Context, key domain.UtxoKey, txid string,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsSpent() || utxo.IsConfirmedSpent() ||
+		utxo.SpentStatus.Txid == txid {
+		return false, nil, nil
+	}
+
+	if err := utxo.Respend(txid); err != nil {
+		return false, nil, err
+	}
+
+	if err := u.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
 func (u *utxoRepositoryPg) confirmSpendUtxos(
 	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
 ) (int, error) {
@@ -615,6 +771,10 @@ func (u *utxoRepositoryPg) updateUtxo(
 		AccountName:         utxo.AccountName,
 		LockTimestamp:       utxo.LockTimestamp,
 		LockExpiryTimestamp: utxo.LockExpiryTimestamp,
+		LockOwner:           utxo.LockOwner,
+		EvictedTimestamp:    utxo.EvictedTimestamp,
+		FrozenTimestamp:     utxo.FrozenTimestamp,
+		Labels:              encodeLabels(utxo.Labels),
 		TxID:                utxo.TxID,
 		Vout:                int32(utxo.VOut),
 	})
@@ -712,11 +872,12 @@ func (u *utxoRepositoryPg) confirmUtxo(
 
 func (u *utxoRepositoryPg) lockUtxos(
 	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0)
 	for _, key := range utxoKeys {
-		done, info, err := u.lockUtxo(ctx, key, timestamp, expiryTimestamp)
+		done, info, err := u.lockUtxo(ctx, key, timestamp, expiryTimestamp, owner)
 		if err != nil {
 			return -1, err
 		}
@@ -738,6 +899,7 @@ func (u *utxoRepositoryPg) lockUtxos(
 
 func (u *utxoRepositoryPg) lockUtxo(
 	ctx context.Context, key domain.UtxoKey, timestamp, expiryTimestamp int64,
+	owner string,
 ) (bool, *domain.UtxoInfo, error) {
 	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
 	if err != nil {
@@ -749,11 +911,11 @@ func (u *utxoRepositoryPg) lockUtxo(
 	}
 
 	utxo := utxos[0]
-	if utxo.IsLocked() {
+	if !utxo.CanLock(owner) {
 		return false, nil, nil
 	}
 
-	utxo.Lock(timestamp, expiryTimestamp)
+	utxo.Lock(timestamp, expiryTimestamp, owner)
 	if err := u.updateUtxo(ctx, utxo); err != nil {
 		return false, nil, err
 	}
@@ -788,6 +950,32 @@ func (u *utxoRepositoryPg) unlockUtxos(
 	return count, nil
 }
 
+func (u *utxoRepositoryPg) releaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, owner string,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.releaseUtxo(ctx, key, owner)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (u *utxoRepositoryPg) unlockUtxo(
 	ctx context.Context, key domain.UtxoKey,
 ) (bool, *domain.UtxoInfo, error) {
@@ -801,7 +989,7 @@ func (u *utxoRepositoryPg) unlockUtxo(
 	}
 
 	utxo := utxos[0]
-	if !utxo.IsLocked() {
+	if !utxo.IsLocked() || !utxo.CanUnlock() {
 		return false, nil, nil
 	}
 
@@ -814,6 +1002,191 @@ func (u *utxoRepositoryPg) unlockUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (u *utxoRepositoryPg) releaseUtxo(
+	ctx context.Context, key domain.UtxoKey, owner string,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsLocked() {
+		return false, nil, nil
+	}
+	if len(owner) > 0 && !utxo.IsLockedBy(owner) {
+		return false, nil, nil
+	}
+
+	utxo.Release()
+	if err := u.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (u *utxoRepositoryPg) freezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.freezeUtxo(ctx, key, timestamp)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (u *utxoRepositoryPg) freezeUtxo(
+	ctx context.Context, key domain.UtxoKey, timestamp int64,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if utxo.IsFrozen() {
+		return false, nil, nil
+	}
+
+	utxo.Freeze(timestamp)
+	if err := u.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (u *utxoRepositoryPg) unfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.unfreezeUtxo(ctx, key)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (u *utxoRepositoryPg) unfreezeUtxo(
+	ctx context.Context, key domain.UtxoKey,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if !utxo.IsFrozen() {
+		return false, nil, nil
+	}
+
+	utxo.Unfreeze()
+	if err := u.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (u *utxoRepositoryPg) evictUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.evictUtxo(ctx, key, timestamp)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoEvicted,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (u *utxoRepositoryPg) evictUtxo(
+	ctx context.Context, key domain.UtxoKey, timestamp int64,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if utxo.IsEvicted() {
+		return false, nil, nil
+	}
+
+	utxo.Evict(timestamp)
+	if err := u.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
 func (u *utxoRepositoryPg) convertToUtxos(
 	utxos []queries.GetAllUtxosRow,
 ) (map[domain.UtxoKey]*domain.Utxo, error) {
@@ -841,6 +1214,10 @@ func (u *utxoRepositoryPg) convertToUtxos(
 				AccountName:         v.AccountName,
 				LockTimestamp:       v.LockTimestamp,
 				LockExpiryTimestamp: v.LockExpiryTimestamp,
+				LockOwner:           v.LockOwner,
+				EvictedTimestamp:    v.EvictedTimestamp,
+				FrozenTimestamp:     v.FrozenTimestamp,
+				Labels:              decodeLabels(v.Labels),
 			}
 			utxosByKey[key] = utxo
 		}
@@ -889,6 +1266,10 @@ func toGetAllUtxosRow(v queries.GetUtxosForAccountRow) queries.GetAllUtxosRow {
 		AccountName:         v.AccountName,
 		LockTimestamp:       v.LockTimestamp,
 		LockExpiryTimestamp: v.LockExpiryTimestamp,
+		LockOwner:           v.LockOwner,
+		EvictedTimestamp:    v.EvictedTimestamp,
+		FrozenTimestamp:     v.FrozenTimestamp,
+		Labels:              v.Labels,
 		ID_2:                v.ID_2,
 		BlockHeight:         v.BlockHeight,
 		BlockTime:           v.BlockTime,
@@ -897,3 +1278,24 @@ func toGetAllUtxosRow(v queries.GetUtxosForAccountRow) queries.GetAllUtxosRow {
 		FkUtxoID:            v.FkUtxoID,
 	}
 }
+
+// encodeLabels serializes the given labels into the JSON string stored in db.
+// An empty list is stored as an empty string.
+func encodeLabels(labels []string) string {
+	if len(labels) <= 0 {
+		return ""
+	}
+	buf, _ := json.Marshal(labels)
+	return string(buf)
+}
+
+func decodeLabels(str string) []string {
+	if len(str) <= 0 {
+		return nil
+	}
+	var labels []string
+	if err := json.Unmarshal([]byte(str), &labels); err != nil {
+		return nil
+	}
+	return labels
+}
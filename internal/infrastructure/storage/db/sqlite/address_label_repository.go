@@ -0,0 +1,75 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type addressLabelRepositorySqlite struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewAddressLabelRepositorySqliteImpl(
+	db *sql.DB,
+) domain.AddressLabelRepository {
+	return newAddressLabelRepositorySqliteImpl(db)
+}
+
+func newAddressLabelRepositorySqliteImpl(
+	db *sql.DB,
+) *addressLabelRepositorySqlite {
+	return &addressLabelRepositorySqlite{
+		db:      db,
+		querier: queries.New(db),
+	}
+}
+
+func (r *addressLabelRepositorySqlite) SetAddressLabels(
+	ctx context.Context, accountName, script string, labels []string,
+) error {
+	if len(labels) <= 0 {
+		return r.querier.DeleteAddressLabel(ctx, queries.DeleteAddressLabelParams{
+			AccountName: accountName,
+			Script:      script,
+		})
+	}
+
+	return r.querier.UpsertAddressLabel(ctx, queries.UpsertAddressLabelParams{
+		AccountName: accountName,
+		Script:      script,
+		Labels:      encodeLabels(labels),
+	})
+}
+
+func (r *addressLabelRepositorySqlite) GetAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) (map[string][]string, error) {
+	rows, err := r.querier.GetAddressLabelsForAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsByScript := make(map[string][]string)
+	for _, row := range rows {
+		labelsByScript[row.Script] = decodeLabels(row.Labels)
+	}
+	return labelsByScript, nil
+}
+
+func (r *addressLabelRepositorySqlite) DeleteAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) error {
+	return r.querier.DeleteAddressLabelsForAccount(ctx, accountName)
+}
+
+func (r *addressLabelRepositorySqlite) close() {}
+
+func (r *addressLabelRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetAddressLabels(ctx)
+}
@@ -0,0 +1,170 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type auditRepositorySqlite struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewAuditEventRepositorySqliteImpl(
+	db *sql.DB,
+) domain.AuditEventRepository {
+	return newAuditEventRepositorySqliteImpl(db)
+}
+
+func newAuditEventRepositorySqliteImpl(db *sql.DB) *auditRepositorySqlite {
+	return &auditRepositorySqlite{
+		db:      db,
+		querier: queries.New(db),
+	}
+}
+
+func (r *auditRepositorySqlite) AddEvent(
+	ctx context.Context, event *domain.AuditEvent,
+) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	sequence := int64(event.Sequence)
+	if err := querierWithTx.InsertAuditEvent(
+		ctx, queries.InsertAuditEventParams{
+			Sequence:     sequence,
+			Timestamp:    event.Timestamp,
+			Caller:       event.Caller,
+			Method:       event.Method,
+			AccountName:  event.AccountName,
+			Txid:         event.Txid,
+			ErrorMessage: event.Error,
+			PrevHash:     event.PrevHash,
+			Hash:         event.Hash,
+		},
+	); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	for asset, amount := range event.Amounts {
+		if err := querierWithTx.InsertAuditEventAmount(
+			ctx, queries.InsertAuditEventAmountParams{
+				Asset:      asset,
+				Amount:     int64(amount),
+				FkSequence: sequence,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+	for _, destination := range event.Destinations {
+		if err := querierWithTx.InsertAuditEventDestination(
+			ctx, queries.InsertAuditEventDestinationParams{
+				Destination: destination,
+				FkSequence:  sequence,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *auditRepositorySqlite) GetLastEvent(
+	ctx context.Context,
+) (*domain.AuditEvent, error) {
+	event, err := r.querier.GetLastAuditEvent(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAuditEventNotFound
+		}
+		return nil, err
+	}
+
+	return r.toAuditEvent(ctx, event)
+}
+
+func (r *auditRepositorySqlite) GetEvents(
+	ctx context.Context, fromSequence, limit uint64,
+) ([]domain.AuditEvent, error) {
+	rows, err := r.querier.GetAuditEvents(ctx, queries.GetAuditEventsParams{
+		Sequence: int64(fromSequence),
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := r.toAuditEvent(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+func (r *auditRepositorySqlite) toAuditEvent(
+	ctx context.Context, event queries.AuditEvent,
+) (*domain.AuditEvent, error) {
+	amounts, err := r.querier.GetAuditEventAmounts(ctx, event.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := r.querier.GetAuditEventDestinations(
+		ctx, event.Sequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amountsByAsset := make(map[string]uint64)
+	for _, a := range amounts {
+		amountsByAsset[a.Asset] = uint64(a.Amount)
+	}
+	dests := make([]string, 0, len(destinations))
+	for _, d := range destinations {
+		dests = append(dests, d.Destination)
+	}
+
+	return &domain.AuditEvent{
+		Sequence:     uint64(event.Sequence),
+		Timestamp:    event.Timestamp,
+		Caller:       event.Caller,
+		Method:       event.Method,
+		AccountName:  event.AccountName,
+		Amounts:      amountsByAsset,
+		Destinations: dests,
+		Txid:         event.Txid,
+		Error:        event.ErrorMessage,
+		PrevHash:     event.PrevHash,
+		Hash:         event.Hash,
+	}, nil
+}
+
+func (r *auditRepositorySqlite) close() {}
+
+func (r *auditRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetAuditEvents(ctx)
+}
@@ -0,0 +1,184 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type broadcastRepositorySqlite struct {
+	db       *sql.DB
+	querier  *queries.Queries
+	chEvents chan domain.BroadcastEvent
+	chLock   *sync.Mutex
+}
+
+func NewBroadcastRepositorySqliteImpl(db *sql.DB) domain.BroadcastRepository {
+	return newBroadcastRepositorySqliteImpl(db)
+}
+
+func newBroadcastRepositorySqliteImpl(db *sql.DB) *broadcastRepositorySqlite {
+	return &broadcastRepositorySqlite{
+		db:       db,
+		querier:  queries.New(db),
+		chEvents: make(chan domain.BroadcastEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *broadcastRepositorySqlite) AddBroadcast(
+	ctx context.Context, broadcast *domain.Broadcast,
+) (bool, error) {
+	if err := r.querier.InsertBroadcast(ctx, queries.InsertBroadcastParams{
+		Txid:            broadcast.Txid,
+		TxHex:           broadcast.TxHex,
+		Status:          int64(broadcast.Status),
+		Attempts:        int64(broadcast.Attempts),
+		NextCheckAt:     broadcast.NextCheckAt,
+		ConflictingTxid: broadcast.ConflictingTxid,
+		CreatedAt:       broadcast.CreatedAt,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	go r.publishEvent(domain.BroadcastEvent{
+		EventType:  domain.BroadcastPending,
+		Broadcasts: []domain.Broadcast{*broadcast},
+	})
+
+	return true, nil
+}
+
+func (r *broadcastRepositorySqlite) GetBroadcast(
+	ctx context.Context, txid string,
+) (*domain.Broadcast, error) {
+	b, err := r.querier.GetBroadcast(ctx, txid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrBroadcastNotFound
+		}
+		return nil, err
+	}
+
+	broadcast := toBroadcast(b)
+	return &broadcast, nil
+}
+
+func (r *broadcastRepositorySqlite) GetPendingBroadcasts(
+	ctx context.Context,
+) ([]domain.Broadcast, error) {
+	rows, err := r.querier.GetBroadcastsByStatus(
+		ctx, int64(domain.BroadcastStatusPending),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	broadcasts := make([]domain.Broadcast, 0, len(rows))
+	for _, b := range rows {
+		broadcasts = append(broadcasts, toBroadcast(b))
+	}
+	return broadcasts, nil
+}
+
+func (r *broadcastRepositorySqlite) UpdateBroadcasts(
+	ctx context.Context, broadcasts []domain.Broadcast,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	broadcastsByStatus := make(map[domain.BroadcastStatus][]domain.Broadcast)
+	for _, broadcast := range broadcasts {
+		b, err := querierWithTx.GetBroadcast(ctx, broadcast.Txid)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrBroadcastNotFound
+			}
+			return err
+		}
+		if _, err := querierWithTx.UpdateBroadcast(
+			ctx, queries.UpdateBroadcastParams{
+				Status:          int64(broadcast.Status),
+				Attempts:        int64(broadcast.Attempts),
+				NextCheckAt:     broadcast.NextCheckAt,
+				ConflictingTxid: broadcast.ConflictingTxid,
+				Txid:            broadcast.Txid,
+			},
+		); err != nil {
+			return err
+		}
+
+		if domain.BroadcastStatus(b.Status) != broadcast.Status {
+			updated := toBroadcast(b)
+			updated.Status = broadcast.Status
+			updated.Attempts = broadcast.Attempts
+			updated.NextCheckAt = broadcast.NextCheckAt
+			updated.ConflictingTxid = broadcast.ConflictingTxid
+			broadcastsByStatus[updated.Status] = append(
+				broadcastsByStatus[updated.Status], updated,
+			)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for status, broadcasts := range broadcastsByStatus {
+		go r.publishEvent(domain.BroadcastEvent{
+			EventType:  domain.BroadcastEventTypeFromStatus(status),
+			Broadcasts: broadcasts,
+		})
+	}
+	return nil
+}
+
+func (r *broadcastRepositorySqlite) GetEventChannel() chan domain.BroadcastEvent {
+	return r.chEvents
+}
+
+func (r *broadcastRepositorySqlite) publishEvent(event domain.BroadcastEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *broadcastRepositorySqlite) close() {
+	close(r.chEvents)
+}
+
+func (r *broadcastRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetBroadcasts(ctx)
+}
+
+func toBroadcast(b queries.Broadcast) domain.Broadcast {
+	return domain.Broadcast{
+		Txid:            b.Txid,
+		TxHex:           b.TxHex,
+		Status:          domain.BroadcastStatus(b.Status),
+		Attempts:        uint32(b.Attempts),
+		NextCheckAt:     b.NextCheckAt,
+		ConflictingTxid: b.ConflictingTxid,
+		CreatedAt:       b.CreatedAt,
+	}
+}
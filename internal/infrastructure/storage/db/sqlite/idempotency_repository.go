@@ -0,0 +1,118 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type idempotencyRepositorySqlite struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewIdempotencyKeyRepositorySqliteImpl(
+	db *sql.DB,
+) domain.IdempotencyKeyRepository {
+	return newIdempotencyKeyRepositorySqliteImpl(db)
+}
+
+func newIdempotencyKeyRepositorySqliteImpl(
+	db *sql.DB,
+) *idempotencyRepositorySqlite {
+	return &idempotencyRepositorySqlite{
+		db:      db,
+		querier: queries.New(db),
+	}
+}
+
+func (r *idempotencyRepositorySqlite) AddKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) (bool, error) {
+	if err := r.querier.InsertIdempotencyKey(
+		ctx, queries.InsertIdempotencyKeyParams{
+			Key:         key.Key,
+			Method:      key.Method,
+			RequestHash: key.RequestHash,
+			Status:      int64(key.Status),
+			Result:      key.Result,
+			CreatedAt:   key.CreatedAt,
+			ExpiresAt:   key.ExpiresAt,
+		},
+	); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *idempotencyRepositorySqlite) GetKey(
+	ctx context.Context, key string,
+) (*domain.IdempotencyKey, error) {
+	k, err := r.querier.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+
+	return &domain.IdempotencyKey{
+		Key:         k.Key,
+		Method:      k.Method,
+		RequestHash: k.RequestHash,
+		Status:      domain.IdempotencyKeyStatus(k.Status),
+		Result:      k.Result,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}, nil
+}
+
+func (r *idempotencyRepositorySqlite) UpdateKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) error {
+	count, err := r.querier.UpdateIdempotencyKey(
+		ctx, queries.UpdateIdempotencyKeyParams{
+			Status: int64(key.Status),
+			Result: key.Result,
+			Key:    key.Key,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return domain.ErrIdempotencyKeyNotFound
+	}
+	return nil
+}
+
+func (r *idempotencyRepositorySqlite) DeleteKey(
+	ctx context.Context, key string,
+) error {
+	return r.querier.DeleteIdempotencyKey(ctx, key)
+}
+
+func (r *idempotencyRepositorySqlite) DeleteExpiredKeys(
+	ctx context.Context, now int64,
+) (int, error) {
+	count, err := r.querier.DeleteExpiredIdempotencyKeys(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *idempotencyRepositorySqlite) close() {}
+
+func (r *idempotencyRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetIdempotencyKeys(ctx)
+}
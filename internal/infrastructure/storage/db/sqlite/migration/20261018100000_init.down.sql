@@ -0,0 +1,31 @@
+DROP TABLE IF EXISTS audit_event_destination;
+
+DROP TABLE IF EXISTS audit_event_amount;
+
+DROP TABLE IF EXISTS audit_event;
+
+DROP TABLE IF EXISTS spend_approval;
+
+DROP TABLE IF EXISTS policy_spend;
+
+DROP TABLE IF EXISTS policy_allowed_script;
+
+DROP TABLE IF EXISTS policy_asset_limit;
+
+DROP TABLE IF EXISTS spending_policy;
+
+DROP TABLE IF EXISTS external_script;
+
+DROP TABLE IF EXISTS utxo_status;
+
+DROP TABLE IF EXISTS utxo;
+
+DROP TABLE IF EXISTS tx_input_account;
+
+DROP TABLE IF EXISTS "transaction";
+
+DROP TABLE IF EXISTS account_script_info;
+
+DROP TABLE IF EXISTS account;
+
+DROP TABLE IF EXISTS wallet;
@@ -0,0 +1,147 @@
+CREATE TABLE wallet (
+    id TEXT NOT NULL PRIMARY KEY,
+    encrypted_mnemonic BLOB NOT NULL,
+    password_hash BLOB NOT NULL,
+    birthday_block_height INTEGER NOT NULL,
+    root_path TEXT NOT NULL,
+    network_name TEXT NOT NULL,
+    next_account_index INTEGER NOT NULL
+);
+
+CREATE TABLE account (
+    namespace TEXT NOT NULL PRIMARY KEY,
+    "index" INTEGER NOT NULL,
+    label TEXT,
+    xpub TEXT NOT NULL,
+    derivation_path TEXT NOT NULL,
+    next_external_index INTEGER NOT NULL,
+    next_internal_index INTEGER NOT NULL,
+    fk_wallet_id TEXT NOT NULL,
+    unconf BOOLEAN,
+    FOREIGN KEY (fk_wallet_id) REFERENCES wallet(id) ON DELETE CASCADE
+);
+
+CREATE TABLE account_script_info (
+    script TEXT NOT NULL PRIMARY KEY,
+    derivation_path TEXT NOT NULL,
+    fk_account_name TEXT NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES account(namespace) ON DELETE CASCADE
+);
+
+CREATE TABLE "transaction" (
+    tx_id TEXT NOT NULL PRIMARY KEY,
+    tx_hex TEXT NOT NULL,
+    block_hash TEXT NOT NULL,
+    block_height INTEGER NOT NULL,
+    block_time INTEGER
+);
+
+CREATE TABLE tx_input_account (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_name TEXT NOT NULL,
+    fk_tx_id TEXT NOT NULL,
+    FOREIGN KEY (fk_tx_id) REFERENCES "transaction"(tx_id) ON DELETE CASCADE
+);
+
+CREATE TABLE utxo (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tx_id TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    value INTEGER NOT NULL,
+    asset TEXT NOT NULL,
+    value_commitment BLOB,
+    asset_commitment BLOB,
+    value_blinder BLOB NOT NULL,
+    asset_blinder BLOB NOT NULL,
+    script BLOB NOT NULL,
+    nonce BLOB,
+    range_proof BLOB,
+    surjection_proof BLOB,
+    account_name TEXT NOT NULL,
+    lock_timestamp INTEGER NOT NULL,
+    lock_expiry_timestamp INTEGER NOT NULL,
+    UNIQUE (tx_id, vout)
+);
+
+CREATE TABLE utxo_status (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_height INTEGER NOT NULL,
+    block_time INTEGER NOT NULL,
+    block_hash TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    fk_utxo_id INTEGER NOT NULL,
+    tx_id TEXT,
+    FOREIGN KEY (fk_utxo_id) REFERENCES utxo(id) ON DELETE CASCADE
+);
+
+CREATE TABLE external_script (
+    account TEXT NOT NULL PRIMARY KEY,
+    script TEXT NOT NULL,
+    blinding_key BLOB
+);
+
+CREATE TABLE spending_policy (
+    account_name TEXT NOT NULL PRIMARY KEY,
+    max_millisats_per_byte INTEGER NOT NULL
+);
+
+CREATE TABLE policy_asset_limit (
+    asset TEXT NOT NULL,
+    spend_limit INTEGER NOT NULL,
+    window_seconds INTEGER NOT NULL,
+    approval_threshold INTEGER NOT NULL,
+    fk_account_name TEXT NOT NULL,
+    PRIMARY KEY (asset, fk_account_name),
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE policy_allowed_script (
+    script TEXT NOT NULL,
+    fk_account_name TEXT NOT NULL,
+    PRIMARY KEY (script, fk_account_name),
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE policy_spend (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    asset TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    timestamp INTEGER NOT NULL,
+    fk_account_name TEXT NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE spend_approval (
+    id TEXT NOT NULL PRIMARY KEY,
+    tx TEXT NOT NULL,
+    sighash_type INTEGER NOT NULL,
+    requested_by TEXT NOT NULL,
+    timestamp INTEGER NOT NULL
+);
+
+CREATE TABLE audit_event (
+    sequence INTEGER NOT NULL PRIMARY KEY,
+    timestamp INTEGER NOT NULL,
+    caller TEXT NOT NULL,
+    method TEXT NOT NULL,
+    account_name TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    error_message TEXT NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE TABLE audit_event_amount (
+    asset TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    fk_sequence INTEGER NOT NULL,
+    PRIMARY KEY (asset, fk_sequence),
+    FOREIGN KEY (fk_sequence) REFERENCES audit_event(sequence) ON DELETE CASCADE
+);
+
+CREATE TABLE audit_event_destination (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    destination TEXT NOT NULL,
+    fk_sequence INTEGER NOT NULL,
+    FOREIGN KEY (fk_sequence) REFERENCES audit_event(sequence) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE idempotency_key (
+    key TEXT NOT NULL PRIMARY KEY,
+    method TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    result TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL
+);
@@ -0,0 +1,16 @@
+CREATE TABLE payment (
+    id TEXT NOT NULL PRIMARY KEY,
+    account_name TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    script TEXT NOT NULL,
+    blinding_key TEXT NOT NULL,
+    deadline INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    txid TEXT NOT NULL,
+    output_index INTEGER NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX payment_status_idx ON payment(status);
+CREATE INDEX payment_txid_idx ON payment(txid);
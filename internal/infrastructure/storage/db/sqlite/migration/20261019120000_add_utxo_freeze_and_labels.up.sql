@@ -0,0 +1,9 @@
+ALTER TABLE utxo ADD COLUMN frozen_timestamp INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE utxo ADD COLUMN labels TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE address_label (
+    account_name TEXT NOT NULL,
+    script TEXT NOT NULL,
+    labels TEXT NOT NULL,
+    PRIMARY KEY (account_name, script)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE broadcast (
+    txid TEXT NOT NULL PRIMARY KEY,
+    tx_hex TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    attempts INTEGER NOT NULL,
+    next_check_at INTEGER NOT NULL,
+    conflicting_txid TEXT NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX broadcast_status_idx ON broadcast(status);
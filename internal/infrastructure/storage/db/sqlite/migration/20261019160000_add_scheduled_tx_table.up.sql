@@ -0,0 +1,11 @@
+CREATE TABLE scheduled_tx (
+    txid TEXT NOT NULL PRIMARY KEY,
+    tx_hex TEXT NOT NULL,
+    locktime INTEGER NOT NULL,
+    broadcast_at INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    conflicting_txid TEXT NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX scheduled_tx_status_idx ON scheduled_tx(status);
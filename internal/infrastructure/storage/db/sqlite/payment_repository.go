@@ -0,0 +1,202 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type paymentRepositorySqlite struct {
+	db       *sql.DB
+	querier  *queries.Queries
+	chEvents chan domain.PaymentEvent
+	chLock   *sync.Mutex
+}
+
+func NewPaymentRepositorySqliteImpl(db *sql.DB) domain.PaymentRepository {
+	return newPaymentRepositorySqliteImpl(db)
+}
+
+func newPaymentRepositorySqliteImpl(db *sql.DB) *paymentRepositorySqlite {
+	return &paymentRepositorySqlite{
+		db:       db,
+		querier:  queries.New(db),
+		chEvents: make(chan domain.PaymentEvent),
+		chLock:   &sync.Mutex{},
+	}
+}
+
+func (r *paymentRepositorySqlite) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (bool, error) {
+	if err := r.querier.InsertPayment(ctx, queries.InsertPaymentParams{
+		ID:          payment.ID,
+		AccountName: payment.AccountName,
+		Asset:       payment.Asset,
+		Amount:      int64(payment.Amount),
+		Script:      payment.Script,
+		BlindingKey: payment.BlindingKey,
+		Deadline:    payment.Deadline,
+		Status:      int64(payment.Status),
+		Txid:        payment.Txid,
+		OutputIndex: int64(payment.OutputIndex),
+		CreatedAt:   payment.CreatedAt,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	go r.publishEvent(domain.PaymentEvent{
+		EventType: domain.PaymentQueued,
+		Payments:  []domain.Payment{*payment},
+	})
+
+	return true, nil
+}
+
+func (r *paymentRepositorySqlite) GetPayment(
+	ctx context.Context, id string,
+) (*domain.Payment, error) {
+	p, err := r.querier.GetPayment(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	payment := toPayment(p)
+	return &payment, nil
+}
+
+func (r *paymentRepositorySqlite) GetQueuedPayments(
+	ctx context.Context, accountName string,
+) ([]domain.Payment, error) {
+	rows, err := r.querier.GetPaymentsByStatus(
+		ctx, int64(domain.PaymentStatusQueued),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]domain.Payment, 0, len(rows))
+	for _, p := range rows {
+		if accountName != "" && p.AccountName != accountName {
+			continue
+		}
+		payments = append(payments, toPayment(p))
+	}
+	return payments, nil
+}
+
+func (r *paymentRepositorySqlite) GetPaymentsByTxid(
+	ctx context.Context, txid string,
+) ([]domain.Payment, error) {
+	rows, err := r.querier.GetPaymentsByTxid(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]domain.Payment, 0, len(rows))
+	for _, p := range rows {
+		payments = append(payments, toPayment(p))
+	}
+	return payments, nil
+}
+
+func (r *paymentRepositorySqlite) UpdatePayments(
+	ctx context.Context, payments []domain.Payment,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	for _, payment := range payments {
+		count, err := querierWithTx.UpdatePayment(
+			ctx, queries.UpdatePaymentParams{
+				Status:      int64(payment.Status),
+				Txid:        payment.Txid,
+				OutputIndex: int64(payment.OutputIndex),
+				ID:          payment.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if count <= 0 {
+			return domain.ErrPaymentNotFound
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	paymentsByStatus := make(map[domain.PaymentStatus][]domain.Payment)
+	for _, payment := range payments {
+		p, err := r.GetPayment(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		paymentsByStatus[p.Status] = append(paymentsByStatus[p.Status], *p)
+	}
+	for status, payments := range paymentsByStatus {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentEventTypeFromStatus(status),
+			Payments:  payments,
+		})
+	}
+	return nil
+}
+
+func (r *paymentRepositorySqlite) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepositorySqlite) publishEvent(event domain.PaymentEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case r.chEvents <- event:
+	default:
+	}
+}
+
+func (r *paymentRepositorySqlite) close() {
+	close(r.chEvents)
+}
+
+func (r *paymentRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetPayments(ctx)
+}
+
+func toPayment(p queries.Payment) domain.Payment {
+	return domain.Payment{
+		ID:          p.ID,
+		AccountName: p.AccountName,
+		Asset:       p.Asset,
+		Amount:      uint64(p.Amount),
+		Script:      p.Script,
+		BlindingKey: p.BlindingKey,
+		Deadline:    p.Deadline,
+		Status:      domain.PaymentStatus(p.Status),
+		Txid:        p.Txid,
+		OutputIndex: uint32(p.OutputIndex),
+		CreatedAt:   p.CreatedAt,
+	}
+}
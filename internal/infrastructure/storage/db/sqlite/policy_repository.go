@@ -0,0 +1,287 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type policyRepositorySqlite struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewSpendingPolicyRepositorySqliteImpl(
+	db *sql.DB,
+) domain.SpendingPolicyRepository {
+	return newSpendingPolicyRepositorySqliteImpl(db)
+}
+
+func newSpendingPolicyRepositorySqliteImpl(db *sql.DB) *policyRepositorySqlite {
+	return &policyRepositorySqlite{
+		db:      db,
+		querier: queries.New(db),
+	}
+}
+
+func (r *policyRepositorySqlite) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) error {
+	return r.upsertPolicy(ctx, *policy)
+}
+
+func (r *policyRepositorySqlite) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	return r.getPolicy(ctx, accountName)
+}
+
+func (r *policyRepositorySqlite) UpdatePolicy(
+	ctx context.Context, accountName string,
+	updateFn func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error),
+) error {
+	policy, err := r.getPolicy(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
+	updatedPolicy, err := updateFn(policy)
+	if err != nil {
+		return err
+	}
+
+	return r.upsertPolicy(ctx, *updatedPolicy)
+}
+
+func (r *policyRepositorySqlite) DeletePolicy(
+	ctx context.Context, accountName string,
+) (bool, error) {
+	if _, err := r.querier.GetSpendingPolicy(ctx, accountName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteSpendingPolicy(ctx, accountName); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositorySqlite) AddApproval(
+	ctx context.Context, approval *domain.SpendApproval,
+) (bool, error) {
+	if err := r.querier.InsertSpendApproval(ctx, queries.InsertSpendApprovalParams{
+		ID:          approval.ID,
+		Tx:          approval.Tx,
+		SighashType: int64(approval.SighashType),
+		RequestedBy: approval.RequestedBy,
+		Timestamp:   approval.Timestamp,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *policyRepositorySqlite) GetApproval(
+	ctx context.Context, id string,
+) (*domain.SpendApproval, error) {
+	approval, err := r.querier.GetSpendApproval(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSpendApprovalNotFound
+		}
+		return nil, err
+	}
+
+	return toSpendApproval(approval), nil
+}
+
+func (r *policyRepositorySqlite) GetAllApprovals(
+	ctx context.Context,
+) ([]domain.SpendApproval, error) {
+	rows, err := r.querier.GetAllSpendApprovals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]domain.SpendApproval, 0, len(rows))
+	for _, row := range rows {
+		approvals = append(approvals, *toSpendApproval(row))
+	}
+	return approvals, nil
+}
+
+func (r *policyRepositorySqlite) DeleteApproval(
+	ctx context.Context, id string,
+) (bool, error) {
+	if _, err := r.querier.GetSpendApproval(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteSpendApproval(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositorySqlite) upsertPolicy(
+	ctx context.Context, policy domain.SpendingPolicy,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	if err := querierWithTx.UpsertSpendingPolicy(
+		ctx, queries.UpsertSpendingPolicyParams{
+			AccountName:         policy.AccountName,
+			MaxMillisatsPerByte: int64(policy.MaxMillisatsPerByte),
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := querierWithTx.DeletePolicyAssetLimits(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for _, l := range policy.AssetLimits {
+		if err := querierWithTx.InsertPolicyAssetLimit(
+			ctx, queries.InsertPolicyAssetLimitParams{
+				Asset:             l.Asset,
+				SpendLimit:        int64(l.SpendLimit),
+				WindowSeconds:     l.WindowSeconds,
+				ApprovalThreshold: int64(l.ApprovalThreshold),
+				FkAccountName:     policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := querierWithTx.DeletePolicyAllowedScripts(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for script := range policy.AllowedScripts {
+		if err := querierWithTx.InsertPolicyAllowedScript(
+			ctx, queries.InsertPolicyAllowedScriptParams{
+				Script:        script,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := querierWithTx.DeletePolicySpends(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	for _, s := range policy.Spends {
+		if err := querierWithTx.InsertPolicySpend(
+			ctx, queries.InsertPolicySpendParams{
+				Asset:         s.Asset,
+				Amount:        int64(s.Amount),
+				Timestamp:     s.Timestamp,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *policyRepositorySqlite) getPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	policy, err := r.querier.GetSpendingPolicy(ctx, accountName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPolicyNotFound
+		}
+		return nil, err
+	}
+
+	limits, err := r.querier.GetPolicyAssetLimits(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	scripts, err := r.querier.GetPolicyAllowedScripts(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	spends, err := r.querier.GetPolicySpends(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	assetLimits := make(map[string]domain.AssetLimit)
+	for _, l := range limits {
+		assetLimits[l.Asset] = domain.AssetLimit{
+			Asset:             l.Asset,
+			SpendLimit:        uint64(l.SpendLimit),
+			WindowSeconds:     l.WindowSeconds,
+			ApprovalThreshold: uint64(l.ApprovalThreshold),
+		}
+	}
+	allowedScripts := make(map[string]struct{})
+	for _, s := range scripts {
+		allowedScripts[s.Script] = struct{}{}
+	}
+	policySpends := make([]domain.Spend, 0, len(spends))
+	for _, s := range spends {
+		policySpends = append(policySpends, domain.Spend{
+			Asset:     s.Asset,
+			Amount:    uint64(s.Amount),
+			Timestamp: s.Timestamp,
+		})
+	}
+
+	return &domain.SpendingPolicy{
+		AccountName:         policy.AccountName,
+		AssetLimits:         assetLimits,
+		AllowedScripts:      allowedScripts,
+		MaxMillisatsPerByte: uint64(policy.MaxMillisatsPerByte),
+		Spends:              policySpends,
+	}, nil
+}
+
+func (r *policyRepositorySqlite) close() {}
+
+func (r *policyRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetSpendingPolicies(ctx)
+	querier.ResetSpendApprovals(ctx)
+}
+
+func toSpendApproval(approval queries.SpendApproval) *domain.SpendApproval {
+	return &domain.SpendApproval{
+		ID:          approval.ID,
+		Tx:          approval.Tx,
+		SighashType: uint32(approval.SighashType),
+		RequestedBy: approval.RequestedBy,
+		Timestamp:   approval.Timestamp,
+	}
+}
@@ -0,0 +1,362 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	sqlite "github.com/mattn/go-sqlite3"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+const (
+	sqliteDriver = "sqlite3"
+	dbFile       = "ocean.db"
+	// foreign keys are disabled by default in sqlite, they must be enabled
+	// for every connection to have cascade deletions.
+	dataSourceTemplate       = "file:%s?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL"
+	inMemoryDataSourceString = "file::memory:?_foreign_keys=on"
+)
+
+// migrations are embedded into the binary so that no extra files are required
+// to run the daemon with this kind of database.
+//
+//go:embed migration/*.sql
+var migrations embed.FS
+
+type repoManager struct {
+	db *sql.DB
+
+	utxoRepository   *utxoRepositorySqlite
+	walletRepository *walletRepositorySqlite
+	txRepository     *txRepositorySqlite
+	scriptRepository *scriptRepositorySqlite
+	policyRepository *policyRepositorySqlite
+	auditRepository  *auditRepositorySqlite
+	idempotencyRepo  *idempotencyRepositorySqlite
+	paymentRepo      *paymentRepositorySqlite
+	addressLabelRepo *addressLabelRepositorySqlite
+	broadcastRepo    *broadcastRepositorySqlite
+	scheduledTxRepo  *scheduledTxRepositorySqlite
+
+	walletEventHandlers *handlerMap
+	utxoEventHandlers   *handlerMap
+	txEventHandlers     *handlerMap
+	scriptEventHandlers *handlerMap
+}
+
+// NewRepoManager is the factory for creating a new sqlite implementation
+// of the ports.RepoManager interface.
+// It takes care of creating the db file on disk (or in-memory if no baseDbDir
+// is provided - to be used only for testing purposes), and of keeping the
+// schema up to date.
+func NewRepoManager(baseDbDir string) (ports.RepoManager, error) {
+	dataSource := inMemoryDataSourceString
+	if len(baseDbDir) > 0 {
+		dataSource = fmt.Sprintf(
+			dataSourceTemplate, filepath.Join(baseDbDir, dbFile),
+		)
+	}
+
+	db, err := connect(dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = migrateDb(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	utxoRepository := newUtxoRepositorySqliteImpl(db)
+	walletRepository := newWalletRepositorySqliteImpl(db)
+	txRepository := newTxRepositorySqliteImpl(db)
+	scriptRepository := newExternalScriptRepositorySqliteImpl(db)
+	policyRepository := newSpendingPolicyRepositorySqliteImpl(db)
+	auditRepository := newAuditEventRepositorySqliteImpl(db)
+	idempotencyRepo := newIdempotencyKeyRepositorySqliteImpl(db)
+	paymentRepo := newPaymentRepositorySqliteImpl(db)
+	addressLabelRepo := newAddressLabelRepositorySqliteImpl(db)
+	broadcastRepo := newBroadcastRepositorySqliteImpl(db)
+	scheduledTxRepo := newScheduledTxRepositorySqliteImpl(db)
+
+	rm := &repoManager{
+		db:                  db,
+		utxoRepository:      utxoRepository,
+		walletRepository:    walletRepository,
+		txRepository:        txRepository,
+		scriptRepository:    scriptRepository,
+		policyRepository:    policyRepository,
+		auditRepository:     auditRepository,
+		idempotencyRepo:     idempotencyRepo,
+		paymentRepo:         paymentRepo,
+		addressLabelRepo:    addressLabelRepo,
+		broadcastRepo:       broadcastRepo,
+		scheduledTxRepo:     scheduledTxRepo,
+		walletEventHandlers: newHandlerMap(),
+		utxoEventHandlers:   newHandlerMap(),
+		txEventHandlers:     newHandlerMap(),
+		scriptEventHandlers: newHandlerMap(),
+	}
+
+	go rm.listenToWalletEvents()
+	go rm.listenToUtxoEvents()
+	go rm.listenToTxEvents()
+	go rm.listenToScriptEvents()
+
+	return rm, nil
+}
+
+func (rm *repoManager) UtxoRepository() domain.UtxoRepository {
+	return rm.utxoRepository
+}
+
+func (rm *repoManager) WalletRepository() domain.WalletRepository {
+	return rm.walletRepository
+}
+
+func (rm *repoManager) TransactionRepository() domain.TransactionRepository {
+	return rm.txRepository
+}
+
+func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepository {
+	return rm.scriptRepository
+}
+
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
+func (rm *repoManager) AuditEventRepository() domain.AuditEventRepository {
+	return rm.auditRepository
+}
+
+func (rm *repoManager) IdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return rm.idempotencyRepo
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepo
+}
+
+func (rm *repoManager) AddressLabelRepository() domain.AddressLabelRepository {
+	return rm.addressLabelRepo
+}
+
+func (rm *repoManager) BroadcastRepository() domain.BroadcastRepository {
+	return rm.broadcastRepo
+}
+
+func (rm *repoManager) ScheduledTxRepository() domain.ScheduledTxRepository {
+	return rm.scheduledTxRepo
+}
+
+func (rm *repoManager) RegisterHandlerForWalletEvent(
+	eventType domain.WalletEventType, handler ports.WalletEventHandler,
+) {
+	rm.walletEventHandlers.set(int(eventType), handler)
+}
+
+func (rm *repoManager) RegisterHandlerForUtxoEvent(
+	eventType domain.UtxoEventType, handler ports.UtxoEventHandler,
+) {
+	rm.utxoEventHandlers.set(int(eventType), handler)
+}
+
+func (rm *repoManager) RegisterHandlerForTxEvent(
+	eventType domain.TransactionEventType, handler ports.TxEventHandler,
+) {
+	rm.txEventHandlers.set(int(eventType), handler)
+}
+
+func (rm *repoManager) RegisterHandlerForExternalScriptEvent(
+	eventType domain.ExternalScriptEventType, handler ports.ScriptEventHandler,
+) {
+	rm.scriptEventHandlers.set(int(eventType), handler)
+}
+
+func (rm *repoManager) listenToWalletEvents() {
+	for event := range rm.walletRepository.chEvents {
+		time.Sleep(time.Millisecond)
+
+		if handlers, ok := rm.walletEventHandlers.get(int(event.EventType)); ok {
+			for i := range handlers {
+				handler := handlers[i]
+				go handler.(ports.WalletEventHandler)(event)
+			}
+		}
+	}
+}
+
+func (rm *repoManager) listenToUtxoEvents() {
+	for event := range rm.utxoRepository.chEvents {
+		time.Sleep(time.Millisecond)
+
+		if handlers, ok := rm.utxoEventHandlers.get(int(event.EventType)); ok {
+			for i := range handlers {
+				handler := handlers[i]
+				go handler.(ports.UtxoEventHandler)(event)
+			}
+		}
+	}
+}
+
+func (rm *repoManager) listenToTxEvents() {
+	for event := range rm.txRepository.chEvents {
+		time.Sleep(time.Millisecond)
+
+		if handlers, ok := rm.txEventHandlers.get(int(event.EventType)); ok {
+			for i := range handlers {
+				handler := handlers[i]
+				go handler.(ports.TxEventHandler)(event)
+			}
+		}
+	}
+}
+
+func (rm *repoManager) listenToScriptEvents() {
+	for event := range rm.scriptRepository.chEvents {
+		time.Sleep(time.Millisecond)
+
+		if handlers, ok := rm.scriptEventHandlers.get(int(event.EventType)); ok {
+			for i := range handlers {
+				handler := handlers[i]
+				go handler.(ports.ScriptEventHandler)(event)
+			}
+		}
+	}
+}
+
+func (rm *repoManager) Reset() {
+	ctx := context.Background()
+	tx, err := rm.db.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer tx.Rollback()
+
+	querier := new(queries.Queries)
+	querier = querier.WithTx(tx)
+	rm.walletRepository.reset(querier, ctx)
+	rm.utxoRepository.reset(querier, ctx)
+	rm.txRepository.reset(querier, ctx)
+	rm.scriptRepository.reset(querier, ctx)
+	rm.policyRepository.reset(querier, ctx)
+	rm.auditRepository.reset(querier, ctx)
+	rm.idempotencyRepo.reset(querier, ctx)
+	rm.paymentRepo.reset(querier, ctx)
+	rm.addressLabelRepo.reset(querier, ctx)
+	rm.broadcastRepo.reset(querier, ctx)
+	rm.scheduledTxRepo.reset(querier, ctx)
+
+	tx.Commit()
+}
+
+func (rm *repoManager) Ping(ctx context.Context) error {
+	return rm.db.PingContext(ctx)
+}
+
+func (rm *repoManager) Close() {
+	rm.utxoRepository.close()
+	rm.txRepository.close()
+	rm.walletRepository.close()
+	rm.scriptRepository.close()
+	rm.policyRepository.close()
+	rm.auditRepository.close()
+	rm.idempotencyRepo.close()
+	rm.paymentRepo.close()
+	rm.addressLabelRepo.close()
+	rm.broadcastRepo.close()
+	rm.scheduledTxRepo.close()
+
+	rm.db.Close()
+}
+
+// handlerMap is a util type to prevent race conditions when registering
+// or retrieving handlers for events.
+type handlerMap struct {
+	handlersByEventType map[int][]interface{}
+	lock                *sync.RWMutex
+}
+
+func newHandlerMap() *handlerMap {
+	return &handlerMap{
+		handlersByEventType: make(map[int][]interface{}),
+		lock:                &sync.RWMutex{},
+	}
+}
+
+func (m *handlerMap) set(key int, val interface{}) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.handlersByEventType[key] = append(m.handlersByEventType[key], val)
+}
+
+func (m *handlerMap) get(key int) ([]interface{}, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, ok := m.handlersByEventType[key]
+	return val, ok
+}
+
+func connect(dataSource string) (*sql.DB, error) {
+	db, err := sql.Open(sqliteDriver, dataSource)
+	if err != nil {
+		return nil, err
+	}
+	// sqlite supports only one writer at a time, therefore a single connection
+	// is shared to serialize the accesses to the db and prevent "database is
+	// locked" errors. This also makes the in-memory db persist across queries.
+	db.SetMaxOpenConns(1)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func migrateDb(db *sql.DB) error {
+	source, err := iofs.New(migrations, "migration")
+	if err != nil {
+		return err
+	}
+
+	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, sqliteDriver, driver)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return err
+	}
+
+	return nil
+}
+
+// isUniqueViolation returns whether the given error is due to the violation
+// of a unique or primary key constraint.
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.ExtendedCode == sqlite.ErrConstraintUnique ||
+		sqliteErr.ExtendedCode == sqlite.ErrConstraintPrimaryKey
+}
@@ -0,0 +1,131 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type scheduledTxRepositorySqlite struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewScheduledTxRepositorySqliteImpl(db *sql.DB) domain.ScheduledTxRepository {
+	return newScheduledTxRepositorySqliteImpl(db)
+}
+
+func newScheduledTxRepositorySqliteImpl(db *sql.DB) *scheduledTxRepositorySqlite {
+	return &scheduledTxRepositorySqlite{
+		db:      db,
+		querier: queries.New(db),
+	}
+}
+
+func (r *scheduledTxRepositorySqlite) AddScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) (bool, error) {
+	if err := r.querier.InsertScheduledTx(ctx, queries.InsertScheduledTxParams{
+		Txid:            tx.Txid,
+		TxHex:           tx.TxHex,
+		Locktime:        int64(tx.Locktime),
+		BroadcastAt:     tx.BroadcastAt,
+		Status:          int64(tx.Status),
+		ConflictingTxid: tx.ConflictingTxid,
+		CreatedAt:       tx.CreatedAt,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r *scheduledTxRepositorySqlite) GetScheduledTx(
+	ctx context.Context, txid string,
+) (*domain.ScheduledTx, error) {
+	row, err := r.querier.GetScheduledTx(ctx, txid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrScheduledTxNotFound
+		}
+		return nil, err
+	}
+
+	tx := toScheduledTx(row)
+	return &tx, nil
+}
+
+func (r *scheduledTxRepositorySqlite) GetAllScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	rows, err := r.querier.GetAllScheduledTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledTxs(rows), nil
+}
+
+func (r *scheduledTxRepositorySqlite) GetPendingScheduledTxs(
+	ctx context.Context,
+) ([]domain.ScheduledTx, error) {
+	rows, err := r.querier.GetScheduledTxsByStatus(
+		ctx, int64(domain.ScheduledTxStatusScheduled),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledTxs(rows), nil
+}
+
+func (r *scheduledTxRepositorySqlite) UpdateScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) error {
+	count, err := r.querier.UpdateScheduledTx(
+		ctx, queries.UpdateScheduledTxParams{
+			Status:          int64(tx.Status),
+			ConflictingTxid: tx.ConflictingTxid,
+			Txid:            tx.Txid,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return domain.ErrScheduledTxNotFound
+	}
+	return nil
+}
+
+func (r *scheduledTxRepositorySqlite) close() {}
+
+func (r *scheduledTxRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetScheduledTxs(ctx)
+}
+
+func toScheduledTxs(rows []queries.ScheduledTx) []domain.ScheduledTx {
+	txs := make([]domain.ScheduledTx, 0, len(rows))
+	for _, row := range rows {
+		txs = append(txs, toScheduledTx(row))
+	}
+	return txs
+}
+
+func toScheduledTx(row queries.ScheduledTx) domain.ScheduledTx {
+	return domain.ScheduledTx{
+		Txid:            row.Txid,
+		TxHex:           row.TxHex,
+		Locktime:        uint32(row.Locktime),
+		BroadcastAt:     row.BroadcastAt,
+		Status:          domain.ScheduledTxStatus(row.Status),
+		ConflictingTxid: row.ConflictingTxid,
+		CreatedAt:       row.CreatedAt,
+	}
+}
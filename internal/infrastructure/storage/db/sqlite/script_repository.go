@@ -0,0 +1,118 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+type scriptRepositorySqlite struct {
+	db       *sql.DB
+	querier  *queries.Queries
+	chLock   *sync.Mutex
+	chEvents chan domain.ExternalScriptEvent
+}
+
+func NewExternalScriptRepositorySqliteImpl(db *sql.DB) domain.ExternalScriptRepository {
+	return newExternalScriptRepositorySqliteImpl(db)
+}
+
+func newExternalScriptRepositorySqliteImpl(db *sql.DB) *scriptRepositorySqlite {
+	return &scriptRepositorySqlite{
+		db:       db,
+		querier:  queries.New(db),
+		chLock:   &sync.Mutex{},
+		chEvents: make(chan domain.ExternalScriptEvent),
+	}
+}
+
+func (r *scriptRepositorySqlite) AddScript(
+	ctx context.Context, info domain.AddressInfo,
+) (bool, error) {
+	if err := r.querier.InsertScript(ctx, queries.InsertScriptParams{
+		Account:     info.Account,
+		Script:      info.Script,
+		BlindingKey: info.BlindingKey,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	go r.publishEvent(domain.ExternalScriptEvent{
+		EventType: domain.ExternalScriptAdded,
+		Info:      info,
+	})
+
+	return true, nil
+}
+
+func (r *scriptRepositorySqlite) GetAllScripts(
+	ctx context.Context,
+) ([]domain.AddressInfo, error) {
+	return r.getScripts(ctx)
+}
+
+func (r *scriptRepositorySqlite) DeleteScript(
+	ctx context.Context, scriptHash string,
+) (bool, error) {
+	_, err := r.querier.GetScript(ctx, scriptHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteScript(ctx, scriptHash); err != nil {
+		return false, err
+	}
+
+	go r.publishEvent(domain.ExternalScriptEvent{
+		EventType: domain.ExternalScriptDeleted,
+		Info:      domain.AddressInfo{Account: scriptHash},
+	})
+
+	return true, nil
+}
+
+func (r *scriptRepositorySqlite) publishEvent(event domain.ExternalScriptEvent) {
+	r.chLock.Lock()
+	defer r.chLock.Unlock()
+
+	r.chEvents <- event
+}
+
+func (r *scriptRepositorySqlite) close() {}
+
+func (r *scriptRepositorySqlite) getScripts(
+	ctx context.Context,
+) ([]domain.AddressInfo, error) {
+	rows, err := r.querier.GetAllScripts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts := make([]domain.AddressInfo, 0, len(rows))
+	for _, r := range rows {
+		scripts = append(scripts, domain.AddressInfo{
+			Account:     r.Account,
+			Script:      r.Script,
+			BlindingKey: r.BlindingKey,
+		})
+	}
+
+	return scripts, nil
+}
+
+func (r *scriptRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetScripts(ctx)
+}
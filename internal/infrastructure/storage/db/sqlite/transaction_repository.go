@@ -0,0 +1,256 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+var (
+	ErrTxNotFound = errors.New("transaction not found")
+)
+
+type txRepositorySqlite struct {
+	db               *sql.DB
+	querier          *queries.Queries
+	chLock           *sync.Mutex
+	chEvents         chan domain.TransactionEvent
+	externalChEvents chan domain.TransactionEvent
+}
+
+func NewTxRepositorySqliteImpl(
+	db *sql.DB,
+) domain.TransactionRepository {
+	return newTxRepositorySqliteImpl(db)
+}
+
+func newTxRepositorySqliteImpl(db *sql.DB) *txRepositorySqlite {
+	return &txRepositorySqlite{
+		db:               db,
+		querier:          queries.New(db),
+		chLock:           &sync.Mutex{},
+		chEvents:         make(chan domain.TransactionEvent),
+		externalChEvents: make(chan domain.TransactionEvent),
+	}
+}
+
+func (t *txRepositorySqlite) AddTransaction(
+	ctx context.Context, trx *domain.Transaction,
+) (bool, error) {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := t.querier.WithTx(tx)
+
+	txPg, err := querierWithTx.InsertTransaction(
+		ctx, queries.InsertTransactionParams{
+			TxID:        trx.TxID,
+			TxHex:       trx.TxHex,
+			BlockHash:   trx.BlockHash,
+			BlockHeight: int64(trx.BlockHeight),
+			BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+			EvictedBy:   trx.EvictedBy,
+		},
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	for k := range trx.Accounts {
+		if _, err := querierWithTx.InsertTransactionInputAccount(
+			ctx, queries.InsertTransactionInputAccountParams{
+				AccountName: k,
+				FkTxID:      txPg.TxID,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionAdded,
+		Transaction: trx,
+	})
+
+	return true, nil
+}
+
+func (t *txRepositorySqlite) ConfirmTransaction(
+	ctx context.Context,
+	txid, blockhash string, blockheight uint64, blocktime int64,
+) (bool, error) {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if tx.IsConfirmed() {
+		return false, nil
+	}
+
+	tx.Confirm(blockhash, blockheight, blocktime)
+
+	if err := t.updateTx(ctx, t.querier, *tx); err != nil {
+		return false, err
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionConfirmed,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
+func (t *txRepositorySqlite) EvictTransaction(
+	ctx context.Context, txid, replacementTxid string,
+) (bool, error) {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if tx.IsConfirmed() || tx.IsEvicted() {
+		return false, nil
+	}
+
+	tx.Evict(replacementTxid)
+
+	if err := t.updateTx(ctx, t.querier, *tx); err != nil {
+		return false, err
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionEvicted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
+func (t *txRepositorySqlite) GetTransaction(
+	ctx context.Context, txid string,
+) (*domain.Transaction, error) {
+	return t.getTx(ctx, txid)
+}
+
+func (t *txRepositorySqlite) UpdateTransaction(
+	ctx context.Context, txid string,
+	updateFn func(tx *domain.Transaction) (*domain.Transaction, error),
+) error {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		return err
+	}
+
+	updatedTx, err := updateFn(tx)
+	if err != nil {
+		return err
+	}
+
+	return t.updateTx(ctx, t.querier, *updatedTx)
+}
+
+func (t *txRepositorySqlite) GetEventChannel() chan domain.TransactionEvent {
+	return t.externalChEvents
+}
+
+func (t *txRepositorySqlite) publishEvent(event domain.TransactionEvent) {
+	t.chLock.Lock()
+	defer t.chLock.Unlock()
+
+	t.chEvents <- event
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case t.externalChEvents <- event:
+	default:
+	}
+}
+
+func (t *txRepositorySqlite) close() {
+	close(t.chEvents)
+	close(t.externalChEvents)
+}
+
+func (t *txRepositorySqlite) updateTx(
+	ctx context.Context, querier *queries.Queries, trx domain.Transaction,
+) error {
+	if _, err := querier.UpdateTransaction(ctx, queries.UpdateTransactionParams{
+		TxHex:       trx.TxHex,
+		BlockHash:   trx.BlockHash,
+		BlockHeight: int64(trx.BlockHeight),
+		BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+		EvictedBy:   trx.EvictedBy,
+		TxID:        trx.TxID,
+	}); err != nil {
+		return err
+	}
+
+	if err := querier.DeleteTransactionInputAccounts(ctx, trx.TxID); err != nil {
+		return err
+	}
+
+	for k := range trx.Accounts {
+		if _, err := querier.InsertTransactionInputAccount(
+			ctx, queries.InsertTransactionInputAccountParams{
+				AccountName: k,
+				FkTxID:      trx.TxID,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *txRepositorySqlite) getTx(
+	ctx context.Context, txid string,
+) (*domain.Transaction, error) {
+	tx, err := t.querier.GetTransaction(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tx) == 0 {
+		return nil, ErrTxNotFound
+	}
+
+	accounts := make(map[string]struct{})
+	for _, v := range tx {
+		if v.AccountName.Valid {
+			accounts[v.AccountName.String] = struct{}{}
+		}
+	}
+
+	return &domain.Transaction{
+		TxID:        tx[0].TxID,
+		TxHex:       tx[0].TxHex,
+		BlockHash:   tx[0].BlockHash,
+		BlockHeight: uint64(tx[0].BlockHeight),
+		BlockTime:   tx[0].BlockTime.Int64,
+		Accounts:    accounts,
+		EvictedBy:   tx[0].EvictedBy,
+	}, nil
+}
+
+func (t *txRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetTransactions(ctx)
+}
@@ -0,0 +1,496 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite/sqlc/queries"
+)
+
+const (
+	//since there can be only 1 wallet in database,
+	//key is hardcoded for easier retrival
+	walletKey = "wallet"
+)
+
+var (
+	ErrorWalletNotFound     = errors.New("wallet not found")
+	ErrWalletAlreadyCreated = errors.New("wallet already created")
+	ErrAccountNotFound      = errors.New("account not found")
+)
+
+type walletRepositorySqlite struct {
+	db               *sql.DB
+	querier          *queries.Queries
+	chLock           *sync.Mutex
+	chEvents         chan domain.WalletEvent
+	externalChEvents chan domain.WalletEvent
+}
+
+func NewWalletRepositorySqliteImpl(db *sql.DB) domain.WalletRepository {
+	return newWalletRepositorySqliteImpl(db)
+}
+
+func newWalletRepositorySqliteImpl(db *sql.DB) *walletRepositorySqlite {
+	return &walletRepositorySqlite{
+		db:               db,
+		querier:          queries.New(db),
+		chLock:           &sync.Mutex{},
+		chEvents:         make(chan domain.WalletEvent),
+		externalChEvents: make(chan domain.WalletEvent),
+	}
+}
+
+func (w *walletRepositorySqlite) CreateWallet(
+	ctx context.Context, wallet *domain.Wallet,
+) error {
+	if err := w.createWallet(ctx, wallet); err != nil {
+		return err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType: domain.WalletCreated,
+	})
+
+	return nil
+}
+
+func (w *walletRepositorySqlite) GetWallet(
+	ctx context.Context,
+) (*domain.Wallet, error) {
+	return w.getWallet(ctx)
+}
+
+func (w *walletRepositorySqlite) UnlockWallet(
+	ctx context.Context,
+	password string,
+) error {
+	wallet, err := w.getWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.Unlock(password); err != nil {
+		return err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType: domain.WalletUnlocked,
+	})
+
+	return nil
+}
+
+func (w *walletRepositorySqlite) LockWallet(
+	ctx context.Context,
+	password string,
+) error {
+	wallet, err := w.getWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.Lock(password); err != nil {
+		return err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType: domain.WalletLocked,
+	})
+
+	return nil
+}
+
+// UpdateWallet updates 3 tables in database: wallet, account, account_script_info
+func (w *walletRepositorySqlite) UpdateWallet(
+	ctx context.Context,
+	updateFn func(v *domain.Wallet) (*domain.Wallet, error),
+) error {
+	wallet, err := w.getWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	updatedWallet, err := updateFn(wallet)
+	if err != nil {
+		return err
+	}
+
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := w.querier.WithTx(tx)
+
+	//update wallet table
+	if _, err := querierWithTx.UpdateWallet(
+		ctx,
+		queries.UpdateWalletParams{
+			ID:                  walletKey,
+			EncryptedMnemonic:   updatedWallet.EncryptedMnemonic,
+			PasswordHash:        updatedWallet.PasswordHash,
+			BirthdayBlockHeight: int64(updatedWallet.BirthdayBlockHeight),
+			RootPath:            updatedWallet.RootPath,
+			NetworkName:         updatedWallet.NetworkName,
+			NextAccountIndex:    int64(updatedWallet.NextAccountIndex),
+		},
+	); err != nil {
+		return err
+	}
+
+	// loop over accounts and update account table if it is existing
+	// or insert new account if it is not existing
+	// insert account scripts as well
+	for _, account := range updatedWallet.Accounts {
+		newAccount := false
+		_, err := querierWithTx.GetAccount(ctx, account.Namespace)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				newAccount = true
+			} else {
+				return err
+			}
+		}
+
+		if newAccount {
+			if _, err := querierWithTx.InsertAccount(ctx, queries.InsertAccountParams{
+				Namespace: account.Namespace,
+				Label: sql.NullString{
+					String: account.Label,
+					Valid:  true,
+				},
+				Index:             int64(account.Index),
+				Xpub:              account.Xpub,
+				DerivationPath:    account.DerivationPath,
+				NextExternalIndex: int64(account.NextExternalIndex),
+				NextInternalIndex: int64(account.NextInternalIndex),
+				FkWalletID:        walletKey,
+			}); err != nil {
+				return err
+			}
+		} else {
+			if _, err := querierWithTx.UpdateAccount(
+				ctx,
+				queries.UpdateAccountParams{
+					NextExternalIndex: int64(account.NextExternalIndex),
+					NextInternalIndex: int64(account.NextInternalIndex),
+					Label: sql.NullString{
+						String: account.Label,
+						Valid:  true,
+					},
+					Namespace: account.Namespace,
+				},
+			); err != nil {
+				return err
+			}
+		}
+
+		if len(account.DerivationPathByScript) > 0 {
+			if err := querierWithTx.DeleteAccountScripts(ctx, account.Namespace); err != nil {
+				return err
+			}
+
+			for k, v := range account.DerivationPathByScript {
+				if err := querierWithTx.InsertAccountScript(
+					ctx,
+					queries.InsertAccountScriptParams{
+						Script:         k,
+						DerivationPath: v,
+						FkAccountName:  account.Namespace,
+					},
+				); err != nil {
+					if isUniqueViolation(err) {
+						continue
+					} else {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (w *walletRepositorySqlite) CreateAccount(
+	ctx context.Context, accountName string, birthdayBlock uint32, unconf bool,
+) (*domain.AccountInfo, error) {
+	var accountInfo *domain.AccountInfo
+	if err := w.UpdateWallet(
+		ctx, func(wallet *domain.Wallet) (*domain.Wallet, error) {
+			account, err := wallet.CreateAccount(accountName, birthdayBlock, unconf)
+			if err != nil {
+				return nil, err
+			}
+			if account == nil {
+				return nil, fmt.Errorf("account %s already existing", accountName)
+			}
+			accountInfo = &account.AccountInfo
+			return wallet, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType:   domain.WalletAccountCreated,
+		AccountName: accountInfo.Namespace,
+	})
+
+	return accountInfo, nil
+}
+
+func (w *walletRepositorySqlite) DeriveNextExternalAddressesForAccount(
+	ctx context.Context,
+	accountName string,
+	numOfAddresses uint64,
+) ([]domain.AddressInfo, error) {
+	addressesInfo := make([]domain.AddressInfo, 0)
+
+	if err := w.UpdateWallet(
+		ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+			for i := 0; i < int(numOfAddresses); i++ {
+				addrInfo, err := w.DeriveNextExternalAddressForAccount(accountName)
+				if err != nil {
+					return nil, err
+				}
+				addressesInfo = append(addressesInfo, *addrInfo)
+			}
+			return w, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType:        domain.WalletAccountAddressesDerived,
+		AccountName:      addressesInfo[0].Account,
+		AccountAddresses: addressesInfo,
+	})
+
+	return addressesInfo, nil
+}
+
+func (w *walletRepositorySqlite) DeriveNextInternalAddressesForAccount(
+	ctx context.Context,
+	accountName string,
+	numOfAddresses uint64,
+) ([]domain.AddressInfo, error) {
+	addressesInfo := make([]domain.AddressInfo, 0)
+
+	if err := w.UpdateWallet(
+		ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+			for i := 0; i < int(numOfAddresses); i++ {
+				addrInfo, err := w.DeriveNextInternalAddressForAccount(accountName)
+				if err != nil {
+					return nil, err
+				}
+				addressesInfo = append(addressesInfo, *addrInfo)
+			}
+			return w, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType:        domain.WalletAccountAddressesDerived,
+		AccountName:      addressesInfo[0].Account,
+		AccountAddresses: addressesInfo,
+	})
+
+	return addressesInfo, nil
+}
+
+func (w *walletRepositorySqlite) DeleteAccount(
+	ctx context.Context,
+	accountName string,
+) error {
+	account, err := w.querier.GetAccount(ctx, accountName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAccountNotFound
+		}
+		return err
+	}
+
+	if err := w.querier.DeleteAccount(ctx, account.Namespace); err != nil {
+		return err
+	}
+
+	go w.publishEvent(domain.WalletEvent{
+		EventType:   domain.WalletAccountDeleted,
+		AccountName: account.Namespace,
+	})
+
+	return nil
+}
+
+func (w *walletRepositorySqlite) GetEventChannel() chan domain.WalletEvent {
+	return w.externalChEvents
+}
+
+func (w *walletRepositorySqlite) publishEvent(event domain.WalletEvent) {
+	w.chLock.Lock()
+	defer w.chLock.Unlock()
+
+	w.chEvents <- event
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case w.externalChEvents <- event:
+	default:
+	}
+}
+
+func (w *walletRepositorySqlite) close() {
+	close(w.chEvents)
+	close(w.externalChEvents)
+}
+
+// getWallet recreates wallet based on 3 tables: wallet, account, account_script_info
+func (w *walletRepositorySqlite) getWallet(
+	ctx context.Context,
+) (*domain.Wallet, error) {
+	walletAccounts, err := w.querier.GetWalletAccountsAndScripts(ctx, walletKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(walletAccounts) == 0 {
+		return nil, ErrorWalletNotFound
+	}
+
+	accounts := make(map[string]*domain.Account, 0)
+	if walletAccounts[0].Namespace.Valid {
+		for _, v := range walletAccounts {
+			if _, ok := accounts[v.Namespace.String]; !ok {
+				derivationPathByScript := make(map[string]string)
+				if v.ScriptDerivationPath.Valid {
+					derivationPathByScript[v.Script.String] = v.ScriptDerivationPath.String
+				}
+
+				accounts[v.Namespace.String] = &domain.Account{
+					AccountInfo: domain.AccountInfo{
+						Namespace:      v.Namespace.String,
+						Label:          v.Label.String,
+						Xpub:           v.Xpub.String,
+						DerivationPath: v.AccountDerivationPath.String,
+					},
+					Index:                  uint32(v.Index.Int64),
+					BirthdayBlock:          uint32(v.BirthdayBlockHeight),
+					NextExternalIndex:      uint(v.NextExternalIndex.Int64),
+					NextInternalIndex:      uint(v.NextInternalIndex.Int64),
+					DerivationPathByScript: derivationPathByScript,
+				}
+			} else {
+				if v.ScriptDerivationPath.Valid {
+					accounts[v.Namespace.String].DerivationPathByScript[v.Script.String] =
+						v.ScriptDerivationPath.String
+				}
+			}
+		}
+	}
+
+	accountsByLabel := make(map[string]string)
+	for namespace, account := range accounts {
+		if account.Label != "" {
+			accountsByLabel[account.Label] = namespace
+		}
+	}
+
+	return &domain.Wallet{
+		EncryptedMnemonic:   walletAccounts[0].EncryptedMnemonic,
+		PasswordHash:        walletAccounts[0].PasswordHash,
+		BirthdayBlockHeight: uint32(walletAccounts[0].BirthdayBlockHeight),
+		RootPath:            walletAccounts[0].RootPath,
+		NetworkName:         walletAccounts[0].NetworkName,
+		Accounts:            accounts,
+		AccountsByLabel:     accountsByLabel,
+		NextAccountIndex:    uint32(walletAccounts[0].NextAccountIndex),
+	}, nil
+}
+
+func (w *walletRepositorySqlite) createWallet(
+	ctx context.Context, wallet *domain.Wallet,
+) error {
+	params := queries.InsertWalletParams{
+		ID:                  walletKey,
+		EncryptedMnemonic:   wallet.EncryptedMnemonic,
+		PasswordHash:        wallet.PasswordHash,
+		BirthdayBlockHeight: int64(wallet.BirthdayBlockHeight),
+		RootPath:            wallet.RootPath,
+		NetworkName:         wallet.NetworkName,
+		NextAccountIndex:    int64(wallet.NextAccountIndex),
+	}
+
+	if len(wallet.Accounts) <= 0 {
+		if _, err := w.querier.InsertWallet(ctx, params); err != nil {
+			if isUniqueViolation(err) {
+				return ErrWalletAlreadyCreated
+			} else {
+				return err
+			}
+		}
+		return nil
+	}
+
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querierWithTx := w.querier.WithTx(tx)
+
+	if _, err := querierWithTx.InsertWallet(ctx, params); err != nil {
+		if isUniqueViolation(err) {
+			return ErrWalletAlreadyCreated
+		} else {
+			return err
+		}
+	}
+
+	for _, account := range wallet.Accounts {
+		if _, err := querierWithTx.InsertAccount(ctx, queries.InsertAccountParams{
+			Namespace:         account.AccountInfo.Namespace,
+			Index:             int64(account.Index),
+			Xpub:              account.AccountInfo.Xpub,
+			DerivationPath:    account.AccountInfo.DerivationPath,
+			NextExternalIndex: int64(account.NextExternalIndex),
+			NextInternalIndex: int64(account.NextInternalIndex),
+			FkWalletID:        walletKey,
+			Label: sql.NullString{
+				String: account.AccountInfo.Label,
+				Valid:  true,
+			},
+		}); err != nil {
+			return err
+		}
+
+		for k, v := range account.DerivationPathByScript {
+			if err := querierWithTx.InsertAccountScript(
+				ctx, queries.InsertAccountScriptParams{
+					Script:         k,
+					DerivationPath: v,
+					FkAccountName:  account.AccountInfo.Namespace,
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (w *walletRepositorySqlite) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetWallet(ctx)
+}
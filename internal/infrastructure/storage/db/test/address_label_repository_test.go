@@ -0,0 +1,106 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestAddressLabelRepository(t *testing.T) {
+	repositories, err := newAddressLabelRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testAddressLabelRepository(t, repo)
+		})
+	}
+}
+
+func testAddressLabelRepository(
+	t *testing.T, repo domain.AddressLabelRepository,
+) {
+	accountName := hex.EncodeToString(randomBytes(8))
+	otherAccountName := hex.EncodeToString(randomBytes(8))
+	script := hex.EncodeToString(randomBytes(22))
+	otherScript := hex.EncodeToString(randomBytes(22))
+
+	t.Run("set and get address labels", func(t *testing.T) {
+		labelsByScript, err := repo.GetAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, labelsByScript)
+
+		err = repo.SetAddressLabels(ctx, accountName, script, []string{"donations"})
+		require.NoError(t, err)
+		err = repo.SetAddressLabels(
+			ctx, accountName, otherScript, []string{"change", "cold"},
+		)
+		require.NoError(t, err)
+		err = repo.SetAddressLabels(
+			ctx, otherAccountName, script, []string{"other"},
+		)
+		require.NoError(t, err)
+
+		labelsByScript, err = repo.GetAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			script:      {"donations"},
+			otherScript: {"change", "cold"},
+		}, labelsByScript)
+
+		err = repo.SetAddressLabels(ctx, accountName, script, []string{"tips"})
+		require.NoError(t, err)
+
+		labelsByScript, err = repo.GetAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Equal(t, []string{"tips"}, labelsByScript[script])
+
+		err = repo.SetAddressLabels(ctx, accountName, otherScript, nil)
+		require.NoError(t, err)
+
+		labelsByScript, err = repo.GetAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, labelsByScript, 1)
+	})
+
+	t.Run("delete address labels for account", func(t *testing.T) {
+		err := repo.DeleteAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+
+		labelsByScript, err := repo.GetAddressLabelsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, labelsByScript)
+
+		labelsByScript, err = repo.GetAddressLabelsForAccount(
+			ctx, otherAccountName,
+		)
+		require.NoError(t, err)
+		require.Len(t, labelsByScript, 1)
+	})
+}
+
+func newAddressLabelRepositories() (
+	map[string]domain.AddressLabelRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.AddressLabelRepository{
+		"inmemory": inmemoryRepoManager.AddressLabelRepository(),
+		"badger":   badgerRepoManager.AddressLabelRepository(),
+		"sqlite":   sqliteRepoManager.AddressLabelRepository(),
+		"postgres": pgRepoManager.AddressLabelRepository(),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestAuditEventRepository(t *testing.T) {
+	repositories, err := newAuditEventRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testAuditEventRepository(t, repo)
+		})
+	}
+}
+
+func testAuditEventRepository(
+	t *testing.T, repo domain.AuditEventRepository,
+) {
+	asset := hex.EncodeToString(randomBytes(32))
+	script := hex.EncodeToString(randomScript())
+	txid := hex.EncodeToString(randomBytes(32))
+
+	events := make([]domain.AuditEvent, 0, 3)
+	var prev *domain.AuditEvent
+	for i := 0; i < 3; i++ {
+		event, err := domain.NewAuditEvent(
+			prev, "test", "Transfer", "test1", map[string]uint64{asset: 1000},
+			[]string{script}, txid, "",
+		)
+		require.NoError(t, err)
+		events = append(events, *event)
+		prev = event
+	}
+
+	t.Run("add_events", func(t *testing.T) {
+		event, err := repo.GetLastEvent(ctx)
+		require.EqualError(t, err, domain.ErrAuditEventNotFound.Error())
+		require.Nil(t, event)
+
+		for i := range events {
+			done, err := repo.AddEvent(ctx, &events[i])
+			require.NoError(t, err)
+			require.True(t, done)
+		}
+
+		done, err := repo.AddEvent(ctx, &events[0])
+		require.NoError(t, err)
+		require.False(t, done)
+
+		event, err = repo.GetLastEvent(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Equal(t, events[2].Hash, event.Hash)
+		require.Equal(t, event.Hash, event.ComputeHash())
+	})
+
+	t.Run("get_events", func(t *testing.T) {
+		gotEvents, err := repo.GetEvents(ctx, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, gotEvents, 3)
+		require.NoError(t, domain.VerifyAuditEvents(nil, gotEvents))
+
+		gotEvents, err = repo.GetEvents(ctx, 2, 1)
+		require.NoError(t, err)
+		require.Len(t, gotEvents, 1)
+		require.Equal(t, events[1].Hash, gotEvents[0].Hash)
+
+		gotEvents, err = repo.GetEvents(ctx, 4, 10)
+		require.NoError(t, err)
+		require.Empty(t, gotEvents)
+	})
+}
+
+func newAuditEventRepositories() (
+	map[string]domain.AuditEventRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.AuditEventRepository{
+		"inmemory": inmemoryRepoManager.AuditEventRepository(),
+		"badger":   badgerRepoManager.AuditEventRepository(),
+		"sqlite":   sqliteRepoManager.AuditEventRepository(),
+		"postgres": pgRepoManager.AuditEventRepository(),
+	}, nil
+}
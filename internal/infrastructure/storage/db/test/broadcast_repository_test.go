@@ -0,0 +1,149 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestBroadcastRepository(t *testing.T) {
+	repositories, err := newBroadcastRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testBroadcastRepository(t, repo)
+		})
+	}
+}
+
+func testBroadcastRepository(t *testing.T, repo domain.BroadcastRepository) {
+	now := time.Now().Unix()
+	broadcast, err := domain.NewBroadcast(randomHex(32), randomHex(100), now)
+	require.NoError(t, err)
+	otherBroadcast, err := domain.NewBroadcast(
+		randomHex(32), randomHex(100), now,
+	)
+	require.NoError(t, err)
+	otherBroadcast.CreatedAt = broadcast.CreatedAt + 1
+
+	t.Run("add_broadcast", func(t *testing.T) {
+		b, err := repo.GetBroadcast(ctx, broadcast.Txid)
+		require.EqualError(t, err, domain.ErrBroadcastNotFound.Error())
+		require.Nil(t, b)
+
+		done, err := repo.AddBroadcast(ctx, broadcast)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddBroadcast(ctx, broadcast)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		done, err = repo.AddBroadcast(ctx, otherBroadcast)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		b, err = repo.GetBroadcast(ctx, broadcast.Txid)
+		require.NoError(t, err)
+		require.NotNil(t, b)
+		require.Equal(t, *broadcast, *b)
+
+		broadcasts, err := repo.GetPendingBroadcasts(ctx)
+		require.NoError(t, err)
+		require.Subset(
+			t, broadcasts, []domain.Broadcast{*broadcast, *otherBroadcast},
+		)
+	})
+
+	t.Run("update_broadcasts", func(t *testing.T) {
+		chEvents := make(chan domain.BroadcastEvent, 1)
+		go func() {
+			timeout := time.After(5 * time.Second)
+			for {
+				select {
+				case event := <-repo.GetEventChannel():
+					// Skip the events of the broadcasts added previously.
+					if event.EventType != domain.BroadcastDropped {
+						continue
+					}
+					chEvents <- event
+					return
+				case <-timeout:
+					close(chEvents)
+					return
+				}
+			}
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		err := broadcast.Rebroadcast(now + 60)
+		require.NoError(t, err)
+		err = otherBroadcast.Drop()
+		require.NoError(t, err)
+
+		err = repo.UpdateBroadcasts(
+			ctx, []domain.Broadcast{*broadcast, *otherBroadcast},
+		)
+		require.NoError(t, err)
+
+		event, ok := <-chEvents
+		require.True(t, ok)
+		require.Equal(t, domain.BroadcastDropped, event.EventType)
+		require.Equal(t, []domain.Broadcast{*otherBroadcast}, event.Broadcasts)
+
+		b, err := repo.GetBroadcast(ctx, broadcast.Txid)
+		require.NoError(t, err)
+		require.Equal(t, *broadcast, *b)
+
+		broadcasts, err := repo.GetPendingBroadcasts(ctx)
+		require.NoError(t, err)
+		require.Contains(t, broadcasts, *broadcast)
+		require.NotContains(t, broadcasts, *otherBroadcast)
+
+		err = broadcast.Conflict(randomHex(32))
+		require.NoError(t, err)
+		err = repo.UpdateBroadcasts(ctx, []domain.Broadcast{*broadcast})
+		require.NoError(t, err)
+
+		b, err = repo.GetBroadcast(ctx, broadcast.Txid)
+		require.NoError(t, err)
+		require.Equal(t, domain.BroadcastStatusConflicted, b.Status)
+		require.Equal(t, broadcast.ConflictingTxid, b.ConflictingTxid)
+
+		unknownBroadcast, err := domain.NewBroadcast(
+			randomHex(32), randomHex(100), now,
+		)
+		require.NoError(t, err)
+		err = repo.UpdateBroadcasts(
+			ctx, []domain.Broadcast{*unknownBroadcast},
+		)
+		require.EqualError(t, err, domain.ErrBroadcastNotFound.Error())
+	})
+}
+
+func newBroadcastRepositories() (
+	map[string]domain.BroadcastRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.BroadcastRepository{
+		"inmemory": inmemoryRepoManager.BroadcastRepository(),
+		"badger":   badgerRepoManager.BroadcastRepository(),
+		"sqlite":   sqliteRepoManager.BroadcastRepository(),
+		"postgres": pgRepoManager.BroadcastRepository(),
+	}, nil
+}
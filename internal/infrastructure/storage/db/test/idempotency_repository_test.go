@@ -0,0 +1,118 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestIdempotencyKeyRepository(t *testing.T) {
+	repositories, err := newIdempotencyKeyRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testIdempotencyKeyRepository(t, repo)
+		})
+	}
+}
+
+func testIdempotencyKeyRepository(
+	t *testing.T, repo domain.IdempotencyKeyRepository,
+) {
+	hash := hex.EncodeToString(randomBytes(32))
+	key, err := domain.NewIdempotencyKey(
+		hex.EncodeToString(randomBytes(16)), "Transfer", hash, time.Hour,
+	)
+	require.NoError(t, err)
+
+	expiredKey := *key
+	expiredKey.Key = hex.EncodeToString(randomBytes(16))
+	expiredKey.ExpiresAt = key.CreatedAt - 1
+
+	t.Run("add_key", func(t *testing.T) {
+		k, err := repo.GetKey(ctx, key.Key)
+		require.EqualError(t, err, domain.ErrIdempotencyKeyNotFound.Error())
+		require.Nil(t, k)
+
+		done, err := repo.AddKey(ctx, key)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddKey(ctx, key)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		k, err = repo.GetKey(ctx, key.Key)
+		require.NoError(t, err)
+		require.NotNil(t, k)
+		require.Equal(t, *key, *k)
+		require.False(t, k.IsCompleted())
+		require.True(t, k.Matches("Transfer", hash))
+	})
+
+	t.Run("update_key", func(t *testing.T) {
+		key.Complete(hex.EncodeToString(randomBytes(100)))
+		err := repo.UpdateKey(ctx, key)
+		require.NoError(t, err)
+
+		k, err := repo.GetKey(ctx, key.Key)
+		require.NoError(t, err)
+		require.True(t, k.IsCompleted())
+		require.Equal(t, key.Result, k.Result)
+
+		err = repo.UpdateKey(ctx, &expiredKey)
+		require.EqualError(t, err, domain.ErrIdempotencyKeyNotFound.Error())
+	})
+
+	t.Run("delete_keys", func(t *testing.T) {
+		done, err := repo.AddKey(ctx, &expiredKey)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		count, err := repo.DeleteExpiredKeys(ctx, key.CreatedAt)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		k, err := repo.GetKey(ctx, expiredKey.Key)
+		require.EqualError(t, err, domain.ErrIdempotencyKeyNotFound.Error())
+		require.Nil(t, k)
+
+		err = repo.DeleteKey(ctx, key.Key)
+		require.NoError(t, err)
+
+		k, err = repo.GetKey(ctx, key.Key)
+		require.EqualError(t, err, domain.ErrIdempotencyKeyNotFound.Error())
+		require.Nil(t, k)
+
+		err = repo.DeleteKey(ctx, key.Key)
+		require.NoError(t, err)
+	})
+}
+
+func newIdempotencyKeyRepositories() (
+	map[string]domain.IdempotencyKeyRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.IdempotencyKeyRepository{
+		"inmemory": inmemoryRepoManager.IdempotencyKeyRepository(),
+		"badger":   badgerRepoManager.IdempotencyKeyRepository(),
+		"sqlite":   sqliteRepoManager.IdempotencyKeyRepository(),
+		"postgres": pgRepoManager.IdempotencyKeyRepository(),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestPaymentRepository(t *testing.T) {
+	repositories, err := newPaymentRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testPaymentRepository(t, repo)
+		})
+	}
+}
+
+func testPaymentRepository(t *testing.T, repo domain.PaymentRepository) {
+	accountName := hex.EncodeToString(randomBytes(8))
+	asset := hex.EncodeToString(randomBytes(32))
+	script := hex.EncodeToString(randomBytes(22))
+	txid := hex.EncodeToString(randomBytes(32))
+
+	payment, err := domain.NewPayment(accountName, asset, 1000, script, "", 0)
+	require.NoError(t, err)
+	otherPayment, err := domain.NewPayment(
+		accountName, asset, 2000, script, "", payment.CreatedAt+60,
+	)
+	require.NoError(t, err)
+	otherPayment.CreatedAt = payment.CreatedAt + 1
+
+	t.Run("add_payment", func(t *testing.T) {
+		p, err := repo.GetPayment(ctx, payment.ID)
+		require.EqualError(t, err, domain.ErrPaymentNotFound.Error())
+		require.Nil(t, p)
+
+		done, err := repo.AddPayment(ctx, payment)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddPayment(ctx, payment)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		done, err = repo.AddPayment(ctx, otherPayment)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		p, err = repo.GetPayment(ctx, payment.ID)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		require.Equal(t, *payment, *p)
+
+		payments, err := repo.GetQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Payment{*payment, *otherPayment}, payments)
+
+		payments, err = repo.GetQueuedPayments(ctx, "")
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(payments), 2)
+
+		payments, err = repo.GetQueuedPayments(ctx, "otheraccount")
+		require.NoError(t, err)
+		require.Empty(t, payments)
+	})
+
+	t.Run("update_payments", func(t *testing.T) {
+		chEvents := make(chan domain.PaymentEvent, 1)
+		go func() {
+			timeout := time.After(5 * time.Second)
+			for {
+				select {
+				case event := <-repo.GetEventChannel():
+					// Skip the events of the payments added previously.
+					if event.EventType != domain.PaymentBroadcasted {
+						continue
+					}
+					chEvents <- event
+					return
+				case <-timeout:
+					close(chEvents)
+					return
+				}
+			}
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		err := payment.Broadcast(txid, 0)
+		require.NoError(t, err)
+		err = otherPayment.Broadcast(txid, 1)
+		require.NoError(t, err)
+
+		err = repo.UpdatePayments(
+			ctx, []domain.Payment{*payment, *otherPayment},
+		)
+		require.NoError(t, err)
+
+		event, ok := <-chEvents
+		require.True(t, ok)
+		require.Equal(t, domain.PaymentBroadcasted, event.EventType)
+		require.Len(t, event.Payments, 2)
+
+		payments, err := repo.GetQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, payments)
+
+		payments, err = repo.GetPaymentsByTxid(ctx, txid)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Payment{*payment, *otherPayment}, payments)
+
+		err = payment.Confirm()
+		require.NoError(t, err)
+		err = repo.UpdatePayments(ctx, []domain.Payment{*payment})
+		require.NoError(t, err)
+
+		p, err := repo.GetPayment(ctx, payment.ID)
+		require.NoError(t, err)
+		require.True(t, p.IsConfirmed())
+
+		unknownPayment, err := domain.NewPayment(
+			accountName, asset, 1000, script, "", 0,
+		)
+		require.NoError(t, err)
+		err = repo.UpdatePayments(ctx, []domain.Payment{*unknownPayment})
+		require.EqualError(t, err, domain.ErrPaymentNotFound.Error())
+	})
+}
+
+func newPaymentRepositories() (map[string]domain.PaymentRepository, error) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.PaymentRepository{
+		"inmemory": inmemoryRepoManager.PaymentRepository(),
+		"badger":   badgerRepoManager.PaymentRepository(),
+		"sqlite":   sqliteRepoManager.PaymentRepository(),
+		"postgres": pgRepoManager.PaymentRepository(),
+	}, nil
+}
@@ -0,0 +1,150 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestSpendingPolicyRepository(t *testing.T) {
+	repositories, err := newSpendingPolicyRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testSpendingPolicyRepository(t, repo)
+		})
+	}
+}
+
+func testSpendingPolicyRepository(
+	t *testing.T, repo domain.SpendingPolicyRepository,
+) {
+	accountName := "test1"
+	asset := hex.EncodeToString(randomBytes(32))
+	script := hex.EncodeToString(randomScript())
+	policy, err := domain.NewSpendingPolicy(
+		accountName, []domain.AssetLimit{
+			{Asset: asset, SpendLimit: 1000, WindowSeconds: 3600, ApprovalThreshold: 500},
+		},
+		[]string{script}, 200,
+	)
+	require.NoError(t, err)
+
+	approval := &domain.SpendApproval{
+		ID:          hex.EncodeToString(randomBytes(32)),
+		Tx:          hex.EncodeToString(randomBytes(100)),
+		SighashType: 1,
+		RequestedBy: "test",
+		Timestamp:   time.Now().Unix(),
+	}
+
+	t.Run("set_policy", func(t *testing.T) {
+		gotPolicy, err := repo.GetPolicy(ctx, accountName)
+		require.EqualError(t, err, domain.ErrPolicyNotFound.Error())
+		require.Nil(t, gotPolicy)
+
+		err = repo.SetPolicy(ctx, policy)
+		require.NoError(t, err)
+
+		gotPolicy, err = repo.GetPolicy(ctx, accountName)
+		require.NoError(t, err)
+		require.NotNil(t, gotPolicy)
+		require.Equal(t, policy.MaxMillisatsPerByte, gotPolicy.MaxMillisatsPerByte)
+		require.Equal(t, policy.AssetLimits, gotPolicy.AssetLimits)
+		require.Equal(t, []string{script}, gotPolicy.GetAllowedScripts())
+	})
+
+	t.Run("update_policy", func(t *testing.T) {
+		err := repo.UpdatePolicy(
+			ctx, accountName,
+			func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error) {
+				p.AddSpends(map[string]uint64{asset: 100}, time.Now())
+				return p, nil
+			},
+		)
+		require.NoError(t, err)
+
+		policy, err := repo.GetPolicy(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, policy.Spends, 1)
+		require.Equal(t, uint64(100), policy.SpentAmount(asset, time.Now()))
+
+		err = repo.UpdatePolicy(
+			ctx, "test2",
+			func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error) {
+				return p, nil
+			},
+		)
+		require.EqualError(t, err, domain.ErrPolicyNotFound.Error())
+	})
+
+	t.Run("delete_policy", func(t *testing.T) {
+		done, err := repo.DeletePolicy(ctx, accountName)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeletePolicy(ctx, accountName)
+		require.NoError(t, err)
+		require.False(t, done)
+	})
+
+	t.Run("add_approval", func(t *testing.T) {
+		done, err := repo.AddApproval(ctx, approval)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddApproval(ctx, approval)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		gotApproval, err := repo.GetApproval(ctx, approval.ID)
+		require.NoError(t, err)
+		require.Equal(t, *approval, *gotApproval)
+
+		approvals, err := repo.GetAllApprovals(ctx)
+		require.NoError(t, err)
+		require.Len(t, approvals, 1)
+	})
+
+	t.Run("delete_approval", func(t *testing.T) {
+		done, err := repo.DeleteApproval(ctx, approval.ID)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeleteApproval(ctx, approval.ID)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		gotApproval, err := repo.GetApproval(ctx, approval.ID)
+		require.EqualError(t, err, domain.ErrSpendApprovalNotFound.Error())
+		require.Nil(t, gotApproval)
+	})
+}
+
+func newSpendingPolicyRepositories() (
+	map[string]domain.SpendingPolicyRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.SpendingPolicyRepository{
+		"inmemory": inmemoryRepoManager.SpendingPolicyRepository(),
+		"badger":   badgerRepoManager.SpendingPolicyRepository(),
+		"sqlite":   sqliteRepoManager.SpendingPolicyRepository(),
+		"postgres": pgRepoManager.SpendingPolicyRepository(),
+	}, nil
+}
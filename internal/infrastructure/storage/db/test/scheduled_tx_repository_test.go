@@ -0,0 +1,116 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
+)
+
+func TestScheduledTxRepository(t *testing.T) {
+	repositories, err := newScheduledTxRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testScheduledTxRepository(t, repo)
+		})
+	}
+}
+
+func testScheduledTxRepository(
+	t *testing.T, repo domain.ScheduledTxRepository,
+) {
+	now := time.Now().Unix()
+	tx, err := domain.NewScheduledTx(randomHex(32), randomHex(100), 1000, 0)
+	require.NoError(t, err)
+	otherTx, err := domain.NewScheduledTx(
+		randomHex(32), randomHex(100), uint32(now+3600), now,
+	)
+	require.NoError(t, err)
+	otherTx.CreatedAt = tx.CreatedAt + 1
+
+	t.Run("add_scheduled_tx", func(t *testing.T) {
+		scheduledTx, err := repo.GetScheduledTx(ctx, tx.Txid)
+		require.EqualError(t, err, domain.ErrScheduledTxNotFound.Error())
+		require.Nil(t, scheduledTx)
+
+		done, err := repo.AddScheduledTx(ctx, tx)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddScheduledTx(ctx, tx)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		done, err = repo.AddScheduledTx(ctx, otherTx)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		scheduledTx, err = repo.GetScheduledTx(ctx, otherTx.Txid)
+		require.NoError(t, err)
+		require.NotNil(t, scheduledTx)
+		require.Equal(t, *otherTx, *scheduledTx)
+
+		txs, err := repo.GetPendingScheduledTxs(ctx)
+		require.NoError(t, err)
+		require.Subset(t, txs, []domain.ScheduledTx{*tx, *otherTx})
+	})
+
+	t.Run("update_scheduled_tx", func(t *testing.T) {
+		err := tx.Cancel()
+		require.NoError(t, err)
+		err = repo.UpdateScheduledTx(ctx, tx)
+		require.NoError(t, err)
+
+		err = otherTx.Fail(randomHex(32))
+		require.NoError(t, err)
+		err = repo.UpdateScheduledTx(ctx, otherTx)
+		require.NoError(t, err)
+
+		scheduledTx, err := repo.GetScheduledTx(ctx, otherTx.Txid)
+		require.NoError(t, err)
+		require.Equal(t, *otherTx, *scheduledTx)
+
+		txs, err := repo.GetPendingScheduledTxs(ctx)
+		require.NoError(t, err)
+		require.NotContains(t, txs, *tx)
+		require.NotContains(t, txs, *otherTx)
+
+		txs, err = repo.GetAllScheduledTxs(ctx)
+		require.NoError(t, err)
+		require.Subset(t, txs, []domain.ScheduledTx{*tx, *otherTx})
+
+		unknownTx, err := domain.NewScheduledTx(
+			randomHex(32), randomHex(100), 0, 0,
+		)
+		require.NoError(t, err)
+		err = repo.UpdateScheduledTx(ctx, unknownTx)
+		require.EqualError(t, err, domain.ErrScheduledTxNotFound.Error())
+	})
+}
+
+func newScheduledTxRepositories() (
+	map[string]domain.ScheduledTxRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.ScheduledTxRepository{
+		"inmemory": inmemoryRepoManager.ScheduledTxRepository(),
+		"badger":   badgerRepoManager.ScheduledTxRepository(),
+		"sqlite":   sqliteRepoManager.ScheduledTxRepository(),
+		"postgres": pgRepoManager.ScheduledTxRepository(),
+	}, nil
+}
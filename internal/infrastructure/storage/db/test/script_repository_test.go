@@ -10,6 +10,7 @@ import (
 	"github.com/vulpemventures/ocean/internal/core/ports"
 	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
 	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
 )
 
 func TestExternalScriptRepository(t *testing.T) {
@@ -76,12 +77,16 @@ func newExternalScriptRepositories(
 	if err != nil {
 		return nil, err
 	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
 
 	handlers := []ports.ScriptEventHandler{
-		handlerFactory("badger"), handlerFactory("inmemory"),
+		handlerFactory("badger"), handlerFactory("inmemory"), handlerFactory("sqlite"),
 	}
 
-	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, pgRepoManager}
+	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, sqliteRepoManager, pgRepoManager}
 
 	for i, handler := range handlers {
 		repoManager := repoManagers[i]
@@ -92,6 +97,7 @@ func newExternalScriptRepositories(
 	return map[string]domain.ExternalScriptRepository{
 		"inmemory": inmemoryRepoManager.ExternalScriptRepository(),
 		"badger":   badgerRepoManager.ExternalScriptRepository(),
+		"sqlite":   sqliteRepoManager.ExternalScriptRepository(),
 		"postgres": pgRepoManager.ExternalScriptRepository(),
 	}, nil
 }
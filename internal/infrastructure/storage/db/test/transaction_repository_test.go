@@ -10,6 +10,7 @@ import (
 	"github.com/vulpemventures/ocean/internal/core/ports"
 	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
 	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
 )
 
 func TestTransactionRepository(t *testing.T) {
@@ -79,6 +80,32 @@ func testTransactionRepository(t *testing.T, repo domain.TransactionRepository)
 		require.True(t, tx.IsConfirmed())
 	})
 
+	t.Run("evict_transaction", func(t *testing.T) {
+		replacedTx := randomTx(accountName)
+		replacementTxid := randomHex(32)
+		done, err := repo.AddTransaction(ctx, replacedTx)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.EvictTransaction(ctx, replacedTx.TxID, replacementTxid)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.EvictTransaction(ctx, replacedTx.TxID, randomHex(32))
+		require.NoError(t, err)
+		require.False(t, done)
+
+		tx, err := repo.GetTransaction(ctx, replacedTx.TxID)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		require.True(t, tx.IsEvicted())
+		require.Equal(t, replacementTxid, tx.EvictedBy)
+
+		done, err = repo.EvictTransaction(ctx, txid, replacementTxid)
+		require.NoError(t, err)
+		require.False(t, done)
+	})
+
 	t.Run("update_transaction", func(t *testing.T) {
 		tx, err := repo.GetTransaction(ctx, txid)
 		require.NoError(t, err)
@@ -109,23 +136,29 @@ func newTransactionRepositories(
 	if err != nil {
 		return nil, err
 	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
 
 	handlers := []ports.TxEventHandler{
-		handlerFactory("badger"), handlerFactory("inmemory"),
+		handlerFactory("badger"), handlerFactory("inmemory"), handlerFactory("sqlite"),
 	}
 
-	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, pgRepoManager}
+	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, sqliteRepoManager, pgRepoManager}
 
 	for i, handler := range handlers {
 		repoManager := repoManagers[i]
 		repoManager.RegisterHandlerForTxEvent(domain.TransactionAdded, handler)
 		repoManager.RegisterHandlerForTxEvent(domain.TransactionUnconfirmed, handler)
 		repoManager.RegisterHandlerForTxEvent(domain.TransactionConfirmed, handler)
+		repoManager.RegisterHandlerForTxEvent(domain.TransactionEvicted, handler)
 	}
 
 	return map[string]domain.TransactionRepository{
 		"inmemory": inmemoryRepoManager.TransactionRepository(),
 		"badger":   badgerRepoManager.TransactionRepository(),
+		"sqlite":   sqliteRepoManager.TransactionRepository(),
 		"postgres": pgRepoManager.TransactionRepository(),
 	}, nil
 }
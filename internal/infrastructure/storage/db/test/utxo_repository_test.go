@@ -10,6 +10,7 @@ import (
 	"github.com/vulpemventures/ocean/internal/core/ports"
 	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
 	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
 )
 
 var (
@@ -49,6 +50,15 @@ func testUtxoRepository(t *testing.T, repo domain.UtxoRepository) {
 	testLockUtxos(t, repo)
 
 	testUnlockUtxos(t, repo)
+	testReleaseUtxos(t, repo)
+
+	testFreezeUtxos(t, repo)
+
+	testUnfreezeUtxos(t, repo)
+
+	testSetUtxoLabels(t, repo)
+
+	testEvictUtxos(t, repo)
 
 	testSpendUtxos(t, repo)
 
@@ -194,11 +204,11 @@ func testConfirmUtxos(t *testing.T, repo domain.UtxoRepository) {
 
 func testLockUtxos(t *testing.T, repo domain.UtxoRepository) {
 	t.Run("lock_utxos", func(t *testing.T) {
-		count, err := repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0)
+		count, err := repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0, "")
 		require.NoError(t, err)
 		require.Equal(t, len(newUtxos), count)
 
-		count, err = repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0)
+		count, err = repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0, "")
 		require.NoError(t, err)
 		require.Zero(t, count)
 
@@ -260,6 +270,198 @@ func testUnlockUtxos(t *testing.T, repo domain.UtxoRepository) {
 	})
 }
 
+func testReleaseUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("release_utxos", func(t *testing.T) {
+		now := time.Now().Unix()
+		expiry := time.Now().Add(time.Hour).Unix()
+
+		count, err := repo.LockUtxos(ctx, utxoKeys, now, expiry, "owner")
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.LockUtxos(ctx, utxoKeys, now, expiry+1, "other")
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		count, err = repo.LockUtxos(ctx, utxoKeys, now, expiry+1, "owner")
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err := repo.GetLockedUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+		for _, u := range utxos {
+			require.Equal(t, "owner", u.LockOwner)
+			require.Equal(t, expiry+1, u.LockExpiryTimestamp)
+		}
+
+		count, err = repo.UnlockUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, "other")
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, "owner")
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		count, err = repo.LockUtxos(ctx, utxoKeys, now, expiry, "owner")
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, "")
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+	})
+}
+
+func testFreezeUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("freeze_utxos", func(t *testing.T) {
+		count, err := repo.FreezeUtxos(ctx, utxoKeys, time.Now().Unix())
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.FreezeUtxos(ctx, utxoKeys, time.Now().Unix())
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetFrozenUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+
+		utxos, err = repo.GetSpendableUtxos(ctx)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetSpendableUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxoBalance, err := repo.GetBalanceForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.NotNil(t, utxoBalance)
+		for asset, balance := range utxoBalance {
+			prevBalance := balanceByAsset[asset]
+			require.Zero(t, balance.Confirmed)
+			require.Zero(t, balance.Unconfirmed)
+			require.Zero(t, balance.Locked)
+			require.Equal(t, prevBalance.Unconfirmed, balance.Frozen)
+		}
+	})
+}
+
+func testUnfreezeUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("unfreeze_utxos", func(t *testing.T) {
+		count, err := repo.UnfreezeUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.UnfreezeUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetFrozenUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetSpendableUtxosForAccount(ctx, accountName, nil)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+
+		utxoBalance, err := repo.GetBalanceForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.NotNil(t, utxoBalance)
+		for asset, balance := range utxoBalance {
+			prevBalance := balanceByAsset[asset]
+			require.Equal(t, prevBalance.Unconfirmed, balance.Confirmed)
+			require.Zero(t, balance.Frozen)
+		}
+	})
+}
+
+func testEvictUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("evict_utxos", func(t *testing.T) {
+		evictedAccountName := "evicted"
+		utxos, keys, _ := randomUtxosForAccount(evictedAccountName)
+		count, err := repo.AddUtxos(ctx, utxos)
+		require.NoError(t, err)
+		require.Equal(t, len(utxos), count)
+
+		now := time.Now().Unix()
+		count, err = repo.LockUtxos(ctx, keys, now, now+60, "owner")
+		require.NoError(t, err)
+		require.Equal(t, len(utxos), count)
+
+		count, err = repo.EvictUtxos(ctx, keys, now)
+		require.NoError(t, err)
+		require.Equal(t, len(utxos), count)
+
+		count, err = repo.EvictUtxos(ctx, keys, now)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		evictedUtxos, err := repo.GetUtxosByKey(ctx, keys)
+		require.NoError(t, err)
+		require.Len(t, evictedUtxos, len(utxos))
+		for _, u := range evictedUtxos {
+			require.True(t, u.IsEvicted())
+			require.False(t, u.IsLocked())
+		}
+
+		spendableUtxos, err := repo.GetSpendableUtxosForAccount(
+			ctx, evictedAccountName, nil,
+		)
+		require.NoError(t, err)
+		require.Empty(t, spendableUtxos)
+
+		lockedUtxos, err := repo.GetLockedUtxosForAccount(
+			ctx, evictedAccountName, nil,
+		)
+		require.NoError(t, err)
+		require.Empty(t, lockedUtxos)
+
+		utxoBalance, err := repo.GetBalanceForAccount(ctx, evictedAccountName)
+		require.NoError(t, err)
+		require.Empty(t, utxoBalance)
+	})
+}
+
+func testSetUtxoLabels(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("set_utxo_labels", func(t *testing.T) {
+		labels := []string{"cold", "exchange"}
+		count, err := repo.SetUtxoLabels(ctx, utxoKeys, labels)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err := repo.GetUtxosByKey(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+		for _, u := range utxos {
+			require.Equal(t, labels, u.Labels)
+		}
+
+		count, err = repo.SetUtxoLabels(ctx, utxoKeys, nil)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err = repo.GetUtxosByKey(ctx, utxoKeys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.Empty(t, u.Labels)
+		}
+	})
+}
+
 func testSpendUtxos(t *testing.T, repo domain.UtxoRepository) {
 	t.Run("spend_utxos", func(t *testing.T) {
 		count, err := repo.SpendUtxos(ctx, utxoKeys, txid)
@@ -320,11 +522,15 @@ func newUtxoRepositories(handlerFactory func(repoType string) ports.UtxoEventHan
 	if err != nil {
 		return nil, err
 	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
 	handlers := []ports.UtxoEventHandler{
-		handlerFactory("badger"), handlerFactory("inmemory"), handlerFactory("postgres"),
+		handlerFactory("badger"), handlerFactory("inmemory"), handlerFactory("sqlite"), handlerFactory("postgres"),
 	}
 
-	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, pgRepoManager}
+	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, sqliteRepoManager, pgRepoManager}
 
 	for i, handler := range handlers {
 		repoManager := repoManagers[i]
@@ -332,12 +538,16 @@ func newUtxoRepositories(handlerFactory func(repoType string) ports.UtxoEventHan
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoConfirmed, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoLocked, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoUnlocked, handler)
+		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoFrozen, handler)
+		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoUnfrozen, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoSpent, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoConfirmedSpend, handler)
+		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoEvicted, handler)
 	}
 	return map[string]domain.UtxoRepository{
 		"inmemory": inmemoryRepoManager.UtxoRepository(),
 		"badger":   badgerRepoManager.UtxoRepository(),
+		"sqlite":   sqliteRepoManager.UtxoRepository(),
 		"postgres": pgRepoManager.UtxoRepository(),
 	}, nil
 }
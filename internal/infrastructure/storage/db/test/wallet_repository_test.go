@@ -16,6 +16,7 @@ import (
 	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
 	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
 	postgresdb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres"
+	sqlitedb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/sqlite"
 )
 
 var (
@@ -198,11 +199,15 @@ func newWalletRepositories(
 	if err != nil {
 		return nil, err
 	}
+	sqliteRepoManager, err := sqlitedb.NewRepoManager("")
+	if err != nil {
+		return nil, err
+	}
 	handlers := []ports.WalletEventHandler{
-		handlerFactory("badger"), handlerFactory("inmemory"),
+		handlerFactory("badger"), handlerFactory("inmemory"), handlerFactory("sqlite"),
 	}
 
-	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, pgRepoManager}
+	repoManagers := []ports.RepoManager{badgerRepoManager, inmemoryRepoManager, sqliteRepoManager, pgRepoManager}
 
 	for i, handler := range handlers {
 		repoManager := repoManagers[i]
@@ -215,6 +220,7 @@ func newWalletRepositories(
 	return map[string]domain.WalletRepository{
 		"inmemory": inmemoryRepoManager.WalletRepository(),
 		"badger":   badgerRepoManager.WalletRepository(),
+		"sqlite":   sqliteRepoManager.WalletRepository(),
 		"postgres": pgRepoManager.WalletRepository(),
 	}, nil
 }
@@ -0,0 +1,40 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const addressLabelRepo = "AddressLabelRepository"
+
+type addressLabelRepository struct {
+	domain.AddressLabelRepository
+	spanner
+}
+
+func (r *addressLabelRepository) SetAddressLabels(
+	ctx context.Context, accountName, script string, labels []string,
+) (err error) {
+	ctx, span := r.start(ctx, addressLabelRepo, "SetAddressLabels")
+	defer func() { end(span, err) }()
+	return r.AddressLabelRepository.SetAddressLabels(
+		ctx, accountName, script, labels,
+	)
+}
+
+func (r *addressLabelRepository) GetAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) (_ map[string][]string, err error) {
+	ctx, span := r.start(ctx, addressLabelRepo, "GetAddressLabelsForAccount")
+	defer func() { end(span, err) }()
+	return r.AddressLabelRepository.GetAddressLabelsForAccount(ctx, accountName)
+}
+
+func (r *addressLabelRepository) DeleteAddressLabelsForAccount(
+	ctx context.Context, accountName string,
+) (err error) {
+	ctx, span := r.start(ctx, addressLabelRepo, "DeleteAddressLabelsForAccount")
+	defer func() { end(span, err) }()
+	return r.AddressLabelRepository.DeleteAddressLabelsForAccount(ctx, accountName)
+}
@@ -0,0 +1,38 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const auditRepo = "AuditEventRepository"
+
+type auditRepository struct {
+	domain.AuditEventRepository
+	spanner
+}
+
+func (r *auditRepository) AddEvent(
+	ctx context.Context, event *domain.AuditEvent,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, auditRepo, "AddEvent")
+	defer func() { end(span, err) }()
+	return r.AuditEventRepository.AddEvent(ctx, event)
+}
+
+func (r *auditRepository) GetLastEvent(
+	ctx context.Context,
+) (_ *domain.AuditEvent, err error) {
+	ctx, span := r.start(ctx, auditRepo, "GetLastEvent")
+	defer func() { end(span, err) }()
+	return r.AuditEventRepository.GetLastEvent(ctx)
+}
+
+func (r *auditRepository) GetEvents(
+	ctx context.Context, fromSequence, limit uint64,
+) (_ []domain.AuditEvent, err error) {
+	ctx, span := r.start(ctx, auditRepo, "GetEvents")
+	defer func() { end(span, err) }()
+	return r.AuditEventRepository.GetEvents(ctx, fromSequence, limit)
+}
@@ -0,0 +1,46 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const broadcastRepo = "BroadcastRepository"
+
+type broadcastRepository struct {
+	domain.BroadcastRepository
+	spanner
+}
+
+func (r *broadcastRepository) AddBroadcast(
+	ctx context.Context, broadcast *domain.Broadcast,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, broadcastRepo, "AddBroadcast")
+	defer func() { end(span, err) }()
+	return r.BroadcastRepository.AddBroadcast(ctx, broadcast)
+}
+
+func (r *broadcastRepository) GetBroadcast(
+	ctx context.Context, txid string,
+) (_ *domain.Broadcast, err error) {
+	ctx, span := r.start(ctx, broadcastRepo, "GetBroadcast")
+	defer func() { end(span, err) }()
+	return r.BroadcastRepository.GetBroadcast(ctx, txid)
+}
+
+func (r *broadcastRepository) GetPendingBroadcasts(
+	ctx context.Context,
+) (_ []domain.Broadcast, err error) {
+	ctx, span := r.start(ctx, broadcastRepo, "GetPendingBroadcasts")
+	defer func() { end(span, err) }()
+	return r.BroadcastRepository.GetPendingBroadcasts(ctx)
+}
+
+func (r *broadcastRepository) UpdateBroadcasts(
+	ctx context.Context, broadcasts []domain.Broadcast,
+) (err error) {
+	ctx, span := r.start(ctx, broadcastRepo, "UpdateBroadcasts")
+	defer func() { end(span, err) }()
+	return r.BroadcastRepository.UpdateBroadcasts(ctx, broadcasts)
+}
@@ -0,0 +1,54 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const idempotencyRepo = "IdempotencyKeyRepository"
+
+type idempotencyRepository struct {
+	domain.IdempotencyKeyRepository
+	spanner
+}
+
+func (r *idempotencyRepository) AddKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, idempotencyRepo, "AddKey")
+	defer func() { end(span, err) }()
+	return r.IdempotencyKeyRepository.AddKey(ctx, key)
+}
+
+func (r *idempotencyRepository) GetKey(
+	ctx context.Context, key string,
+) (_ *domain.IdempotencyKey, err error) {
+	ctx, span := r.start(ctx, idempotencyRepo, "GetKey")
+	defer func() { end(span, err) }()
+	return r.IdempotencyKeyRepository.GetKey(ctx, key)
+}
+
+func (r *idempotencyRepository) UpdateKey(
+	ctx context.Context, key *domain.IdempotencyKey,
+) (err error) {
+	ctx, span := r.start(ctx, idempotencyRepo, "UpdateKey")
+	defer func() { end(span, err) }()
+	return r.IdempotencyKeyRepository.UpdateKey(ctx, key)
+}
+
+func (r *idempotencyRepository) DeleteKey(
+	ctx context.Context, key string,
+) (err error) {
+	ctx, span := r.start(ctx, idempotencyRepo, "DeleteKey")
+	defer func() { end(span, err) }()
+	return r.IdempotencyKeyRepository.DeleteKey(ctx, key)
+}
+
+func (r *idempotencyRepository) DeleteExpiredKeys(
+	ctx context.Context, now int64,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, idempotencyRepo, "DeleteExpiredKeys")
+	defer func() { end(span, err) }()
+	return r.IdempotencyKeyRepository.DeleteExpiredKeys(ctx, now)
+}
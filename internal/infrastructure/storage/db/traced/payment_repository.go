@@ -0,0 +1,54 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const paymentRepo = "PaymentRepository"
+
+type paymentRepository struct {
+	domain.PaymentRepository
+	spanner
+}
+
+func (r *paymentRepository) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, paymentRepo, "AddPayment")
+	defer func() { end(span, err) }()
+	return r.PaymentRepository.AddPayment(ctx, payment)
+}
+
+func (r *paymentRepository) GetPayment(
+	ctx context.Context, id string,
+) (_ *domain.Payment, err error) {
+	ctx, span := r.start(ctx, paymentRepo, "GetPayment")
+	defer func() { end(span, err) }()
+	return r.PaymentRepository.GetPayment(ctx, id)
+}
+
+func (r *paymentRepository) GetQueuedPayments(
+	ctx context.Context, accountName string,
+) (_ []domain.Payment, err error) {
+	ctx, span := r.start(ctx, paymentRepo, "GetQueuedPayments")
+	defer func() { end(span, err) }()
+	return r.PaymentRepository.GetQueuedPayments(ctx, accountName)
+}
+
+func (r *paymentRepository) GetPaymentsByTxid(
+	ctx context.Context, txid string,
+) (_ []domain.Payment, err error) {
+	ctx, span := r.start(ctx, paymentRepo, "GetPaymentsByTxid")
+	defer func() { end(span, err) }()
+	return r.PaymentRepository.GetPaymentsByTxid(ctx, txid)
+}
+
+func (r *paymentRepository) UpdatePayments(
+	ctx context.Context, payments []domain.Payment,
+) (err error) {
+	ctx, span := r.start(ctx, paymentRepo, "UpdatePayments")
+	defer func() { end(span, err) }()
+	return r.PaymentRepository.UpdatePayments(ctx, payments)
+}
@@ -0,0 +1,79 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const policyRepo = "SpendingPolicyRepository"
+
+type policyRepository struct {
+	domain.SpendingPolicyRepository
+	spanner
+}
+
+func (r *policyRepository) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) (err error) {
+	ctx, span := r.start(ctx, policyRepo, "SetPolicy")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.SetPolicy(ctx, policy)
+}
+
+func (r *policyRepository) GetPolicy(
+	ctx context.Context, accountName string,
+) (_ *domain.SpendingPolicy, err error) {
+	ctx, span := r.start(ctx, policyRepo, "GetPolicy")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.GetPolicy(ctx, accountName)
+}
+
+func (r *policyRepository) UpdatePolicy(
+	ctx context.Context, accountName string,
+	updateFn func(p *domain.SpendingPolicy) (*domain.SpendingPolicy, error),
+) (err error) {
+	ctx, span := r.start(ctx, policyRepo, "UpdatePolicy")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.UpdatePolicy(ctx, accountName, updateFn)
+}
+
+func (r *policyRepository) DeletePolicy(
+	ctx context.Context, accountName string,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, policyRepo, "DeletePolicy")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.DeletePolicy(ctx, accountName)
+}
+
+func (r *policyRepository) AddApproval(
+	ctx context.Context, approval *domain.SpendApproval,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, policyRepo, "AddApproval")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.AddApproval(ctx, approval)
+}
+
+func (r *policyRepository) GetApproval(
+	ctx context.Context, id string,
+) (_ *domain.SpendApproval, err error) {
+	ctx, span := r.start(ctx, policyRepo, "GetApproval")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.GetApproval(ctx, id)
+}
+
+func (r *policyRepository) GetAllApprovals(
+	ctx context.Context,
+) (_ []domain.SpendApproval, err error) {
+	ctx, span := r.start(ctx, policyRepo, "GetAllApprovals")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.GetAllApprovals(ctx)
+}
+
+func (r *policyRepository) DeleteApproval(
+	ctx context.Context, id string,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, policyRepo, "DeleteApproval")
+	defer func() { end(span, err) }()
+	return r.SpendingPolicyRepository.DeleteApproval(ctx, id)
+}
@@ -0,0 +1,120 @@
+// Package traceddb decorates any repository manager by recording a span for
+// every call to its repositories.
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer(
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/traced",
+)
+
+type repoManager struct {
+	ports.RepoManager
+
+	walletRepository *walletRepository
+	utxoRepository   *utxoRepository
+	txRepository     *txRepository
+	scriptRepository *scriptRepository
+	policyRepository *policyRepository
+	auditRepository  *auditRepository
+	idempotencyRepo  *idempotencyRepository
+	paymentRepo      *paymentRepository
+	addressLabelRepo *addressLabelRepository
+	broadcastRepo    *broadcastRepository
+	scheduledTxRepo  *scheduledTxRepository
+}
+
+// NewRepoManager returns a repo manager that wraps the given one of the
+// given type, and traces every call to the underlying repositories.
+func NewRepoManager(rm ports.RepoManager, dbType string) ports.RepoManager {
+	s := spanner{attribute.String("db.system", dbType)}
+	return &repoManager{
+		RepoManager:      rm,
+		walletRepository: &walletRepository{rm.WalletRepository(), s},
+		utxoRepository:   &utxoRepository{rm.UtxoRepository(), s},
+		txRepository:     &txRepository{rm.TransactionRepository(), s},
+		scriptRepository: &scriptRepository{rm.ExternalScriptRepository(), s},
+		policyRepository: &policyRepository{rm.SpendingPolicyRepository(), s},
+		auditRepository:  &auditRepository{rm.AuditEventRepository(), s},
+		idempotencyRepo:  &idempotencyRepository{rm.IdempotencyKeyRepository(), s},
+		paymentRepo:      &paymentRepository{rm.PaymentRepository(), s},
+		addressLabelRepo: &addressLabelRepository{rm.AddressLabelRepository(), s},
+		broadcastRepo:    &broadcastRepository{rm.BroadcastRepository(), s},
+		scheduledTxRepo:  &scheduledTxRepository{rm.ScheduledTxRepository(), s},
+	}
+}
+
+func (rm *repoManager) WalletRepository() domain.WalletRepository {
+	return rm.walletRepository
+}
+
+func (rm *repoManager) UtxoRepository() domain.UtxoRepository {
+	return rm.utxoRepository
+}
+
+func (rm *repoManager) TransactionRepository() domain.TransactionRepository {
+	return rm.txRepository
+}
+
+func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepository {
+	return rm.scriptRepository
+}
+
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
+func (rm *repoManager) AuditEventRepository() domain.AuditEventRepository {
+	return rm.auditRepository
+}
+
+func (rm *repoManager) IdempotencyKeyRepository() domain.IdempotencyKeyRepository {
+	return rm.idempotencyRepo
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepo
+}
+
+func (rm *repoManager) AddressLabelRepository() domain.AddressLabelRepository {
+	return rm.addressLabelRepo
+}
+
+func (rm *repoManager) BroadcastRepository() domain.BroadcastRepository {
+	return rm.broadcastRepo
+}
+
+func (rm *repoManager) ScheduledTxRepository() domain.ScheduledTxRepository {
+	return rm.scheduledTxRepo
+}
+
+type spanner struct {
+	dbSystem attribute.KeyValue
+}
+
+func (s spanner) start(
+	ctx context.Context, repo, method string,
+) (context.Context, trace.Span) {
+	return tracer.Start(
+		ctx, repo+"."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(s.dbSystem),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
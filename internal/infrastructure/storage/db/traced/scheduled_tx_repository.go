@@ -0,0 +1,54 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const scheduledTxRepo = "ScheduledTxRepository"
+
+type scheduledTxRepository struct {
+	domain.ScheduledTxRepository
+	spanner
+}
+
+func (r *scheduledTxRepository) AddScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, scheduledTxRepo, "AddScheduledTx")
+	defer func() { end(span, err) }()
+	return r.ScheduledTxRepository.AddScheduledTx(ctx, tx)
+}
+
+func (r *scheduledTxRepository) GetScheduledTx(
+	ctx context.Context, txid string,
+) (_ *domain.ScheduledTx, err error) {
+	ctx, span := r.start(ctx, scheduledTxRepo, "GetScheduledTx")
+	defer func() { end(span, err) }()
+	return r.ScheduledTxRepository.GetScheduledTx(ctx, txid)
+}
+
+func (r *scheduledTxRepository) GetAllScheduledTxs(
+	ctx context.Context,
+) (_ []domain.ScheduledTx, err error) {
+	ctx, span := r.start(ctx, scheduledTxRepo, "GetAllScheduledTxs")
+	defer func() { end(span, err) }()
+	return r.ScheduledTxRepository.GetAllScheduledTxs(ctx)
+}
+
+func (r *scheduledTxRepository) GetPendingScheduledTxs(
+	ctx context.Context,
+) (_ []domain.ScheduledTx, err error) {
+	ctx, span := r.start(ctx, scheduledTxRepo, "GetPendingScheduledTxs")
+	defer func() { end(span, err) }()
+	return r.ScheduledTxRepository.GetPendingScheduledTxs(ctx)
+}
+
+func (r *scheduledTxRepository) UpdateScheduledTx(
+	ctx context.Context, tx *domain.ScheduledTx,
+) (err error) {
+	ctx, span := r.start(ctx, scheduledTxRepo, "UpdateScheduledTx")
+	defer func() { end(span, err) }()
+	return r.ScheduledTxRepository.UpdateScheduledTx(ctx, tx)
+}
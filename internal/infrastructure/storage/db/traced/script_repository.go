@@ -0,0 +1,38 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const scriptRepo = "ExternalScriptRepository"
+
+type scriptRepository struct {
+	domain.ExternalScriptRepository
+	spanner
+}
+
+func (r *scriptRepository) AddScript(
+	ctx context.Context, script domain.AddressInfo,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, scriptRepo, "AddScript")
+	defer func() { end(span, err) }()
+	return r.ExternalScriptRepository.AddScript(ctx, script)
+}
+
+func (r *scriptRepository) GetAllScripts(
+	ctx context.Context,
+) (_ []domain.AddressInfo, err error) {
+	ctx, span := r.start(ctx, scriptRepo, "GetAllScripts")
+	defer func() { end(span, err) }()
+	return r.ExternalScriptRepository.GetAllScripts(ctx)
+}
+
+func (r *scriptRepository) DeleteScript(
+	ctx context.Context, scriptHash string,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, scriptRepo, "DeleteScript")
+	defer func() { end(span, err) }()
+	return r.ExternalScriptRepository.DeleteScript(ctx, scriptHash)
+}
@@ -0,0 +1,58 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const txRepo = "TransactionRepository"
+
+type txRepository struct {
+	domain.TransactionRepository
+	spanner
+}
+
+func (r *txRepository) AddTransaction(
+	ctx context.Context, tx *domain.Transaction,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, txRepo, "AddTransaction")
+	defer func() { end(span, err) }()
+	return r.TransactionRepository.AddTransaction(ctx, tx)
+}
+
+func (r *txRepository) ConfirmTransaction(
+	ctx context.Context,
+	txid, blockHash string, blockheight uint64, blocktime int64,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, txRepo, "ConfirmTransaction")
+	defer func() { end(span, err) }()
+	return r.TransactionRepository.ConfirmTransaction(
+		ctx, txid, blockHash, blockheight, blocktime,
+	)
+}
+
+func (r *txRepository) EvictTransaction(
+	ctx context.Context, txid, replacementTxid string,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, txRepo, "EvictTransaction")
+	defer func() { end(span, err) }()
+	return r.TransactionRepository.EvictTransaction(ctx, txid, replacementTxid)
+}
+
+func (r *txRepository) GetTransaction(
+	ctx context.Context, txid string,
+) (_ *domain.Transaction, err error) {
+	ctx, span := r.start(ctx, txRepo, "GetTransaction")
+	defer func() { end(span, err) }()
+	return r.TransactionRepository.GetTransaction(ctx, txid)
+}
+
+func (r *txRepository) UpdateTransaction(
+	ctx context.Context, txid string,
+	updateFn func(tx *domain.Transaction) (*domain.Transaction, error),
+) (err error) {
+	ctx, span := r.start(ctx, txRepo, "UpdateTransaction")
+	defer func() { end(span, err) }()
+	return r.TransactionRepository.UpdateTransaction(ctx, txid, updateFn)
+}
@@ -0,0 +1,185 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const utxoRepo = "UtxoRepository"
+
+type utxoRepository struct {
+	domain.UtxoRepository
+	spanner
+}
+
+func (r *utxoRepository) AddUtxos(
+	ctx context.Context, utxos []*domain.Utxo,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "AddUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.AddUtxos(ctx, utxos)
+}
+
+func (r *utxoRepository) GetUtxosByKey(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetUtxosByKey")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetUtxosByKey(ctx, utxoKeys)
+}
+
+func (r *utxoRepository) GetAllUtxos(
+	ctx context.Context,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetAllUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetAllUtxos(ctx)
+}
+
+func (r *utxoRepository) GetSpendableUtxos(
+	ctx context.Context,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetSpendableUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetSpendableUtxos(ctx)
+}
+
+func (r *utxoRepository) GetAllUtxosForAccount(
+	ctx context.Context, account string,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetAllUtxosForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetAllUtxosForAccount(ctx, account)
+}
+
+func (r *utxoRepository) GetSpendableUtxosForAccount(
+	ctx context.Context, account string, scripts [][]byte,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetSpendableUtxosForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetSpendableUtxosForAccount(ctx, account, scripts)
+}
+
+func (r *utxoRepository) GetLockedUtxosForAccount(
+	ctx context.Context, account string, scripts [][]byte,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetLockedUtxosForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetLockedUtxosForAccount(ctx, account, scripts)
+}
+
+func (r *utxoRepository) GetFrozenUtxosForAccount(
+	ctx context.Context, account string, scripts [][]byte,
+) (_ []*domain.Utxo, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetFrozenUtxosForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetFrozenUtxosForAccount(ctx, account, scripts)
+}
+
+func (r *utxoRepository) GetBalanceForAccount(
+	ctx context.Context, account string,
+) (_ map[string]*domain.Balance, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "GetBalanceForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.GetBalanceForAccount(ctx, account)
+}
+
+func (r *utxoRepository) SpendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "SpendUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.SpendUtxos(ctx, utxoKeys, txid)
+}
+
+func (r *utxoRepository) RespendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, txid string,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "RespendUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.RespendUtxos(ctx, utxoKeys, txid)
+}
+
+func (r *utxoRepository) ConfirmSpendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "ConfirmSpendUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.ConfirmSpendUtxos(ctx, utxoKeys, status)
+}
+
+func (r *utxoRepository) ConfirmUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, status domain.UtxoStatus,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "ConfirmUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.ConfirmUtxos(ctx, utxoKeys, status)
+}
+
+func (r *utxoRepository) LockUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+	timestamp, expiryTimestamp int64, owner string,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "LockUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.LockUtxos(
+		ctx, utxoKeys, timestamp, expiryTimestamp, owner,
+	)
+}
+
+func (r *utxoRepository) UnlockUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "UnlockUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.UnlockUtxos(ctx, utxoKeys)
+}
+
+func (r *utxoRepository) ReleaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, owner string,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "ReleaseUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.ReleaseUtxos(ctx, utxoKeys, owner)
+}
+
+func (r *utxoRepository) FreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "FreezeUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.FreezeUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (r *utxoRepository) UnfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "UnfreezeUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.UnfreezeUtxos(ctx, utxoKeys)
+}
+
+func (r *utxoRepository) EvictUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp int64,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "EvictUtxos")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.EvictUtxos(ctx, utxoKeys, timestamp)
+}
+
+func (r *utxoRepository) SetUtxoLabels(
+	ctx context.Context, utxoKeys []domain.UtxoKey, labels []string,
+) (_ int, err error) {
+	ctx, span := r.start(ctx, utxoRepo, "SetUtxoLabels")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.SetUtxoLabels(ctx, utxoKeys, labels)
+}
+
+func (r *utxoRepository) DeleteUtxosForAccount(
+	ctx context.Context, accountName string,
+) (err error) {
+	ctx, span := r.start(ctx, utxoRepo, "DeleteUtxosForAccount")
+	defer func() { end(span, err) }()
+	return r.UtxoRepository.DeleteUtxosForAccount(ctx, accountName)
+}
@@ -0,0 +1,93 @@
+package traceddb
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+const walletRepo = "WalletRepository"
+
+type walletRepository struct {
+	domain.WalletRepository
+	spanner
+}
+
+func (r *walletRepository) CreateWallet(
+	ctx context.Context, wallet *domain.Wallet,
+) (err error) {
+	ctx, span := r.start(ctx, walletRepo, "CreateWallet")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.CreateWallet(ctx, wallet)
+}
+
+func (r *walletRepository) GetWallet(
+	ctx context.Context,
+) (_ *domain.Wallet, err error) {
+	ctx, span := r.start(ctx, walletRepo, "GetWallet")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.GetWallet(ctx)
+}
+
+func (r *walletRepository) UnlockWallet(
+	ctx context.Context, password string,
+) (err error) {
+	ctx, span := r.start(ctx, walletRepo, "UnlockWallet")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.UnlockWallet(ctx, password)
+}
+
+func (r *walletRepository) LockWallet(
+	ctx context.Context, password string,
+) (err error) {
+	ctx, span := r.start(ctx, walletRepo, "LockWallet")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.LockWallet(ctx, password)
+}
+
+func (r *walletRepository) UpdateWallet(
+	ctx context.Context,
+	updateFn func(v *domain.Wallet) (*domain.Wallet, error),
+) (err error) {
+	ctx, span := r.start(ctx, walletRepo, "UpdateWallet")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.UpdateWallet(ctx, updateFn)
+}
+
+func (r *walletRepository) CreateAccount(
+	ctx context.Context, accountName string, birthdayBlock uint32, unconf bool,
+) (_ *domain.AccountInfo, err error) {
+	ctx, span := r.start(ctx, walletRepo, "CreateAccount")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.CreateAccount(
+		ctx, accountName, birthdayBlock, unconf,
+	)
+}
+
+func (r *walletRepository) DeriveNextExternalAddressesForAccount(
+	ctx context.Context, accountName string, numOfAddresses uint64,
+) (_ []domain.AddressInfo, err error) {
+	ctx, span := r.start(ctx, walletRepo, "DeriveNextExternalAddressesForAccount")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.DeriveNextExternalAddressesForAccount(
+		ctx, accountName, numOfAddresses,
+	)
+}
+
+func (r *walletRepository) DeriveNextInternalAddressesForAccount(
+	ctx context.Context, accountName string, numOfAddresses uint64,
+) (_ []domain.AddressInfo, err error) {
+	ctx, span := r.start(ctx, walletRepo, "DeriveNextInternalAddressesForAccount")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.DeriveNextInternalAddressesForAccount(
+		ctx, accountName, numOfAddresses,
+	)
+}
+
+func (r *walletRepository) DeleteAccount(
+	ctx context.Context, accountName string,
+) (err error) {
+	ctx, span := r.start(ctx, walletRepo, "DeleteAccount")
+	defer func() { end(span, err) }()
+	return r.WalletRepository.DeleteAccount(ctx, accountName)
+}
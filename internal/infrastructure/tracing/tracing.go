@@ -0,0 +1,87 @@
+// Package tracing configures the OpenTelemetry tracer provider used by the
+// instrumented packages of the daemon.
+// By default, the global provider is a no-op one, so spans are recorded only
+// once an OTLP exporter is configured.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+const serviceName = "oceand"
+
+// Config holds the configuration of the OTLP exporter.
+//   - Endpoint - (optional) The host:port of the OTLP gRPC collector. Tracing is disabled if empty.
+//   - Insecure - (optional) Whether to connect to the collector without TLS.
+//   - SampleRatio - (optional) The fraction of traces to sample, in range (0, 1]. Defaults to 1.
+//   - Version - (optional) The version of the daemon attached to every span.
+type Config struct {
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+	Version     string
+}
+
+func (c Config) validate() error {
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio must be in range (0, 1]")
+	}
+	return nil
+}
+
+// Init sets the global tracer provider exporting spans to the configured OTLP
+// collector, and returns the function to flush the pending spans and stop
+// the exporter.
+// The global no-op provider is left untouched if no endpoint is configured.
+func Init(config Config) (func(context.Context) error, error) {
+	if len(config.Endpoint) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: %s", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(config.Version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	sampleRatio := config.SampleRatio
+	if sampleRatio == 0 {
+		sampleRatio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(sampleRatio),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
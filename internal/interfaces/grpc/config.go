@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"strings"
 
+	grpc_handler "github.com/vulpemventures/ocean/internal/interfaces/grpc/handler"
+	grpc_interceptor "github.com/vulpemventures/ocean/internal/interfaces/grpc/interceptor"
 	"golang.org/x/net/http2"
 )
 
@@ -16,17 +19,65 @@ const (
 )
 
 type ServiceConfig struct {
-	Port         int
-	NoTLS        bool
-	TLSLocation  string
-	ExtraIPs     []string
-	ExtraDomains []string
+	Port          int
+	RestPort      int
+	NoTLS         bool
+	TLSLocation   string
+	ExtraIPs      []string
+	ExtraDomains  []string
+	ClientCA      string
+	ClientRoles   []string
+	RateLimits    []string
+	RequestLimits grpc_handler.RequestLimits
 }
 
 func (c ServiceConfig) validate() error {
 	if c.Port < minPort || c.Port > maxPort {
 		return fmt.Errorf("port must be in range [%d, %d]", minPort, maxPort)
 	}
+	if c.withRest() {
+		if c.RestPort < minPort || c.RestPort > maxPort {
+			return fmt.Errorf(
+				"rest port must be in range [%d, %d]", minPort, maxPort,
+			)
+		}
+		if c.RestPort == c.Port {
+			return fmt.Errorf("rest port must be different from port")
+		}
+	}
+	if len(c.ClientCA) > 0 {
+		if c.insecure() {
+			return fmt.Errorf("client CA requires TLS to be enabled")
+		}
+		if !pathExists(c.ClientCA) {
+			return fmt.Errorf("client CA file %s not found", c.ClientCA)
+		}
+		if len(c.ClientRoles) <= 0 {
+			return fmt.Errorf("client roles must not be empty if client CA is set")
+		}
+	}
+	for _, r := range c.ClientRoles {
+		subject, role, ok := strings.Cut(r, ":")
+		if !ok || len(subject) <= 0 {
+			return fmt.Errorf(
+				"invalid client role %s, must be in the form subject:role", r,
+			)
+		}
+		if !grpc_interceptor.IsValidRole(role) {
+			return fmt.Errorf(
+				"unknown role %s for subject %s, must be one of %s", role, subject,
+				[]string{
+					grpc_interceptor.RoleReadonly, grpc_interceptor.RoleOperator,
+					grpc_interceptor.RoleAdmin,
+				},
+			)
+		}
+	}
+	for _, l := range c.RateLimits {
+		if _, _, err := grpc_interceptor.ParseRateLimit(l); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -34,28 +85,79 @@ func (c ServiceConfig) insecure() bool {
 	return c.NoTLS
 }
 
+// withRest returns whether the REST gateway is enabled.
+func (c ServiceConfig) withRest() bool {
+	return c.RestPort > 0
+}
+
+func (c ServiceConfig) withClientAuth() bool {
+	return !c.insecure() && len(c.ClientCA) > 0
+}
+
+// clientRoles returns the roles mapped by the subject of the client
+// certificates, or nil if client authentication is disabled.
+func (c ServiceConfig) clientRoles() map[string]string {
+	if !c.withClientAuth() {
+		return nil
+	}
+	roles := make(map[string]string)
+	for _, r := range c.ClientRoles {
+		subject, role, _ := strings.Cut(r, ":")
+		roles[subject] = role
+	}
+	return roles
+}
+
+// rateLimiter returns the limiter enforcing the configured budgets, or nil if
+// rate limiting is disabled.
+func (c ServiceConfig) rateLimiter() *grpc_interceptor.RateLimiter {
+	if len(c.RateLimits) <= 0 {
+		return nil
+	}
+	limits := make(map[string]grpc_interceptor.RateLimit)
+	for _, l := range c.RateLimits {
+		method, limit, _ := grpc_interceptor.ParseRateLimit(l)
+		limits[method] = limit
+	}
+	return grpc_interceptor.NewRateLimiter(limits)
+}
+
 func (c ServiceConfig) address() string {
 	return fmt.Sprintf(":%d", c.Port)
 }
 
+// listener returns the plain tcp listener, the TLS handshake is up to the
+// gRPC server credentials.
 func (c ServiceConfig) listener() net.Listener {
 	lis, _ := net.Listen("tcp", c.address())
+	return lis
+}
 
-	if c.insecure() {
-		return lis
+func (c ServiceConfig) restAddress() string {
+	return fmt.Sprintf(":%d", c.RestPort)
+}
+
+// restListener returns the tcp listener for the REST gateway, wrapped with
+// the given TLS config, if any.
+func (c ServiceConfig) restListener(tlsConfig *tls.Config) (net.Listener, error) {
+	lis, err := net.Listen("tcp", c.restAddress())
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		return lis, nil
 	}
-	return tls.NewListener(lis, c.tlsConfig())
+	return tls.NewListener(lis, tlsConfig), nil
 }
 
+// tlsConfig returns the base TLS config, without certificates.
 func (c ServiceConfig) tlsConfig() *tls.Config {
 	if c.insecure() {
 		return nil
 	}
-	cert, _ := tls.LoadX509KeyPair(c.tlsCertPath(), c.tlsKeyPath())
 	return &tls.Config{
-		MinVersion:   tls.VersionTLS12,
-		NextProtos:   []string{"http/1.1", http2.NextProtoTLS, "h2-14"}, // h2-14 is just for compatibility. will be eventually removed.
-		Certificates: []tls.Certificate{cert},
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"http/1.1", http2.NextProtoTLS, "h2-14"}, // h2-14 is just for compatibility. will be eventually removed.
 		CipherSuites: []uint16{
 			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
 			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
@@ -0,0 +1,377 @@
+package grpc_interface
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	grpc_interceptor "github.com/vulpemventures/ocean/internal/interfaces/grpc/interceptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	gatewayBufferSize = 1024 * 1024
+
+	// Paths of the notification streams served as Server-Sent Events.
+	txNotificationsPath        = "/v1/notifications/transactions"
+	utxoNotificationsPath      = "/v1/notifications/utxos"
+	paymentNotificationsPath   = "/v1/notifications/payments"
+	broadcastNotificationsPath = "/v1/notifications/broadcasts"
+
+	// Paths of the liveness and readiness probes.
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
+
+// restGateway serves the REST/JSON version of the gRPC services, along with
+// the liveness and readiness probes.
+// Every HTTP request is translated into a gRPC call to an in-process server,
+// not reachable from the outside, that identifies and authorizes the caller
+// based on the TLS client info forwarded by the gateway. This way, the REST
+// interface is subject to the same authentication and audit of the gRPC one.
+type restGateway struct {
+	config     ServiceConfig
+	tlsConfig  *tls.Config
+	healthSvc  *application.HealthService
+	grpcServer *grpc.Server
+	conn       *grpc.ClientConn
+	httpServer *http.Server
+
+	streamsCtx   context.Context
+	closeStreams context.CancelFunc
+}
+
+func newRestGateway(
+	config ServiceConfig, tlsConfig *tls.Config,
+	auditSvc grpc_interceptor.AuditServiceFn,
+	healthSvc *application.HealthService,
+	limiter *grpc_interceptor.RateLimiter, registerHandlers func(*grpc.Server),
+) (*restGateway, error) {
+	grpcServer := grpc.NewServer(
+		grpc_interceptor.GatewayUnaryInterceptor(
+			auditSvc, config.clientRoles(), limiter,
+		),
+		grpc_interceptor.GatewayStreamInterceptor(
+			auditSvc, config.clientRoles(), limiter,
+		),
+	)
+	registerHandlers(grpcServer)
+
+	lis := bufconn.Listen(gatewayBufferSize)
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///ocean",
+		grpc.WithContextDialer(func(
+			ctx context.Context, _ string,
+		) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithMetadata(gatewayMetadata),
+	)
+	registerFns := []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		pb.RegisterWalletServiceHandler,
+		pb.RegisterAccountServiceHandler,
+		pb.RegisterTransactionServiceHandler,
+		pb.RegisterNotificationServiceHandler,
+	}
+	for _, register := range registerFns {
+		if err := register(context.Background(), mux, conn); err != nil {
+			conn.Close()
+			grpcServer.Stop()
+			return nil, err
+		}
+	}
+
+	streamsCtx, closeStreams := context.WithCancel(context.Background())
+	gw := &restGateway{
+		config:       config,
+		tlsConfig:    tlsConfig,
+		healthSvc:    healthSvc,
+		grpcServer:   grpcServer,
+		conn:         conn,
+		httpServer:   &http.Server{Handler: mux},
+		streamsCtx:   streamsCtx,
+		closeStreams: closeStreams,
+	}
+
+	notifyClient := pb.NewNotificationServiceClient(conn)
+	if err := mux.HandlePath(
+		http.MethodGet, txNotificationsPath, gw.serveEvents(
+			mux, "/ocean.v1.NotificationService/TransactionNotifications",
+			func(ctx context.Context) (grpc.ClientStream, error) {
+				return notifyClient.TransactionNotifications(
+					ctx, &pb.TransactionNotificationsRequest{},
+				)
+			},
+			func() proto.Message { return &pb.TransactionNotificationsResponse{} },
+		),
+	); err != nil {
+		gw.stop()
+		return nil, err
+	}
+	if err := mux.HandlePath(
+		http.MethodGet, utxoNotificationsPath, gw.serveEvents(
+			mux, "/ocean.v1.NotificationService/UtxosNotifications",
+			func(ctx context.Context) (grpc.ClientStream, error) {
+				return notifyClient.UtxosNotifications(
+					ctx, &pb.UtxosNotificationsRequest{},
+				)
+			},
+			func() proto.Message { return &pb.UtxosNotificationsResponse{} },
+		),
+	); err != nil {
+		gw.stop()
+		return nil, err
+	}
+	if err := mux.HandlePath(
+		http.MethodGet, paymentNotificationsPath, gw.serveEvents(
+			mux, "/ocean.v1.NotificationService/PaymentNotifications",
+			func(ctx context.Context) (grpc.ClientStream, error) {
+				return notifyClient.PaymentNotifications(
+					ctx, &pb.PaymentNotificationsRequest{},
+				)
+			},
+			func() proto.Message { return &pb.PaymentNotificationsResponse{} },
+		),
+	); err != nil {
+		gw.stop()
+		return nil, err
+	}
+	if err := mux.HandlePath(
+		http.MethodGet, broadcastNotificationsPath, gw.serveEvents(
+			mux, "/ocean.v1.NotificationService/BroadcastNotifications",
+			func(ctx context.Context) (grpc.ClientStream, error) {
+				return notifyClient.BroadcastNotifications(
+					ctx, &pb.BroadcastNotificationsRequest{},
+				)
+			},
+			func() proto.Message { return &pb.BroadcastNotificationsResponse{} },
+		),
+	); err != nil {
+		gw.stop()
+		return nil, err
+	}
+
+	// The probes are not subject to authorization, they don't leak any info
+	// about the wallet.
+	if err := mux.HandlePath(http.MethodGet, healthzPath, gw.serveLiveness); err != nil {
+		gw.stop()
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, readyzPath, gw.serveReadiness); err != nil {
+		gw.stop()
+		return nil, err
+	}
+
+	return gw, nil
+}
+
+func (g *restGateway) start() error {
+	lis, err := g.config.restListener(g.tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := g.httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Warn("rest gateway: unexpected server shutdown")
+		}
+	}()
+	return nil
+}
+
+// stop closes all the open notification streams before shutting down the
+// http server, otherwise it would wait forever for them to terminate.
+func (g *restGateway) stop() {
+	g.closeStreams()
+	g.httpServer.Shutdown(context.Background())
+	g.conn.Close()
+	g.grpcServer.GracefulStop()
+}
+
+// serveLiveness just lets the caller know the daemon is up and running.
+func (g *restGateway) serveLiveness(
+	w http.ResponseWriter, _ *http.Request, _ map[string]string,
+) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// serveReadiness returns the result of the readiness checks, with status 503
+// if any of them failed.
+func (g *restGateway) serveReadiness(
+	w http.ResponseWriter, r *http.Request, _ map[string]string,
+) {
+	readiness := g.healthSvc.CheckReadiness(r.Context())
+
+	checks := make([]healthCheck, 0, len(readiness.Checks))
+	for _, c := range readiness.Checks {
+		checks = append(checks, healthCheck{c.Name, c.IsHealthy, c.Reason})
+	}
+	statusCode := http.StatusOK
+	if !readiness.IsReady {
+		statusCode = http.StatusServiceUnavailable
+	}
+	writeJSON(w, statusCode, readinessResponse{readiness.IsReady, checks})
+}
+
+// serveEvents returns the handler that opens a notification stream with the
+// in-process server and forwards every received message to the client as a
+// Server-Sent Event.
+func (g *restGateway) serveEvents(
+	mux *runtime.ServeMux, method string,
+	openStream func(ctx context.Context) (grpc.ClientStream, error),
+	newMessage func() proto.Message,
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			runtime.HTTPError(
+				r.Context(), mux, outbound, w, r,
+				status.Error(codes.Unimplemented, "streaming not supported"),
+			)
+			return
+		}
+
+		streamCtx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-g.streamsCtx.Done():
+				cancel()
+			case <-streamCtx.Done():
+			}
+		}()
+
+		ctx, err := runtime.AnnotateContext(
+			streamCtx, mux, r, method, runtime.WithHTTPPathPattern(r.URL.Path),
+		)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		stream, err := openStream(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// The server sends the headers once the subscription is active,
+		// otherwise the stream is closed right away with an error, for example
+		// if the caller is not authorized.
+		if header, _ := stream.Header(); header == nil {
+			err := stream.RecvMsg(newMessage())
+			if err == io.EOF {
+				err = status.Error(codes.Unavailable, "stream closed by server")
+			}
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			msg := newMessage()
+			if err := stream.RecvMsg(msg); err != nil {
+				if ctx.Err() == nil && err != io.EOF {
+					writeEvent(w, outbound, "error", status.Convert(err).Proto())
+					flusher.Flush()
+				}
+				return
+			}
+			if err := writeEvent(w, outbound, "", msg); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeEvent writes the given message as a Server-Sent Event of the given
+// type. The type is omitted if empty.
+func writeEvent(
+	w io.Writer, marshaler runtime.Marshaler, event string, msg proto.Message,
+) error {
+	data, err := marshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if len(event) > 0 {
+		if _, err := fmt.Fprintf(w, "event: %s\n", event); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+type readinessResponse struct {
+	Ready  bool          `json:"ready"`
+	Checks []healthCheck `json:"checks"`
+}
+
+type healthCheck struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+// gatewayHeaderMatcher forwards the HTTP headers as gRPC metadata like the
+// default matcher, except for the client subject that must be set only by
+// the gateway itself. The header selecting the wallet is forwarded as is.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpc_interceptor.WalletIDMetadataKey) {
+		return grpc_interceptor.WalletIDMetadataKey, true
+	}
+	mdKey, ok := runtime.DefaultHeaderMatcher(key)
+	if strings.EqualFold(mdKey, grpc_interceptor.ClientSubjectMetadataKey) {
+		return "", false
+	}
+	return mdKey, ok
+}
+
+// gatewayMetadata forwards the subject of the verified TLS client certificate
+// of the HTTP request, if any, to the in-process gRPC server.
+func gatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	subject, ok := grpc_interceptor.TLSClientSubject(r.TLS)
+	if !ok {
+		return nil
+	}
+	return metadata.Pairs(grpc_interceptor.ClientSubjectMetadataKey, subject)
+}
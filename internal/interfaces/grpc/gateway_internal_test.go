@@ -0,0 +1,432 @@
+package grpc_interface
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	walletInfoPath    = "/v1/wallet/info"
+	transferPath      = "/v1/transaction/transfer"
+	deleteAccountPath = "/v1/account/delete"
+)
+
+func TestRestGateway(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other-ca")
+	viewer := ca.newClientCert(t, "viewer")
+	operator := ca.newClientCert(t, "operator")
+	admin := ca.newClientCert(t, "admin")
+	unmapped := ca.newClientCert(t, "unknown")
+
+	t.Run("with client auth", func(t *testing.T) {
+		handlers := &recordingHandlers{}
+		gw := newTestRestGateway(t, ca, []string{
+			"viewer:readonly", "operator:operator", "admin:admin",
+		}, handlers)
+
+		t.Run("identify caller by client certificate", func(t *testing.T) {
+			resp := gw.do(t, viewer, http.MethodGet, walletInfoPath, nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "viewer", handlers.lastCall().caller)
+			require.False(t, handlers.lastCall().isAdmin)
+		})
+
+		t.Run("ignore spoofed client subject", func(t *testing.T) {
+			spoofedHeaders := []string{
+				"X-Ocean-Client-Subject",
+				"Grpc-Metadata-X-Ocean-Client-Subject",
+			}
+			for _, header := range spoofedHeaders {
+				headers := map[string]string{header: "admin"}
+
+				// Without client certificate the caller is not authenticated.
+				resp := gw.do(t, nil, http.MethodGet, walletInfoPath, headers)
+				require.Equal(t, http.StatusUnauthorized, resp.StatusCode, header)
+
+				resp = gw.do(t, viewer, http.MethodPost, deleteAccountPath, headers)
+				require.Equal(t, http.StatusForbidden, resp.StatusCode, header)
+
+				resp = gw.do(t, viewer, http.MethodGet, walletInfoPath, headers)
+				require.Equal(t, http.StatusOK, resp.StatusCode, header)
+				require.Equal(t, "viewer", handlers.lastCall().caller, header)
+			}
+		})
+
+		t.Run("enforce roles", func(t *testing.T) {
+			tests := []struct {
+				name         string
+				cert         *tls.Certificate
+				method       string
+				path         string
+				expectedCode int
+			}{
+				{"missing certificate", nil, http.MethodGet, walletInfoPath, http.StatusUnauthorized},
+				{"unmapped subject", unmapped, http.MethodGet, walletInfoPath, http.StatusForbidden},
+				{"readonly calling operator rpc", viewer, http.MethodPost, transferPath, http.StatusForbidden},
+				{"operator calling operator rpc", operator, http.MethodPost, transferPath, http.StatusOK},
+				{"operator calling admin rpc", operator, http.MethodPost, deleteAccountPath, http.StatusForbidden},
+				{"admin calling admin rpc", admin, http.MethodPost, deleteAccountPath, http.StatusOK},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					resp := gw.do(t, tt.cert, tt.method, tt.path, nil)
+					require.Equal(t, tt.expectedCode, resp.StatusCode)
+				})
+			}
+			require.Equal(t, "admin", handlers.lastCall().caller)
+			require.True(t, handlers.lastCall().isAdmin)
+		})
+
+		t.Run("reject certificate of unknown CA", func(t *testing.T) {
+			_, err := gw.request(
+				t, otherCA.newClientCert(t, "admin"), http.MethodGet,
+				walletInfoPath, nil,
+			)
+			require.Error(t, err)
+		})
+
+		t.Run("notification streams", func(t *testing.T) {
+			paths := []string{
+				txNotificationsPath, utxoNotificationsPath,
+				paymentNotificationsPath, broadcastNotificationsPath,
+			}
+			for _, path := range paths {
+				resp := gw.do(t, viewer, http.MethodGet, path, nil)
+				require.Equal(t, http.StatusOK, resp.StatusCode, path)
+				require.Equal(
+					t, "text/event-stream", resp.Header.Get("Content-Type"), path,
+				)
+
+				events := readEvents(t, resp.Body)
+				require.Len(t, events, 3, path)
+				for _, e := range events[:2] {
+					require.Empty(t, e.name, path)
+					require.Contains(t, e.data, "EVENT_TYPE", path)
+				}
+				// Errors closing the stream are sent as events as well.
+				require.Equal(t, "error", events[2].name, path)
+				require.Contains(t, events[2].data, "stream closed", path)
+				require.Equal(t, "viewer", handlers.lastCall().caller, path)
+			}
+		})
+
+		t.Run("reject unauthorized notification streams", func(t *testing.T) {
+			resp := gw.do(t, nil, http.MethodGet, txNotificationsPath, nil)
+			require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NotEqual(
+				t, "text/event-stream", resp.Header.Get("Content-Type"),
+			)
+
+			resp = gw.do(t, unmapped, http.MethodGet, utxoNotificationsPath, nil)
+			require.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	})
+
+	t.Run("without client auth", func(t *testing.T) {
+		handlers := &recordingHandlers{}
+		gw := newTestRestGateway(t, nil, nil, handlers)
+
+		t.Run("identify caller by remote address", func(t *testing.T) {
+			resp := gw.do(t, nil, http.MethodGet, walletInfoPath, nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "127.0.0.1", handlers.lastCall().caller)
+		})
+
+		t.Run("ignore spoofed forwarded address", func(t *testing.T) {
+			spoofedHeaders := []string{
+				"X-Forwarded-For",
+				"Grpc-Metadata-X-Forwarded-For",
+			}
+			for _, header := range spoofedHeaders {
+				headers := map[string]string{header: "6.6.6.6"}
+				resp := gw.do(t, nil, http.MethodGet, walletInfoPath, headers)
+				require.Equal(t, http.StatusOK, resp.StatusCode, header)
+				require.Equal(t, "127.0.0.1", handlers.lastCall().caller, header)
+			}
+		})
+
+		t.Run("ignore spoofed client subject", func(t *testing.T) {
+			headers := map[string]string{"X-Ocean-Client-Subject": "admin"}
+			resp := gw.do(t, nil, http.MethodPost, deleteAccountPath, headers)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "127.0.0.1", handlers.lastCall().caller)
+			require.False(t, handlers.lastCall().isAdmin)
+		})
+	})
+}
+
+type testRestGateway struct {
+	server *httptest.Server
+}
+
+// newTestRestGateway serves the REST gateway backed by the given handlers
+// over TLS. Client certificates signed by the given CA, if any, are verified
+// and their subjects mapped to the given roles.
+func newTestRestGateway(
+	t *testing.T, ca *testCA, clientRoles []string, handlers *recordingHandlers,
+) *testRestGateway {
+	config := ServiceConfig{}
+	tlsConfig := &tls.Config{}
+	if ca != nil {
+		// Only the CA path is checked to enable client authentication.
+		config.ClientCA = "ca.pem"
+		config.ClientRoles = clientRoles
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(ca.cert)
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	auditLog := application.NewAuditService(inmemory.NewRepoManager())
+	auditSvc := func(context.Context) (*application.AuditService, error) {
+		return auditLog, nil
+	}
+	gw, err := newRestGateway(
+		config, tlsConfig, auditSvc, nil, nil,
+		func(s *grpc.Server) {
+			pb.RegisterWalletServiceServer(s, &walletStub{handlers: handlers})
+			pb.RegisterAccountServiceServer(s, &accountStub{handlers: handlers})
+			pb.RegisterTransactionServiceServer(
+				s, &transactionStub{handlers: handlers},
+			)
+			pb.RegisterNotificationServiceServer(
+				s, &notificationStub{handlers: handlers},
+			)
+		},
+	)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(gw.httpServer.Handler)
+	server.TLS = tlsConfig
+	server.StartTLS()
+	t.Cleanup(func() {
+		gw.stop()
+		server.Close()
+	})
+
+	return &testRestGateway{server}
+}
+
+// do makes the given request, failing the test in case of error.
+func (g *testRestGateway) do(
+	t *testing.T, cert *tls.Certificate, method, path string,
+	headers map[string]string,
+) *http.Response {
+	resp, err := g.request(t, cert, method, path, headers)
+	require.NoError(t, err)
+	return resp
+}
+
+func (g *testRestGateway) request(
+	t *testing.T, cert *tls.Certificate, method, path string,
+	headers map[string]string,
+) (*http.Response, error) {
+	transport := g.server.Client().Transport.(*http.Transport).Clone()
+	if cert != nil {
+		// Unlike the default client, always send the certificate, even if not
+		// signed by any of the CAs accepted by the server.
+		transport.TLSClientConfig.GetClientCertificate = func(
+			*tls.CertificateRequestInfo,
+		) (*tls.Certificate, error) {
+			return cert, nil
+		}
+	}
+	client := &http.Client{Transport: transport}
+	t.Cleanup(transport.CloseIdleConnections)
+
+	var body io.Reader
+	if method == http.MethodPost {
+		body = strings.NewReader("{}")
+	}
+	req, err := http.NewRequest(method, g.server.URL+path, body)
+	require.NoError(t, err)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp, nil
+}
+
+type event struct {
+	name string
+	data string
+}
+
+// readEvents reads the Server-Sent Events from the given body until the
+// stream is closed.
+func readEvents(t *testing.T, body io.Reader) []event {
+	events := make([]event, 0)
+	current := event{}
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			current.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		case line == "":
+			events = append(events, current)
+			current = event{}
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+type call struct {
+	caller  string
+	isAdmin bool
+}
+
+// recordingHandlers records the identity of the caller of every RPC served
+// by the stubs.
+type recordingHandlers struct {
+	lock  sync.Mutex
+	calls []call
+}
+
+func (h *recordingHandlers) record(ctx context.Context) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.calls = append(h.calls, call{
+		caller:  application.CallerFromContext(ctx),
+		isAdmin: application.HasAdminRights(ctx),
+	})
+}
+
+func (h *recordingHandlers) lastCall() call {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if len(h.calls) <= 0 {
+		return call{}
+	}
+	return h.calls[len(h.calls)-1]
+}
+
+type walletStub struct {
+	pb.UnimplementedWalletServiceServer
+	handlers *recordingHandlers
+}
+
+func (s *walletStub) GetInfo(
+	ctx context.Context, _ *pb.GetInfoRequest,
+) (*pb.GetInfoResponse, error) {
+	s.handlers.record(ctx)
+	return &pb.GetInfoResponse{}, nil
+}
+
+type accountStub struct {
+	pb.UnimplementedAccountServiceServer
+	handlers *recordingHandlers
+}
+
+func (s *accountStub) DeleteAccount(
+	ctx context.Context, _ *pb.DeleteAccountRequest,
+) (*pb.DeleteAccountResponse, error) {
+	s.handlers.record(ctx)
+	return &pb.DeleteAccountResponse{}, nil
+}
+
+type transactionStub struct {
+	pb.UnimplementedTransactionServiceServer
+	handlers *recordingHandlers
+}
+
+func (s *transactionStub) Transfer(
+	ctx context.Context, _ *pb.TransferRequest,
+) (*pb.TransferResponse, error) {
+	s.handlers.record(ctx)
+	return &pb.TransferResponse{}, nil
+}
+
+// notificationStub sends 2 notifications on every stream before closing it
+// with an error.
+type notificationStub struct {
+	pb.UnimplementedNotificationServiceServer
+	handlers *recordingHandlers
+}
+
+var errStreamClosed = status.Error(codes.Unavailable, "stream closed")
+
+func (s *notificationStub) TransactionNotifications(
+	_ *pb.TransactionNotificationsRequest,
+	stream pb.NotificationService_TransactionNotificationsServer,
+) error {
+	s.handlers.record(stream.Context())
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.TransactionNotificationsResponse{
+			EventType: pb.TxEventType_TX_EVENT_TYPE_BROADCASTED,
+		}); err != nil {
+			return err
+		}
+	}
+	return errStreamClosed
+}
+
+func (s *notificationStub) UtxosNotifications(
+	_ *pb.UtxosNotificationsRequest,
+	stream pb.NotificationService_UtxosNotificationsServer,
+) error {
+	s.handlers.record(stream.Context())
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.UtxosNotificationsResponse{
+			EventType: pb.UtxoEventType_UTXO_EVENT_TYPE_NEW,
+		}); err != nil {
+			return err
+		}
+	}
+	return errStreamClosed
+}
+
+func (s *notificationStub) PaymentNotifications(
+	_ *pb.PaymentNotificationsRequest,
+	stream pb.NotificationService_PaymentNotificationsServer,
+) error {
+	s.handlers.record(stream.Context())
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.PaymentNotificationsResponse{
+			EventType: pb.PaymentEventType_PAYMENT_EVENT_TYPE_QUEUED,
+		}); err != nil {
+			return err
+		}
+	}
+	return errStreamClosed
+}
+
+func (s *notificationStub) BroadcastNotifications(
+	_ *pb.BroadcastNotificationsRequest,
+	stream pb.NotificationService_BroadcastNotificationsServer,
+) error {
+	s.handlers.record(stream.Context())
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.BroadcastNotificationsResponse{
+			EventType: pb.BroadcastEventType_BROADCAST_EVENT_TYPE_PENDING,
+		}); err != nil {
+			return err
+		}
+	}
+	return errStreamClosed
+}
@@ -11,18 +11,30 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// AccountServiceFn returns the account service of the wallet selected by the
+// request.
+type AccountServiceFn func(ctx context.Context) (*application.AccountService, error)
+
 type account struct {
-	appSvc *application.AccountService
+	appSvc AccountServiceFn
+	limits RequestLimits
 }
 
-func NewAccountHandler(appSvc *application.AccountService) pb.AccountServiceServer {
-	return &account{appSvc: appSvc}
+func NewAccountHandler(
+	appSvc AccountServiceFn, limits RequestLimits,
+) pb.AccountServiceServer {
+	return &account{appSvc: appSvc, limits: limits.withDefaults()}
 }
 
 func (a *account) CreateAccountBIP44(
 	ctx context.Context, req *pb.CreateAccountBIP44Request,
 ) (*pb.CreateAccountBIP44Response, error) {
-	accountInfo, err := a.appSvc.CreateAccountBIP44(ctx, req.GetLabel(), req.GetUnconfidential())
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountInfo, err := appSvc.CreateAccountBIP44(ctx, req.GetLabel(), req.GetUnconfidential())
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +65,11 @@ func (a *account) CreateAccountCustom(
 func (a *account) SetAccountLabel(
 	ctx context.Context, req *pb.SetAccountLabelRequest,
 ) (*pb.SetAccountLabelResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountName, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -62,7 +79,7 @@ func (a *account) SetAccountLabel(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	accountInfo, err := a.appSvc.SetAccountLabel(ctx, accountName, label)
+	accountInfo, err := appSvc.SetAccountLabel(ctx, accountName, label)
 	if err != nil {
 		return nil, err
 	}
@@ -88,13 +105,21 @@ func (a *account) SetAccountTemplate(
 func (a *account) DeriveAddresses(
 	ctx context.Context, req *pb.DeriveAddressesRequest,
 ) (*pb.DeriveAddressesResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	numOfAddresses := req.GetNumOfAddresses()
+	numOfAddresses, err := a.limits.parseNumOfAddresses(req.GetNumOfAddresses())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	addressesInfo, err := a.appSvc.DeriveAddressesForAccount(
+	addressesInfo, err := appSvc.DeriveAddressesForAccount(
 		ctx, name, numOfAddresses,
 	)
 	if err != nil {
@@ -109,13 +134,21 @@ func (a *account) DeriveAddresses(
 func (a *account) DeriveChangeAddresses(
 	ctx context.Context, req *pb.DeriveChangeAddressesRequest,
 ) (*pb.DeriveChangeAddressesResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	numOfAddresses := req.GetNumOfAddresses()
+	numOfAddresses, err := a.limits.parseNumOfAddresses(req.GetNumOfAddresses())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	addressesInfo, err := a.appSvc.DeriveChangeAddressesForAccount(
+	addressesInfo, err := appSvc.DeriveChangeAddressesForAccount(
 		ctx, name, numOfAddresses,
 	)
 	if err != nil {
@@ -129,29 +162,40 @@ func (a *account) DeriveChangeAddresses(
 func (a *account) ListAddresses(
 	ctx context.Context, req *pb.ListAddressesRequest,
 ) (*pb.ListAddressesResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	addressesInfo, err := a.appSvc.ListAddressesForAccount(ctx, name)
+	addressesInfo, err := appSvc.ListAddressesForAccount(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.ListAddressesResponse{
-		Addresses: addressesInfo.Addresses(),
+		Addresses:     addressesInfo.Addresses(),
+		AddressLabels: parseAddressLabels(addressesInfo),
 	}, nil
 }
 
 func (a *account) Balance(
 	ctx context.Context, req *pb.BalanceRequest,
 ) (*pb.BalanceResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	balanceInfo, err := a.appSvc.GetBalanceForAccount(ctx, name)
+	balanceInfo, err := appSvc.GetBalanceForAccount(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +206,7 @@ func (a *account) Balance(
 			UnconfirmedBalance: b.Unconfirmed,
 			LockedBalance:      b.Locked,
 			TotalBalance:       b.Total(),
+			FrozenBalance:      b.Frozen,
 		}
 	}
 	return &pb.BalanceResponse{Balance: balance}, nil
@@ -170,6 +215,11 @@ func (a *account) Balance(
 func (a *account) ListUtxos(
 	ctx context.Context, req *pb.ListUtxosRequest,
 ) (*pb.ListUtxosResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -183,13 +233,20 @@ func (a *account) ListUtxos(
 		}
 		scripts = append(scripts, script)
 	}
+	filter := application.UtxoFilter{
+		Label:            req.GetLabel(),
+		Asset:            req.GetAsset(),
+		MinConfirmations: req.GetMinConfirmations(),
+		Frozen:           parseFrozenFilter(req.GetFrozenFilter()),
+	}
 
-	utxosInfo, err := a.appSvc.ListUtxosForAccount(ctx, name, scripts)
+	utxosInfo, err := appSvc.ListUtxosForAccount(ctx, name, scripts, filter)
 	if err != nil {
 		return nil, err
 	}
 	spendableUtxos := parseUtxos(utxosInfo.Spendable.Info())
 	lockedUtxos := parseUtxos(utxosInfo.Locked.Info())
+	frozenUtxos := parseUtxos(utxosInfo.Frozen.Info())
 	return &pb.ListUtxosResponse{
 		SpendableUtxos: &pb.Utxos{
 			AccountName: name,
@@ -199,19 +256,214 @@ func (a *account) ListUtxos(
 			AccountName: name,
 			Utxos:       lockedUtxos,
 		},
+		FrozenUtxos: &pb.Utxos{
+			AccountName: name,
+			Utxos:       frozenUtxos,
+		},
 	}, nil
 }
 
 func (a *account) DeleteAccount(
 	ctx context.Context, req *pb.DeleteAccountRequest,
 ) (*pb.DeleteAccountResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := a.appSvc.DeleteAccount(ctx, name); err != nil {
+	if err := appSvc.DeleteAccount(ctx, name); err != nil {
 		return nil, err
 	}
 	return &pb.DeleteAccountResponse{}, nil
 }
+
+func (a *account) SetSpendingPolicy(
+	ctx context.Context, req *pb.SetSpendingPolicyRequest,
+) (*pb.SetSpendingPolicyResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limits, err := parseAssetLimits(req.GetAssetLimits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scripts, err := parseScripts(req.GetAllowedScripts())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	policy, err := appSvc.SetSpendingPolicy(
+		ctx, name, password, limits, scripts, req.GetMaxMillisatsPerByte(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetSpendingPolicyResponse{
+		Policy: parseSpendingPolicy(policy),
+	}, nil
+}
+
+func (a *account) GetSpendingPolicy(
+	ctx context.Context, req *pb.GetSpendingPolicyRequest,
+) (*pb.GetSpendingPolicyResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	policy, err := appSvc.GetSpendingPolicy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSpendingPolicyResponse{
+		Policy: parseSpendingPolicy(policy),
+	}, nil
+}
+
+func (a *account) DeleteSpendingPolicy(
+	ctx context.Context, req *pb.DeleteSpendingPolicyRequest,
+) (*pb.DeleteSpendingPolicyResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := appSvc.DeleteSpendingPolicy(ctx, name, password); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSpendingPolicyResponse{}, nil
+}
+
+func (a *account) FreezeUtxos(
+	ctx context.Context, req *pb.FreezeUtxosRequest,
+) (*pb.FreezeUtxosResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	utxos, err := a.limits.parseCoinControlInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	count, err := appSvc.FreezeUtxos(ctx, name, utxos)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FreezeUtxosResponse{Count: uint64(count)}, nil
+}
+
+func (a *account) UnfreezeUtxos(
+	ctx context.Context, req *pb.UnfreezeUtxosRequest,
+) (*pb.UnfreezeUtxosResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	utxos, err := a.limits.parseCoinControlInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	count, err := appSvc.UnfreezeUtxos(ctx, name, utxos)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnfreezeUtxosResponse{Count: uint64(count)}, nil
+}
+
+func (a *account) SetUtxoLabels(
+	ctx context.Context, req *pb.SetUtxoLabelsRequest,
+) (*pb.SetUtxoLabelsResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	utxos, err := a.limits.parseCoinControlInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	labels, err := parseLabels(req.GetLabels())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	count, err := appSvc.SetUtxoLabels(ctx, name, utxos, labels)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetUtxoLabelsResponse{Count: uint64(count)}, nil
+}
+
+func (a *account) SetAddressLabels(
+	ctx context.Context, req *pb.SetAddressLabelsRequest,
+) (*pb.SetAddressLabelsResponse, error) {
+	appSvc, err := a.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addr := req.GetAddress()
+	if addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing address")
+	}
+	labels, err := parseLabels(req.GetLabels())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := appSvc.SetAddressLabels(ctx, name, addr, labels); err != nil {
+		return nil, err
+	}
+	return &pb.SetAddressLabelsResponse{}, nil
+}
@@ -7,18 +7,25 @@ import (
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
 	"github.com/vulpemventures/ocean/internal/core/application"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 var ErrStreamConnectionClosed = fmt.Errorf("connection closed on by server")
 
+// NotificationServiceFn returns the notification service of the wallet
+// selected by the request.
+type NotificationServiceFn func(
+	ctx context.Context,
+) (*application.NotificationService, error)
+
 type notification struct {
-	appSvc  *application.NotificationService
+	appSvc  NotificationServiceFn
 	chClose chan struct{}
 }
 
 func NewNotificationHandler(
-	appSvc *application.NotificationService, chClose chan struct{},
+	appSvc NotificationServiceFn, chClose chan struct{},
 ) pb.NotificationServiceServer {
 	return &notification{appSvc, chClose}
 }
@@ -27,11 +34,20 @@ func (n notification) TransactionNotifications(
 	req *pb.TransactionNotificationsRequest,
 	stream pb.NotificationService_TransactionNotificationsServer,
 ) error {
-	chTxEvents, err := n.appSvc.GetTxChannel(stream.Context())
+	appSvc, err := n.appSvc(stream.Context())
 	if err != nil {
 		return err
 	}
 
+	chTxEvents, err := appSvc.GetTxChannel(stream.Context())
+	if err != nil {
+		return err
+	}
+	// Let the client know that the subscription is active.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
 	for {
 		select {
 		case e := <-chTxEvents:
@@ -64,10 +80,19 @@ func (n notification) UtxosNotifications(
 	req *pb.UtxosNotificationsRequest,
 	stream pb.NotificationService_UtxosNotificationsServer,
 ) error {
-	chUtxoEvents, err := n.appSvc.GetUtxoChannel(stream.Context())
+	appSvc, err := n.appSvc(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	chUtxoEvents, err := appSvc.GetUtxoChannel(stream.Context())
 	if err != nil {
 		return err
 	}
+	// Let the client know that the subscription is active.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
 
 	for {
 		select {
@@ -86,9 +111,84 @@ func (n notification) UtxosNotifications(
 	}
 }
 
+func (n notification) PaymentNotifications(
+	req *pb.PaymentNotificationsRequest,
+	stream pb.NotificationService_PaymentNotificationsServer,
+) error {
+	appSvc, err := n.appSvc(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	chPaymentEvents, err := appSvc.GetPaymentChannel(stream.Context())
+	if err != nil {
+		return err
+	}
+	// Let the client know that the subscription is active.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case e := <-chPaymentEvents:
+			if err := stream.Send(&pb.PaymentNotificationsResponse{
+				EventType: parsePaymentEventType(e.EventType),
+				Payments:  parsePayments(e.Payments),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-n.chClose:
+			return ErrStreamConnectionClosed
+		}
+	}
+}
+
+func (n notification) BroadcastNotifications(
+	req *pb.BroadcastNotificationsRequest,
+	stream pb.NotificationService_BroadcastNotificationsServer,
+) error {
+	appSvc, err := n.appSvc(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	chBroadcastEvents, err := appSvc.GetBroadcastChannel(stream.Context())
+	if err != nil {
+		return err
+	}
+	// Let the client know that the subscription is active.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case e := <-chBroadcastEvents:
+			if err := stream.Send(&pb.BroadcastNotificationsResponse{
+				EventType:  parseBroadcastEventType(e.EventType),
+				Broadcasts: parseBroadcasts(e.Broadcasts),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-n.chClose:
+			return ErrStreamConnectionClosed
+		}
+	}
+}
+
 func (n notification) WatchExternalScript(
 	ctx context.Context, req *pb.WatchExternalScriptRequest,
 ) (*pb.WatchExternalScriptResponse, error) {
+	appSvc, err := n.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	script, err := parseScript(req.GetScript())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -97,7 +197,7 @@ func (n notification) WatchExternalScript(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	label, err := n.appSvc.WatchScript(ctx, script, blindingKey)
+	label, err := appSvc.WatchScript(ctx, script, blindingKey)
 	if err != nil {
 		return nil, err
 	}
@@ -109,11 +209,16 @@ func (n notification) WatchExternalScript(
 func (n notification) UnwatchExternalScript(
 	ctx context.Context, req *pb.UnwatchExternalScriptRequest,
 ) (*pb.UnwatchExternalScriptResponse, error) {
+	appSvc, err := n.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	label, err := parseAccountName(req.GetLabel())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err := n.appSvc.StopWatchingScript(ctx, label); err != nil {
+	if err := appSvc.StopWatchingScript(ctx, label); err != nil {
 		return nil, err
 	}
 	return &pb.UnwatchExternalScriptResponse{}, nil
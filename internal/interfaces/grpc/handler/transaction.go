@@ -10,25 +10,37 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// TransactionServiceFn returns the transaction service of the wallet selected
+// by the request.
+type TransactionServiceFn func(
+	ctx context.Context,
+) (*application.TransactionService, error)
+
 type transaction struct {
-	appSvc *application.TransactionService
+	appSvc TransactionServiceFn
+	limits RequestLimits
 }
 
 func NewTransactionHandler(
-	appSvc *application.TransactionService,
+	appSvc TransactionServiceFn, limits RequestLimits,
 ) pb.TransactionServiceServer {
-	return &transaction{appSvc}
+	return &transaction{appSvc, limits.withDefaults()}
 }
 
 func (t *transaction) GetTransaction(
 	ctx context.Context, req *pb.GetTransactionRequest,
 ) (*pb.GetTransactionResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	txid := req.GetTxid()
 	if err := validateTxid(txid); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	txInfo, err := t.appSvc.GetTransactionInfo(ctx, txid)
+	txInfo, err := appSvc.GetTransactionInfo(ctx, txid)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +54,11 @@ func (t *transaction) GetTransaction(
 func (t *transaction) SelectUtxos(
 	ctx context.Context, req *pb.SelectUtxosRequest,
 ) (*pb.SelectUtxosResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountName, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -55,9 +72,18 @@ func (t *transaction) SelectUtxos(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	strategy := parseCoinSelectionStrategy(req.GetStrategy())
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lockDuration, err := parseLockDuration(req.GetLockExpirySeconds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	utxos, change, expirationDate, err := t.appSvc.SelectUtxos(
-		ctx, accountName, targetAsset, targetAmount, strategy,
+	utxos, change, lock, err := appSvc.SelectUtxos(
+		ctx, accountName, targetAsset, targetAmount, strategy, millisatsPerByte,
+		lockDuration,
 	)
 	if err != nil {
 		return nil, err
@@ -65,38 +91,89 @@ func (t *transaction) SelectUtxos(
 	return &pb.SelectUtxosResponse{
 		Utxos:          parseUtxos(utxos.Info()),
 		Change:         change,
-		ExpirationDate: expirationDate,
+		ExpirationDate: lock.ExpirationDate,
+		LockToken:      lock.Token,
 	}, nil
 }
 
 func (t *transaction) LockUtxos(
 	ctx context.Context, req *pb.LockUtxosRequest,
 ) (*pb.LockUtxosResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountName, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	inputs, err := parseInputs(req.GetUtxos())
+	inputs, err := t.limits.parseInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lockToken, err := parseLockToken(req.GetLockToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lockDuration, err := parseLockDuration(req.GetLockExpirySeconds())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	expirationDate, err := t.appSvc.LockUtxos(ctx, accountName, inputs)
+
+	lock, err := appSvc.LockUtxos(
+		ctx, accountName, inputs, lockToken, lockDuration,
+	)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.LockUtxosResponse{
-		ExpirationDate: expirationDate,
+		ExpirationDate: lock.ExpirationDate,
+		LockToken:      lock.Token,
 	}, nil
 }
 
+func (t *transaction) UnlockUtxos(
+	ctx context.Context, req *pb.UnlockUtxosRequest,
+) (*pb.UnlockUtxosResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	inputs, err := t.limits.parseCoinControlInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lockToken, err := parseLockToken(req.GetLockToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	count, err := appSvc.UnlockUtxos(ctx, accountName, inputs, lockToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnlockUtxosResponse{Count: uint64(count)}, nil
+}
+
 func (t *transaction) EstimateFees(
 	ctx context.Context, req *pb.EstimateFeesRequest,
 ) (*pb.EstimateFeesResponse, error) {
-	inputs, err := parseInputs(req.GetInputs())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := t.limits.parseInputs(req.GetInputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	outputs, err := parseOutputs(req.GetOutputs())
+	outputs, err := t.limits.parseOutputs(req.GetOutputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -105,7 +182,7 @@ func (t *transaction) EstimateFees(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	feeAmount, err := t.appSvc.EstimateFees(
+	feeAmount, err := appSvc.EstimateFees(
 		ctx, inputs, outputs, millisatsPerByte,
 	)
 	if err != nil {
@@ -118,12 +195,17 @@ func (t *transaction) EstimateFees(
 func (t *transaction) SignTransaction(
 	ctx context.Context, req *pb.SignTransactionRequest,
 ) (*pb.SignTransactionResponse, error) {
-	txHex, err := parseTxHex(req.GetTxHex())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txHex, err := t.limits.parseTxHex(req.GetTxHex())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	signedTx, err := t.appSvc.SignTransaction(ctx, txHex, req.GetSighashType())
+	signedTx, err := appSvc.SignTransaction(ctx, txHex, req.GetSighashType())
 	if err != nil {
 		return nil, err
 	}
@@ -134,12 +216,22 @@ func (t *transaction) SignTransaction(
 func (t *transaction) BroadcastTransaction(
 	ctx context.Context, req *pb.BroadcastTransactionRequest,
 ) (*pb.BroadcastTransactionResponse, error) {
-	txHex, err := parseTxHex(req.GetTxHex())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txHex, err := t.limits.parseTxHex(req.GetTxHex())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	txid, err := t.appSvc.BroadcastTransaction(ctx, txHex)
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	txid, err := appSvc.BroadcastTransaction(ctx, txHex)
 	if err != nil {
 		return nil, err
 	}
@@ -150,16 +242,26 @@ func (t *transaction) BroadcastTransaction(
 func (t *transaction) CreatePset(
 	ctx context.Context, req *pb.CreatePsetRequest,
 ) (*pb.CreatePsetResponse, error) {
-	inputs, err := parseInputs(req.GetInputs())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := t.limits.parseInputs(req.GetInputs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	outputs, err := t.limits.parseOutputs(req.GetOutputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	outputs, err := parseOutputs(req.GetOutputs())
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ptx, err := t.appSvc.CreatePset(ctx, inputs, outputs)
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	ptx, err := appSvc.CreatePset(ctx, inputs, outputs)
 	if err != nil {
 		return nil, err
 	}
@@ -169,20 +271,30 @@ func (t *transaction) CreatePset(
 func (t *transaction) UpdatePset(
 	ctx context.Context, req *pb.UpdatePsetRequest,
 ) (*pb.UpdatePsetResponse, error) {
-	ptx, err := parsePset(req.GetPset())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := t.limits.parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	inputs, err := t.limits.parseInputs(req.GetInputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	inputs, err := parseInputs(req.GetInputs())
+	outputs, err := t.limits.parseOutputs(req.GetOutputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	outputs, err := parseOutputs(req.GetOutputs())
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	updatedPtx, err := t.appSvc.UpdatePset(ctx, ptx, inputs, outputs)
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	updatedPtx, err := appSvc.UpdatePset(ctx, ptx, inputs, outputs)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +305,12 @@ func (t *transaction) UpdatePset(
 func (t *transaction) BlindPset(
 	ctx context.Context, req *pb.BlindPsetRequest,
 ) (*pb.BlindPsetResponse, error) {
-	ptx, err := parsePset(req.GetPset())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := t.limits.parsePset(req.GetPset())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -201,8 +318,13 @@ func (t *transaction) BlindPset(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	blindedPtx, err := t.appSvc.BlindPset(
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	blindedPtx, err := appSvc.BlindPset(
 		ctx, ptx, extraUnblindedIns, req.GetLastBlinder(),
 	)
 	if err != nil {
@@ -215,12 +337,22 @@ func (t *transaction) BlindPset(
 func (t *transaction) SignPset(
 	ctx context.Context, req *pb.SignPsetRequest,
 ) (*pb.SignPsetResponse, error) {
-	ptx, err := parsePset(req.GetPset())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := t.limits.parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	signedPtx, err := t.appSvc.SignPset(ctx, ptx, req.GetSighashType())
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	signedPtx, err := appSvc.SignPset(ctx, ptx, req.GetSighashType())
 	if err != nil {
 		return nil, err
 	}
@@ -249,11 +381,16 @@ func (t *transaction) Burn(
 func (t *transaction) Transfer(
 	ctx context.Context, req *pb.TransferRequest,
 ) (*pb.TransferResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountName, err := parseAccountName(req.GetAccountName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	outputs, err := parseOutputs(req.GetReceivers())
+	outputs, err := t.limits.parseOutputs(req.GetReceivers())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -261,8 +398,17 @@ func (t *transaction) Transfer(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	strategy := parseCoinSelectionStrategy(req.GetCoinSelectionStrategy())
 
-	txHex, err := t.appSvc.Transfer(ctx, accountName, outputs, millisatsPerByte)
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	txHex, err := appSvc.Transfer(
+		ctx, accountName, outputs, millisatsPerByte, strategy,
+		req.GetSubtractFeeFromOutputs(), req.GetLocktime(), req.GetSequences(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +416,317 @@ func (t *transaction) Transfer(
 	return &pb.TransferResponse{TxHex: txHex}, nil
 }
 
+func (t *transaction) SweepAccount(
+	ctx context.Context, req *pb.SweepAccountRequest,
+) (*pb.SweepAccountResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	script, blindingKey, err := parseAddress(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	assets, err := parseAssets(req.GetAssets())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	txHex, err := appSvc.SweepAccount(
+		ctx, accountName, assets, script, blindingKey, millisatsPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SweepAccountResponse{TxHex: txHex}, nil
+}
+
+func (t *transaction) ConsolidateUtxos(
+	ctx context.Context, req *pb.ConsolidateUtxosRequest,
+) (*pb.ConsolidateUtxosResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	asset := req.GetAsset()
+	if asset != "" {
+		if _, err := parseAsset(asset); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	valueThreshold, err := parseValueThreshold(req.GetValueThreshold())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txids, err := appSvc.ConsolidateUtxos(
+		ctx, accountName, asset, application.ConsolidationOptions{
+			ValueThreshold:   valueThreshold,
+			MaxInputs:        req.GetMaxInputs(),
+			MillisatsPerByte: millisatsPerByte,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConsolidateUtxosResponse{Txids: txids}, nil
+}
+
+func (t *transaction) BumpFee(
+	ctx context.Context, req *pb.BumpFeeRequest,
+) (*pb.BumpFeeResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetMillisatsPerByte() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing fee rate")
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bumpedTx, err := appSvc.BumpFee(ctx, txid, millisatsPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BumpFeeResponse{
+		Txid:   bumpedTx.Txid,
+		TxHex:  bumpedTx.TxHex,
+		Method: parseBumpFeeMethod(bumpedTx.Method),
+	}, nil
+}
+
+func (t *transaction) EnqueuePayment(
+	ctx context.Context, req *pb.EnqueuePaymentRequest,
+) (*pb.EnqueuePaymentResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetReceiver() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing receiver")
+	}
+	outputs, err := t.limits.parseOutputs([]*pb.Output{req.GetReceiver()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	deadline, err := parseDeadline(req.GetDeadline())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	idempotencyKey, err := parseIdempotencyKey(req.GetIdempotencyKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx = application.ContextWithIdempotencyKey(ctx, idempotencyKey)
+	paymentId, err := appSvc.EnqueuePayment(
+		ctx, accountName, outputs[0], deadline,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnqueuePaymentResponse{PaymentId: paymentId}, nil
+}
+
+func (t *transaction) GetPayment(
+	ctx context.Context, req *pb.GetPaymentRequest,
+) (*pb.GetPaymentResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentId, err := parsePaymentId(req.GetPaymentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payment, err := appSvc.GetPayment(ctx, paymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPaymentResponse{Payment: parsePayment(*payment)}, nil
+}
+
+func (t *transaction) GetBroadcast(
+	ctx context.Context, req *pb.GetBroadcastRequest,
+) (*pb.GetBroadcastResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	broadcast, err := appSvc.GetBroadcast(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBroadcastResponse{Broadcast: parseBroadcast(*broadcast)}, nil
+}
+
+func (t *transaction) ScheduleTransaction(
+	ctx context.Context, req *pb.ScheduleTransactionRequest,
+) (*pb.ScheduleTransactionResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txHex, err := t.limits.parseTxHex(req.GetTxHex())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	broadcastAt, err := parseBroadcastAt(req.GetBroadcastAt())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := appSvc.ScheduleTransaction(ctx, txHex, broadcastAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ScheduleTransactionResponse{
+		ScheduledTransaction: parseScheduledTx(*tx),
+	}, nil
+}
+
+func (t *transaction) GetScheduledTransaction(
+	ctx context.Context, req *pb.GetScheduledTransactionRequest,
+) (*pb.GetScheduledTransactionResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := appSvc.GetScheduledTransaction(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetScheduledTransactionResponse{
+		ScheduledTransaction: parseScheduledTx(*tx),
+	}, nil
+}
+
+func (t *transaction) ListScheduledTransactions(
+	ctx context.Context, _ *pb.ListScheduledTransactionsRequest,
+) (*pb.ListScheduledTransactionsResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := appSvc.ListScheduledTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListScheduledTransactionsResponse{
+		ScheduledTransactions: parseScheduledTxs(txs),
+	}, nil
+}
+
+func (t *transaction) CancelScheduledTransaction(
+	ctx context.Context, req *pb.CancelScheduledTransactionRequest,
+) (*pb.CancelScheduledTransactionResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := appSvc.CancelScheduledTransaction(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelScheduledTransactionResponse{
+		ScheduledTransaction: parseScheduledTx(*tx),
+	}, nil
+}
+
+func (t *transaction) FlushPayments(
+	ctx context.Context, req *pb.FlushPaymentsRequest,
+) (*pb.FlushPaymentsResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txids, err := appSvc.FlushPayments(
+		ctx, req.GetAccountName(), application.PaymentBatcherOptions{
+			MaxBatchSize:     req.GetMaxBatchSize(),
+			MillisatsPerByte: millisatsPerByte,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FlushPaymentsResponse{Txids: txids}, nil
+}
+
 func (t *transaction) PegInAddress(
 	ctx context.Context, req *pb.PegInAddressRequest,
 ) (*pb.PegInAddressResponse, error) {
@@ -285,12 +742,17 @@ func (t *transaction) ClaimPegIn(
 func (t *transaction) SignPsetWithSchnorrKey(
 	ctx context.Context, req *pb.SignPsetWithSchnorrKeyRequest,
 ) (*pb.SignPsetWithSchnorrKeyResponse, error) {
-	tx, err := parsePset(req.GetTx())
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.limits.parsePset(req.GetTx())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	signedTx, err := t.appSvc.SignPsetWithSchnorrKey(
+	signedTx, err := appSvc.SignPsetWithSchnorrKey(
 		ctx, tx, req.GetSighashType(),
 	)
 	if err != nil {
@@ -302,6 +764,67 @@ func (t *transaction) SignPsetWithSchnorrKey(
 	}, nil
 }
 
+func (t *transaction) ListSpendApprovals(
+	ctx context.Context, _ *pb.ListSpendApprovalsRequest,
+) (*pb.ListSpendApprovalsResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, err := appSvc.ListSpendApprovals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListSpendApprovalsResponse{
+		Approvals: parseSpendApprovals(approvals),
+	}, nil
+}
+
+func (t *transaction) ApproveSpend(
+	ctx context.Context, req *pb.ApproveSpendRequest,
+) (*pb.ApproveSpendResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseApprovalId(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signedTx, txHex, err := appSvc.ApproveSpend(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveSpendResponse{
+		SignedTx: signedTx,
+		TxHex:    txHex,
+	}, nil
+}
+
+func (t *transaction) RejectSpend(
+	ctx context.Context, req *pb.RejectSpendRequest,
+) (*pb.RejectSpendResponse, error) {
+	appSvc, err := t.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseApprovalId(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := appSvc.RejectSpend(ctx, id); err != nil {
+		return nil, err
+	}
+	return &pb.RejectSpendResponse{}, nil
+}
+
 func validateTxid(txid string) error {
 	if txid == "" {
 		return fmt.Errorf("missing txid")
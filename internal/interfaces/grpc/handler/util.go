@@ -3,6 +3,8 @@ package grpc_handler
 import (
 	"encoding/hex"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/vulpemventures/go-elements/address"
@@ -13,6 +15,56 @@ import (
 	path "github.com/vulpemventures/ocean/pkg/wallet/derivation-path"
 )
 
+const (
+	// DefaultMaxNumOfAddresses is the default max number of addresses that can
+	// be derived with a single request.
+	DefaultMaxNumOfAddresses = 100
+	// DefaultMaxNumOfInputs and DefaultMaxNumOfOutputs are the default max
+	// number of ins and outs of a single request.
+	DefaultMaxNumOfInputs  = 256
+	DefaultMaxNumOfOutputs = 256
+	// DefaultMaxTxSize is the default max size in bytes of the serialized
+	// transactions and psets of a single request.
+	DefaultMaxTxSize = 1 << 20
+	// maxIdempotencyKeyLength is the max length of the idempotency key of a
+	// request.
+	maxIdempotencyKeyLength = 255
+	// maxNumOfLabels and maxLabelLength are the max number of labels that can
+	// be set on a utxo or address and the max length of each of them.
+	maxNumOfLabels = 32
+	maxLabelLength = 64
+	// maxLockDuration is the max duration of a utxo lock that can be set with
+	// a single request.
+	maxLockDuration = 24 * time.Hour
+	// lockTokenLength is the length of a hex encoded utxo lock token.
+	lockTokenLength = 32
+)
+
+// RequestLimits are the max sizes of a single request. Any limit left to
+// zero is replaced by its default.
+type RequestLimits struct {
+	MaxNumOfAddresses uint64
+	MaxNumOfInputs    int
+	MaxNumOfOutputs   int
+	MaxTxSize         int
+}
+
+func (l RequestLimits) withDefaults() RequestLimits {
+	if l.MaxNumOfAddresses == 0 {
+		l.MaxNumOfAddresses = DefaultMaxNumOfAddresses
+	}
+	if l.MaxNumOfInputs == 0 {
+		l.MaxNumOfInputs = DefaultMaxNumOfInputs
+	}
+	if l.MaxNumOfOutputs == 0 {
+		l.MaxNumOfOutputs = DefaultMaxNumOfOutputs
+	}
+	if l.MaxTxSize == 0 {
+		l.MaxTxSize = DefaultMaxTxSize
+	}
+	return l
+}
+
 func parseMnemonic(mnemonic string) (string, error) {
 	if mnemonic == "" {
 		return "", fmt.Errorf("missing mnemonic")
@@ -27,6 +79,22 @@ func parsePassword(password string) (string, error) {
 	return password, nil
 }
 
+func parseBackup(backup []byte) ([]byte, error) {
+	if len(backup) <= 0 {
+		return nil, fmt.Errorf("missing backup")
+	}
+	return backup, nil
+}
+
+func parseExtraIPs(ips []string) ([]string, error) {
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid extra ip %s", ip)
+		}
+	}
+	return ips, nil
+}
+
 func parseNetwork(network string) pb.GetInfoResponse_Network {
 	switch network {
 	case "liquid":
@@ -55,6 +123,20 @@ func parseAccounts(accounts []application.AccountInfo) []*pb.AccountInfo {
 	return list
 }
 
+func parseAccountsSyncStatus(
+	accounts []application.AccountSyncStatus,
+) []*pb.AccountSyncStatus {
+	list := make([]*pb.AccountSyncStatus, 0, len(accounts))
+	for _, a := range accounts {
+		list = append(list, &pb.AccountSyncStatus{
+			AccountName:  a.AccountName,
+			BlockHeight:  a.BlockHeight,
+			BlocksBehind: a.BlocksBehind,
+		})
+	}
+	return list
+}
+
 func parseAccountName(name string) (string, error) {
 	if name == "" {
 		return "", fmt.Errorf("missing account namespace or label")
@@ -97,6 +179,8 @@ func parseUtxos(utxos []domain.UtxoInfo) []*pb.Utxo {
 			AccountName:     u.AccountName,
 			SpentStatus:     spentStatus,
 			ConfirmedStatus: confirmedStatus,
+			Frozen:          u.Frozen,
+			Labels:          u.Labels,
 		})
 	}
 	return list
@@ -113,7 +197,23 @@ func parseBlockDetails(tx application.TransactionInfo) *pb.BlockDetails {
 	}
 }
 
-func parseInputs(ins []*pb.Input) ([]application.Input, error) {
+func (l RequestLimits) parseNumOfAddresses(num uint64) (uint64, error) {
+	if num > l.MaxNumOfAddresses {
+		return 0, fmt.Errorf(
+			"number of addresses must not exceed %d", l.MaxNumOfAddresses,
+		)
+	}
+	return num, nil
+}
+
+func (l RequestLimits) parseInputs(
+	ins []*pb.Input,
+) ([]application.Input, error) {
+	if len(ins) > l.MaxNumOfInputs {
+		return nil, fmt.Errorf(
+			"number of inputs must not exceed %d", l.MaxNumOfInputs,
+		)
+	}
 	inputs := make([]application.Input, 0, len(ins))
 	for _, in := range ins {
 		inputs = append(inputs, application.Input{
@@ -127,21 +227,93 @@ func parseInputs(ins []*pb.Input) ([]application.Input, error) {
 	return inputs, nil
 }
 
-func parseOutputs(outs []*pb.Output) ([]application.Output, error) {
+func (l RequestLimits) parseCoinControlInputs(
+	ins []*pb.Input,
+) ([]application.Input, error) {
+	if len(ins) <= 0 {
+		return nil, fmt.Errorf("missing utxos")
+	}
+	return l.parseInputs(ins)
+}
+
+func parseLockDuration(seconds uint64) (time.Duration, error) {
+	if seconds > uint64(maxLockDuration/time.Second) {
+		return 0, fmt.Errorf(
+			"lock expiry must not exceed %d seconds",
+			uint64(maxLockDuration/time.Second),
+		)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func parseLockToken(token string) (string, error) {
+	if len(token) <= 0 {
+		return "", nil
+	}
+	if len(token) != lockTokenLength {
+		return "", fmt.Errorf("invalid lock token length")
+	}
+	if _, err := hex.DecodeString(token); err != nil {
+		return "", fmt.Errorf("invalid lock token format, must be hex")
+	}
+	return token, nil
+}
+
+func parseLabels(labels []string) ([]string, error) {
+	if len(labels) > maxNumOfLabels {
+		return nil, fmt.Errorf("number of labels must not exceed %d", maxNumOfLabels)
+	}
+	for _, l := range labels {
+		if len(l) > maxLabelLength {
+			return nil, fmt.Errorf(
+				"label %q exceeds max length of %d", l, maxLabelLength,
+			)
+		}
+	}
+	return labels, nil
+}
+
+func parseFrozenFilter(filter pb.UtxoFrozenFilter) application.FrozenFilter {
+	switch filter {
+	case pb.UtxoFrozenFilter_UTXO_FROZEN_FILTER_ONLY:
+		return application.FrozenFilterOnly
+	case pb.UtxoFrozenFilter_UTXO_FROZEN_FILTER_EXCLUDE:
+		return application.FrozenFilterNone
+	default:
+		return application.FrozenFilterAll
+	}
+}
+
+func parseAddressLabels(info application.AddressesInfo) []*pb.AddressLabels {
+	list := make([]*pb.AddressLabels, 0)
+	for _, in := range info {
+		if len(in.Labels) <= 0 {
+			continue
+		}
+		list = append(list, &pb.AddressLabels{
+			Address: in.Address,
+			Labels:  in.Labels,
+		})
+	}
+	return list
+}
+
+func (l RequestLimits) parseOutputs(
+	outs []*pb.Output,
+) ([]application.Output, error) {
+	if len(outs) > l.MaxNumOfOutputs {
+		return nil, fmt.Errorf(
+			"number of outputs must not exceed %d", l.MaxNumOfOutputs,
+		)
+	}
 	outputs := make([]application.Output, 0, len(outs))
 	for _, out := range outs {
 		var script, blindKey []byte
 		if addr := out.GetAddress(); addr != "" {
-			isConf, err := address.IsConfidential(addr)
-			if err != nil {
+			var err error
+			if script, blindKey, err = parseAddress(addr); err != nil {
 				return nil, err
 			}
-			if isConf {
-				res, _ := address.FromConfidential(addr)
-				script, blindKey = res.Script, res.BlindingKey
-			} else {
-				script, _ = address.ToOutputScript(addr)
-			}
 		} else {
 			script, _ = hex.DecodeString(out.GetScript())
 			blindKey, _ = hex.DecodeString(out.GetBlindingPubkey())
@@ -160,6 +332,31 @@ func parseOutputs(outs []*pb.Output) ([]application.Output, error) {
 	return outputs, nil
 }
 
+func parseAddress(addr string) ([]byte, []byte, error) {
+	if len(addr) == 0 {
+		return nil, nil, fmt.Errorf("missing address")
+	}
+	isConf, err := address.IsConfidential(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isConf {
+		res, _ := address.FromConfidential(addr)
+		return res.Script, res.BlindingKey, nil
+	}
+	script, _ := address.ToOutputScript(addr)
+	return script, nil, nil
+}
+
+func parseAssets(assets []string) ([]string, error) {
+	for _, asset := range assets {
+		if _, err := parseAsset(asset); err != nil {
+			return nil, err
+		}
+	}
+	return assets, nil
+}
+
 func parseAmount(amount uint64) (uint64, error) {
 	if amount == 0 {
 		return 0, fmt.Errorf("missing amount")
@@ -167,6 +364,13 @@ func parseAmount(amount uint64) (uint64, error) {
 	return amount, nil
 }
 
+func parseValueThreshold(threshold uint64) (uint64, error) {
+	if threshold == 0 {
+		return 0, fmt.Errorf("missing value threshold")
+	}
+	return threshold, nil
+}
+
 func parseAsset(asset string) (string, error) {
 	if len(asset) == 0 {
 		return "", fmt.Errorf("missing asset")
@@ -182,7 +386,14 @@ func parseAsset(asset string) (string, error) {
 }
 
 func parseCoinSelectionStrategy(str pb.SelectUtxosRequest_Strategy) int {
-	return application.CoinSelectionStrategySmallestSubset
+	switch str {
+	case pb.SelectUtxosRequest_STRATEGY_LOWEST_WASTE:
+		return application.CoinSelectionStrategyBranchAndBound
+	case pb.SelectUtxosRequest_STRATEGY_KNAPSACK:
+		return application.CoinSelectionStrategyKnapsack
+	default:
+		return application.CoinSelectionStrategySmallestSubset
+	}
 }
 
 func parseMillisatsPerByte(ratio uint64) (uint64, error) {
@@ -195,17 +406,24 @@ func parseMillisatsPerByte(ratio uint64) (uint64, error) {
 	return ratio, nil
 }
 
-func parseTxHex(txHex string) (string, error) {
+func (l RequestLimits) parseTxHex(txHex string) (string, error) {
 	if len(txHex) == 0 {
 		return "", fmt.Errorf("missing tx hex")
 	}
+	if len(txHex) > 2*l.MaxTxSize {
+		return "", fmt.Errorf("tx must not exceed %d bytes", l.MaxTxSize)
+	}
 	return txHex, nil
 }
 
-func parsePset(ptx string) (string, error) {
+func (l RequestLimits) parsePset(ptx string) (string, error) {
 	if len(ptx) == 0 {
 		return "", fmt.Errorf("missing pset")
 	}
+	// The pset is base64 encoded, therefore its size is 4/3 of the raw one.
+	if len(ptx) > l.MaxTxSize/3*4 {
+		return "", fmt.Errorf("pset must not exceed %d bytes", l.MaxTxSize)
+	}
 	return ptx, nil
 }
 
@@ -217,6 +435,8 @@ func parseTxEventType(eventType domain.TransactionEventType) pb.TxEventType {
 		return pb.TxEventType_TX_EVENT_TYPE_CONFIRMED
 	case domain.TransactionUnconfirmed:
 		return pb.TxEventType_TX_EVENT_TYPE_UNCONFIRMED
+	case domain.TransactionEvicted:
+		return pb.TxEventType_TX_EVENT_TYPE_EVICTED
 	default:
 		return pb.TxEventType_TX_EVENT_TYPE_UNSPECIFIED
 	}
@@ -236,11 +456,193 @@ func parseUtxoEventType(eventType domain.UtxoEventType) pb.UtxoEventType {
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_SPENT
 	case domain.UtxoConfirmedSpend:
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_CONFIRMED_SPENT
+	case domain.UtxoFrozen:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_FROZEN
+	case domain.UtxoUnfrozen:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNFROZEN
+	case domain.UtxoEvicted:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_EVICTED
 	default:
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNSPECIFIED
 	}
 }
 
+func parseBumpFeeMethod(method application.BumpFeeMethod) pb.BumpFeeMethod {
+	switch method {
+	case application.BumpFeeMethodReplacement:
+		return pb.BumpFeeMethod_BUMP_FEE_METHOD_REPLACEMENT
+	case application.BumpFeeMethodChildPaysForParent:
+		return pb.BumpFeeMethod_BUMP_FEE_METHOD_CHILD_PAYS_FOR_PARENT
+	default:
+		return pb.BumpFeeMethod_BUMP_FEE_METHOD_UNSPECIFIED
+	}
+}
+
+func parsePayments(payments []domain.Payment) []*pb.Payment {
+	list := make([]*pb.Payment, 0, len(payments))
+	for _, p := range payments {
+		list = append(list, parsePayment(p))
+	}
+	return list
+}
+
+func parsePayment(p domain.Payment) *pb.Payment {
+	return &pb.Payment{
+		Id:          p.ID,
+		AccountName: p.AccountName,
+		Asset:       p.Asset,
+		Amount:      p.Amount,
+		Script:      p.Script,
+		Deadline:    p.Deadline,
+		Status:      parsePaymentStatus(p.Status),
+		Txid:        p.Txid,
+		OutputIndex: p.OutputIndex,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func parsePaymentStatus(status domain.PaymentStatus) pb.PaymentStatus {
+	switch status {
+	case domain.PaymentStatusQueued:
+		return pb.PaymentStatus_PAYMENT_STATUS_QUEUED
+	case domain.PaymentStatusBroadcasted:
+		return pb.PaymentStatus_PAYMENT_STATUS_BROADCASTED
+	case domain.PaymentStatusConfirmed:
+		return pb.PaymentStatus_PAYMENT_STATUS_CONFIRMED
+	case domain.PaymentStatusPending:
+		return pb.PaymentStatus_PAYMENT_STATUS_PENDING
+	default:
+		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func parsePaymentEventType(
+	eventType domain.PaymentEventType,
+) pb.PaymentEventType {
+	switch eventType {
+	case domain.PaymentQueued:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_QUEUED
+	case domain.PaymentBroadcasted:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_BROADCASTED
+	case domain.PaymentConfirmed:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_CONFIRMED
+	case domain.PaymentPending:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_PENDING
+	default:
+		return pb.PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func parseBroadcasts(broadcasts []domain.Broadcast) []*pb.Broadcast {
+	list := make([]*pb.Broadcast, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		list = append(list, parseBroadcast(b))
+	}
+	return list
+}
+
+func parseBroadcast(b domain.Broadcast) *pb.Broadcast {
+	return &pb.Broadcast{
+		Txid:            b.Txid,
+		TxHex:           b.TxHex,
+		Status:          parseBroadcastStatus(b.Status),
+		Attempts:        b.Attempts,
+		NextCheckAt:     b.NextCheckAt,
+		ConflictingTxid: b.ConflictingTxid,
+		CreatedAt:       b.CreatedAt,
+	}
+}
+
+func parseBroadcastStatus(status domain.BroadcastStatus) pb.BroadcastStatus {
+	switch status {
+	case domain.BroadcastStatusPending:
+		return pb.BroadcastStatus_BROADCAST_STATUS_PENDING
+	case domain.BroadcastStatusConfirmed:
+		return pb.BroadcastStatus_BROADCAST_STATUS_CONFIRMED
+	case domain.BroadcastStatusDropped:
+		return pb.BroadcastStatus_BROADCAST_STATUS_DROPPED
+	case domain.BroadcastStatusConflicted:
+		return pb.BroadcastStatus_BROADCAST_STATUS_CONFLICTED
+	default:
+		return pb.BroadcastStatus_BROADCAST_STATUS_UNSPECIFIED
+	}
+}
+
+func parseBroadcastEventType(
+	eventType domain.BroadcastEventType,
+) pb.BroadcastEventType {
+	switch eventType {
+	case domain.BroadcastPending:
+		return pb.BroadcastEventType_BROADCAST_EVENT_TYPE_PENDING
+	case domain.BroadcastConfirmed:
+		return pb.BroadcastEventType_BROADCAST_EVENT_TYPE_CONFIRMED
+	case domain.BroadcastDropped:
+		return pb.BroadcastEventType_BROADCAST_EVENT_TYPE_DROPPED
+	case domain.BroadcastConflicted:
+		return pb.BroadcastEventType_BROADCAST_EVENT_TYPE_CONFLICTED
+	default:
+		return pb.BroadcastEventType_BROADCAST_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func parseScheduledTxs(txs []domain.ScheduledTx) []*pb.ScheduledTransaction {
+	list := make([]*pb.ScheduledTransaction, 0, len(txs))
+	for _, tx := range txs {
+		list = append(list, parseScheduledTx(tx))
+	}
+	return list
+}
+
+func parseScheduledTx(tx domain.ScheduledTx) *pb.ScheduledTransaction {
+	return &pb.ScheduledTransaction{
+		Txid:            tx.Txid,
+		TxHex:           tx.TxHex,
+		Locktime:        tx.Locktime,
+		BroadcastAt:     tx.BroadcastAt,
+		Status:          parseScheduledTxStatus(tx.Status),
+		ConflictingTxid: tx.ConflictingTxid,
+		CreatedAt:       tx.CreatedAt,
+	}
+}
+
+func parseScheduledTxStatus(
+	status domain.ScheduledTxStatus,
+) pb.ScheduledTransactionStatus {
+	switch status {
+	case domain.ScheduledTxStatusScheduled:
+		return pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_SCHEDULED
+	case domain.ScheduledTxStatusBroadcasted:
+		return pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_BROADCASTED
+	case domain.ScheduledTxStatusCancelled:
+		return pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_CANCELLED
+	case domain.ScheduledTxStatusFailed:
+		return pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_FAILED
+	default:
+		return pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+func parsePaymentId(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("missing payment id")
+	}
+	return id, nil
+}
+
+func parseDeadline(deadline int64) (int64, error) {
+	if deadline < 0 {
+		return 0, fmt.Errorf("invalid deadline: must not be negative")
+	}
+	return deadline, nil
+}
+
+func parseBroadcastAt(broadcastAt int64) (int64, error) {
+	if broadcastAt < 0 {
+		return 0, fmt.Errorf("invalid broadcast time: must not be negative")
+	}
+	return broadcastAt, nil
+}
+
 func parseBlockHeight(height uint32) (uint32, error) {
 	if int(height) < 0 {
 		return 0, fmt.Errorf("invalid block height")
@@ -318,3 +720,103 @@ func parsePrvkey(key string) (string, error) {
 	}
 	return key, nil
 }
+
+func parseScripts(scripts []string) ([]string, error) {
+	for _, script := range scripts {
+		if _, err := parseScript(script); err != nil {
+			return nil, err
+		}
+	}
+	return scripts, nil
+}
+
+func parseAssetLimits(limits []*pb.AssetLimit) ([]domain.AssetLimit, error) {
+	assetLimits := make([]domain.AssetLimit, 0, len(limits))
+	for _, l := range limits {
+		asset, err := parseAsset(l.GetAsset())
+		if err != nil {
+			return nil, err
+		}
+		if l.GetWindowSeconds() < 0 {
+			return nil, fmt.Errorf("invalid window: must not be negative")
+		}
+		assetLimits = append(assetLimits, domain.AssetLimit{
+			Asset:             asset,
+			SpendLimit:        l.GetSpendLimit(),
+			WindowSeconds:     l.GetWindowSeconds(),
+			ApprovalThreshold: l.GetApprovalThreshold(),
+		})
+	}
+	return assetLimits, nil
+}
+
+func parseSpendingPolicy(policy *domain.SpendingPolicy) *pb.SpendingPolicy {
+	limits := make([]*pb.AssetLimit, 0, len(policy.AssetLimits))
+	for _, l := range policy.AssetLimits {
+		limits = append(limits, &pb.AssetLimit{
+			Asset:             l.Asset,
+			SpendLimit:        l.SpendLimit,
+			WindowSeconds:     l.WindowSeconds,
+			ApprovalThreshold: l.ApprovalThreshold,
+		})
+	}
+	return &pb.SpendingPolicy{
+		AccountName:         policy.AccountName,
+		AssetLimits:         limits,
+		AllowedScripts:      policy.GetAllowedScripts(),
+		MaxMillisatsPerByte: policy.MaxMillisatsPerByte,
+	}
+}
+
+func parseSpendApprovals(approvals []domain.SpendApproval) []*pb.SpendApproval {
+	list := make([]*pb.SpendApproval, 0, len(approvals))
+	for _, a := range approvals {
+		list = append(list, &pb.SpendApproval{
+			Id:          a.ID,
+			Tx:          a.Tx,
+			SighashType: a.SighashType,
+			RequestedBy: a.RequestedBy,
+			Timestamp:   a.Timestamp,
+		})
+	}
+	return list
+}
+
+func parseApprovalId(id string) (string, error) {
+	if len(id) <= 0 {
+		return "", fmt.Errorf("missing approval id")
+	}
+	if len(id) != 64 {
+		return "", fmt.Errorf("invalid approval id length")
+	}
+	return id, nil
+}
+
+func parseIdempotencyKey(key string) (string, error) {
+	if len(key) > maxIdempotencyKeyLength {
+		return "", fmt.Errorf(
+			"idempotency key must not exceed %d chars", maxIdempotencyKeyLength,
+		)
+	}
+	return key, nil
+}
+
+func parseAuditEvents(events []domain.AuditEvent) []*pb.AuditEvent {
+	list := make([]*pb.AuditEvent, 0, len(events))
+	for _, e := range events {
+		list = append(list, &pb.AuditEvent{
+			Sequence:     e.Sequence,
+			Timestamp:    e.Timestamp,
+			Caller:       e.Caller,
+			Method:       e.Method,
+			AccountName:  e.AccountName,
+			Amounts:      e.Amounts,
+			Destinations: e.Destinations,
+			Txid:         e.Txid,
+			Error:        e.Error,
+			PrevHash:     e.PrevHash,
+			Hash:         e.Hash,
+		})
+	}
+	return list
+}
@@ -10,22 +10,50 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// TLSRotator regenerates the TLS key pair of the server with a certificate
+// bound to the given extra IPs and domains, and returns it PEM encoded.
+type TLSRotator func(extraIPs, extraDomains []string) (string, error)
+
+// WalletServiceFn returns the wallet service of the wallet selected by the
+// request.
+type WalletServiceFn func(ctx context.Context) (*application.WalletService, error)
+
+// AuditServiceFn returns the audit service of the wallet selected by the
+// request.
+type AuditServiceFn func(ctx context.Context) (*application.AuditService, error)
+
 type wallet struct {
-	appSvc *application.WalletService
+	appSvc    WalletServiceFn
+	auditSvc  AuditServiceFn
+	healthSvc *application.HealthService
+	rotateTLS TLSRotator
 }
 
+// NewWalletHandler returns the handler of the wallet service. The TLS
+// rotator is expected to be nil if TLS is disabled.
+// Unlike the health service, shared by all wallets, the wallet and audit
+// services are resolved at every request.
 func NewWalletHandler(
-	appSvc *application.WalletService,
+	appSvc WalletServiceFn, auditSvc AuditServiceFn,
+	healthSvc *application.HealthService, rotateTLS TLSRotator,
 ) pb.WalletServiceServer {
 	return &wallet{
-		appSvc: appSvc,
+		appSvc:    appSvc,
+		auditSvc:  auditSvc,
+		healthSvc: healthSvc,
+		rotateTLS: rotateTLS,
 	}
 }
 
 func (w *wallet) GenSeed(
 	ctx context.Context, _ *pb.GenSeedRequest,
 ) (*pb.GenSeedResponse, error) {
-	mnemonic, err := w.appSvc.GenSeed(ctx)
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := appSvc.GenSeed(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +65,11 @@ func (w *wallet) GenSeed(
 func (w *wallet) CreateWallet(
 	ctx context.Context, req *pb.CreateWalletRequest,
 ) (*pb.CreateWalletResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	mnemonic, err := parseMnemonic(req.GetMnemonic())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -46,7 +79,7 @@ func (w *wallet) CreateWallet(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := w.appSvc.CreateWallet(
+	if err := appSvc.CreateWallet(
 		ctx, strings.Split(mnemonic, " "), password,
 	); err != nil {
 		return nil, err
@@ -58,12 +91,17 @@ func (w *wallet) CreateWallet(
 func (w *wallet) Unlock(
 	ctx context.Context, req *pb.UnlockRequest,
 ) (*pb.UnlockResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	password, err := parsePassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := w.appSvc.Unlock(ctx, password); err != nil {
+	if err := appSvc.Unlock(ctx, password); err != nil {
 		return nil, err
 	}
 
@@ -73,12 +111,17 @@ func (w *wallet) Unlock(
 func (w *wallet) Lock(
 	ctx context.Context, req *pb.LockRequest,
 ) (*pb.LockResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	password, err := parsePassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := w.appSvc.Lock(ctx, password); err != nil {
+	if err := appSvc.Lock(ctx, password); err != nil {
 		return nil, err
 	}
 
@@ -88,6 +131,11 @@ func (w *wallet) Lock(
 func (w *wallet) ChangePassword(
 	ctx context.Context, req *pb.ChangePasswordRequest,
 ) (*pb.ChangePasswordResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	currentPwd, err := parsePassword(req.GetCurrentPassword())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -97,7 +145,7 @@ func (w *wallet) ChangePassword(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := w.appSvc.ChangePassword(
+	if err := appSvc.ChangePassword(
 		ctx, currentPwd, newPwd,
 	); err != nil {
 		return nil, err
@@ -109,6 +157,11 @@ func (w *wallet) ChangePassword(
 func (w *wallet) RestoreWallet(
 	req *pb.RestoreWalletRequest, stream pb.WalletService_RestoreWalletServer,
 ) error {
+	appSvc, err := w.appSvc(stream.Context())
+	if err != nil {
+		return err
+	}
+
 	mnemonic, err := parseMnemonic(req.GetMnemonic())
 	if err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -127,7 +180,7 @@ func (w *wallet) RestoreWallet(
 	}
 
 	chMessages := make(chan application.WalletRestoreMessage)
-	go w.appSvc.RestoreWallet(
+	go appSvc.RestoreWallet(
 		stream.Context(), chMessages,
 		strings.Split(mnemonic, " "), rootPath, password, birthdayBlock,
 		req.GetEmptyAccountThreshold(), req.GetUnusedAddressThreshold(),
@@ -148,16 +201,33 @@ func (w *wallet) RestoreWallet(
 }
 
 func (w *wallet) Status(ctx context.Context, _ *pb.StatusRequest) (*pb.StatusResponse, error) {
-	status := w.appSvc.GetStatus(ctx)
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := appSvc.GetStatus(ctx)
+	syncStatus := w.healthSvc.GetSyncStatus(ctx)
 	return &pb.StatusResponse{
-		Initialized: status.IsInitialized,
-		Unlocked:    status.IsUnlocked,
-		Synced:      status.IsSynced,
+		Initialized:      status.IsInitialized,
+		Unlocked:         status.IsUnlocked,
+		Synced:           status.IsSynced,
+		ScannerType:      syncStatus.ScannerType,
+		ScannerConnected: syncStatus.IsScannerConnected,
+		TipHeight:        syncStatus.TipHeight,
+		BackendHeight:    syncStatus.BackendHeight,
+		LastBlockAge:     uint64(syncStatus.LastBlockAge.Seconds()),
+		LaggingAccounts:  parseAccountsSyncStatus(syncStatus.LaggingAccounts),
 	}, nil
 }
 
 func (w *wallet) GetInfo(ctx context.Context, _ *pb.GetInfoRequest) (*pb.GetInfoResponse, error) {
-	info, err := w.appSvc.GetInfo(ctx)
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := appSvc.GetInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -182,12 +252,17 @@ func (w *wallet) Auth(
 	ctx context.Context,
 	req *pb.AuthRequest,
 ) (*pb.AuthResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	password, err := parsePassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	verified, err := w.appSvc.Auth(ctx, password)
+	verified, err := appSvc.Auth(ctx, password)
 	if err != nil {
 		return nil, err
 	}
@@ -196,3 +271,88 @@ func (w *wallet) Auth(
 		Verified: verified,
 	}, nil
 }
+
+func (w *wallet) ListAuditEvents(
+	ctx context.Context, req *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
+	auditSvc, err := w.auditSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, nextSequence, err := auditSvc.ListEvents(
+		ctx, req.GetFromSequence(), req.GetLimit(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Events:       parseAuditEvents(events),
+		NextSequence: nextSequence,
+	}, nil
+}
+
+func (w *wallet) RotateTLS(
+	_ context.Context, req *pb.RotateTLSRequest,
+) (*pb.RotateTLSResponse, error) {
+	if w.rotateTLS == nil {
+		return nil, status.Error(codes.FailedPrecondition, "TLS is disabled")
+	}
+	extraIPs, err := parseExtraIPs(req.GetExtraIps())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cert, err := w.rotateTLS(extraIPs, req.GetExtraDomains())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateTLSResponse{Cert: cert}, nil
+}
+
+func (w *wallet) ExportBackup(
+	ctx context.Context, req *pb.ExportBackupRequest,
+) (*pb.ExportBackupResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	backup, err := appSvc.ExportBackup(ctx, password, req.GetIncludeCache())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportBackupResponse{Backup: backup}, nil
+}
+
+func (w *wallet) ImportBackup(
+	ctx context.Context, req *pb.ImportBackupRequest,
+) (*pb.ImportBackupResponse, error) {
+	appSvc, err := w.appSvc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := parseBackup(req.GetBackup())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := appSvc.ImportBackup(ctx, backup, password); err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportBackupResponse{}, nil
+}
@@ -0,0 +1,360 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+)
+
+// auditInfoFn extracts the info to be recorded in the audit log from the
+// request and response of an RPC. The response is nil if the RPC failed.
+type auditInfoFn func(req, resp interface{}) application.AuditEventInfo
+
+// auditedMethods maps the state-changing RPCs to their auditInfoFn. Any
+// other RPC not listed as readonly is audited with noAuditInfo, so that a new
+// RPC can never go unaudited.
+var auditedMethods = map[string]auditInfoFn{
+	"/ocean.v1.WalletService/CreateWallet":   noAuditInfo,
+	"/ocean.v1.WalletService/RestoreWallet":  noAuditInfo,
+	"/ocean.v1.WalletService/ChangePassword": noAuditInfo,
+	"/ocean.v1.WalletService/Unlock":         noAuditInfo,
+	"/ocean.v1.WalletService/Lock":           noAuditInfo,
+	"/ocean.v1.WalletService/RotateTLS":      noAuditInfo,
+	"/ocean.v1.WalletService/ExportBackup":   noAuditInfo,
+	"/ocean.v1.WalletService/ImportBackup":   noAuditInfo,
+	"/ocean.v1.AccountService/CreateAccountBIP44": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.CreateAccountBIP44Response)
+		return application.AuditEventInfo{
+			AccountName: res.GetInfo().GetNamespace(),
+		}
+	},
+	"/ocean.v1.AccountService/CreateAccountMultiSig": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.CreateAccountMultiSigResponse)
+		return application.AuditEventInfo{
+			AccountName: res.GetInfo().GetNamespace(),
+		}
+	},
+	"/ocean.v1.AccountService/CreateAccountCustom": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.CreateAccountCustomResponse)
+		return application.AuditEventInfo{
+			AccountName: res.GetInfo().GetNamespace(),
+		}
+	},
+	"/ocean.v1.AccountService/SetAccountLabel": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SetAccountLabelRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/SetAccountTemplate": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SetAccountTemplateRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/SetUtxoLabels": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SetUtxoLabelsRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/SetAddressLabels": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SetAddressLabelsRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/DeleteAccount": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.DeleteAccountRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/SetSpendingPolicy": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SetSpendingPolicyRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/DeleteSpendingPolicy": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.DeleteSpendingPolicyRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/FreezeUtxos": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.FreezeUtxosRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.AccountService/UnfreezeUtxos": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.UnfreezeUtxosRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.TransactionService/LockUtxos": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.LockUtxosRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.TransactionService/UnlockUtxos": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.UnlockUtxosRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.TransactionService/SignTransaction": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SignTransactionRequest)
+		return application.AuditEventInfo{Tx: r.GetTxHex()}
+	},
+	"/ocean.v1.TransactionService/SignPset": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SignPsetRequest)
+		return application.AuditEventInfo{Tx: r.GetPset()}
+	},
+	"/ocean.v1.TransactionService/SignPsetWithSchnorrKey": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SignPsetWithSchnorrKeyRequest)
+		return application.AuditEventInfo{Tx: r.GetTx()}
+	},
+	"/ocean.v1.TransactionService/SelectUtxos": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SelectUtxosRequest)
+		return application.AuditEventInfo{AccountName: r.GetAccountName()}
+	},
+	"/ocean.v1.TransactionService/BroadcastTransaction": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.BroadcastTransactionRequest)
+		res, _ := resp.(*pb.BroadcastTransactionResponse)
+		return application.AuditEventInfo{
+			Tx:   r.GetTxHex(),
+			Txid: res.GetTxid(),
+		}
+	},
+	"/ocean.v1.TransactionService/ScheduleTransaction": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.ScheduleTransactionRequest)
+		res, _ := resp.(*pb.ScheduleTransactionResponse)
+		return application.AuditEventInfo{
+			Tx:   r.GetTxHex(),
+			Txid: res.GetScheduledTransaction().GetTxid(),
+		}
+	},
+	"/ocean.v1.TransactionService/CancelScheduledTransaction": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.CancelScheduledTransactionRequest)
+		return application.AuditEventInfo{Txid: r.GetTxid()}
+	},
+	"/ocean.v1.TransactionService/Transfer": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.TransferRequest)
+		res, _ := resp.(*pb.TransferResponse)
+		amounts := make(map[string]uint64)
+		destinations := make([]string, 0, len(r.GetReceivers()))
+		for _, out := range r.GetReceivers() {
+			amounts[out.GetAsset()] += out.GetAmount()
+			destination := out.GetAddress()
+			if destination == "" {
+				destination = out.GetScript()
+			}
+			destinations = append(destinations, destination)
+		}
+		return application.AuditEventInfo{
+			AccountName:  r.GetAccountName(),
+			Tx:           res.GetTxHex(),
+			Amounts:      amounts,
+			Destinations: destinations,
+		}
+	},
+	"/ocean.v1.TransactionService/SweepAccount": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.SweepAccountRequest)
+		res, _ := resp.(*pb.SweepAccountResponse)
+		return application.AuditEventInfo{
+			AccountName:  r.GetAccountName(),
+			Tx:           res.GetTxHex(),
+			Destinations: []string{r.GetAddress()},
+		}
+	},
+	"/ocean.v1.TransactionService/ConsolidateUtxos": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.ConsolidateUtxosRequest)
+		res, _ := resp.(*pb.ConsolidateUtxosResponse)
+		return application.AuditEventInfo{
+			AccountName: r.GetAccountName(),
+			Txid:        strings.Join(res.GetTxids(), ","),
+		}
+	},
+	"/ocean.v1.TransactionService/BumpFee": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.BumpFeeResponse)
+		return application.AuditEventInfo{
+			Txid: res.GetTxid(),
+			Tx:   res.GetTxHex(),
+		}
+	},
+	"/ocean.v1.TransactionService/EnqueuePayment": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.EnqueuePaymentRequest)
+		out := r.GetReceiver()
+		destination := out.GetAddress()
+		if destination == "" {
+			destination = out.GetScript()
+		}
+		return application.AuditEventInfo{
+			AccountName:  r.GetAccountName(),
+			Amounts:      map[string]uint64{out.GetAsset(): out.GetAmount()},
+			Destinations: []string{destination},
+		}
+	},
+	"/ocean.v1.TransactionService/FlushPayments": func(
+		req, resp interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.FlushPaymentsRequest)
+		res, _ := resp.(*pb.FlushPaymentsResponse)
+		return application.AuditEventInfo{
+			AccountName: r.GetAccountName(),
+			Txid:        strings.Join(res.GetTxids(), ","),
+		}
+	},
+	"/ocean.v1.TransactionService/ApproveSpend": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.ApproveSpendResponse)
+		return application.AuditEventInfo{Tx: res.GetSignedTx()}
+	},
+	"/ocean.v1.TransactionService/RejectSpend":  noAuditInfo,
+	"/ocean.v1.TransactionService/PegInAddress": noAuditInfo,
+	"/ocean.v1.TransactionService/ClaimPegIn": func(
+		_, resp interface{},
+	) application.AuditEventInfo {
+		res, _ := resp.(*pb.ClaimPegInResponse)
+		return application.AuditEventInfo{Tx: res.GetTxHex()}
+	},
+	"/ocean.v1.NotificationService/WatchExternalScript": func(
+		req, _ interface{},
+	) application.AuditEventInfo {
+		r, _ := req.(*pb.WatchExternalScriptRequest)
+		return application.AuditEventInfo{
+			Destinations: []string{r.GetScript()},
+		}
+	},
+	"/ocean.v1.NotificationService/UnwatchExternalScript": noAuditInfo,
+	"/ocean.v1.NotificationService/AddWebhook":            noAuditInfo,
+	"/ocean.v1.NotificationService/RemoveWebhook":         noAuditInfo,
+}
+
+// noAuditInfo is used for those RPCs for which only caller and method are
+// relevant.
+func noAuditInfo(_, _ interface{}) application.AuditEventInfo {
+	return application.AuditEventInfo{}
+}
+
+// auditInfoFnOf returns the auditInfoFn of the given RPC, if not readonly.
+func auditInfoFnOf(method string) (auditInfoFn, bool) {
+	if _, ok := readonlyMethods[method]; ok {
+		return nil, false
+	}
+	if infoFn, ok := auditedMethods[method]; ok {
+		return infoFn, true
+	}
+	return noAuditInfo, true
+}
+
+// AuditServiceFn returns the audit service of the wallet selected by the RPC.
+type AuditServiceFn func(ctx context.Context) (*application.AuditService, error)
+
+func unaryAuditor(auditSvc AuditServiceFn) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		infoFn, ok := auditInfoFnOf(info.FullMethod)
+		if !ok {
+			return resp, err
+		}
+
+		// Handlers return typed nil pointers in case of failure.
+		var respOrNil interface{}
+		if err == nil {
+			respOrNil = resp
+		}
+		eventInfo := infoFn(req, respOrNil)
+		recordAuditEvent(ctx, auditSvc, info.FullMethod, eventInfo, err)
+
+		return resp, err
+	}
+}
+
+func streamAuditor(auditSvc AuditServiceFn) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, stream)
+
+		infoFn, ok := auditInfoFnOf(info.FullMethod)
+		if !ok {
+			return err
+		}
+
+		// Requests and responses of streams are not available here.
+		eventInfo := infoFn(nil, nil)
+		recordAuditEvent(stream.Context(), auditSvc, info.FullMethod, eventInfo, err)
+
+		return err
+	}
+}
+
+// recordAuditEvent records the given event in the audit log of the wallet
+// selected by the RPC. Failures are only logged to never affect the result
+// of the RPC.
+func recordAuditEvent(
+	ctx context.Context, auditSvc AuditServiceFn, method string,
+	eventInfo application.AuditEventInfo, err error,
+) {
+	eventInfo.Method = method
+	eventInfo.Err = err
+
+	svc, auditErr := auditSvc(ctx)
+	if auditErr == nil {
+		auditErr = svc.RecordEvent(ctx, eventInfo)
+	}
+	if auditErr != nil {
+		log.WithError(auditErr).Warnf(
+			"failed to record audit event for method %s", method,
+		)
+	}
+}
@@ -0,0 +1,44 @@
+package grpc_interceptor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"google.golang.org/grpc"
+)
+
+func TestAuditedMethods(t *testing.T) {
+	services := []grpc.ServiceDesc{
+		pb.WalletService_ServiceDesc,
+		pb.AccountService_ServiceDesc,
+		pb.TransactionService_ServiceDesc,
+		pb.NotificationService_ServiceDesc,
+	}
+
+	for _, svc := range services {
+		methods := make([]string, 0)
+		for _, m := range svc.Methods {
+			methods = append(methods, m.MethodName)
+		}
+		for _, s := range svc.Streams {
+			methods = append(methods, s.StreamName)
+		}
+
+		for _, name := range methods {
+			method := fmt.Sprintf("/%s/%s", svc.ServiceName, name)
+			_, isReadonly := readonlyMethods[method]
+			infoFn, isAudited := auditInfoFnOf(method)
+			require.Equal(t, !isReadonly, isAudited, method)
+			if isAudited {
+				require.NotNil(t, infoFn, method)
+			}
+		}
+	}
+
+	// Responses are nil for failed RPCs, and so are requests for streams.
+	for method, infoFn := range auditedMethods {
+		require.NotPanics(t, func() { infoFn(nil, nil) }, method)
+	}
+}
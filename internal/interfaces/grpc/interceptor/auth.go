@@ -0,0 +1,218 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"crypto/tls"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Permission roles that can be assigned to the subjects of client
+// certificates. Every role is granted the permissions of the lower ones.
+const (
+	RoleReadonly = "readonly"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+var (
+	roleLevels = map[string]int{
+		RoleReadonly: 0,
+		RoleOperator: 1,
+		RoleAdmin:    2,
+	}
+
+	// readonlyMethods are the RPCs that do not change the state of the wallet.
+	readonlyMethods = map[string]struct{}{
+		"/ocean.v1.WalletService/Status":                         {},
+		"/ocean.v1.WalletService/GetInfo":                        {},
+		"/ocean.v1.WalletService/Auth":                           {},
+		"/ocean.v1.AccountService/ListAddresses":                 {},
+		"/ocean.v1.AccountService/Balance":                       {},
+		"/ocean.v1.AccountService/ListUtxos":                     {},
+		"/ocean.v1.AccountService/GetSpendingPolicy":             {},
+		"/ocean.v1.TransactionService/GetTransaction":            {},
+		"/ocean.v1.TransactionService/EstimateFees":              {},
+		"/ocean.v1.TransactionService/ListSpendApprovals":        {},
+		"/ocean.v1.TransactionService/GetPayment":                {},
+		"/ocean.v1.TransactionService/GetBroadcast":              {},
+		"/ocean.v1.TransactionService/GetScheduledTransaction":   {},
+		"/ocean.v1.TransactionService/ListScheduledTransactions": {},
+		"/ocean.v1.NotificationService/TransactionNotifications": {},
+		"/ocean.v1.NotificationService/UtxosNotifications":       {},
+		"/ocean.v1.NotificationService/PaymentNotifications":     {},
+		"/ocean.v1.NotificationService/BroadcastNotifications":   {},
+		"/ocean.v1.NotificationService/ListWebhooks":             {},
+		"/grpc.health.v1.Health/Check":                           {},
+		"/grpc.health.v1.Health/Watch":                           {},
+	}
+	// operatorMethods are the RPCs required for the daily operations, like
+	// deriving addresses or crafting and signing transactions.
+	operatorMethods = map[string]struct{}{
+		"/ocean.v1.WalletService/GenSeed":                         {},
+		"/ocean.v1.AccountService/CreateAccountBIP44":             {},
+		"/ocean.v1.AccountService/CreateAccountMultiSig":          {},
+		"/ocean.v1.AccountService/CreateAccountCustom":            {},
+		"/ocean.v1.AccountService/SetAccountLabel":                {},
+		"/ocean.v1.AccountService/SetAccountTemplate":             {},
+		"/ocean.v1.AccountService/DeriveAddresses":                {},
+		"/ocean.v1.AccountService/DeriveChangeAddresses":          {},
+		"/ocean.v1.AccountService/FreezeUtxos":                    {},
+		"/ocean.v1.AccountService/UnfreezeUtxos":                  {},
+		"/ocean.v1.AccountService/SetUtxoLabels":                  {},
+		"/ocean.v1.AccountService/SetAddressLabels":               {},
+		"/ocean.v1.TransactionService/SelectUtxos":                {},
+		"/ocean.v1.TransactionService/LockUtxos":                  {},
+		"/ocean.v1.TransactionService/UnlockUtxos":                {},
+		"/ocean.v1.TransactionService/SignTransaction":            {},
+		"/ocean.v1.TransactionService/BroadcastTransaction":       {},
+		"/ocean.v1.TransactionService/ScheduleTransaction":        {},
+		"/ocean.v1.TransactionService/CancelScheduledTransaction": {},
+		"/ocean.v1.TransactionService/CreatePset":                 {},
+		"/ocean.v1.TransactionService/UpdatePset":                 {},
+		"/ocean.v1.TransactionService/BlindPset":                  {},
+		"/ocean.v1.TransactionService/SignPset":                   {},
+		"/ocean.v1.TransactionService/Mint":                       {},
+		"/ocean.v1.TransactionService/Remint":                     {},
+		"/ocean.v1.TransactionService/Burn":                       {},
+		"/ocean.v1.TransactionService/Transfer":                   {},
+		"/ocean.v1.TransactionService/SweepAccount":               {},
+		"/ocean.v1.TransactionService/ConsolidateUtxos":           {},
+		"/ocean.v1.TransactionService/BumpFee":                    {},
+		"/ocean.v1.TransactionService/EnqueuePayment":             {},
+		"/ocean.v1.TransactionService/FlushPayments":              {},
+		"/ocean.v1.TransactionService/PegInAddress":               {},
+		"/ocean.v1.TransactionService/ClaimPegIn":                 {},
+		"/ocean.v1.TransactionService/SignPsetWithSchnorrKey":     {},
+		"/ocean.v1.NotificationService/WatchExternalScript":       {},
+		"/ocean.v1.NotificationService/UnwatchExternalScript":     {},
+		"/ocean.v1.NotificationService/AddWebhook":                {},
+		"/ocean.v1.NotificationService/RemoveWebhook":             {},
+	}
+)
+
+// IsValidRole returns whether the given role is one of the supported ones.
+func IsValidRole(role string) bool {
+	_, ok := roleLevels[role]
+	return ok
+}
+
+// requiredRole returns the minimum role required to call the given RPC.
+// Any RPC not explicitly listed requires the admin role.
+func requiredRole(method string) string {
+	if _, ok := readonlyMethods[method]; ok {
+		return RoleReadonly
+	}
+	if _, ok := operatorMethods[method]; ok {
+		return RoleOperator
+	}
+	return RoleAdmin
+}
+
+func unaryAuthorizer(
+	rolesBySubject map[string]string, subjectOf identityFn,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		role, err := authorize(ctx, rolesBySubject, subjectOf, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(contextWithRole(ctx, role), req)
+	}
+}
+
+func streamAuthorizer(
+	rolesBySubject map[string]string, subjectOf identityFn,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		role, err := authorize(
+			stream.Context(), rolesBySubject, subjectOf, info.FullMethod,
+		)
+		if err != nil {
+			return err
+		}
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = contextWithRole(stream.Context(), role)
+		return handler(srv, wrapped)
+	}
+}
+
+// authorize makes sure the subject of the verified client certificate is
+// mapped to a role that grants the permission to call the given RPC, and
+// returns such role.
+func authorize(
+	ctx context.Context, rolesBySubject map[string]string, subjectOf identityFn,
+	method string,
+) (string, error) {
+	subject, ok := subjectOf(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing client certificate")
+	}
+
+	role, ok := rolesBySubject[subject]
+	if !ok {
+		return "", status.Errorf(
+			codes.PermissionDenied, "no role assigned to subject %s", subject,
+		)
+	}
+
+	if roleLevels[role] < roleLevels[requiredRole(method)] {
+		return "", status.Errorf(
+			codes.PermissionDenied, "role %s is not allowed to call %s", role, method,
+		)
+	}
+	return role, nil
+}
+
+// contextWithRole grants admin rights to the app services, like releasing
+// the utxo locks held by others, if the caller has the admin role.
+func contextWithRole(ctx context.Context, role string) context.Context {
+	if role == RoleAdmin {
+		return application.ContextWithAdminRights(ctx)
+	}
+	return ctx
+}
+
+// clientCertSubject returns the subject common name of the verified TLS
+// client certificate, if any.
+func clientCertSubject(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", false
+	}
+	return TLSClientSubject(&tlsInfo.State)
+}
+
+// TLSClientSubject returns the subject common name of the verified client
+// certificate of the given TLS connection, if any.
+func TLSClientSubject(state *tls.ConnectionState) (string, bool) {
+	if state == nil {
+		return "", false
+	}
+	chains := state.VerifiedChains
+	if len(chains) <= 0 || len(chains[0]) <= 0 {
+		return "", false
+	}
+	cn := chains[0][0].Subject.CommonName
+	return cn, cn != ""
+}
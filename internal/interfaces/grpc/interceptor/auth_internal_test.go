@@ -0,0 +1,272 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	readonlyMethod = "/ocean.v1.AccountService/Balance"
+	operatorMethod = "/ocean.v1.TransactionService/Transfer"
+	adminMethod    = "/ocean.v1.AccountService/DeleteAccount"
+)
+
+var rolesBySubject = map[string]string{
+	"viewer":   RoleReadonly,
+	"operator": RoleOperator,
+	"admin":    RoleAdmin,
+}
+
+func TestRequiredRole(t *testing.T) {
+	require.Equal(t, RoleReadonly, requiredRole(readonlyMethod))
+	require.Equal(t, RoleOperator, requiredRole(operatorMethod))
+
+	// Any RPC not explicitly listed requires the admin role.
+	unlisted := []string{
+		adminMethod,
+		"/ocean.v1.WalletService/RotateTLS",
+		"/ocean.v1.WalletService/CreateWallet",
+		"/ocean.v1.UnknownService/Unknown",
+		"",
+	}
+	for _, method := range unlisted {
+		require.Equal(t, RoleAdmin, requiredRole(method), method)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name         string
+		subject      string
+		method       string
+		expectedRole string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "missing subject",
+			method:       readonlyMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "unmapped subject",
+			subject:      "unknown",
+			method:       readonlyMethod,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "readonly calling readonly rpc",
+			subject:      "viewer",
+			method:       readonlyMethod,
+			expectedRole: RoleReadonly,
+		},
+		{
+			name:         "readonly calling operator rpc",
+			subject:      "viewer",
+			method:       operatorMethod,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "readonly calling admin rpc",
+			subject:      "viewer",
+			method:       adminMethod,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "operator calling operator rpc",
+			subject:      "operator",
+			method:       operatorMethod,
+			expectedRole: RoleOperator,
+		},
+		{
+			name:         "operator calling admin rpc",
+			subject:      "operator",
+			method:       adminMethod,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "admin calling admin rpc",
+			subject:      "admin",
+			method:       adminMethod,
+			expectedRole: RoleAdmin,
+		},
+		{
+			name:         "admin calling readonly rpc",
+			subject:      "admin",
+			method:       readonlyMethod,
+			expectedRole: RoleAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := authorize(
+				context.Background(), rolesBySubject, subjectFn(tt.subject),
+				tt.method,
+			)
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
+
+func TestUnaryAuthorizer(t *testing.T) {
+	var isAdmin bool
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		isAdmin = application.HasAdminRights(ctx)
+		return nil, nil
+	}
+
+	t.Run("grants admin rights to admins", func(t *testing.T) {
+		interceptor := unaryAuthorizer(rolesBySubject, subjectFn("admin"))
+		_, err := interceptor(
+			context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: operatorMethod}, handler,
+		)
+		require.NoError(t, err)
+		require.True(t, isAdmin)
+	})
+
+	t.Run("no admin rights to operators", func(t *testing.T) {
+		interceptor := unaryAuthorizer(rolesBySubject, subjectFn("operator"))
+		_, err := interceptor(
+			context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: operatorMethod}, handler,
+		)
+		require.NoError(t, err)
+		require.False(t, isAdmin)
+	})
+
+	t.Run("rejects before calling the handler", func(t *testing.T) {
+		called := false
+		interceptor := unaryAuthorizer(rolesBySubject, subjectFn("viewer"))
+		_, err := interceptor(
+			context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: operatorMethod},
+			func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			},
+		)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.False(t, called)
+	})
+}
+
+func TestUnaryCaller(t *testing.T) {
+	var caller, authenticatedCaller string
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		caller = application.CallerFromContext(ctx)
+		authenticatedCaller = application.AuthenticatedCallerFromContext(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: readonlyMethod}
+
+	tests := []struct {
+		name                        string
+		authenticate                identityFn
+		identify                    identityFn
+		expectedCaller              string
+		expectedAuthenticatedCaller string
+	}{
+		{
+			name:                        "authenticated caller",
+			authenticate:                subjectFn("alice"),
+			identify:                    subjectFn("10.0.0.1"),
+			expectedCaller:              "alice",
+			expectedAuthenticatedCaller: "alice",
+		},
+		{
+			name:           "caller identified by address",
+			authenticate:   subjectFn(""),
+			identify:       subjectFn("10.0.0.1"),
+			expectedCaller: "10.0.0.1",
+		},
+		{
+			name:         "unknown caller",
+			authenticate: subjectFn(""),
+			identify:     subjectFn(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := unaryCaller(tt.authenticate, tt.identify)
+			_, err := interceptor(context.Background(), nil, info, handler)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCaller, caller)
+			require.Equal(t, tt.expectedAuthenticatedCaller, authenticatedCaller)
+		})
+	}
+}
+
+func TestTLSClientSubject(t *testing.T) {
+	cert := func(cn string) *x509.Certificate {
+		return &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+	}
+
+	tests := []struct {
+		name            string
+		state           *tls.ConnectionState
+		expectedSubject string
+		expectedOk      bool
+	}{
+		{
+			name: "no connection state",
+		},
+		{
+			name:  "no client certificate",
+			state: &tls.ConnectionState{},
+		},
+		{
+			name: "unverified client certificate",
+			state: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert("alice")},
+			},
+		},
+		{
+			name: "verified client certificate without common name",
+			state: &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert("")}},
+			},
+		},
+		{
+			name: "verified client certificate",
+			state: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert("alice")},
+				VerifiedChains:   [][]*x509.Certificate{{cert("alice"), cert("ca")}},
+			},
+			expectedSubject: "alice",
+			expectedOk:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, ok := TLSClientSubject(tt.state)
+			require.Equal(t, tt.expectedOk, ok)
+			require.Equal(t, tt.expectedSubject, subject)
+		})
+	}
+}
+
+// subjectFn returns an identityFn always identifying the caller with the
+// given subject, if not empty.
+func subjectFn(subject string) identityFn {
+	return func(context.Context) (string, bool) {
+		return subject, subject != ""
+	}
+}
@@ -0,0 +1,71 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"net"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// identityFn returns the identity of the caller of an RPC, if any.
+type identityFn func(ctx context.Context) (string, bool)
+
+func unaryCaller(authenticate, identify identityFn) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withCaller(ctx, authenticate, identify), req)
+	}
+}
+
+func streamCaller(
+	authenticate, identify identityFn,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withCaller(
+			stream.Context(), authenticate, identify,
+		)
+		return handler(srv, wrapped)
+	}
+}
+
+// withCaller adds the identity of the caller to the given context. The
+// caller is authenticated if verified by the given function, otherwise it's
+// identified, for example by its address, with the other one.
+func withCaller(
+	ctx context.Context, authenticate, identify identityFn,
+) context.Context {
+	if caller, ok := authenticate(ctx); ok {
+		return application.ContextWithAuthenticatedCaller(ctx, caller)
+	}
+	caller, ok := identify(ctx)
+	if !ok {
+		return ctx
+	}
+	return application.ContextWithCaller(ctx, caller)
+}
+
+// peerHost returns the host of the address the RPC came from, if any.
+func peerHost(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return host, true
+}
@@ -0,0 +1,93 @@
+package grpc_interceptor
+
+import (
+	"context"
+
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo details attached to the errors
+// returned to clients.
+const errorDomain = "ocean"
+
+var codesByReason = map[application.ErrorReason]codes.Code{
+	application.ReasonInvalidArgument:          codes.InvalidArgument,
+	application.ReasonNotFound:                 codes.NotFound,
+	application.ReasonInsufficientFunds:        codes.FailedPrecondition,
+	application.ReasonWalletNotInitialized:     codes.FailedPrecondition,
+	application.ReasonWalletAlreadyInitialized: codes.AlreadyExists,
+	application.ReasonWalletLocked:             codes.FailedPrecondition,
+	application.ReasonWalletUnlocked:           codes.FailedPrecondition,
+	application.ReasonInvalidPassword:          codes.Unauthenticated,
+	application.ReasonMaxAccountsReached:       codes.ResourceExhausted,
+	application.ReasonAccountNotEmpty:          codes.FailedPrecondition,
+	application.ReasonUtxoLocked:               codes.FailedPrecondition,
+	application.ReasonUtxoNotLocked:            codes.FailedPrecondition,
+	application.ReasonUtxoFrozen:               codes.FailedPrecondition,
+	application.ReasonUtxoLockNotOwned:         codes.PermissionDenied,
+	application.ReasonInvalidTransaction:       codes.InvalidArgument,
+	application.ReasonInvalidPset:              codes.InvalidArgument,
+	application.ReasonInvalidBackup:            codes.InvalidArgument,
+	application.ReasonSpendApprovalRequired:    codes.FailedPrecondition,
+	application.ReasonSpendingPolicyViolation:  codes.PermissionDenied,
+	application.ReasonIdempotencyKeyMismatch:   codes.InvalidArgument,
+	application.ReasonIdempotencyKeyPending:    codes.Aborted,
+	application.ReasonTransactionNotBumpable:   codes.FailedPrecondition,
+	application.ReasonTransactionNotScheduled:  codes.FailedPrecondition,
+	application.ReasonCallerNotAuthenticated:   codes.Unauthenticated,
+}
+
+// unaryErrorMapper converts the errors of the application services into gRPC
+// status errors with the code matching their reason, and the reason itself
+// attached as ErrorInfo details. It must be the last of the chain so that any
+// other interceptor sees the final status code.
+func unaryErrorMapper(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+func streamErrorMapper(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return toStatusError(handler(srv, stream))
+}
+
+// toStatusError returns the given error as is if it's already a gRPC status
+// error, or if its reason is unknown.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	reason, metadata := application.ErrorReasonOf(err)
+	code, ok := codesByReason[reason]
+	if !ok {
+		return err
+	}
+	st, detailsErr := status.New(code, err.Error()).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   string(reason),
+			Domain:   errorDomain,
+			Metadata: metadata,
+		},
+	)
+	if detailsErr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+}
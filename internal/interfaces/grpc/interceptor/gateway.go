@@ -0,0 +1,49 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ClientSubjectMetadataKey is the metadata key used by the REST gateway to
+	// forward the subject of the verified TLS client certificate of the caller.
+	ClientSubjectMetadataKey = "x-ocean-client-subject"
+	// forwardedForMetadataKey is the metadata key used by the REST gateway to
+	// forward the address of the caller.
+	forwardedForMetadataKey = "x-forwarded-for"
+)
+
+// forwardedSubject returns the subject of the client certificate forwarded
+// by the REST gateway, if any.
+func forwardedSubject(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(ClientSubjectMetadataKey)
+	if len(values) != 1 || len(values[0]) <= 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// forwardedAddr returns the address the HTTP request forwarded by the REST
+// gateway came from, if any.
+func forwardedAddr(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(forwardedForMetadataKey)
+	if len(values) <= 0 {
+		return "", false
+	}
+	// The gateway appends the remote address of the request to the list of
+	// the proxies possibly set by the client, only the last one is trusted.
+	addrs := strings.Split(values[len(values)-1], ",")
+	addr := strings.TrimSpace(addrs[len(addrs)-1])
+	return addr, len(addr) > 0
+}
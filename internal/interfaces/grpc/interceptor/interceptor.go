@@ -5,20 +5,120 @@ import (
 	"google.golang.org/grpc"
 )
 
-// UnaryInterceptor returns the unary interceptor
-func UnaryInterceptor() grpc.ServerOption {
-	return grpc.UnaryInterceptor(
-		middleware.ChainUnaryServer(
-			unaryLogger,
-		),
-	)
+// UnaryInterceptor returns the unary interceptor, tracing every RPC, recording
+// its latency and status code, and every state-changing RPC in the audit log
+// of the wallet selected by the caller.
+// If a rate limiter is given, the RPCs of callers exceeding their budget are
+// rejected.
+// If client roles are given, the caller is authorized based on the role
+// assigned to the subject of its client certificate.
+func UnaryInterceptor(
+	auditSvc AuditServiceFn, clientRoles map[string]string,
+	limiter *RateLimiter,
+) grpc.ServerOption {
+	interceptors := []grpc.UnaryServerInterceptor{
+		unaryTracer,
+		unaryMetrics,
+		unaryLogger,
+		unaryCaller(clientCertSubject, peerHost),
+		unaryWalletSelector,
+	}
+	if limiter != nil {
+		interceptors = append(interceptors, unaryRateLimiter(limiter))
+	}
+	interceptors = append(interceptors, unaryAuditor(auditSvc))
+	if clientRoles != nil {
+		interceptors = append(
+			interceptors, unaryAuthorizer(clientRoles, clientCertSubject),
+		)
+	}
+	interceptors = append(interceptors, unaryErrorMapper)
+	return grpc.UnaryInterceptor(middleware.ChainUnaryServer(interceptors...))
 }
 
-// StreamInterceptor returns the stream interceptor with a logrus log
-func StreamInterceptor() grpc.ServerOption {
-	return grpc.StreamInterceptor(
-		middleware.ChainStreamServer(
-			streamLogger,
-		),
-	)
+// StreamInterceptor returns the stream interceptor with a logrus log,
+// recording every state-changing stream in the audit log of the wallet
+// selected by the caller.
+// If a rate limiter is given, the streams of callers exceeding their budget
+// are rejected.
+// If client roles are given, the caller is authorized based on the role
+// assigned to the subject of its client certificate.
+func StreamInterceptor(
+	auditSvc AuditServiceFn, clientRoles map[string]string,
+	limiter *RateLimiter,
+) grpc.ServerOption {
+	interceptors := []grpc.StreamServerInterceptor{
+		streamTracer,
+		streamMetrics,
+		streamLogger,
+		streamCaller(clientCertSubject, peerHost),
+		streamWalletSelector,
+	}
+	if limiter != nil {
+		interceptors = append(interceptors, streamRateLimiter(limiter))
+	}
+	interceptors = append(interceptors, streamAuditor(auditSvc))
+	if clientRoles != nil {
+		interceptors = append(
+			interceptors, streamAuthorizer(clientRoles, clientCertSubject),
+		)
+	}
+	interceptors = append(interceptors, streamErrorMapper)
+	return grpc.StreamInterceptor(middleware.ChainStreamServer(interceptors...))
+}
+
+// GatewayUnaryInterceptor returns the unary interceptor for the in-process
+// gRPC server backing the REST gateway.
+// Unlike UnaryInterceptor, the caller is identified and authorized based on
+// the client info forwarded by the gateway as request metadata.
+func GatewayUnaryInterceptor(
+	auditSvc AuditServiceFn, clientRoles map[string]string,
+	limiter *RateLimiter,
+) grpc.ServerOption {
+	interceptors := []grpc.UnaryServerInterceptor{
+		unaryTracer,
+		unaryMetrics,
+		unaryLogger,
+		unaryCaller(forwardedSubject, forwardedAddr),
+		unaryWalletSelector,
+	}
+	if limiter != nil {
+		interceptors = append(interceptors, unaryRateLimiter(limiter))
+	}
+	interceptors = append(interceptors, unaryAuditor(auditSvc))
+	if clientRoles != nil {
+		interceptors = append(
+			interceptors, unaryAuthorizer(clientRoles, forwardedSubject),
+		)
+	}
+	interceptors = append(interceptors, unaryErrorMapper)
+	return grpc.UnaryInterceptor(middleware.ChainUnaryServer(interceptors...))
+}
+
+// GatewayStreamInterceptor returns the stream interceptor for the in-process
+// gRPC server backing the REST gateway.
+// Unlike StreamInterceptor, the caller is identified and authorized based on
+// the client info forwarded by the gateway as request metadata.
+func GatewayStreamInterceptor(
+	auditSvc AuditServiceFn, clientRoles map[string]string,
+	limiter *RateLimiter,
+) grpc.ServerOption {
+	interceptors := []grpc.StreamServerInterceptor{
+		streamTracer,
+		streamMetrics,
+		streamLogger,
+		streamCaller(forwardedSubject, forwardedAddr),
+		streamWalletSelector,
+	}
+	if limiter != nil {
+		interceptors = append(interceptors, streamRateLimiter(limiter))
+	}
+	interceptors = append(interceptors, streamAuditor(auditSvc))
+	if clientRoles != nil {
+		interceptors = append(
+			interceptors, streamAuthorizer(clientRoles, forwardedSubject),
+		)
+	}
+	interceptors = append(interceptors, streamErrorMapper)
+	return grpc.StreamInterceptor(middleware.ChainStreamServer(interceptors...))
 }
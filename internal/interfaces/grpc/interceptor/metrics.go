@@ -0,0 +1,40 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/infrastructure/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func unaryMetrics(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.ObserveRpc(
+		info.FullMethod, status.Code(err).String(), time.Since(start),
+	)
+	return resp, err
+}
+
+// streamMetrics records the duration of the stream, that is how long the
+// client stayed subscribed.
+func streamMetrics(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	metrics.ObserveRpc(
+		info.FullMethod, status.Code(err).String(), time.Since(start),
+	)
+	return err
+}
@@ -0,0 +1,180 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnyMethod is the method name matching every RPC without a dedicated rate
+// limit.
+const AnyMethod = "*"
+
+var (
+	// limiterIdleTimeout is the time after which the bucket of a client that
+	// stopped calling a method is dropped.
+	limiterIdleTimeout = 10 * time.Minute
+
+	unknownCaller = "unknown"
+)
+
+// RateLimit is the token-bucket budget of a client for a method: the bucket
+// holds up to Burst tokens and is refilled at a rate of Rate tokens per
+// second.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// ParseRateLimit parses a rate limit in the form method:rate:burst, where
+// method is either the name of an RPC, like DeriveAddresses, or * for any
+// RPC.
+func ParseRateLimit(str string) (string, RateLimit, error) {
+	parts := strings.Split(str, ":")
+	if len(parts) != 3 || len(parts[0]) <= 0 {
+		return "", RateLimit{}, fmt.Errorf(
+			"invalid rate limit %s, must be in the form method:rate:burst", str,
+		)
+	}
+	r, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || r <= 0 {
+		return "", RateLimit{}, fmt.Errorf(
+			"invalid rate for method %s, must be a positive number", parts[0],
+		)
+	}
+	burst, err := strconv.Atoi(parts[2])
+	if err != nil || burst <= 0 {
+		return "", RateLimit{}, fmt.Errorf(
+			"invalid burst for method %s, must be a positive integer", parts[0],
+		)
+	}
+	return parts[0], RateLimit{r, burst}, nil
+}
+
+// RateLimiter keeps a token bucket per client identity and method, and
+// rejects the RPCs of clients that exhausted their budget.
+type RateLimiter struct {
+	limits map[string]RateLimit
+
+	lock      *sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	caller string
+	method string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a rate limiter enforcing the given budgets, mapped
+// by method name. Methods without a budget are not limited, unless a default
+// one is set for AnyMethod.
+func NewRateLimiter(limits map[string]RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limits:    limits,
+		lock:      &sync.Mutex{},
+		buckets:   make(map[bucketKey]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (l *RateLimiter) allow(caller, fullMethod string) bool {
+	return l.allowAt(caller, fullMethod, time.Now())
+}
+
+func (l *RateLimiter) allowAt(caller, fullMethod string, now time.Time) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	limit, ok := l.limits[method]
+	if !ok {
+		if limit, ok = l.limits[AnyMethod]; !ok {
+			return true
+		}
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.lastSweep) > limiterIdleTimeout {
+		for key, b := range l.buckets {
+			if now.Sub(b.lastSeen) > limiterIdleTimeout {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	key := bucketKey{caller, fullMethod}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst),
+		}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, 1)
+}
+
+// unaryRateLimiter rejects with ResourceExhausted the RPCs of the callers
+// that exhausted their budget for the method. It must follow the caller
+// interceptor in the chain.
+func unaryRateLimiter(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !limiter.allow(rateLimitedCaller(ctx), info.FullMethod) {
+			return nil, errRateLimitExceeded(info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamRateLimiter(limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		caller := rateLimitedCaller(stream.Context())
+		if !limiter.allow(caller, info.FullMethod) {
+			return errRateLimitExceeded(info.FullMethod)
+		}
+		return handler(srv, stream)
+	}
+}
+
+// rateLimitedCaller returns the identity of the caller the budget is charged
+// to. Unidentified callers are told apart by their host so that they don't
+// share the same budget.
+func rateLimitedCaller(ctx context.Context) string {
+	if caller := application.CallerFromContext(ctx); caller != "" {
+		return caller
+	}
+	if host, ok := peerHost(ctx); ok {
+		return host
+	}
+	return unknownCaller
+}
+
+func errRateLimitExceeded(method string) error {
+	return status.Errorf(
+		codes.ResourceExhausted, "rate limit exceeded for method %s", method,
+	)
+}
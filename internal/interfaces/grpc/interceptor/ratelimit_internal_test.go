@@ -0,0 +1,171 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	limitedMethod   = "/ocean.v1.AccountService/DeriveAddresses"
+	unlimitedMethod = "/ocean.v1.AccountService/Balance"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	method, limit, err := ParseRateLimit("DeriveAddresses:0.5:10")
+	require.NoError(t, err)
+	require.Equal(t, "DeriveAddresses", method)
+	require.Equal(t, RateLimit{Rate: 0.5, Burst: 10}, limit)
+
+	invalid := []string{
+		"",
+		"DeriveAddresses",
+		"DeriveAddresses:1",
+		":1:1",
+		"DeriveAddresses:1:1:1",
+		"DeriveAddresses:0:1",
+		"DeriveAddresses:-1:1",
+		"DeriveAddresses:one:1",
+		"DeriveAddresses:1:0",
+		"DeriveAddresses:1:1.5",
+	}
+	for _, str := range invalid {
+		_, _, err := ParseRateLimit(str)
+		require.Error(t, err, str)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	newLimiter := func() *RateLimiter {
+		return NewRateLimiter(map[string]RateLimit{
+			"DeriveAddresses": {Rate: 1, Burst: 2},
+		})
+	}
+	now := time.Now()
+
+	t.Run("rejects once the burst is exhausted", func(t *testing.T) {
+		limiter := newLimiter()
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.False(t, limiter.allowAt("alice", limitedMethod, now))
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		limiter := newLimiter()
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.False(t, limiter.allowAt("alice", limitedMethod, now))
+
+		// One token per second is added back.
+		later := now.Add(time.Second)
+		require.True(t, limiter.allowAt("alice", limitedMethod, later))
+		require.False(t, limiter.allowAt("alice", limitedMethod, later))
+
+		// The bucket never holds more than burst tokens.
+		muchLater := later.Add(time.Minute)
+		require.True(t, limiter.allowAt("alice", limitedMethod, muchLater))
+		require.True(t, limiter.allowAt("alice", limitedMethod, muchLater))
+		require.False(t, limiter.allowAt("alice", limitedMethod, muchLater))
+	})
+
+	t.Run("keeps a bucket per caller", func(t *testing.T) {
+		limiter := newLimiter()
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.False(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("bob", limitedMethod, now))
+	})
+
+	t.Run("ignores methods without budget", func(t *testing.T) {
+		limiter := newLimiter()
+		for i := 0; i < 10; i++ {
+			require.True(t, limiter.allowAt("alice", unlimitedMethod, now))
+		}
+	})
+
+	t.Run("applies default budget to any method", func(t *testing.T) {
+		limiter := NewRateLimiter(map[string]RateLimit{
+			"DeriveAddresses": {Rate: 1, Burst: 2},
+			AnyMethod:         {Rate: 1, Burst: 1},
+		})
+		require.True(t, limiter.allowAt("alice", unlimitedMethod, now))
+		require.False(t, limiter.allowAt("alice", unlimitedMethod, now))
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+	})
+
+	t.Run("drops idle buckets", func(t *testing.T) {
+		limiter := newLimiter()
+		require.True(t, limiter.allowAt("alice", limitedMethod, now))
+		require.True(t, limiter.allowAt("bob", limitedMethod, now))
+		require.Len(t, limiter.buckets, 2)
+
+		later := now.Add(limiterIdleTimeout + time.Second)
+		require.True(t, limiter.allowAt("bob", limitedMethod, later))
+		require.Len(t, limiter.buckets, 1)
+	})
+}
+
+func TestUnaryRateLimiter(t *testing.T) {
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: limitedMethod}
+	peerCtx := func(addr string) context.Context {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+		require.NoError(t, err)
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		anotherCtx context.Context
+		sameBucket bool
+	}{
+		{
+			name:       "callers",
+			ctx:        application.ContextWithCaller(context.Background(), "alice"),
+			anotherCtx: application.ContextWithCaller(context.Background(), "bob"),
+		},
+		{
+			name:       "unidentified callers with different hosts",
+			ctx:        peerCtx("10.0.0.1:5000"),
+			anotherCtx: peerCtx("10.0.0.2:5000"),
+		},
+		{
+			name:       "unidentified callers with same host",
+			ctx:        peerCtx("10.0.0.1:5000"),
+			anotherCtx: peerCtx("10.0.0.1:5001"),
+			sameBucket: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := unaryRateLimiter(NewRateLimiter(map[string]RateLimit{
+				"DeriveAddresses": {Rate: 0.001, Burst: 1},
+			}))
+
+			_, err := interceptor(tt.ctx, nil, info, handler)
+			require.NoError(t, err)
+			_, err = interceptor(tt.ctx, nil, info, handler)
+			require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+			_, err = interceptor(tt.anotherCtx, nil, info, handler)
+			if tt.sameBucket {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
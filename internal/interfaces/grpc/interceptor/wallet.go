@@ -0,0 +1,68 @@
+package grpc_interceptor
+
+import (
+	"context"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/vulpemventures/ocean/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// WalletIDMetadataKey is the metadata key used by clients to select the
+// wallet an RPC refers to. The default wallet is selected if missing.
+const WalletIDMetadataKey = "x-ocean-wallet-id"
+
+func unaryWalletSelector(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := withWalletID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func streamWalletSelector(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := withWalletID(stream.Context())
+	if err != nil {
+		return err
+	}
+	wrapped := middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+	return handler(srv, wrapped)
+}
+
+// withWalletID adds the id of the wallet selected by the caller, if any, to
+// the given context.
+func withWalletID(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	values := md.Get(WalletIDMetadataKey)
+	if len(values) <= 0 {
+		return ctx, nil
+	}
+	if len(values) > 1 {
+		return nil, status.Error(
+			codes.InvalidArgument, "multiple wallet ids in request metadata",
+		)
+	}
+
+	walletID := values[0]
+	if err := application.ValidateWalletID(walletID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return application.ContextWithWalletID(ctx, walletID), nil
+}
@@ -2,6 +2,7 @@ package grpc_interface
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"math/big"
 	"strings"
@@ -10,13 +11,22 @@ import (
 	log "github.com/sirupsen/logrus"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
 	appconfig "github.com/vulpemventures/ocean/internal/app-config"
+	"github.com/vulpemventures/ocean/internal/core/application"
 	grpc_handler "github.com/vulpemventures/ocean/internal/interfaces/grpc/handler"
 	grpc_interceptor "github.com/vulpemventures/ocean/internal/interfaces/grpc/interceptor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
+	readinessCheckInterval = 10 * time.Second
+
+	genSeedMethod       = "/ocean.v1.WalletService/GenSeed"
+	createWalletMethod  = "/ocean.v1.WalletService/CreateWallet"
+	restoreWalletMethod = "/ocean.v1.WalletService/RestoreWallet"
+
 	tlsKeyFile        = "key.pem"
 	tlsCertFile       = "cert.pem"
 	serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
@@ -26,6 +36,10 @@ type service struct {
 	config                   ServiceConfig
 	appConfig                *appconfig.AppConfig
 	grpcServer               *grpc.Server
+	restGateway              *restGateway
+	healthServer             *health.Server
+	chStopHealthChecks       chan struct{}
+	tlsManager               *tlsManager
 	chCloseStreamConnections chan (struct{})
 
 	log  func(format string, a ...interface{})
@@ -38,7 +52,7 @@ func NewService(config ServiceConfig, appConfig *appconfig.AppConfig) (*service,
 		log.Infof(format, a...)
 	}
 	warnFn := func(err error, format string, a ...interface{}) {
-		format = fmt.Sprintf("account service: %s", format)
+		format = fmt.Sprintf("grpc service: %s", format)
 		log.WithError(err).Warnf(format, a...)
 	}
 	if err := config.validate(); err != nil {
@@ -48,6 +62,7 @@ func NewService(config ServiceConfig, appConfig *appconfig.AppConfig) (*service,
 		return nil, fmt.Errorf("invalid app config: %s", err)
 	}
 
+	var tlsMgr *tlsManager
 	if !config.insecure() {
 		if err := generateTLSKeyPair(
 			config.TLSLocation, config.ExtraIPs, config.ExtraDomains,
@@ -55,10 +70,20 @@ func NewService(config ServiceConfig, appConfig *appconfig.AppConfig) (*service,
 			return nil, fmt.Errorf("error while creating TLS keypair: %s", err)
 		}
 		logFn("created TLS keypair in path %s", config.TLSLocation)
+
+		mgr, err := newTLSManager(config, logFn, warnFn)
+		if err != nil {
+			return nil, fmt.Errorf("error while loading TLS keypair: %s", err)
+		}
+		if config.withClientAuth() {
+			logFn("enabled client authentication with CA %s", config.ClientCA)
+		}
+		tlsMgr = mgr
 	}
 	chCloseStreamConnections := make(chan struct{})
 	return &service{
-		config, appConfig, nil, chCloseStreamConnections, logFn, warnFn,
+		config, appConfig, nil, nil, nil, nil, tlsMgr, chCloseStreamConnections,
+		logFn, warnFn,
 	}, nil
 }
 
@@ -85,39 +110,75 @@ func (s *service) Stop() {
 }
 
 func (s *service) start() (*grpc.Server, error) {
+	// The same limiter is shared with the REST gateway so that a client can't
+	// double its budget by calling both interfaces.
+	limiter := s.config.rateLimiter()
 	grpcConfig := []grpc.ServerOption{
-		grpc_interceptor.UnaryInterceptor(), grpc_interceptor.StreamInterceptor(),
+		grpc_interceptor.UnaryInterceptor(
+			s.auditService, s.config.clientRoles(), limiter,
+		),
+		grpc_interceptor.StreamInterceptor(
+			s.auditService, s.config.clientRoles(), limiter,
+		),
 	}
+	var rotateTLS grpc_handler.TLSRotator
+	var tlsConfig *tls.Config
 	if !s.config.insecure() {
-		creds, err := credentials.NewServerTLSFromFile(
-			s.config.tlsCertPath(), s.config.tlsKeyPath(),
-		)
-		if err != nil {
-			return nil, err
-		}
+		tlsConfig = s.tlsManager.tlsConfig()
+		creds := credentials.NewTLS(tlsConfig)
 		grpcConfig = append(grpcConfig, grpc.Creds(creds))
+		rotateTLS = s.tlsManager.rotate
 	}
 
 	grpcServer := grpc.NewServer(grpcConfig...)
 
-	walletHandler := grpc_handler.NewWalletHandler(s.appConfig.WalletService())
-	pb.RegisterWalletServiceServer(grpcServer, walletHandler)
-
-	s.log("registered wallet handler on public interface")
-
-	accountHandler := grpc_handler.NewAccountHandler(s.appConfig.AccountService())
-	txHandler := grpc_handler.NewTransactionHandler(s.appConfig.TransactionService())
+	walletHandler := grpc_handler.NewWalletHandler(
+		s.walletService, s.auditService, s.appConfig.HealthService(), rotateTLS,
+	)
+	accountHandler := grpc_handler.NewAccountHandler(
+		s.accountService, s.config.RequestLimits,
+	)
+	txHandler := grpc_handler.NewTransactionHandler(
+		s.transactionService, s.config.RequestLimits,
+	)
 	notifyHandler := grpc_handler.NewNotificationHandler(
-		s.appConfig.NotificationService(), s.chCloseStreamConnections,
+		s.notificationService, s.chCloseStreamConnections,
 	)
+	registerHandlers := func(srv *grpc.Server) {
+		pb.RegisterWalletServiceServer(srv, walletHandler)
+		pb.RegisterAccountServiceServer(srv, accountHandler)
+		pb.RegisterTransactionServiceServer(srv, txHandler)
+		pb.RegisterNotificationServiceServer(srv, notifyHandler)
+	}
 
-	pb.RegisterAccountServiceServer(grpcServer, accountHandler)
-	pb.RegisterTransactionServiceServer(grpcServer, txHandler)
-	pb.RegisterNotificationServiceServer(grpcServer, notifyHandler)
+	registerHandlers(grpcServer)
+	s.log("registered wallet handler on public interface")
 	s.log("registered account handler on public interface")
 	s.log("registered transaction handler on public interface")
 	s.log("registered notification handler on public interface")
 
+	s.healthServer = health.NewServer()
+	s.chStopHealthChecks = make(chan struct{})
+	healthpb.RegisterHealthServer(grpcServer, s.healthServer)
+	s.log("registered health handler on public interface")
+	go s.checkReadiness()
+
+	if s.config.withRest() {
+		gw, err := newRestGateway(
+			s.config, tlsConfig, s.auditService,
+			s.appConfig.HealthService(), limiter, registerHandlers,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating rest gateway: %s", err)
+		}
+		if err := gw.start(); err != nil {
+			gw.stop()
+			return nil, fmt.Errorf("error while starting rest gateway: %s", err)
+		}
+		s.restGateway = gw
+		s.log("start serving rest gateway on %s", s.config.restAddress())
+	}
+
 	go grpcServer.Serve(s.config.listener())
 
 	switch {
@@ -137,6 +198,13 @@ func (s *service) stop(onlyGrpcServer bool) {
 	default:
 	}
 
+	close(s.chStopHealthChecks)
+	s.healthServer.Shutdown()
+	s.log("stopped health checks")
+	if s.restGateway != nil {
+		s.restGateway.stop()
+		s.log("stopped rest gateway")
+	}
 	s.grpcServer.GracefulStop()
 	s.log("stopped grpc server")
 	if onlyGrpcServer {
@@ -145,12 +213,120 @@ func (s *service) stop(onlyGrpcServer bool) {
 
 	s.appConfig.BlockchainScanner().Stop()
 	s.log("stopped blockchain scanner")
-	s.appConfig.RepoManager().Close()
+	s.appConfig.Close()
 	s.log("closed connection with db")
+	if s.tlsManager != nil {
+		s.tlsManager.close()
+		s.log("stopped watching TLS files")
+	}
+}
+
+// walletServices returns the application services of the wallet selected by
+// the request. Only the RPCs creating or restoring a wallet are allowed to
+// refer to a wallet not existing yet, while GenSeed doesn't depend on any
+// wallet and is served by the default one.
+func (s *service) walletServices(
+	ctx context.Context,
+) (*appconfig.WalletServices, error) {
+	method, _ := grpc.Method(ctx)
+	switch method {
+	case createWalletMethod, restoreWalletMethod:
+		return s.appConfig.NewWalletServices(ctx)
+	case genSeedMethod:
+		ctx = application.ContextWithWalletID(ctx, application.DefaultWalletID)
+	}
+	return s.appConfig.WalletServices(ctx)
+}
+
+func (s *service) walletService(
+	ctx context.Context,
+) (*application.WalletService, error) {
+	svcs, err := s.walletServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svcs.WalletService, nil
+}
+
+func (s *service) accountService(
+	ctx context.Context,
+) (*application.AccountService, error) {
+	svcs, err := s.walletServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svcs.AccountService, nil
+}
+
+func (s *service) transactionService(
+	ctx context.Context,
+) (*application.TransactionService, error) {
+	svcs, err := s.walletServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svcs.TransactionService, nil
+}
+
+func (s *service) notificationService(
+	ctx context.Context,
+) (*application.NotificationService, error) {
+	svcs, err := s.walletServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svcs.NotificationService, nil
+}
+
+func (s *service) auditService(
+	ctx context.Context,
+) (*application.AuditService, error) {
+	svcs, err := s.walletServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svcs.AuditService, nil
+}
+
+// checkReadiness periodically updates the serving status reported by the
+// health service based on the readiness of the daemon.
+func (s *service) checkReadiness() {
+	services := []string{
+		"",
+		pb.WalletService_ServiceDesc.ServiceName,
+		pb.AccountService_ServiceDesc.ServiceName,
+		pb.TransactionService_ServiceDesc.ServiceName,
+		pb.NotificationService_ServiceDesc.ServiceName,
+	}
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		readiness := s.appConfig.HealthService().CheckReadiness(
+			context.Background(),
+		)
+		servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+		if readiness.IsReady {
+			servingStatus = healthpb.HealthCheckResponse_SERVING
+		}
+		for _, svc := range services {
+			s.healthServer.SetServingStatus(svc, servingStatus)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.chStopHealthChecks:
+			return
+		}
+	}
 }
 
 func (s *service) autoInitAndUnlock() {
-	wallet := s.appConfig.WalletService()
+	wallet, err := s.appConfig.WalletService()
+	if err != nil {
+		s.warn(err, "failed to auto init")
+		return
+	}
 	status := wallet.GetStatus(context.Background())
 	if !status.IsInitialized {
 		s.autoInit()
@@ -162,7 +338,11 @@ func (s *service) autoInitAndUnlock() {
 func (s *service) autoUnlock() {
 	attempts := 0
 	ctx := context.Background()
-	wallet := s.appConfig.WalletService()
+	wallet, err := s.appConfig.WalletService()
+	if err != nil {
+		s.warn(err, "failed to auto unlock")
+		return
+	}
 	for attempts < 3 {
 		if err := wallet.Unlock(ctx, s.appConfig.Password); err != nil {
 			attempts++
@@ -179,7 +359,11 @@ func (s *service) autoUnlock() {
 func (s *service) autoInit() {
 	attempts := 0
 	ctx := context.Background()
-	wallet := s.appConfig.WalletService()
+	wallet, err := s.appConfig.WalletService()
+	if err != nil {
+		s.warn(err, "failed to auto init")
+		return
+	}
 	for attempts < 3 {
 		mnemonic := strings.Split(s.appConfig.Mnemonic, " ")
 		if err := wallet.CreateWallet(
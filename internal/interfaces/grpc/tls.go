@@ -0,0 +1,187 @@
+package grpc_interface
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// tlsManager serves the TLS key pair and, if client authentication is
+// enabled, the pool of client CAs to the gRPC server.
+// Files are watched for changes so that they are reloaded without restarting
+// the server. The key pair can also be regenerated on demand.
+type tlsManager struct {
+	config    ServiceConfig
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	lock      *sync.RWMutex
+	// rotateLock serializes the rotations of the key pair.
+	rotateLock *sync.Mutex
+	watcher    *fsnotify.Watcher
+
+	log  func(format string, a ...interface{})
+	warn func(err error, format string, a ...interface{})
+}
+
+func newTLSManager(
+	config ServiceConfig,
+	logFn func(format string, a ...interface{}),
+	warnFn func(err error, format string, a ...interface{}),
+) (*tlsManager, error) {
+	m := &tlsManager{
+		config:     config,
+		lock:       &sync.RWMutex{},
+		rotateLock: &sync.Mutex{},
+		log:        logFn,
+		warn:       warnFn,
+	}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS files watcher: %s", err)
+	}
+	dirs := map[string]struct{}{filepath.Dir(config.tlsKeyPath()): {}}
+	if config.withClientAuth() {
+		dirs[filepath.Dir(config.ClientCA)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("failed to watch TLS dir %s: %s", dir, err)
+		}
+	}
+	m.watcher = watcher
+
+	go m.watch()
+
+	return m, nil
+}
+
+// tlsConfig returns the TLS config for the gRPC server. The key pair and
+// client CAs are resolved for every new connection so that the most recently
+// loaded ones are always used.
+func (m *tlsManager) tlsConfig() *tls.Config {
+	base := m.config.tlsConfig()
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.Certificates = []tls.Certificate{*m.cert}
+		if m.clientCAs != nil {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			cfg.ClientCAs = m.clientCAs
+		}
+		return cfg, nil
+	}
+	return base
+}
+
+// rotate regenerates the key pair with a certificate bound to the given
+// extra IPs and domains, and starts serving it right away.
+// The PEM encoded certificate is returned.
+func (m *tlsManager) rotate(extraIPs, extraDomains []string) (string, error) {
+	m.rotateLock.Lock()
+	defer m.rotateLock.Unlock()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate TLS key: %s", err)
+	}
+	cert, err := writeTLSKeyPair(
+		m.config.tlsKeyPath(), m.config.tlsCertPath(), priv,
+		extraIPs, extraDomains,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to write TLS key pair: %s", err)
+	}
+	if err := m.reload(); err != nil {
+		return "", err
+	}
+
+	m.log("rotated TLS key pair in path %s", m.config.TLSLocation)
+	return string(cert), nil
+}
+
+func (m *tlsManager) close() {
+	if m.watcher != nil {
+		m.watcher.Close()
+	}
+}
+
+// reload loads key pair and client CAs from disk. In case of failure, the
+// current ones are left untouched.
+func (m *tlsManager) reload() error {
+	cert, err := tls.LoadX509KeyPair(
+		m.config.tlsCertPath(), m.config.tlsKeyPath(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS key pair: %s", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if m.config.withClientAuth() {
+		buf, err := os.ReadFile(m.config.ClientCA)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %s", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(buf) {
+			return fmt.Errorf("no valid certificate found in client CA file")
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.cert = &cert
+	m.clientCAs = clientCAs
+	return nil
+}
+
+func (m *tlsManager) watch() {
+	files := map[string]struct{}{
+		filepath.Clean(m.config.tlsKeyPath()):  {},
+		filepath.Clean(m.config.tlsCertPath()): {},
+	}
+	if m.config.withClientAuth() {
+		files[filepath.Clean(m.config.ClientCA)] = struct{}{}
+	}
+
+	for {
+		select {
+		case event, ok := <-m.watcher.Events:
+			if !ok {
+				return
+			}
+			if _, ok := files[filepath.Clean(event.Name)]; !ok {
+				continue
+			}
+			if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+				continue
+			}
+			if err := m.reload(); err != nil {
+				m.warn(err, "failed to reload TLS files after %s changed", event.Name)
+				continue
+			}
+			m.log("reloaded TLS files after %s changed", event.Name)
+		case err, ok := <-m.watcher.Errors:
+			if !ok {
+				return
+			}
+			m.warn(err, "error while watching TLS files")
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package grpc_interface
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	grpc_interceptor "github.com/vulpemventures/ocean/internal/interfaces/grpc/interceptor"
+)
+
+func TestTLSManager(t *testing.T) {
+	datadir := t.TempDir()
+	tlsDir := filepath.Join(datadir, "tls")
+	err := generateTLSKeyPair(tlsDir, nil, nil)
+	require.NoError(t, err)
+
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other-ca")
+	caPath := filepath.Join(datadir, "ca.pem")
+	err = os.WriteFile(caPath, ca.certPEM, 0644)
+	require.NoError(t, err)
+
+	config := ServiceConfig{TLSLocation: tlsDir, ClientCA: caPath}
+	m, err := newTLSManager(
+		config,
+		func(string, ...interface{}) {},
+		func(error, string, ...interface{}) {},
+	)
+	require.NoError(t, err)
+	defer m.close()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", m.tlsConfig())
+	require.NoError(t, err)
+	defer lis.Close()
+
+	serverCert, err := os.ReadFile(config.tlsCertPath())
+	require.NoError(t, err)
+	alice := ca.newClientCert(t, "alice")
+
+	t.Run("verified client certificate", func(t *testing.T) {
+		subject, ok, err := handshake(lis, serverCert, alice)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "alice", subject)
+	})
+
+	t.Run("missing client certificate", func(t *testing.T) {
+		_, _, err := handshake(lis, serverCert, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("client certificate of unknown CA", func(t *testing.T) {
+		_, _, err := handshake(lis, serverCert, otherCA.newClientCert(t, "alice"))
+		require.Error(t, err)
+	})
+
+	t.Run("rotate key pair", func(t *testing.T) {
+		rotatedCert, err := m.rotate(nil, []string{"ocean.example.com"})
+		require.NoError(t, err)
+		require.NotEqual(t, string(serverCert), rotatedCert)
+
+		certOnDisk, err := os.ReadFile(config.tlsCertPath())
+		require.NoError(t, err)
+		require.Equal(t, rotatedCert, string(certOnDisk))
+
+		// The rotated certificate is served right away.
+		subject, ok, err := handshake(lis, []byte(rotatedCert), alice)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "alice", subject)
+
+		_, _, err = handshake(lis, serverCert, alice)
+		require.Error(t, err)
+		serverCert = []byte(rotatedCert)
+	})
+
+	t.Run("reload rotated client CA", func(t *testing.T) {
+		bob := otherCA.newClientCert(t, "bob")
+		err := os.WriteFile(caPath, otherCA.certPEM, 0644)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			subject, ok, err := handshake(lis, serverCert, bob)
+			return err == nil && ok && subject == "bob"
+		}, 5*time.Second, 50*time.Millisecond)
+
+		_, _, err = handshake(lis, serverCert, alice)
+		require.Error(t, err)
+	})
+
+	t.Run("reload key pair rotated on disk", func(t *testing.T) {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rotatedCert, err := writeTLSKeyPair(
+			config.tlsKeyPath(), config.tlsCertPath(), priv, nil, nil,
+		)
+		require.NoError(t, err)
+
+		bob := otherCA.newClientCert(t, "bob")
+		require.Eventually(t, func() bool {
+			_, _, err := handshake(lis, rotatedCert, bob)
+			return err == nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("keep serving on invalid files", func(t *testing.T) {
+		err := os.WriteFile(caPath, []byte("invalid"), 0644)
+		require.NoError(t, err)
+		require.Error(t, m.reload())
+
+		certOnDisk, err := os.ReadFile(config.tlsCertPath())
+		require.NoError(t, err)
+		bob := otherCA.newClientCert(t, "bob")
+		subject, ok, err := handshake(lis, certOnDisk, bob)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "bob", subject)
+	})
+}
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCA(t *testing.T, cn string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader, template, template, &key.PublicKey, key,
+	)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// newClientCert returns a client certificate with the given common name
+// signed by the CA.
+func (ca *testCA) newClientCert(t *testing.T, cn string) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader, template, ca.cert, &key.PublicKey, ca.key,
+	)
+	require.NoError(t, err)
+
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// handshake connects to the given TLS listener trusting only the given server
+// certificate and authenticating with the given client certificate, if any.
+// The subject of the client certificate verified by the server is returned.
+func handshake(
+	lis net.Listener, serverCert []byte, clientCert *tls.Certificate,
+) (string, bool, error) {
+	type result struct {
+		subject string
+		ok      bool
+		err     error
+	}
+	chResult := make(chan result, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			chResult <- result{err: err}
+			return
+		}
+		defer conn.Close()
+
+		tlsConn := conn.(*tls.Conn)
+		if err := tlsConn.Handshake(); err != nil {
+			chResult <- result{err: err}
+			return
+		}
+		state := tlsConn.ConnectionState()
+		subject, ok := grpc_interceptor.TLSClientSubject(&state)
+		chResult <- result{subject: subject, ok: ok}
+	}()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AppendCertsFromPEM(serverCert)
+	clientConfig := &tls.Config{RootCAs: rootCAs, ServerName: "127.0.0.1"}
+	if clientCert != nil {
+		clientConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err == nil {
+		// With TLS 1.3 the client certificate is verified after the client
+		// completes the handshake, make sure the server is done as well.
+		conn.Write([]byte{0})
+		conn.Close()
+	}
+	res := <-chResult
+	if err != nil {
+		return "", false, err
+	}
+	return res.subject, res.ok, res.err
+}
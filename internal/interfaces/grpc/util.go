@@ -34,6 +34,22 @@ func generateTLSKeyPair(
 		return nil
 	}
 
+	priv, err := createOrLoadTLSKey(keyPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = writeTLSKeyPair(keyPath, certPath, priv, extraIPs, extraDomains)
+	return err
+}
+
+// writeTLSKeyPair creates a new self-signed certificate for the given key,
+// and writes both to the given paths, replacing any existing file.
+// The PEM encoded certificate is returned.
+func writeTLSKeyPair(
+	keyPath, certPath string, priv *ecdsa.PrivateKey,
+	extraIPs, extraDomains []string,
+) ([]byte, error) {
 	organization := "vulpemventures"
 	now := time.Now()
 	validUntil := now.AddDate(1, 0, 0)
@@ -41,7 +57,7 @@ func generateTLSKeyPair(
 	// Generate a serial number that's below the serialNumberLimit.
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
-		return fmt.Errorf("failed to generate serial number: %s", err)
+		return nil, fmt.Errorf("failed to generate serial number: %s", err)
 	}
 
 	// Collect the host's IP addresses, including loopback, in a slice.
@@ -66,7 +82,7 @@ func generateTLSKeyPair(
 	// Add all the interface IPs that aren't already in the slice.
 	addrs, err := net.InterfaceAddrs()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for _, a := range addrs {
 		ipAddr, _, err := net.ParseCIDR(a.String())
@@ -77,7 +93,7 @@ func generateTLSKeyPair(
 
 	host, err := os.Hostname()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	dnsNames := []string{host}
@@ -93,14 +109,9 @@ func generateTLSKeyPair(
 
 	dnsNames = append(dnsNames, "unix", "unixpacket")
 
-	priv, err := createOrLoadTLSKey(keyPath)
-	if err != nil {
-		return err
-	}
-
 	keybytes, err := x509.MarshalECPrivateKey(priv)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// construct certificate template
@@ -126,31 +137,44 @@ func generateTLSKeyPair(
 		rand.Reader, &template, &template, &priv.PublicKey, priv,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to create certificate: %v", err)
+		return nil, fmt.Errorf("failed to create certificate: %v", err)
 	}
 
 	certBuf := &bytes.Buffer{}
 	if err := pem.Encode(
 		certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: derBytes},
 	); err != nil {
-		return fmt.Errorf("failed to encode certificate: %v", err)
+		return nil, fmt.Errorf("failed to encode certificate: %v", err)
 	}
 
 	keyBuf := &bytes.Buffer{}
 	if err := pem.Encode(
 		keyBuf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keybytes},
 	); err != nil {
-		return fmt.Errorf("failed to encode private key: %v", err)
+		return nil, fmt.Errorf("failed to encode private key: %v", err)
 	}
 
-	if err := ioutil.WriteFile(certPath, certBuf.Bytes(), 0644); err != nil {
+	// Files are replaced atomically to prevent a watcher from loading a
+	// partially written key pair.
+	if err := writeFileAtomic(keyPath, keyBuf.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(certPath, certBuf.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+
+	return certBuf.Bytes(), nil
+}
+
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, perm); err != nil {
 		return err
 	}
-	if err := ioutil.WriteFile(keyPath, keyBuf.Bytes(), 0600); err != nil {
-		os.Remove(certPath)
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
 		return err
 	}
-
 	return nil
 }
 
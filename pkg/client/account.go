@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+)
+
+// Balance returns the balance of the given account per asset, restricted to
+// the given addresses if any.
+func (c *Client) Balance(
+	ctx context.Context, accountName string, addresses ...string,
+) (map[string]*pb.BalanceInfo, error) {
+	reply, err := c.account.Balance(ctx, &pb.BalanceRequest{
+		AccountName: accountName,
+		Addresses:   addresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetBalance(), nil
+}
+
+// ListUtxos returns the spendable and the locked utxos of the given account,
+// restricted to the given addresses if any.
+func (c *Client) ListUtxos(
+	ctx context.Context, accountName string, addresses ...string,
+) (spendable, locked []*pb.Utxo, err error) {
+	reply, err := c.account.ListUtxos(ctx, &pb.ListUtxosRequest{
+		AccountName: accountName,
+		Addresses:   addresses,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reply.GetSpendableUtxos().GetUtxos(),
+		reply.GetLockedUtxos().GetUtxos(), nil
+}
+
+// UtxoFilterArgs are the args to list the utxos of an account that match
+// some criteria.
+type UtxoFilterArgs struct {
+	// AccountName is the name of the account whose utxos to list.
+	AccountName string
+	// Addresses restricts the list to the utxos locked by these addresses.
+	Addresses []string
+	// Label restricts the list to the utxos with this label.
+	Label string
+	// Asset restricts the list to the utxos of this asset.
+	Asset string
+	// MinConfirmations restricts the list to the utxos with at least this
+	// number of confirmations.
+	MinConfirmations uint32
+	// FrozenFilter tells whether to list frozen utxos too, only them or
+	// none of them.
+	FrozenFilter pb.UtxoFrozenFilter
+}
+
+func (a UtxoFilterArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	return nil
+}
+
+// FilterUtxos returns the spendable, locked and frozen utxos of an account
+// matching the given filter.
+func (c *Client) FilterUtxos(
+	ctx context.Context, args UtxoFilterArgs,
+) (spendable, locked, frozen []*pb.Utxo, err error) {
+	if err := args.validate(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	reply, err := c.account.ListUtxos(ctx, &pb.ListUtxosRequest{
+		AccountName:      args.AccountName,
+		Addresses:        args.Addresses,
+		Label:            args.Label,
+		Asset:            args.Asset,
+		MinConfirmations: args.MinConfirmations,
+		FrozenFilter:     args.FrozenFilter,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return reply.GetSpendableUtxos().GetUtxos(),
+		reply.GetLockedUtxos().GetUtxos(),
+		reply.GetFrozenUtxos().GetUtxos(), nil
+}
+
+// UtxoRef identifies a utxo by its outpoint.
+type UtxoRef struct {
+	// Txid is the hash of the transaction the utxo belongs to.
+	Txid string
+	// Index is the output index of the utxo.
+	Index uint32
+}
+
+func utxoRefs(refs []UtxoRef) ([]*pb.Input, error) {
+	if len(refs) <= 0 {
+		return nil, fmt.Errorf("missing utxos")
+	}
+	ins := make([]*pb.Input, 0, len(refs))
+	for i, r := range refs {
+		if len(r.Txid) <= 0 {
+			return nil, fmt.Errorf("missing txid for utxo %d", i)
+		}
+		ins = append(ins, &pb.Input{Txid: r.Txid, Index: r.Index})
+	}
+	return ins, nil
+}
+
+// FreezeUtxos excludes the given utxos of an account from coin selection
+// until unfrozen, and returns the number of newly frozen ones.
+func (c *Client) FreezeUtxos(
+	ctx context.Context, accountName string, utxos ...UtxoRef,
+) (uint64, error) {
+	ins, err := utxoRefs(utxos)
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := c.account.FreezeUtxos(ctx, &pb.FreezeUtxosRequest{
+		AccountName: accountName,
+		Utxos:       ins,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetCount(), nil
+}
+
+// UnfreezeUtxos makes the given frozen utxos of an account spendable again,
+// and returns the number of those actually unfrozen.
+func (c *Client) UnfreezeUtxos(
+	ctx context.Context, accountName string, utxos ...UtxoRef,
+) (uint64, error) {
+	ins, err := utxoRefs(utxos)
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := c.account.UnfreezeUtxos(ctx, &pb.UnfreezeUtxosRequest{
+		AccountName: accountName,
+		Utxos:       ins,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetCount(), nil
+}
+
+// SetUtxoLabels replaces the labels of the given utxos of an account, an
+// empty list removes them.
+func (c *Client) SetUtxoLabels(
+	ctx context.Context, accountName string, labels []string,
+	utxos ...UtxoRef,
+) (uint64, error) {
+	ins, err := utxoRefs(utxos)
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := c.account.SetUtxoLabels(ctx, &pb.SetUtxoLabelsRequest{
+		AccountName: accountName,
+		Utxos:       ins,
+		Labels:      labels,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetCount(), nil
+}
+
+// SetAddressLabels replaces the labels of a derived address of an account,
+// an empty list removes them.
+func (c *Client) SetAddressLabels(
+	ctx context.Context, accountName, address string, labels ...string,
+) error {
+	if len(address) <= 0 {
+		return fmt.Errorf("missing address")
+	}
+
+	_, err := c.account.SetAddressLabels(ctx, &pb.SetAddressLabelsRequest{
+		AccountName: accountName,
+		Address:     address,
+		Labels:      labels,
+	})
+	return err
+}
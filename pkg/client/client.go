@@ -0,0 +1,97 @@
+// Package client is the Go SDK of the ocean wallet daemon.
+//
+// It wraps the generated gRPC stubs of the ocean API with a high-level client
+// that takes care of setting up the connection, offers typed helpers for the
+// most common operations, and keeps the notification streams open across
+// network failures or daemon restarts.
+//
+// The raw service clients are still available for any other operation:
+//
+//	c, err := client.New("localhost:18000", client.WithTLSCert(certPath))
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	info, err := c.Wallet().GetInfo(ctx, &pb.GetInfoRequest{})
+package client
+
+import (
+	"fmt"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"google.golang.org/grpc"
+)
+
+// Client is the high-level client of the ocean daemon. It's safe for
+// concurrent use.
+type Client struct {
+	conn *grpc.ClientConn
+	opts *options
+
+	wallet       pb.WalletServiceClient
+	account      pb.AccountServiceClient
+	transaction  pb.TransactionServiceClient
+	notification pb.NotificationServiceClient
+}
+
+// New returns a client connected to the daemon listening at the given
+// address. TLS is enabled by default, therefore either WithTLSCert or
+// WithInsecure is required.
+func New(addr string, opts ...Option) (*Client, error) {
+	if len(addr) <= 0 {
+		return nil, fmt.Errorf("missing daemon address")
+	}
+
+	o := defaultOptions()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	dialOpts, err := o.grpcDialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(addr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ocean daemon: %s", err)
+	}
+
+	return &Client{
+		conn:         conn,
+		opts:         o,
+		wallet:       pb.NewWalletServiceClient(conn),
+		account:      pb.NewAccountServiceClient(conn),
+		transaction:  pb.NewTransactionServiceClient(conn),
+		notification: pb.NewNotificationServiceClient(conn),
+	}, nil
+}
+
+// Wallet returns the raw client of the wallet service.
+func (c *Client) Wallet() pb.WalletServiceClient {
+	return c.wallet
+}
+
+// Account returns the raw client of the account service.
+func (c *Client) Account() pb.AccountServiceClient {
+	return c.account
+}
+
+// Transaction returns the raw client of the transaction service.
+func (c *Client) Transaction() pb.TransactionServiceClient {
+	return c.transaction
+}
+
+// Notification returns the raw client of the notification service.
+func (c *Client) Notification() pb.NotificationServiceClient {
+	return c.notification
+}
+
+// Close closes the connection with the daemon, and therefore every open
+// notification subscription.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,612 @@
+package client_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
+	"github.com/vulpemventures/ocean/pkg/client/clienttest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testAccount = "test"
+	testAsset   = "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	testAddress = "el1qqfttsemg4sapwrfmmccyztj4wa8gpn5yfetkda4z5uy5e2jysgrszmj0xa8tzftde78kvtl26dtxw6q6gcuawte5xeyvkunws"
+	testTxHex   = "0200000000"
+	testTxid    = "0000000000000000000000000000000000000000000000000000000000000001"
+
+	testPaymentId = "00000000000000000000000000000001"
+	testLockToken = "00000000000000000000000000000002"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name string
+			addr string
+			opts []client.Option
+		}{
+			{
+				name: "missing address",
+				addr: "",
+				opts: []client.Option{client.WithInsecure()},
+			},
+			{
+				name: "missing tls cert",
+				addr: "localhost:18000",
+			},
+			{
+				name: "invalid tls cert path",
+				addr: "localhost:18000",
+				opts: []client.Option{client.WithTLSCert("")},
+			},
+			{
+				name: "invalid reconnect delay",
+				addr: "localhost:18000",
+				opts: []client.Option{
+					client.WithInsecure(),
+					client.WithReconnectDelay(time.Minute, time.Second),
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				c, err := client.New(tt.addr, tt.opts...)
+				require.Error(t, err)
+				require.Nil(t, c)
+			})
+		}
+	})
+}
+
+func TestClient(t *testing.T) {
+	var labelsReq *pb.SetUtxoLabelsRequest
+	accountSvc := &clienttest.AccountService{
+		BalanceFunc: func(
+			_ context.Context, req *pb.BalanceRequest,
+		) (*pb.BalanceResponse, error) {
+			if req.GetAccountName() != testAccount {
+				return nil, status.Error(codes.NotFound, "account not found")
+			}
+			return &pb.BalanceResponse{
+				Balance: map[string]*pb.BalanceInfo{
+					testAsset: {ConfirmedBalance: 1000, TotalBalance: 1000},
+				},
+			}, nil
+		},
+		ListUtxosFunc: func(
+			_ context.Context, _ *pb.ListUtxosRequest,
+		) (*pb.ListUtxosResponse, error) {
+			return &pb.ListUtxosResponse{
+				SpendableUtxos: &pb.Utxos{
+					Utxos: []*pb.Utxo{{Txid: testTxid, Index: 0}},
+				},
+				LockedUtxos: &pb.Utxos{
+					Utxos: []*pb.Utxo{{Txid: testTxid, Index: 1}},
+				},
+				FrozenUtxos: &pb.Utxos{
+					Utxos: []*pb.Utxo{{Txid: testTxid, Index: 2, Frozen: true}},
+				},
+			}, nil
+		},
+		FreezeUtxosFunc: func(
+			_ context.Context, req *pb.FreezeUtxosRequest,
+		) (*pb.FreezeUtxosResponse, error) {
+			return &pb.FreezeUtxosResponse{
+				Count: uint64(len(req.GetUtxos())),
+			}, nil
+		},
+		SetUtxoLabelsFunc: func(
+			_ context.Context, req *pb.SetUtxoLabelsRequest,
+		) (*pb.SetUtxoLabelsResponse, error) {
+			labelsReq = req
+			return &pb.SetUtxoLabelsResponse{
+				Count: uint64(len(req.GetUtxos())),
+			}, nil
+		},
+	}
+	var transferReq *pb.TransferRequest
+	var sweepReq *pb.SweepAccountRequest
+	var consolidateReq *pb.ConsolidateUtxosRequest
+	var bumpFeeReq *pb.BumpFeeRequest
+	var enqueueReq *pb.EnqueuePaymentRequest
+	var lockReq *pb.LockUtxosRequest
+	txSvc := &clienttest.TransactionService{
+		TransferFunc: func(
+			_ context.Context, req *pb.TransferRequest,
+		) (*pb.TransferResponse, error) {
+			transferReq = req
+			return &pb.TransferResponse{TxHex: testTxHex}, nil
+		},
+		SweepAccountFunc: func(
+			_ context.Context, req *pb.SweepAccountRequest,
+		) (*pb.SweepAccountResponse, error) {
+			sweepReq = req
+			return &pb.SweepAccountResponse{TxHex: testTxHex}, nil
+		},
+		ConsolidateUtxosFunc: func(
+			_ context.Context, req *pb.ConsolidateUtxosRequest,
+		) (*pb.ConsolidateUtxosResponse, error) {
+			consolidateReq = req
+			return &pb.ConsolidateUtxosResponse{Txids: []string{testTxid}}, nil
+		},
+		BumpFeeFunc: func(
+			_ context.Context, req *pb.BumpFeeRequest,
+		) (*pb.BumpFeeResponse, error) {
+			bumpFeeReq = req
+			return &pb.BumpFeeResponse{
+				Txid:   testTxid,
+				TxHex:  testTxHex,
+				Method: pb.BumpFeeMethod_BUMP_FEE_METHOD_REPLACEMENT,
+			}, nil
+		},
+		LockUtxosFunc: func(
+			_ context.Context, req *pb.LockUtxosRequest,
+		) (*pb.LockUtxosResponse, error) {
+			lockReq = req
+			return &pb.LockUtxosResponse{
+				LockToken: testLockToken, ExpirationDate: 2000000000,
+			}, nil
+		},
+		UnlockUtxosFunc: func(
+			_ context.Context, req *pb.UnlockUtxosRequest,
+		) (*pb.UnlockUtxosResponse, error) {
+			if req.GetLockToken() != testLockToken {
+				return nil, status.Error(codes.PermissionDenied, "lock not owned")
+			}
+			return &pb.UnlockUtxosResponse{
+				Count: uint64(len(req.GetUtxos())),
+			}, nil
+		},
+		EnqueuePaymentFunc: func(
+			_ context.Context, req *pb.EnqueuePaymentRequest,
+		) (*pb.EnqueuePaymentResponse, error) {
+			enqueueReq = req
+			return &pb.EnqueuePaymentResponse{PaymentId: testPaymentId}, nil
+		},
+		GetPaymentFunc: func(
+			_ context.Context, req *pb.GetPaymentRequest,
+		) (*pb.GetPaymentResponse, error) {
+			if req.GetPaymentId() != testPaymentId {
+				return nil, status.Error(codes.NotFound, "payment not found")
+			}
+			return &pb.GetPaymentResponse{Payment: &pb.Payment{
+				Id:     testPaymentId,
+				Status: pb.PaymentStatus_PAYMENT_STATUS_BROADCASTED,
+				Txid:   testTxid,
+			}}, nil
+		},
+		FlushPaymentsFunc: func(
+			_ context.Context, _ *pb.FlushPaymentsRequest,
+		) (*pb.FlushPaymentsResponse, error) {
+			return &pb.FlushPaymentsResponse{Txids: []string{testTxid}}, nil
+		},
+		BroadcastTransactionFunc: func(
+			_ context.Context, _ *pb.BroadcastTransactionRequest,
+		) (*pb.BroadcastTransactionResponse, error) {
+			return &pb.BroadcastTransactionResponse{Txid: testTxid}, nil
+		},
+		GetBroadcastFunc: func(
+			_ context.Context, req *pb.GetBroadcastRequest,
+		) (*pb.GetBroadcastResponse, error) {
+			if req.GetTxid() != testTxid {
+				return nil, status.Error(codes.NotFound, "broadcast not found")
+			}
+			return &pb.GetBroadcastResponse{Broadcast: &pb.Broadcast{
+				Txid:   testTxid,
+				TxHex:  testTxHex,
+				Status: pb.BroadcastStatus_BROADCAST_STATUS_PENDING,
+			}}, nil
+		},
+		ScheduleTransactionFunc: func(
+			_ context.Context, req *pb.ScheduleTransactionRequest,
+		) (*pb.ScheduleTransactionResponse, error) {
+			return &pb.ScheduleTransactionResponse{
+				ScheduledTransaction: &pb.ScheduledTransaction{
+					Txid:        testTxid,
+					TxHex:       req.GetTxHex(),
+					BroadcastAt: req.GetBroadcastAt(),
+					Status:      pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_SCHEDULED,
+				},
+			}, nil
+		},
+		CancelScheduledTransactionFunc: func(
+			_ context.Context, req *pb.CancelScheduledTransactionRequest,
+		) (*pb.CancelScheduledTransactionResponse, error) {
+			if req.GetTxid() != testTxid {
+				return nil, status.Error(
+					codes.NotFound, "scheduled transaction not found",
+				)
+			}
+			return &pb.CancelScheduledTransactionResponse{
+				ScheduledTransaction: &pb.ScheduledTransaction{
+					Txid:   testTxid,
+					TxHex:  testTxHex,
+					Status: pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_CANCELLED,
+				},
+			}, nil
+		},
+	}
+
+	srv := clienttest.NewServer(clienttest.Services{
+		Account:     accountSvc,
+		Transaction: txSvc,
+	})
+	t.Cleanup(srv.Close)
+
+	c, err := srv.Client()
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+
+	ctx := context.Background()
+
+	t.Run("balance", func(t *testing.T) {
+		balance, err := c.Balance(ctx, testAccount)
+		require.NoError(t, err)
+		require.Len(t, balance, 1)
+		require.Equal(t, 1000, int(balance[testAsset].GetTotalBalance()))
+
+		balance, err = c.Balance(ctx, "unknown")
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Nil(t, balance)
+	})
+
+	t.Run("list utxos", func(t *testing.T) {
+		spendable, locked, err := c.ListUtxos(ctx, testAccount)
+		require.NoError(t, err)
+		require.Len(t, spendable, 1)
+		require.Len(t, locked, 1)
+	})
+
+	t.Run("filter utxos", func(t *testing.T) {
+		spendable, locked, frozen, err := c.FilterUtxos(
+			ctx, client.UtxoFilterArgs{
+				AccountName:  testAccount,
+				FrozenFilter: pb.UtxoFrozenFilter_UTXO_FROZEN_FILTER_ONLY,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, spendable, 1)
+		require.Len(t, locked, 1)
+		require.Len(t, frozen, 1)
+
+		_, _, _, err = c.FilterUtxos(ctx, client.UtxoFilterArgs{})
+		require.Error(t, err)
+	})
+
+	t.Run("coin control", func(t *testing.T) {
+		utxo := client.UtxoRef{Txid: testTxid, Index: 0}
+		count, err := c.FreezeUtxos(ctx, testAccount, utxo)
+		require.NoError(t, err)
+		require.Equal(t, 1, int(count))
+
+		count, err = c.SetUtxoLabels(ctx, testAccount, []string{"cold"}, utxo)
+		require.NoError(t, err)
+		require.Equal(t, 1, int(count))
+		require.NotNil(t, labelsReq)
+		require.Equal(t, []string{"cold"}, labelsReq.GetLabels())
+
+		_, err = c.FreezeUtxos(ctx, testAccount)
+		require.Error(t, err)
+	})
+
+	t.Run("lock utxos", func(t *testing.T) {
+		utxo := client.UtxoRef{Txid: testTxid, Index: 0}
+		lock, err := c.LockUtxos(ctx, client.LockUtxosArgs{
+			AccountName: testAccount,
+			Utxos:       []client.UtxoRef{utxo},
+			Duration:    10 * time.Minute,
+		})
+		require.NoError(t, err)
+		require.Equal(t, testLockToken, lock.Token)
+		require.Equal(t, 2000000000, int(lock.ExpirationDate))
+		require.NotNil(t, lockReq)
+		require.Equal(t, 600, int(lockReq.GetLockExpirySeconds()))
+
+		count, err := c.UnlockUtxos(ctx, testAccount, lock.Token, utxo)
+		require.NoError(t, err)
+		require.Equal(t, 1, int(count))
+
+		_, err = c.UnlockUtxos(ctx, testAccount, "", utxo)
+		require.Error(t, err)
+
+		_, err = c.LockUtxos(ctx, client.LockUtxosArgs{AccountName: testAccount})
+		require.Error(t, err)
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		txHex, err := c.Transfer(ctx, client.TransferArgs{
+			AccountName: testAccount,
+			Receivers: []client.Receiver{
+				{Address: testAddress, Asset: testAsset, Amount: 100},
+			},
+			MillisatsPerByte:       110,
+			IdempotencyKey:         "key",
+			CoinSelectionStrategy:  pb.SelectUtxosRequest_STRATEGY_LOWEST_WASTE,
+			SubtractFeeFromOutputs: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, testTxHex, txHex)
+		require.NotNil(t, transferReq)
+		require.Len(t, transferReq.GetReceivers(), 1)
+		require.Equal(t, 110, int(transferReq.GetMillisatsPerByte()))
+		require.Equal(t, "key", transferReq.GetIdempotencyKey())
+		require.Equal(
+			t, pb.SelectUtxosRequest_STRATEGY_LOWEST_WASTE,
+			transferReq.GetCoinSelectionStrategy(),
+		)
+		require.True(t, transferReq.GetSubtractFeeFromOutputs())
+
+		_, err = c.Transfer(ctx, client.TransferArgs{
+			AccountName: testAccount,
+			Receivers:   []client.Receiver{{Address: testAddress, Asset: testAsset}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("sweep", func(t *testing.T) {
+		txHex, err := c.Sweep(ctx, client.SweepArgs{
+			AccountName: testAccount,
+			Address:     testAddress,
+			Assets:      []string{testAsset},
+		})
+		require.NoError(t, err)
+		require.Equal(t, testTxHex, txHex)
+		require.NotNil(t, sweepReq)
+		require.Equal(t, testAddress, sweepReq.GetAddress())
+		require.Equal(t, []string{testAsset}, sweepReq.GetAssets())
+
+		_, err = c.Sweep(ctx, client.SweepArgs{AccountName: testAccount})
+		require.Error(t, err)
+	})
+
+	t.Run("consolidate utxos", func(t *testing.T) {
+		txids, err := c.ConsolidateUtxos(ctx, client.ConsolidateArgs{
+			AccountName:    testAccount,
+			ValueThreshold: 1000,
+			MaxInputs:      10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{testTxid}, txids)
+		require.NotNil(t, consolidateReq)
+		require.Equal(t, 1000, int(consolidateReq.GetValueThreshold()))
+		require.Equal(t, 10, int(consolidateReq.GetMaxInputs()))
+
+		_, err = c.ConsolidateUtxos(ctx, client.ConsolidateArgs{
+			AccountName: testAccount,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("bump fee", func(t *testing.T) {
+		res, err := c.BumpFee(ctx, testTxid, 200)
+		require.NoError(t, err)
+		require.Equal(t, testTxid, res.GetTxid())
+		require.Equal(
+			t, pb.BumpFeeMethod_BUMP_FEE_METHOD_REPLACEMENT, res.GetMethod(),
+		)
+		require.NotNil(t, bumpFeeReq)
+		require.Equal(t, testTxid, bumpFeeReq.GetTxid())
+		require.Equal(t, 200, int(bumpFeeReq.GetMillisatsPerByte()))
+
+		_, err = c.BumpFee(ctx, testTxid, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("payments", func(t *testing.T) {
+		paymentId, err := c.EnqueuePayment(ctx, client.EnqueuePaymentArgs{
+			AccountName: testAccount,
+			Receiver: client.Receiver{
+				Address: testAddress, Asset: testAsset, Amount: 100,
+			},
+			Deadline:       1000,
+			IdempotencyKey: "key",
+		})
+		require.NoError(t, err)
+		require.Equal(t, testPaymentId, paymentId)
+		require.NotNil(t, enqueueReq)
+		require.Equal(t, testAddress, enqueueReq.GetReceiver().GetAddress())
+		require.Equal(t, 1000, int(enqueueReq.GetDeadline()))
+		require.Equal(t, "key", enqueueReq.GetIdempotencyKey())
+
+		_, err = c.EnqueuePayment(ctx, client.EnqueuePaymentArgs{
+			AccountName: testAccount,
+			Receiver:    client.Receiver{Address: testAddress, Asset: testAsset},
+		})
+		require.Error(t, err)
+
+		payment, err := c.GetPayment(ctx, paymentId)
+		require.NoError(t, err)
+		require.Equal(t, testTxid, payment.GetTxid())
+
+		payment, err = c.GetPayment(ctx, "unknown")
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Nil(t, payment)
+
+		txids, err := c.FlushPayments(ctx, testAccount, 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{testTxid}, txids)
+	})
+
+	t.Run("broadcast", func(t *testing.T) {
+		txid, err := c.Broadcast(ctx, testTxHex, "")
+		require.NoError(t, err)
+		require.Equal(t, testTxid, txid)
+
+		broadcast, err := c.GetBroadcast(ctx, txid)
+		require.NoError(t, err)
+		require.Equal(
+			t, pb.BroadcastStatus_BROADCAST_STATUS_PENDING, broadcast.GetStatus(),
+		)
+
+		broadcast, err = c.GetBroadcast(ctx, "unknown")
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Nil(t, broadcast)
+
+		_, err = c.GetBroadcast(ctx, "")
+		require.Error(t, err)
+	})
+
+	t.Run("schedule", func(t *testing.T) {
+		tx, err := c.ScheduleTransaction(ctx, testTxHex, 1700000000)
+		require.NoError(t, err)
+		require.Equal(t, testTxid, tx.GetTxid())
+		require.Equal(
+			t, pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_SCHEDULED,
+			tx.GetStatus(),
+		)
+
+		_, err = c.ScheduleTransaction(ctx, testTxHex, -1)
+		require.Error(t, err)
+
+		tx, err = c.CancelScheduledTransaction(ctx, testTxid)
+		require.NoError(t, err)
+		require.Equal(
+			t, pb.ScheduledTransactionStatus_SCHEDULED_TRANSACTION_STATUS_CANCELLED,
+			tx.GetStatus(),
+		)
+
+		tx, err = c.CancelScheduledTransaction(ctx, "unknown")
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Nil(t, tx)
+	})
+
+	t.Run("unimplemented", func(t *testing.T) {
+		_, err := c.BlindAndSignPset(ctx, "pset")
+		require.Error(t, err)
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestWalletID(t *testing.T) {
+	accountSvc := &clienttest.AccountService{
+		BalanceFunc: func(
+			ctx context.Context, _ *pb.BalanceRequest,
+		) (*pb.BalanceResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			if ids := md.Get(client.WalletIDMetadataKey); len(ids) > 0 {
+				return nil, status.Error(codes.NotFound, ids[0])
+			}
+			return &pb.BalanceResponse{}, nil
+		},
+	}
+
+	srv := clienttest.NewServer(clienttest.Services{Account: accountSvc})
+	t.Cleanup(srv.Close)
+
+	t.Run("default wallet", func(t *testing.T) {
+		c, err := srv.Client()
+		require.NoError(t, err)
+		t.Cleanup(func() { c.Close() })
+
+		_, err = c.Balance(context.Background(), testAccount)
+		require.NoError(t, err)
+	})
+
+	t.Run("selected wallet", func(t *testing.T) {
+		c, err := srv.Client(client.WithWalletID("vault-1"))
+		require.NoError(t, err)
+		t.Cleanup(func() { c.Close() })
+
+		_, err = c.Balance(context.Background(), testAccount)
+		require.Error(t, err)
+		require.Equal(t, "vault-1", status.Convert(err).Message())
+	})
+}
+
+func TestNotifications(t *testing.T) {
+	t.Run("reconnect on transient error", func(t *testing.T) {
+		var attempts int32
+		notificationSvc := &clienttest.NotificationService{
+			TransactionNotificationsFunc: func(
+				_ *pb.TransactionNotificationsRequest,
+				stream pb.NotificationService_TransactionNotificationsServer,
+			) error {
+				attempt := atomic.AddInt32(&attempts, 1)
+				if err := stream.Send(&pb.TransactionNotificationsResponse{
+					AccountNames: []string{testAccount},
+					Txid:         testTxid,
+				}); err != nil {
+					return err
+				}
+				// Break the stream the first time.
+				if attempt == 1 {
+					return status.Error(codes.Unavailable, "daemon restarting")
+				}
+				<-stream.Context().Done()
+				return nil
+			},
+		}
+		srv := clienttest.NewServer(clienttest.Services{
+			Notification: notificationSvc,
+		})
+		t.Cleanup(srv.Close)
+
+		var streamErrors int32
+		c, err := srv.Client(
+			client.WithReconnectDelay(10*time.Millisecond, 50*time.Millisecond),
+			client.WithStreamErrorHandler(func(error) {
+				atomic.AddInt32(&streamErrors, 1)
+			}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { c.Close() })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch, err := c.TxNotifications(ctx)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			select {
+			case n := <-ch:
+				require.Equal(t, testTxid, n.GetTxid())
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for notification")
+			}
+		}
+		require.Equal(t, 2, int(atomic.LoadInt32(&attempts)))
+		require.Equal(t, 1, int(atomic.LoadInt32(&streamErrors)))
+
+		cancel()
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for channel to be closed")
+		}
+	})
+
+	t.Run("stop on permanent error", func(t *testing.T) {
+		srv := clienttest.NewServer(clienttest.Services{})
+		t.Cleanup(srv.Close)
+
+		c, err := srv.Client()
+		require.NoError(t, err)
+		t.Cleanup(func() { c.Close() })
+
+		ch, err := c.UtxoNotifications(context.Background())
+		require.NoError(t, err)
+
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for channel to be closed")
+		}
+	})
+}
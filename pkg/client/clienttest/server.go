@@ -0,0 +1,92 @@
+// Package clienttest provides an in-memory mock of the ocean daemon for the
+// unit tests of the code using the client package.
+//
+// The mocks of the services return Unimplemented for every RPC, unless the
+// function of the same name is set:
+//
+//	txSvc := &clienttest.TransactionService{
+//		TransferFunc: func(
+//			ctx context.Context, req *pb.TransferRequest,
+//		) (*pb.TransferResponse, error) {
+//			return &pb.TransferResponse{TxHex: txHex}, nil
+//		},
+//	}
+//	srv := clienttest.NewServer(clienttest.Services{Transaction: txSvc})
+//	defer srv.Close()
+//
+//	c, err := srv.Client()
+package clienttest
+
+import (
+	"context"
+	"net"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// Services are the implementations of the ocean services served by the mock
+// server. Those not set return Unimplemented for every RPC.
+type Services struct {
+	Wallet       pb.WalletServiceServer
+	Account      pb.AccountServiceServer
+	Transaction  pb.TransactionServiceServer
+	Notification pb.NotificationServiceServer
+}
+
+// Server is a gRPC server serving the given services over an in-memory
+// connection.
+type Server struct {
+	listener *bufconn.Listener
+	server   *grpc.Server
+}
+
+// NewServer starts serving the given services.
+func NewServer(services Services) *Server {
+	if services.Wallet == nil {
+		services.Wallet = &pb.UnimplementedWalletServiceServer{}
+	}
+	if services.Account == nil {
+		services.Account = &AccountService{}
+	}
+	if services.Transaction == nil {
+		services.Transaction = &TransactionService{}
+	}
+	if services.Notification == nil {
+		services.Notification = &NotificationService{}
+	}
+
+	listener := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	pb.RegisterWalletServiceServer(server, services.Wallet)
+	pb.RegisterAccountServiceServer(server, services.Account)
+	pb.RegisterTransactionServiceServer(server, services.Transaction)
+	pb.RegisterNotificationServiceServer(server, services.Notification)
+
+	go server.Serve(listener)
+
+	return &Server{listener, server}
+}
+
+// Client returns a client connected to the server. Any given option is
+// applied on top of those required to connect.
+func (s *Server) Client(opts ...client.Option) (*client.Client, error) {
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	}
+	opts = append([]client.Option{
+		client.WithInsecure(),
+		client.WithDialOptions(grpc.WithContextDialer(dialer)),
+	}, opts...)
+	return client.New("bufnet", opts...)
+}
+
+// Close stops the server, closing every open stream.
+func (s *Server) Close() {
+	s.server.Stop()
+	s.listener.Close()
+}
@@ -0,0 +1,370 @@
+package clienttest
+
+import (
+	"context"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+)
+
+// AccountService is the mock of the account service, covering the RPCs used
+// by the typed helpers of the client.
+type AccountService struct {
+	pb.UnimplementedAccountServiceServer
+
+	BalanceFunc func(
+		ctx context.Context, req *pb.BalanceRequest,
+	) (*pb.BalanceResponse, error)
+	ListUtxosFunc func(
+		ctx context.Context, req *pb.ListUtxosRequest,
+	) (*pb.ListUtxosResponse, error)
+	FreezeUtxosFunc func(
+		ctx context.Context, req *pb.FreezeUtxosRequest,
+	) (*pb.FreezeUtxosResponse, error)
+	UnfreezeUtxosFunc func(
+		ctx context.Context, req *pb.UnfreezeUtxosRequest,
+	) (*pb.UnfreezeUtxosResponse, error)
+	SetUtxoLabelsFunc func(
+		ctx context.Context, req *pb.SetUtxoLabelsRequest,
+	) (*pb.SetUtxoLabelsResponse, error)
+	SetAddressLabelsFunc func(
+		ctx context.Context, req *pb.SetAddressLabelsRequest,
+	) (*pb.SetAddressLabelsResponse, error)
+}
+
+func (s *AccountService) Balance(
+	ctx context.Context, req *pb.BalanceRequest,
+) (*pb.BalanceResponse, error) {
+	if s.BalanceFunc == nil {
+		return s.UnimplementedAccountServiceServer.Balance(ctx, req)
+	}
+	return s.BalanceFunc(ctx, req)
+}
+
+func (s *AccountService) ListUtxos(
+	ctx context.Context, req *pb.ListUtxosRequest,
+) (*pb.ListUtxosResponse, error) {
+	if s.ListUtxosFunc == nil {
+		return s.UnimplementedAccountServiceServer.ListUtxos(ctx, req)
+	}
+	return s.ListUtxosFunc(ctx, req)
+}
+
+func (s *AccountService) FreezeUtxos(
+	ctx context.Context, req *pb.FreezeUtxosRequest,
+) (*pb.FreezeUtxosResponse, error) {
+	if s.FreezeUtxosFunc == nil {
+		return s.UnimplementedAccountServiceServer.FreezeUtxos(ctx, req)
+	}
+	return s.FreezeUtxosFunc(ctx, req)
+}
+
+func (s *AccountService) UnfreezeUtxos(
+	ctx context.Context, req *pb.UnfreezeUtxosRequest,
+) (*pb.UnfreezeUtxosResponse, error) {
+	if s.UnfreezeUtxosFunc == nil {
+		return s.UnimplementedAccountServiceServer.UnfreezeUtxos(ctx, req)
+	}
+	return s.UnfreezeUtxosFunc(ctx, req)
+}
+
+func (s *AccountService) SetUtxoLabels(
+	ctx context.Context, req *pb.SetUtxoLabelsRequest,
+) (*pb.SetUtxoLabelsResponse, error) {
+	if s.SetUtxoLabelsFunc == nil {
+		return s.UnimplementedAccountServiceServer.SetUtxoLabels(ctx, req)
+	}
+	return s.SetUtxoLabelsFunc(ctx, req)
+}
+
+func (s *AccountService) SetAddressLabels(
+	ctx context.Context, req *pb.SetAddressLabelsRequest,
+) (*pb.SetAddressLabelsResponse, error) {
+	if s.SetAddressLabelsFunc == nil {
+		return s.UnimplementedAccountServiceServer.SetAddressLabels(ctx, req)
+	}
+	return s.SetAddressLabelsFunc(ctx, req)
+}
+
+// TransactionService is the mock of the transaction service, covering the
+// RPCs used by the typed helpers of the client.
+type TransactionService struct {
+	pb.UnimplementedTransactionServiceServer
+
+	TransferFunc func(
+		ctx context.Context, req *pb.TransferRequest,
+	) (*pb.TransferResponse, error)
+	SweepAccountFunc func(
+		ctx context.Context, req *pb.SweepAccountRequest,
+	) (*pb.SweepAccountResponse, error)
+	ConsolidateUtxosFunc func(
+		ctx context.Context, req *pb.ConsolidateUtxosRequest,
+	) (*pb.ConsolidateUtxosResponse, error)
+	LockUtxosFunc func(
+		ctx context.Context, req *pb.LockUtxosRequest,
+	) (*pb.LockUtxosResponse, error)
+	UnlockUtxosFunc func(
+		ctx context.Context, req *pb.UnlockUtxosRequest,
+	) (*pb.UnlockUtxosResponse, error)
+	EnqueuePaymentFunc func(
+		ctx context.Context, req *pb.EnqueuePaymentRequest,
+	) (*pb.EnqueuePaymentResponse, error)
+	GetPaymentFunc func(
+		ctx context.Context, req *pb.GetPaymentRequest,
+	) (*pb.GetPaymentResponse, error)
+	FlushPaymentsFunc func(
+		ctx context.Context, req *pb.FlushPaymentsRequest,
+	) (*pb.FlushPaymentsResponse, error)
+	BroadcastTransactionFunc func(
+		ctx context.Context, req *pb.BroadcastTransactionRequest,
+	) (*pb.BroadcastTransactionResponse, error)
+	BumpFeeFunc func(
+		ctx context.Context, req *pb.BumpFeeRequest,
+	) (*pb.BumpFeeResponse, error)
+	GetBroadcastFunc func(
+		ctx context.Context, req *pb.GetBroadcastRequest,
+	) (*pb.GetBroadcastResponse, error)
+	ScheduleTransactionFunc func(
+		ctx context.Context, req *pb.ScheduleTransactionRequest,
+	) (*pb.ScheduleTransactionResponse, error)
+	GetScheduledTransactionFunc func(
+		ctx context.Context, req *pb.GetScheduledTransactionRequest,
+	) (*pb.GetScheduledTransactionResponse, error)
+	ListScheduledTransactionsFunc func(
+		ctx context.Context, req *pb.ListScheduledTransactionsRequest,
+	) (*pb.ListScheduledTransactionsResponse, error)
+	CancelScheduledTransactionFunc func(
+		ctx context.Context, req *pb.CancelScheduledTransactionRequest,
+	) (*pb.CancelScheduledTransactionResponse, error)
+	BlindPsetFunc func(
+		ctx context.Context, req *pb.BlindPsetRequest,
+	) (*pb.BlindPsetResponse, error)
+	SignPsetFunc func(
+		ctx context.Context, req *pb.SignPsetRequest,
+	) (*pb.SignPsetResponse, error)
+}
+
+func (s *TransactionService) Transfer(
+	ctx context.Context, req *pb.TransferRequest,
+) (*pb.TransferResponse, error) {
+	if s.TransferFunc == nil {
+		return s.UnimplementedTransactionServiceServer.Transfer(ctx, req)
+	}
+	return s.TransferFunc(ctx, req)
+}
+
+func (s *TransactionService) SweepAccount(
+	ctx context.Context, req *pb.SweepAccountRequest,
+) (*pb.SweepAccountResponse, error) {
+	if s.SweepAccountFunc == nil {
+		return s.UnimplementedTransactionServiceServer.SweepAccount(ctx, req)
+	}
+	return s.SweepAccountFunc(ctx, req)
+}
+
+func (s *TransactionService) ConsolidateUtxos(
+	ctx context.Context, req *pb.ConsolidateUtxosRequest,
+) (*pb.ConsolidateUtxosResponse, error) {
+	if s.ConsolidateUtxosFunc == nil {
+		return s.UnimplementedTransactionServiceServer.ConsolidateUtxos(ctx, req)
+	}
+	return s.ConsolidateUtxosFunc(ctx, req)
+}
+
+func (s *TransactionService) LockUtxos(
+	ctx context.Context, req *pb.LockUtxosRequest,
+) (*pb.LockUtxosResponse, error) {
+	if s.LockUtxosFunc == nil {
+		return s.UnimplementedTransactionServiceServer.LockUtxos(ctx, req)
+	}
+	return s.LockUtxosFunc(ctx, req)
+}
+
+func (s *TransactionService) UnlockUtxos(
+	ctx context.Context, req *pb.UnlockUtxosRequest,
+) (*pb.UnlockUtxosResponse, error) {
+	if s.UnlockUtxosFunc == nil {
+		return s.UnimplementedTransactionServiceServer.UnlockUtxos(ctx, req)
+	}
+	return s.UnlockUtxosFunc(ctx, req)
+}
+
+func (s *TransactionService) EnqueuePayment(
+	ctx context.Context, req *pb.EnqueuePaymentRequest,
+) (*pb.EnqueuePaymentResponse, error) {
+	if s.EnqueuePaymentFunc == nil {
+		return s.UnimplementedTransactionServiceServer.EnqueuePayment(ctx, req)
+	}
+	return s.EnqueuePaymentFunc(ctx, req)
+}
+
+func (s *TransactionService) GetPayment(
+	ctx context.Context, req *pb.GetPaymentRequest,
+) (*pb.GetPaymentResponse, error) {
+	if s.GetPaymentFunc == nil {
+		return s.UnimplementedTransactionServiceServer.GetPayment(ctx, req)
+	}
+	return s.GetPaymentFunc(ctx, req)
+}
+
+func (s *TransactionService) FlushPayments(
+	ctx context.Context, req *pb.FlushPaymentsRequest,
+) (*pb.FlushPaymentsResponse, error) {
+	if s.FlushPaymentsFunc == nil {
+		return s.UnimplementedTransactionServiceServer.FlushPayments(ctx, req)
+	}
+	return s.FlushPaymentsFunc(ctx, req)
+}
+
+func (s *TransactionService) BroadcastTransaction(
+	ctx context.Context, req *pb.BroadcastTransactionRequest,
+) (*pb.BroadcastTransactionResponse, error) {
+	if s.BroadcastTransactionFunc == nil {
+		return s.UnimplementedTransactionServiceServer.BroadcastTransaction(
+			ctx, req,
+		)
+	}
+	return s.BroadcastTransactionFunc(ctx, req)
+}
+
+func (s *TransactionService) BumpFee(
+	ctx context.Context, req *pb.BumpFeeRequest,
+) (*pb.BumpFeeResponse, error) {
+	if s.BumpFeeFunc == nil {
+		return s.UnimplementedTransactionServiceServer.BumpFee(ctx, req)
+	}
+	return s.BumpFeeFunc(ctx, req)
+}
+
+func (s *TransactionService) GetBroadcast(
+	ctx context.Context, req *pb.GetBroadcastRequest,
+) (*pb.GetBroadcastResponse, error) {
+	if s.GetBroadcastFunc == nil {
+		return s.UnimplementedTransactionServiceServer.GetBroadcast(ctx, req)
+	}
+	return s.GetBroadcastFunc(ctx, req)
+}
+
+func (s *TransactionService) ScheduleTransaction(
+	ctx context.Context, req *pb.ScheduleTransactionRequest,
+) (*pb.ScheduleTransactionResponse, error) {
+	if s.ScheduleTransactionFunc == nil {
+		return s.UnimplementedTransactionServiceServer.ScheduleTransaction(ctx, req)
+	}
+	return s.ScheduleTransactionFunc(ctx, req)
+}
+
+func (s *TransactionService) GetScheduledTransaction(
+	ctx context.Context, req *pb.GetScheduledTransactionRequest,
+) (*pb.GetScheduledTransactionResponse, error) {
+	if s.GetScheduledTransactionFunc == nil {
+		return s.UnimplementedTransactionServiceServer.GetScheduledTransaction(ctx, req)
+	}
+	return s.GetScheduledTransactionFunc(ctx, req)
+}
+
+func (s *TransactionService) ListScheduledTransactions(
+	ctx context.Context, req *pb.ListScheduledTransactionsRequest,
+) (*pb.ListScheduledTransactionsResponse, error) {
+	if s.ListScheduledTransactionsFunc == nil {
+		return s.UnimplementedTransactionServiceServer.ListScheduledTransactions(ctx, req)
+	}
+	return s.ListScheduledTransactionsFunc(ctx, req)
+}
+
+func (s *TransactionService) CancelScheduledTransaction(
+	ctx context.Context, req *pb.CancelScheduledTransactionRequest,
+) (*pb.CancelScheduledTransactionResponse, error) {
+	if s.CancelScheduledTransactionFunc == nil {
+		return s.UnimplementedTransactionServiceServer.CancelScheduledTransaction(ctx, req)
+	}
+	return s.CancelScheduledTransactionFunc(ctx, req)
+}
+
+func (s *TransactionService) BlindPset(
+	ctx context.Context, req *pb.BlindPsetRequest,
+) (*pb.BlindPsetResponse, error) {
+	if s.BlindPsetFunc == nil {
+		return s.UnimplementedTransactionServiceServer.BlindPset(ctx, req)
+	}
+	return s.BlindPsetFunc(ctx, req)
+}
+
+func (s *TransactionService) SignPset(
+	ctx context.Context, req *pb.SignPsetRequest,
+) (*pb.SignPsetResponse, error) {
+	if s.SignPsetFunc == nil {
+		return s.UnimplementedTransactionServiceServer.SignPset(ctx, req)
+	}
+	return s.SignPsetFunc(ctx, req)
+}
+
+// NotificationService is the mock of the notification service, covering the
+// notification streams.
+type NotificationService struct {
+	pb.UnimplementedNotificationServiceServer
+
+	TransactionNotificationsFunc func(
+		req *pb.TransactionNotificationsRequest,
+		stream pb.NotificationService_TransactionNotificationsServer,
+	) error
+	UtxosNotificationsFunc func(
+		req *pb.UtxosNotificationsRequest,
+		stream pb.NotificationService_UtxosNotificationsServer,
+	) error
+	PaymentNotificationsFunc func(
+		req *pb.PaymentNotificationsRequest,
+		stream pb.NotificationService_PaymentNotificationsServer,
+	) error
+	BroadcastNotificationsFunc func(
+		req *pb.BroadcastNotificationsRequest,
+		stream pb.NotificationService_BroadcastNotificationsServer,
+	) error
+}
+
+func (s *NotificationService) TransactionNotifications(
+	req *pb.TransactionNotificationsRequest,
+	stream pb.NotificationService_TransactionNotificationsServer,
+) error {
+	if s.TransactionNotificationsFunc == nil {
+		return s.UnimplementedNotificationServiceServer.TransactionNotifications(
+			req, stream,
+		)
+	}
+	return s.TransactionNotificationsFunc(req, stream)
+}
+
+func (s *NotificationService) UtxosNotifications(
+	req *pb.UtxosNotificationsRequest,
+	stream pb.NotificationService_UtxosNotificationsServer,
+) error {
+	if s.UtxosNotificationsFunc == nil {
+		return s.UnimplementedNotificationServiceServer.UtxosNotifications(
+			req, stream,
+		)
+	}
+	return s.UtxosNotificationsFunc(req, stream)
+}
+
+func (s *NotificationService) PaymentNotifications(
+	req *pb.PaymentNotificationsRequest,
+	stream pb.NotificationService_PaymentNotificationsServer,
+) error {
+	if s.PaymentNotificationsFunc == nil {
+		return s.UnimplementedNotificationServiceServer.PaymentNotifications(
+			req, stream,
+		)
+	}
+	return s.PaymentNotificationsFunc(req, stream)
+}
+
+func (s *NotificationService) BroadcastNotifications(
+	req *pb.BroadcastNotificationsRequest,
+	stream pb.NotificationService_BroadcastNotificationsServer,
+) error {
+	if s.BroadcastNotificationsFunc == nil {
+		return s.UnimplementedNotificationServiceServer.BroadcastNotifications(
+			req, stream,
+		)
+	}
+	return s.BroadcastNotificationsFunc(req, stream)
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permanentStreamErrors are the status codes for which it's pointless to
+// re-open a broken notification stream.
+var permanentStreamErrors = map[codes.Code]struct{}{
+	codes.Canceled:         {},
+	codes.InvalidArgument:  {},
+	codes.Unimplemented:    {},
+	codes.Unauthenticated:  {},
+	codes.PermissionDenied: {},
+}
+
+type recvFn func() (interface{}, error)
+
+type openStreamFn func(ctx context.Context) (recvFn, error)
+
+// TxNotifications subscribes to the notifications about the transactions of
+// the wallet, and returns the channel where they are sent.
+// If the stream breaks, for example because the daemon restarted, it's
+// transparently re-opened with an exponential backoff. The channel is closed
+// once the given context is canceled, the client is closed, or the daemon
+// rejects the subscription with a permanent error, like PermissionDenied.
+func (c *Client) TxNotifications(
+	ctx context.Context,
+) (<-chan *pb.TransactionNotificationsResponse, error) {
+	ch := make(chan *pb.TransactionNotificationsResponse)
+	open := func(ctx context.Context) (recvFn, error) {
+		stream, err := c.notification.TransactionNotifications(
+			ctx, &pb.TransactionNotificationsRequest{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return func() (interface{}, error) { return stream.Recv() }, nil
+	}
+	forward := func(msg interface{}) {
+		select {
+		case ch <- msg.(*pb.TransactionNotificationsResponse):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := c.subscribe(ctx, open, forward, func() { close(ch) }); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// UtxoNotifications subscribes to the notifications about the utxos of the
+// wallet, and returns the channel where they are sent.
+// Like for TxNotifications, the stream is transparently re-opened if broken,
+// and the channel is closed once the subscription ends.
+func (c *Client) UtxoNotifications(
+	ctx context.Context,
+) (<-chan *pb.UtxosNotificationsResponse, error) {
+	ch := make(chan *pb.UtxosNotificationsResponse)
+	open := func(ctx context.Context) (recvFn, error) {
+		stream, err := c.notification.UtxosNotifications(
+			ctx, &pb.UtxosNotificationsRequest{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return func() (interface{}, error) { return stream.Recv() }, nil
+	}
+	forward := func(msg interface{}) {
+		select {
+		case ch <- msg.(*pb.UtxosNotificationsResponse):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := c.subscribe(ctx, open, forward, func() { close(ch) }); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// PaymentNotifications subscribes to the notifications about the queued
+// payments of the wallet, and returns the channel where they are sent.
+// Like for TxNotifications, the stream is transparently re-opened if broken,
+// and the channel is closed once the subscription ends.
+func (c *Client) PaymentNotifications(
+	ctx context.Context,
+) (<-chan *pb.PaymentNotificationsResponse, error) {
+	ch := make(chan *pb.PaymentNotificationsResponse)
+	open := func(ctx context.Context) (recvFn, error) {
+		stream, err := c.notification.PaymentNotifications(
+			ctx, &pb.PaymentNotificationsRequest{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return func() (interface{}, error) { return stream.Recv() }, nil
+	}
+	forward := func(msg interface{}) {
+		select {
+		case ch <- msg.(*pb.PaymentNotificationsResponse):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := c.subscribe(ctx, open, forward, func() { close(ch) }); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// BroadcastNotifications subscribes to the notifications about the
+// transactions broadcasted by the wallet, and returns the channel where they
+// are sent. Like for TxNotifications, the stream is transparently re-opened if
+// broken, and the channel is closed once the subscription ends.
+func (c *Client) BroadcastNotifications(
+	ctx context.Context,
+) (<-chan *pb.BroadcastNotificationsResponse, error) {
+	ch := make(chan *pb.BroadcastNotificationsResponse)
+	open := func(ctx context.Context) (recvFn, error) {
+		stream, err := c.notification.BroadcastNotifications(
+			ctx, &pb.BroadcastNotificationsRequest{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return func() (interface{}, error) { return stream.Recv() }, nil
+	}
+	forward := func(msg interface{}) {
+		select {
+		case ch <- msg.(*pb.BroadcastNotificationsResponse):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := c.subscribe(ctx, open, forward, func() { close(ch) }); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// subscribe opens a stream and forwards every received message in background,
+// re-opening the stream whenever it breaks with a transient error. The given
+// stop function is called when the subscription ends.
+func (c *Client) subscribe(
+	ctx context.Context, open openStreamFn, forward func(msg interface{}),
+	stop func(),
+) error {
+	recv, err := open(ctx)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer stop()
+
+		delay := c.opts.minReconnectDelay
+		for {
+			msg, err := recv()
+			if err == nil {
+				delay = c.opts.minReconnectDelay
+				forward(msg)
+				continue
+			}
+
+			for {
+				if ctx.Err() != nil {
+					return
+				}
+				c.opts.onStreamError(err)
+				if isPermanentStreamError(err) {
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(delay):
+				}
+				if delay *= 2; delay > c.opts.maxReconnectDelay {
+					delay = c.opts.maxReconnectDelay
+				}
+
+				if recv, err = open(ctx); err == nil {
+					break
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func isPermanentStreamError(err error) bool {
+	_, ok := permanentStreamErrors[status.Code(err)]
+	return ok
+}
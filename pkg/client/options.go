@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// WalletIDMetadataKey is the request metadata key used to select the wallet
+// of a daemon serving more than one.
+const WalletIDMetadataKey = "x-ocean-wallet-id"
+
+var (
+	// DefaultMaxMsgRecvSize is the default max size of the messages received
+	// from the daemon, like the list of utxos or transactions of an account.
+	DefaultMaxMsgRecvSize = 200 * 1024 * 1024
+	// DefaultMinReconnectDelay and DefaultMaxReconnectDelay are the default
+	// bounds of the exponential backoff between two attempts to re-open a
+	// broken notification stream.
+	DefaultMinReconnectDelay = time.Second
+	DefaultMaxReconnectDelay = 30 * time.Second
+)
+
+// Option customizes the connection to the daemon or the behavior of the
+// client.
+type Option func(o *options) error
+
+type options struct {
+	noTLS             bool
+	tlsCertPath       string
+	tlsClientCertPath string
+	tlsClientKeyPath  string
+	perRPCCreds       credentials.PerRPCCredentials
+	walletID          string
+	maxMsgRecvSize    int
+	minReconnectDelay time.Duration
+	maxReconnectDelay time.Duration
+	onStreamError     func(err error)
+	dialOpts          []grpc.DialOption
+}
+
+func defaultOptions() *options {
+	return &options{
+		maxMsgRecvSize:    DefaultMaxMsgRecvSize,
+		minReconnectDelay: DefaultMinReconnectDelay,
+		maxReconnectDelay: DefaultMaxReconnectDelay,
+		onStreamError:     func(error) {},
+	}
+}
+
+// WithInsecure disables TLS, to connect to a daemon with TLS disabled.
+func WithInsecure() Option {
+	return func(o *options) error {
+		o.noTLS = true
+		return nil
+	}
+}
+
+// WithTLSCert sets the path of the TLS certificate of the daemon, usually
+// <datadir>/tls/cert.pem.
+func WithTLSCert(certPath string) Option {
+	return func(o *options) error {
+		if len(certPath) <= 0 {
+			return fmt.Errorf("missing TLS certificate path")
+		}
+		o.tlsCertPath = certPath
+		return nil
+	}
+}
+
+// WithTLSClientCert sets the paths of the TLS client key pair used to
+// authenticate with a daemon with client authentication enabled.
+func WithTLSClientCert(certPath, keyPath string) Option {
+	return func(o *options) error {
+		if len(certPath) <= 0 || len(keyPath) <= 0 {
+			return fmt.Errorf("missing TLS client certificate or key path")
+		}
+		o.tlsClientCertPath = certPath
+		o.tlsClientKeyPath = keyPath
+		return nil
+	}
+}
+
+// WithPerRPCCredentials attaches the given credentials, like a macaroon or a
+// bearer token, to every request. This is useful when the daemon sits behind
+// a proxy that authenticates the requests.
+func WithPerRPCCredentials(creds credentials.PerRPCCredentials) Option {
+	return func(o *options) error {
+		if creds == nil {
+			return fmt.Errorf("missing per-rpc credentials")
+		}
+		o.perRPCCreds = creds
+		return nil
+	}
+}
+
+// WithWalletID selects the wallet all requests refer to, for daemons serving
+// more than one. The default wallet of the daemon is used if not set.
+func WithWalletID(walletID string) Option {
+	return func(o *options) error {
+		if len(walletID) <= 0 {
+			return fmt.Errorf("missing wallet id")
+		}
+		o.walletID = walletID
+		return nil
+	}
+}
+
+// WithMaxMsgRecvSize customizes the max size of the messages received from
+// the daemon.
+func WithMaxMsgRecvSize(size int) Option {
+	return func(o *options) error {
+		if size <= 0 {
+			return fmt.Errorf("max message size must be a positive number")
+		}
+		o.maxMsgRecvSize = size
+		return nil
+	}
+}
+
+// WithReconnectDelay customizes the bounds of the exponential backoff between
+// two attempts to re-open a broken notification stream.
+func WithReconnectDelay(min, max time.Duration) Option {
+	return func(o *options) error {
+		if min <= 0 || max < min {
+			return fmt.Errorf(
+				"reconnect delays must be positive, with max not lower than min",
+			)
+		}
+		o.minReconnectDelay = min
+		o.maxReconnectDelay = max
+		return nil
+	}
+}
+
+// WithStreamErrorHandler sets the function called whenever a notification
+// stream breaks, for example to log the error.
+func WithStreamErrorHandler(handler func(err error)) Option {
+	return func(o *options) error {
+		if handler == nil {
+			return fmt.Errorf("missing stream error handler")
+		}
+		o.onStreamError = handler
+		return nil
+	}
+}
+
+// WithDialOptions adds the given options to those used to dial the daemon.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) error {
+		o.dialOpts = append(o.dialOpts, opts...)
+		return nil
+	}
+}
+
+func (o *options) grpcDialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(o.maxMsgRecvSize)),
+	}
+
+	if o.noTLS {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		if len(o.tlsCertPath) <= 0 {
+			return nil, fmt.Errorf(
+				"missing TLS certificate path, must be set if TLS is enabled",
+			)
+		}
+		creds, err := tlsCreds(
+			o.tlsCertPath, o.tlsClientCertPath, o.tlsClientKeyPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	if o.perRPCCreds != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(o.perRPCCreds))
+	}
+
+	if len(o.walletID) > 0 {
+		opts = append(
+			opts,
+			grpc.WithChainUnaryInterceptor(o.unaryWalletSelector),
+			grpc.WithChainStreamInterceptor(o.streamWalletSelector),
+		)
+	}
+
+	return append(opts, o.dialOpts...), nil
+}
+
+func (o *options) unaryWalletSelector(
+	ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, WalletIDMetadataKey, o.walletID)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (o *options) streamWalletSelector(
+	ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, WalletIDMetadataKey, o.walletID)
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// tlsCreds returns the TLS credentials to connect to the daemon. The client
+// key pair is required only if the daemon has client authentication enabled.
+func tlsCreds(
+	certPath, clientCertPath, clientKeyPath string,
+) (credentials.TransportCredentials, error) {
+	buf, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %s", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(buf) {
+		return nil, fmt.Errorf("failed to load TLS certificate: invalid format")
+	}
+	tlsConfig := &tls.Config{RootCAs: rootCAs}
+
+	if len(clientCertPath) > 0 || len(clientKeyPath) > 0 {
+		cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS client key pair: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
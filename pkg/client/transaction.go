@@ -0,0 +1,533 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+// Receiver is the destination of the funds of a transfer.
+type Receiver struct {
+	// Address to send funds to.
+	Address string
+	// Asset hash.
+	Asset string
+	// Amount in satoshis.
+	Amount uint64
+}
+
+// TransferArgs are the args of a transfer.
+type TransferArgs struct {
+	// AccountName is the name of the account to send the funds from.
+	AccountName string
+	// Receivers are the destinations of the funds.
+	Receivers []Receiver
+	// MillisatsPerByte is the fee rate, the daemon uses its default if zero.
+	MillisatsPerByte uint64
+	// IdempotencyKey is the optional key to safely retry the transfer.
+	IdempotencyKey string
+	// CoinSelectionStrategy is the algorithm to select the utxos to spend,
+	// the daemon uses its default if unspecified.
+	CoinSelectionStrategy pb.SelectUtxosRequest_Strategy
+	// SubtractFeeFromOutputs makes the LBTC receivers pay the fees, in equal
+	// parts, instead of adding them to the amount spent.
+	SubtractFeeFromOutputs bool
+	// Locktime is the optional nLockTime of the transaction, either a block
+	// height or a unix timestamp if not lower than 500000000.
+	Locktime uint32
+	// Sequences are the optional nSequence of the inputs. Since inputs are
+	// selected by the daemon, at most one can be given, applied to all of
+	// them. The daemon enables the locktime by default if not set.
+	Sequences []uint32
+}
+
+func (a TransferArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	if len(a.Receivers) <= 0 {
+		return fmt.Errorf("missing receivers")
+	}
+	for i, r := range a.Receivers {
+		if len(r.Address) <= 0 {
+			return fmt.Errorf("missing address for receiver %d", i)
+		}
+		if r.Amount == 0 {
+			return fmt.Errorf("missing amount for receiver %d", i)
+		}
+	}
+	return nil
+}
+
+func (a TransferArgs) receivers() []*pb.Output {
+	outs := make([]*pb.Output, 0, len(a.Receivers))
+	for _, r := range a.Receivers {
+		outs = append(outs, &pb.Output{
+			Address: r.Address,
+			Asset:   r.Asset,
+			Amount:  r.Amount,
+		})
+	}
+	return outs
+}
+
+// Transfer sends funds from an account to the given receivers and returns
+// the signed transaction in hex format, ready to be broadcasted.
+func (c *Client) Transfer(ctx context.Context, args TransferArgs) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	reply, err := c.transaction.Transfer(ctx, &pb.TransferRequest{
+		AccountName:            args.AccountName,
+		Receivers:              args.receivers(),
+		MillisatsPerByte:       args.MillisatsPerByte,
+		IdempotencyKey:         args.IdempotencyKey,
+		CoinSelectionStrategy:  args.CoinSelectionStrategy,
+		SubtractFeeFromOutputs: args.SubtractFeeFromOutputs,
+		Locktime:               args.Locktime,
+		Sequences:              args.Sequences,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.GetTxHex(), nil
+}
+
+// SweepArgs are the args of a sweep.
+type SweepArgs struct {
+	// AccountName is the name of the account to send the funds from.
+	AccountName string
+	// Address is the destination of the funds.
+	Address string
+	// Assets are the assets to sweep, all those of the account if empty.
+	Assets []string
+	// MillisatsPerByte is the fee rate, the daemon uses its default if zero.
+	MillisatsPerByte uint64
+	// IdempotencyKey is the optional key to safely retry the sweep.
+	IdempotencyKey string
+}
+
+func (a SweepArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	if len(a.Address) <= 0 {
+		return fmt.Errorf("missing address")
+	}
+	return nil
+}
+
+// Sweep sends all the spendable funds of the given assets of an account to
+// the given address, subtracting the fees from the LBTC amount, and returns
+// the signed transaction in hex format, ready to be broadcasted.
+func (c *Client) Sweep(ctx context.Context, args SweepArgs) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	reply, err := c.transaction.SweepAccount(ctx, &pb.SweepAccountRequest{
+		AccountName:      args.AccountName,
+		Address:          args.Address,
+		Assets:           args.Assets,
+		MillisatsPerByte: args.MillisatsPerByte,
+		IdempotencyKey:   args.IdempotencyKey,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.GetTxHex(), nil
+}
+
+// ConsolidateArgs are the args of a utxo consolidation.
+type ConsolidateArgs struct {
+	// AccountName is the name of the account whose utxos to merge.
+	AccountName string
+	// Asset is the asset whose utxos to merge, all assets if empty.
+	Asset string
+	// ValueThreshold is the value in satoshis below which utxos are merged.
+	ValueThreshold uint64
+	// MaxInputs caps the inputs of every tx, the daemon uses its default if
+	// zero.
+	MaxInputs uint32
+	// MillisatsPerByte is the fee rate, the daemon uses its default if zero.
+	MillisatsPerByte uint64
+}
+
+func (a ConsolidateArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	if a.ValueThreshold == 0 {
+		return fmt.Errorf("missing value threshold")
+	}
+	return nil
+}
+
+// ConsolidateUtxos merges the small utxos of an account into new ones and
+// returns the hashes of the already broadcasted transactions.
+func (c *Client) ConsolidateUtxos(
+	ctx context.Context, args ConsolidateArgs,
+) ([]string, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+
+	reply, err := c.transaction.ConsolidateUtxos(
+		ctx, &pb.ConsolidateUtxosRequest{
+			AccountName:      args.AccountName,
+			Asset:            args.Asset,
+			ValueThreshold:   args.ValueThreshold,
+			MaxInputs:        args.MaxInputs,
+			MillisatsPerByte: args.MillisatsPerByte,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetTxids(), nil
+}
+
+// UtxosLock is a lock of utxos, identified by the token required to extend
+// or release it.
+type UtxosLock struct {
+	// Token identifies the owner of the lock.
+	Token string
+	// ExpirationDate is the unix time at which the lock expires.
+	ExpirationDate int64
+}
+
+// LockUtxosArgs are the args of a utxo lock.
+type LockUtxosArgs struct {
+	// AccountName is the name of the account owning the utxos.
+	AccountName string
+	// Utxos are the utxos to lock.
+	Utxos []UtxoRef
+	// LockToken is the optional token of an existing lock to extend.
+	LockToken string
+	// Duration is the duration of the lock, the daemon uses its default if
+	// zero.
+	Duration time.Duration
+}
+
+func (a LockUtxosArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	if a.Duration < 0 {
+		return fmt.Errorf("invalid lock duration")
+	}
+	return nil
+}
+
+// LockUtxos locks the given utxos of an account so that they are not
+// selected by other transactions until the lock expires or is released.
+// Providing the token of an existing lock extends its expiration.
+func (c *Client) LockUtxos(
+	ctx context.Context, args LockUtxosArgs,
+) (*UtxosLock, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	ins, err := utxoRefs(args.Utxos)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := c.transaction.LockUtxos(ctx, &pb.LockUtxosRequest{
+		AccountName:       args.AccountName,
+		Utxos:             ins,
+		LockToken:         args.LockToken,
+		LockExpirySeconds: uint64(args.Duration / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UtxosLock{
+		Token:          reply.GetLockToken(),
+		ExpirationDate: reply.GetExpirationDate(),
+	}, nil
+}
+
+// UnlockUtxos releases the given locked utxos of an account before their
+// expiration, and returns the number of those actually unlocked. The lock
+// token can be omitted only by an admin.
+func (c *Client) UnlockUtxos(
+	ctx context.Context, accountName, lockToken string, utxos ...UtxoRef,
+) (uint64, error) {
+	ins, err := utxoRefs(utxos)
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := c.transaction.UnlockUtxos(ctx, &pb.UnlockUtxosRequest{
+		AccountName: accountName,
+		Utxos:       ins,
+		LockToken:   lockToken,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetCount(), nil
+}
+
+// EnqueuePaymentArgs are the args of a queued payment.
+type EnqueuePaymentArgs struct {
+	// AccountName is the name of the account to send the funds from.
+	AccountName string
+	// Receiver is the destination of the funds.
+	Receiver Receiver
+	// Deadline is the optional unix time by which the payment must be
+	// broadcasted.
+	Deadline int64
+	// IdempotencyKey is the optional key to safely retry the request.
+	IdempotencyKey string
+}
+
+func (a EnqueuePaymentArgs) validate() error {
+	if len(a.AccountName) <= 0 {
+		return fmt.Errorf("missing account name")
+	}
+	if len(a.Receiver.Address) <= 0 {
+		return fmt.Errorf("missing receiver address")
+	}
+	if a.Receiver.Amount == 0 {
+		return fmt.Errorf("missing receiver amount")
+	}
+	if a.Deadline < 0 {
+		return fmt.Errorf("invalid deadline")
+	}
+	return nil
+}
+
+// EnqueuePayment adds a payment to the payout queue of an account and
+// returns its id. The payment is broadcasted by the daemon along with the
+// other queued ones in a single transaction.
+func (c *Client) EnqueuePayment(
+	ctx context.Context, args EnqueuePaymentArgs,
+) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	reply, err := c.transaction.EnqueuePayment(
+		ctx, &pb.EnqueuePaymentRequest{
+			AccountName: args.AccountName,
+			Receiver: &pb.Output{
+				Address: args.Receiver.Address,
+				Asset:   args.Receiver.Asset,
+				Amount:  args.Receiver.Amount,
+			},
+			Deadline:       args.Deadline,
+			IdempotencyKey: args.IdempotencyKey,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return reply.GetPaymentId(), nil
+}
+
+// GetPayment returns the queued payment with the given id, including the
+// transaction output paying it once broadcasted.
+func (c *Client) GetPayment(
+	ctx context.Context, paymentId string,
+) (*pb.Payment, error) {
+	if len(paymentId) <= 0 {
+		return nil, fmt.Errorf("missing payment id")
+	}
+
+	reply, err := c.transaction.GetPayment(
+		ctx, &pb.GetPaymentRequest{PaymentId: paymentId},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetPayment(), nil
+}
+
+// FlushPayments broadcasts right away the queued payments of the given
+// account, or of all accounts if empty, and returns the hashes of the
+// transactions. The fee rate is optional, the daemon uses its default if zero.
+func (c *Client) FlushPayments(
+	ctx context.Context, accountName string, millisatsPerByte uint64,
+) ([]string, error) {
+	reply, err := c.transaction.FlushPayments(
+		ctx, &pb.FlushPaymentsRequest{
+			AccountName:      accountName,
+			MillisatsPerByte: millisatsPerByte,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetTxids(), nil
+}
+
+// Broadcast publishes the given transaction in hex format and returns its
+// hash. The idempotency key is optional.
+func (c *Client) Broadcast(
+	ctx context.Context, txHex, idempotencyKey string,
+) (string, error) {
+	reply, err := c.transaction.BroadcastTransaction(
+		ctx, &pb.BroadcastTransactionRequest{
+			TxHex:          txHex,
+			IdempotencyKey: idempotencyKey,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return reply.GetTxid(), nil
+}
+
+// BumpFee raises the fee rate of the given unconfirmed transaction, either by
+// replacing it or with a child spending its outputs owned by the wallet. It
+// returns the hash and hex of the broadcasted transaction along with the
+// method used.
+func (c *Client) BumpFee(
+	ctx context.Context, txid string, millisatsPerByte uint64,
+) (*pb.BumpFeeResponse, error) {
+	if len(txid) <= 0 {
+		return nil, fmt.Errorf("missing txid")
+	}
+	if millisatsPerByte == 0 {
+		return nil, fmt.Errorf("missing fee rate")
+	}
+
+	return c.transaction.BumpFee(ctx, &pb.BumpFeeRequest{
+		Txid:             txid,
+		MillisatsPerByte: millisatsPerByte,
+	})
+}
+
+// GetBroadcast returns the status of the given transaction broadcasted by the
+// wallet, which is tracked until confirmed and rebroadcasted if missing from
+// mempool.
+func (c *Client) GetBroadcast(
+	ctx context.Context, txid string,
+) (*pb.Broadcast, error) {
+	if len(txid) <= 0 {
+		return nil, fmt.Errorf("missing txid")
+	}
+
+	reply, err := c.transaction.GetBroadcast(
+		ctx, &pb.GetBroadcastRequest{Txid: txid},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetBroadcast(), nil
+}
+
+// ScheduleTransaction makes the daemon broadcast the given signed
+// transaction once its locktime and the given unix time, if not zero, are
+// reached. The wallet inputs of the transaction must be locked.
+func (c *Client) ScheduleTransaction(
+	ctx context.Context, txHex string, broadcastAt int64,
+) (*pb.ScheduledTransaction, error) {
+	if len(txHex) <= 0 {
+		return nil, fmt.Errorf("missing tx")
+	}
+	if broadcastAt < 0 {
+		return nil, fmt.Errorf("invalid broadcast time")
+	}
+
+	reply, err := c.transaction.ScheduleTransaction(
+		ctx, &pb.ScheduleTransactionRequest{
+			TxHex:       txHex,
+			BroadcastAt: broadcastAt,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetScheduledTransaction(), nil
+}
+
+// GetScheduledTransaction returns the given scheduled transaction.
+func (c *Client) GetScheduledTransaction(
+	ctx context.Context, txid string,
+) (*pb.ScheduledTransaction, error) {
+	if len(txid) <= 0 {
+		return nil, fmt.Errorf("missing txid")
+	}
+
+	reply, err := c.transaction.GetScheduledTransaction(
+		ctx, &pb.GetScheduledTransactionRequest{Txid: txid},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetScheduledTransaction(), nil
+}
+
+// ListScheduledTransactions returns all the scheduled transactions, whatever
+// their status.
+func (c *Client) ListScheduledTransactions(
+	ctx context.Context,
+) ([]*pb.ScheduledTransaction, error) {
+	reply, err := c.transaction.ListScheduledTransactions(
+		ctx, &pb.ListScheduledTransactionsRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetScheduledTransactions(), nil
+}
+
+// CancelScheduledTransaction prevents the given scheduled transaction from
+// being broadcasted and unlocks its wallet inputs.
+func (c *Client) CancelScheduledTransaction(
+	ctx context.Context, txid string,
+) (*pb.ScheduledTransaction, error) {
+	if len(txid) <= 0 {
+		return nil, fmt.Errorf("missing txid")
+	}
+
+	reply, err := c.transaction.CancelScheduledTransaction(
+		ctx, &pb.CancelScheduledTransactionRequest{Txid: txid},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetScheduledTransaction(), nil
+}
+
+// BlindAndSignPset blinds the given partial transaction as last blinder and
+// signs the inputs owned by the wallet.
+func (c *Client) BlindAndSignPset(ctx context.Context, ptx string) (string, error) {
+	blindReply, err := c.transaction.BlindPset(ctx, &pb.BlindPsetRequest{
+		Pset:        ptx,
+		LastBlinder: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to blind pset: %w", err)
+	}
+
+	signReply, err := c.transaction.SignPset(ctx, &pb.SignPsetRequest{
+		Pset: blindReply.GetPset(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign pset: %w", err)
+	}
+	return signReply.GetPset(), nil
+}
+
+// FinalizeAndBroadcastPset finalizes the given fully signed partial
+// transaction, extracts the final transaction and publishes it. It returns the
+// hash of the transaction. The idempotency key is optional.
+func (c *Client) FinalizeAndBroadcastPset(
+	ctx context.Context, ptx, idempotencyKey string,
+) (string, error) {
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionArgs{PsetBase64: ptx},
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to finalize pset: %w", err)
+	}
+	return c.Broadcast(ctx, txHex, idempotencyKey)
+}
@@ -12,12 +12,15 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
 	minPort = 1024
 	maxPort = 49151
+
+	metricsPath = "/metrics"
 )
 
 // Service opts holds configuration options for the profiler service.
@@ -57,7 +60,12 @@ func NewService(opts ServiceOpts) (*ProfilerService, error) {
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	server := &http.Server{Addr: opts.address()}
+	// Besides the pprof endpoints registered with the default mux, publish the
+	// metrics of the default Prometheus registry.
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.Handler())
+	mux.Handle("/", http.DefaultServeMux)
+	server := &http.Server{Addr: opts.address(), Handler: mux}
 	logFn := func(level log.Level, format string, a ...interface{}) {
 		format = fmt.Sprintf("profiler: %s", format)
 		var logFn func(format string, args ...interface{})
@@ -88,6 +96,11 @@ func (s *ProfilerService) Start() error {
 		log.InfoLevel,
 		"start at url http://localhost:%d/debug/pprof/", s.opts.Port,
 	)
+	s.log(
+		log.InfoLevel,
+		"metrics published at url http://localhost:%d%s",
+		s.opts.Port, metricsPath,
+	)
 	return nil
 }
 
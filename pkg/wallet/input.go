@@ -53,6 +53,8 @@ type Input struct {
 	RedeemScript    []byte
 	ScriptSigSize   int
 	WitnessSize     int
+	// Sequence is optional and defaults to 0xffffffff if not set.
+	Sequence uint32
 }
 
 func (i Input) Validate() error {
@@ -6,12 +6,15 @@ import (
 	"github.com/vulpemventures/go-elements/confidential"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 var (
 	ErrMissingPset       = fmt.Errorf("missing pset base64")
 	ErrMissingInputs     = fmt.Errorf("at least one input is mandatory to create a partial transaction with one or more confidential outputs")
 	ErrInvalidSignatures = fmt.Errorf("transaction contains invalid signature(s)")
+	ErrLocktimeDisabled  = fmt.Errorf("locktime is disabled if all inputs have final sequence")
+	ErrInvalidSequences  = fmt.Errorf("number of sequences must be either 1 or equal to the number of inputs")
 
 	DummyFeeAmount = uint64(700)
 )
@@ -19,6 +22,13 @@ var (
 type CreatePsetArgs struct {
 	Inputs  []Input
 	Outputs []Output
+	// Locktime is the optional nLockTime of the transaction, either a block
+	// height or a unix timestamp if greater than or equal to 500000000.
+	// Inputs without sequence are made non-final to enforce it.
+	Locktime uint32
+	// Sequences optionally override the sequence of the inputs, either one per
+	// input, or a single one for all of them.
+	Sequences []uint32
 }
 
 func (a CreatePsetArgs) validate() error {
@@ -27,6 +37,21 @@ func (a CreatePsetArgs) validate() error {
 			return fmt.Errorf("invalid input %d: %s", i, err)
 		}
 	}
+	if len(a.Sequences) > 1 && len(a.Sequences) != len(a.Inputs) {
+		return ErrInvalidSequences
+	}
+	if a.Locktime > 0 && len(a.Inputs) > 0 {
+		isLocktimeEnabled := false
+		for i := range a.Inputs {
+			if a.sequence(i) != transaction.DefaultSequence {
+				isLocktimeEnabled = true
+				break
+			}
+		}
+		if !isLocktimeEnabled {
+			return ErrLocktimeDisabled
+		}
+	}
 
 	for i, out := range a.Outputs {
 		if err := out.Validate(); err != nil {
@@ -42,15 +67,41 @@ func (a CreatePsetArgs) validate() error {
 
 func (a CreatePsetArgs) inputs() []psetv2.InputArgs {
 	ins := make([]psetv2.InputArgs, 0, len(a.Inputs))
-	for _, in := range a.Inputs {
+	for i, in := range a.Inputs {
 		ins = append(ins, psetv2.InputArgs{
-			Txid:    in.TxID,
-			TxIndex: in.TxIndex,
+			Txid:     in.TxID,
+			TxIndex:  in.TxIndex,
+			Sequence: a.sequence(i),
 		})
 	}
 	return ins
 }
 
+// sequence returns the sequence of the i-th input, made non-final if
+// unset and the locktime is set.
+func (a CreatePsetArgs) sequence(i int) uint32 {
+	sequence := a.Inputs[i].Sequence
+	switch len(a.Sequences) {
+	case 0:
+	case 1:
+		sequence = a.Sequences[0]
+	default:
+		sequence = a.Sequences[i]
+	}
+	if sequence == 0 && a.Locktime > 0 {
+		sequence = transaction.DefaultSequence - 1
+	}
+	return sequence
+}
+
+func (a CreatePsetArgs) locktime() *uint32 {
+	if a.Locktime == 0 {
+		return nil
+	}
+	locktime := a.Locktime
+	return &locktime
+}
+
 func (a CreatePsetArgs) outputs() []psetv2.OutputArgs {
 	outs := make([]psetv2.OutputArgs, 0, len(a.Outputs))
 	for _, out := range a.Outputs {
@@ -65,7 +116,7 @@ func CreatePset(args CreatePsetArgs) (string, error) {
 		return "", err
 	}
 
-	ptx, err := psetv2.New(args.inputs(), args.outputs(), nil)
+	ptx, err := psetv2.New(args.inputs(), args.outputs(), args.locktime())
 	if err != nil {
 		return "", err
 	}
@@ -130,8 +181,9 @@ func (a UpdatePsetArgs) inputs() []psetv2.InputArgs {
 	ins := make([]psetv2.InputArgs, 0, len(a.Inputs))
 	for _, in := range a.Inputs {
 		ins = append(ins, psetv2.InputArgs{
-			Txid:    in.TxID,
-			TxIndex: in.TxIndex,
+			Txid:     in.TxID,
+			TxIndex:  in.TxIndex,
+			Sequence: in.Sequence,
 		})
 	}
 	return ins
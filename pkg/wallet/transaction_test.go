@@ -38,6 +38,90 @@ func TestCreatePset(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, ptx)
 	})
+
+	t.Run("valid_with_locktime", func(t *testing.T) {
+		inputs := randomInputs(2)
+		inputs[1].Sequence = 0xfffffffd
+		outputs := randomOutputs(3)
+
+		psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:   inputs,
+			Outputs:  outputs,
+			Locktime: 1000,
+		})
+		require.NoError(t, err)
+
+		ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+		require.NoError(t, err)
+		require.Equal(t, uint32(1000), ptx.Locktime())
+		require.Equal(t, uint32(0xfffffffe), ptx.Inputs[0].Sequence)
+		require.Equal(t, uint32(0xfffffffd), ptx.Inputs[1].Sequence)
+	})
+
+	t.Run("valid_with_sequences", func(t *testing.T) {
+		inputs := randomInputs(2)
+		outputs := randomOutputs(3)
+
+		psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:    inputs,
+			Outputs:   outputs,
+			Locktime:  1000,
+			Sequences: []uint32{0xfffffffd, 0},
+		})
+		require.NoError(t, err)
+
+		ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0xfffffffd), ptx.Inputs[0].Sequence)
+		require.Equal(t, uint32(0xfffffffe), ptx.Inputs[1].Sequence)
+
+		psetBase64, err = wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:    inputs,
+			Outputs:   outputs,
+			Sequences: []uint32{0xfffffffd},
+		})
+		require.NoError(t, err)
+
+		ptx, err = psetv2.NewPsetFromBase64(psetBase64)
+		require.NoError(t, err)
+		for _, in := range ptx.Inputs {
+			require.Equal(t, uint32(0xfffffffd), in.Sequence)
+		}
+	})
+
+	t.Run("invalid_sequences", func(t *testing.T) {
+		psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:    randomInputs(3),
+			Outputs:   randomOutputs(3),
+			Sequences: []uint32{0xfffffffd, 0xfffffffd},
+		})
+		require.ErrorIs(t, err, wallet.ErrInvalidSequences)
+		require.Empty(t, psetBase64)
+	})
+
+	t.Run("invalid_locktime", func(t *testing.T) {
+		inputs := randomInputs(2)
+		for i := range inputs {
+			inputs[i].Sequence = 0xffffffff
+		}
+
+		psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:   inputs,
+			Outputs:  randomOutputs(3),
+			Locktime: 1000,
+		})
+		require.ErrorIs(t, err, wallet.ErrLocktimeDisabled)
+		require.Empty(t, psetBase64)
+
+		psetBase64, err = wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:    randomInputs(2),
+			Outputs:   randomOutputs(3),
+			Locktime:  1000,
+			Sequences: []uint32{0xffffffff},
+		})
+		require.ErrorIs(t, err, wallet.ErrLocktimeDisabled)
+		require.Empty(t, psetBase64)
+	})
 }
 
 func randomInputs(num int) []wallet.Input {